@@ -44,9 +44,10 @@ resource "grafbase_graph" "feature_branch" {
 
 # Development branches
 resource "grafbase_branch" "dev_main" {
-  account_slug = grafbase_graph.local_dev.account_slug
-  graph_slug   = grafbase_graph.local_dev.slug
-  name         = "main"
+  account_slug     = grafbase_graph.local_dev.account_slug
+  graph_slug       = grafbase_graph.local_dev.slug
+  name             = "main"
+  allow_production = true
 }
 
 resource "grafbase_branch" "dev_feature" {
@@ -33,9 +33,10 @@ resource "grafbase_graph" "example" {
 
 # Create branches for the graph
 resource "grafbase_branch" "main" {
-  account_slug = grafbase_graph.example.account_slug
-  graph_slug   = grafbase_graph.example.slug
-  name         = "main"
+  account_slug     = grafbase_graph.example.account_slug
+  graph_slug       = grafbase_graph.example.slug
+  name             = "main"
+  allow_production = true
 }
 
 resource "grafbase_branch" "staging" {
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+	"time"
+)
+
+// AccessToken represents a short-lived access token of an account
+type AccessToken struct {
+	ID        string    `json:"id"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// CreateAccessTokenInput represents the input for minting a short-lived access
+// token. Empty Scopes grant every scope allowed by the account token policy.
+type CreateAccessTokenInput struct {
+	AccountSlug string   `json:"accountSlug"`
+	Scopes      []string `json:"scopes"`
+	TTLSeconds  int64    `json:"ttlSeconds"`
+}
+
+// CreateAccessToken mints a short-lived access token
+func (c *Client) CreateAccessToken(ctx context.Context, input CreateAccessTokenInput) (*AccessToken, error) {
+	query := `
+		mutation CreateAccessToken($input: AccessTokenCreateInput!) {
+			accessTokenCreate(input: $input) {
+				__typename
+				... on AccessTokenCreateSuccess {
+					accessToken {
+						id
+						token
+						expiresAt
+					}
+				}
+				... on TokenPolicyViolationError {
+					message
+				}
+			}
+		}
+	`
+
+	if input.Scopes == nil {
+		input.Scopes = []string{}
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*AccessToken](ctx, c, operation{
+		action:  "create access token",
+		query:   query,
+		path:    []string{"accessTokenCreate"},
+		success: "AccessTokenCreateSuccess",
+		field:   "accessToken",
+		errors: map[string]string{
+			"TokenPolicyViolationError": "access token violates the account token policy: ${message}",
+		},
+	}, variables)
+}
+
+// RevokeAccessToken revokes a short-lived access token before it expires
+func (c *Client) RevokeAccessToken(ctx context.Context, id string) error {
+	query := `
+		mutation RevokeAccessToken($input: AccessTokenRevokeInput!) {
+			accessTokenRevoke(input: $input) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"id": id,
+		},
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "revoke access token",
+		query:   query,
+		path:    []string{"accessTokenRevoke"},
+		success: "AccessTokenRevokeSuccess",
+		errors: map[string]string{
+			"AccessTokenDoesNotExistError": "access token does not exist",
+		},
+	}, variables)
+
+	return err
+}
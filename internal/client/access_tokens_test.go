@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCreateAccessToken(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := CreateAccessTokenInput{AccountSlug: "my-account", TTLSeconds: 3600}
+
+	server.Handle("CreateAccessToken", `{"accessTokenCreate": {"__typename": "AccessTokenCreateSuccess", "accessToken": {
+		"id": "token-1", "token": "gbt_secret", "expiresAt": "2024-01-15T11:30:00Z"
+	}}}`)
+	token, err := c.CreateAccessToken(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token.ID != "token-1" || token.Token != "gbt_secret" || token.ExpiresAt.IsZero() {
+		t.Errorf("unexpected token: %+v", token)
+	}
+
+	var sent CreateAccessTokenInput
+	if err := server.LastRequest("CreateAccessToken").Input(&sent); err != nil {
+		t.Fatalf("unable to decode input: %s", err)
+	}
+	if sent.TTLSeconds != 3600 || sent.Scopes == nil {
+		t.Errorf("unexpected input: %+v", sent)
+	}
+
+	server.Handle("CreateAccessToken", `{"accessTokenCreate": {"__typename": "TokenPolicyViolationError", "message": "scope admin is not allowed"}}`)
+	if _, err := c.CreateAccessToken(ctx, input); err == nil || errorMessage(err) != "access token violates the account token policy: scope admin is not allowed" {
+		t.Errorf("expected token policy violation, got %v", err)
+	}
+}
+
+func TestRevokeAccessToken(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("RevokeAccessToken", `{"accessTokenRevoke": {"__typename": "AccessTokenRevokeSuccess"}}`)
+	if err := c.RevokeAccessToken(ctx, "token-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("RevokeAccessToken", `{"accessTokenRevoke": {"__typename": "AccessTokenDoesNotExistError"}}`)
+	if err := c.RevokeAccessToken(ctx, "token-1"); err == nil || errorMessage(err) != "access token does not exist" {
+		t.Errorf("expected access token does not exist, got %v", err)
+	}
+}
@@ -0,0 +1,229 @@
+package client
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate go run ./clientmock/gen.go
+
+// API is the set of Grafbase operations used by the provider. Resources and
+// data sources depend on it rather than on *Client, so their logic can be
+// tested against clientmock.API without an HTTP server.
+type API interface {
+	// Access tokens
+	CreateAccessToken(ctx context.Context, input CreateAccessTokenInput) (*AccessToken, error)
+	RevokeAccessToken(ctx context.Context, id string) error
+
+	// API keys
+	CreateAPIKey(ctx context.Context, input CreateAPIKeyInput) (*APIKey, string, error)
+	GetAPIKey(ctx context.Context, id string) (*APIKey, error)
+	RevokeAPIKey(ctx context.Context, id string) error
+
+	// Audit logs
+	ListAuditLogs(ctx context.Context, accountSlug string, filter AuditLogFilter) ([]AuditLogEntry, error)
+
+	// Auto-branching rules
+	CreateAutoBranchingRule(ctx context.Context, input CreateAutoBranchingRuleInput) (*AutoBranchingRule, error)
+	UpdateAutoBranchingRule(ctx context.Context, input UpdateAutoBranchingRuleInput) (*AutoBranchingRule, error)
+	GetAutoBranchingRule(ctx context.Context, id string) (*AutoBranchingRule, error)
+	DeleteAutoBranchingRule(ctx context.Context, id string) error
+
+	// Billing
+	GetInvoiceUsage(ctx context.Context, accountSlug string) (*InvoiceUsage, error)
+
+	// Branch aliases
+	SetBranchAlias(ctx context.Context, input SetBranchAliasInput) (*BranchAlias, error)
+	GetBranchAlias(ctx context.Context, accountSlug, graphSlug, name string) (*BranchAlias, error)
+	DeleteBranchAlias(ctx context.Context, input DeleteBranchAliasInput) error
+
+	// Branch protection
+	GetBranchProtection(ctx context.Context, accountSlug, graphSlug, branchName string) (*BranchProtection, error)
+	SetBranchProtection(ctx context.Context, input SetBranchProtectionInput) (*BranchProtection, error)
+	DeleteBranchProtection(ctx context.Context, input DeleteBranchProtectionInput) error
+
+	// API budgets
+	SetAPIBudget(ctx context.Context, input SetAPIBudgetInput) (*APIBudget, error)
+	GetAPIBudget(ctx context.Context, accountSlug, graphSlug string) (*APIBudget, error)
+	DeleteAPIBudget(ctx context.Context, input DeleteAPIBudgetInput) error
+
+	// Accounts, graphs, and branches
+	GetAccountBySlug(ctx context.Context, slug string) (*Account, error)
+	CreateGraph(ctx context.Context, input CreateGraphInput) (*Graph, error)
+	UpdateGraph(ctx context.Context, input UpdateGraphInput) (*Graph, error)
+	TransferGraph(ctx context.Context, input TransferGraphInput) (*Graph, error)
+	GetGraph(ctx context.Context, accountSlug, graphSlug string) (*Graph, error)
+	GetGraphByID(ctx context.Context, id string) (*Graph, error)
+	DeleteGraph(ctx context.Context, id string) error
+	CreateBranch(ctx context.Context, input CreateBranchInput) (*Branch, error)
+	GetBranch(ctx context.Context, accountSlug, graphSlug, branchName string) (*Branch, error)
+	GetBranchByID(ctx context.Context, id string) (*Branch, error)
+	PromoteBranch(ctx context.Context, input PromoteBranchInput) (*Branch, error)
+	UpdateBranchRegions(ctx context.Context, input UpdateBranchRegionsInput) (*Branch, error)
+	DeleteBranch(ctx context.Context, input DeleteBranchInput) error
+
+	// Compositions
+	GetLatestBranchComposition(ctx context.Context, accountSlug, graphSlug, branchName string) (*Composition, error)
+
+	// Default branch settings
+	GetDefaultBranchSettings(ctx context.Context, accountSlug, graphSlug string) (*DefaultBranchSettings, error)
+	SetDefaultBranchSettings(ctx context.Context, input SetDefaultBranchSettingsInput) (*DefaultBranchSettings, error)
+
+	// Deployments
+	GetLatestBranchDeployment(ctx context.Context, accountSlug, graphSlug, branchName string) (*Deployment, error)
+
+	// Custom domains
+	CreateCustomDomain(ctx context.Context, input CreateCustomDomainInput) (*CustomDomain, error)
+	GetCustomDomain(ctx context.Context, id string) (*CustomDomain, error)
+	DeleteCustomDomain(ctx context.Context, id string) error
+
+	// Environment variables
+	GetBranchEnvironmentVariables(ctx context.Context, accountSlug, graphSlug, branchName string) ([]EnvironmentVariable, error)
+	UpsertBranchEnvironmentVariables(ctx context.Context, input UpsertBranchEnvironmentVariablesInput) error
+	DeleteBranchEnvironmentVariables(ctx context.Context, input DeleteBranchEnvironmentVariablesInput) error
+
+	// Feature flags
+	GetBranchFeatureFlags(ctx context.Context, accountSlug, graphSlug, branchName string) ([]FeatureFlag, error)
+	SetBranchFeatureFlags(ctx context.Context, input SetBranchFeatureFlagsInput) ([]FeatureFlag, error)
+
+	// Gateway configuration
+	SetGatewayConfig(ctx context.Context, input SetGatewayConfigInput) (*GatewayConfig, error)
+	GetGatewayConfig(ctx context.Context, accountSlug, graphSlug, branchName string) (*GatewayConfig, error)
+	DeleteGatewayConfig(ctx context.Context, input DeleteGatewayConfigInput) error
+	ListGatewayVersions(ctx context.Context) ([]GatewayVersion, error)
+
+	// Graph collaborators
+	AddGraphCollaborator(ctx context.Context, input AddGraphCollaboratorInput) (*GraphCollaborator, error)
+	GetGraphCollaborator(ctx context.Context, id string) (*GraphCollaborator, error)
+	UpdateGraphCollaboratorRole(ctx context.Context, input UpdateGraphCollaboratorRoleInput) (*GraphCollaborator, error)
+	RemoveGraphCollaborator(ctx context.Context, id string) error
+
+	// Graph keys
+	CreateGraphKey(ctx context.Context, input CreateGraphKeyInput) (*GraphKey, string, error)
+	GetGraphKey(ctx context.Context, id string) (*GraphKey, error)
+	RevokeGraphKey(ctx context.Context, id string) error
+
+	// Graph settings
+	GetGraphSettings(ctx context.Context, accountSlug, graphSlug string) (*GraphSettings, error)
+	SetGraphSettings(ctx context.Context, input SetGraphSettingsInput) (*GraphSettings, error)
+
+	// Graph usage
+	GetGraphUsage(ctx context.Context, accountSlug, graphSlug string, from, to time.Time) (*GraphUsage, error)
+
+	// MCP endpoints
+	GetMCPEndpoint(ctx context.Context, accountSlug, graphSlug, branchName string) (*MCPEndpoint, error)
+	SetMCPEndpoint(ctx context.Context, input SetMCPEndpointInput) (*MCPEndpoint, error)
+
+	// Account members
+	InviteAccountMember(ctx context.Context, input InviteAccountMemberInput) (*AccountMember, error)
+	ListAccountMembers(ctx context.Context, accountSlug string) ([]AccountMember, error)
+	GetAccountMember(ctx context.Context, accountSlug, email string) (*AccountMember, error)
+	UpdateAccountMemberRole(ctx context.Context, input UpdateAccountMemberRoleInput) (*AccountMember, error)
+	RemoveAccountMember(ctx context.Context, input RemoveAccountMemberInput) error
+
+	// Notification channels
+	CreateNotificationChannel(ctx context.Context, input CreateNotificationChannelInput) (*NotificationChannel, error)
+	UpdateNotificationChannel(ctx context.Context, input UpdateNotificationChannelInput) (*NotificationChannel, error)
+	GetNotificationChannel(ctx context.Context, id string) (*NotificationChannel, error)
+	DeleteNotificationChannel(ctx context.Context, id string) error
+
+	// Operation checks
+	GetOperationChecksConfig(ctx context.Context, accountSlug, graphSlug, branchName string) (*OperationChecksConfig, error)
+	SetOperationChecksConfig(ctx context.Context, input SetOperationChecksConfigInput) (*OperationChecksConfig, error)
+	CreateOperationCheckException(ctx context.Context, input CreateOperationCheckExceptionInput) (*OperationCheckException, error)
+	UpdateOperationCheckException(ctx context.Context, input UpdateOperationCheckExceptionInput) (*OperationCheckException, error)
+	GetOperationCheckException(ctx context.Context, id string) (*OperationCheckException, error)
+	DeleteOperationCheckException(ctx context.Context, id string) error
+
+	// Listings
+	ListGraphs(ctx context.Context, accountSlug string) ([]Graph, error)
+	SearchGraphs(ctx context.Context, accountSlug string, filter GraphFilter) ([]Graph, error)
+	ListBranches(ctx context.Context, accountSlug, graphSlug string) ([]Branch, error)
+	ListSubgraphs(ctx context.Context, accountSlug, graphSlug, branchName string) ([]Subgraph, error)
+
+	// Regions
+	ListRegions(ctx context.Context) ([]Region, error)
+
+	// Request logging rules
+	CreateRequestLoggingRule(ctx context.Context, input CreateRequestLoggingRuleInput) (*RequestLoggingRule, error)
+	UpdateRequestLoggingRule(ctx context.Context, input UpdateRequestLoggingRuleInput) (*RequestLoggingRule, error)
+	GetRequestLoggingRule(ctx context.Context, id string) (*RequestLoggingRule, error)
+	DeleteRequestLoggingRule(ctx context.Context, id string) error
+
+	// Schemas and subgraphs
+	GetFederatedSchema(ctx context.Context, accountSlug, graphSlug, branchName string) (string, error)
+	GetSubgraphSchema(ctx context.Context, accountSlug, graphSlug, branchName, subgraphName string) (*PublishedSubgraph, error)
+	DiffSubgraphSchema(ctx context.Context, accountSlug, graphSlug, branchName, subgraphName, schema string) ([]SchemaChange, error)
+	DeleteSubgraph(ctx context.Context, input DeleteSubgraphInput) error
+	PublishSubgraph(ctx context.Context, input PublishSubgraphInput) (*SchemaVersion, error)
+
+	// Schema checks
+	CreateSchemaCheck(ctx context.Context, input SchemaCheckInput) (*SchemaCheck, error)
+	GetLatestOperationCheckResult(ctx context.Context, accountSlug, graphSlug, branchName, subgraphName string) (*OperationCheckResult, error)
+
+	// Schema contracts
+	SetSchemaContract(ctx context.Context, input SetSchemaContractInput) (*SchemaContract, error)
+	GetSchemaContract(ctx context.Context, accountSlug, graphSlug, branchName, name string) (*SchemaContract, error)
+	DeleteSchemaContract(ctx context.Context, input DeleteSchemaContractInput) error
+
+	// Schema proposals
+	CreateSchemaProposal(ctx context.Context, input CreateSchemaProposalInput) (*SchemaProposal, error)
+	GetSchemaProposal(ctx context.Context, id string) (*SchemaProposal, error)
+	UpdateSchemaProposal(ctx context.Context, input UpdateSchemaProposalInput) (*SchemaProposal, error)
+	CloseSchemaProposal(ctx context.Context, id string) error
+
+	// Schema registry mirrors
+	CreateSchemaRegistryMirror(ctx context.Context, input CreateSchemaRegistryMirrorInput) (*SchemaRegistryMirror, error)
+	UpdateSchemaRegistryMirror(ctx context.Context, input UpdateSchemaRegistryMirrorInput) (*SchemaRegistryMirror, error)
+	GetSchemaRegistryMirror(ctx context.Context, id string) (*SchemaRegistryMirror, error)
+	DeleteSchemaRegistryMirror(ctx context.Context, id string) error
+
+	// Schema tags
+	SetSchemaTag(ctx context.Context, input SetSchemaTagInput) (*SchemaTag, error)
+	GetSchemaTag(ctx context.Context, accountSlug, graphSlug, name string) (*SchemaTag, error)
+	DeleteSchemaTag(ctx context.Context, input DeleteSchemaTagInput) error
+
+	// Subgraph headers
+	SetSubgraphHeaders(ctx context.Context, input SetSubgraphHeadersInput) (*SubgraphHeaders, error)
+	GetSubgraphHeaders(ctx context.Context, accountSlug, graphSlug, branchName, subgraphName string) (*SubgraphHeaders, error)
+	DeleteSubgraphHeaders(ctx context.Context, input DeleteSubgraphHeadersInput) error
+
+	// Subgraph routing overrides
+	SetSubgraphRoutingOverride(ctx context.Context, input SetSubgraphRoutingOverrideInput) (*SubgraphRoutingOverride, error)
+	GetSubgraphRoutingOverride(ctx context.Context, accountSlug, graphSlug, branchName, subgraphName string) (*SubgraphRoutingOverride, error)
+	DeleteSubgraphRoutingOverride(ctx context.Context, input DeleteSubgraphRoutingOverrideInput) error
+	ListSubgraphRoutingOverrides(ctx context.Context, accountSlug, graphSlug, branchName string) ([]SubgraphRoutingOverride, error)
+	LookupSubgraphRoutingOverride(ctx context.Context, accountSlug, graphSlug, branchName, subgraphName string) (*SubgraphRoutingOverride, error)
+
+	// Teams
+	CreateTeam(ctx context.Context, input CreateTeamInput) (*Team, error)
+	UpdateTeam(ctx context.Context, input UpdateTeamInput) (*Team, error)
+	GetTeam(ctx context.Context, accountSlug, teamSlug string) (*Team, error)
+	DeleteTeam(ctx context.Context, id string) error
+	AddTeamMember(ctx context.Context, input AddTeamMemberInput) (*TeamMember, error)
+	ListTeamMembers(ctx context.Context, accountSlug, teamSlug string) ([]TeamMember, error)
+	GetTeamMember(ctx context.Context, accountSlug, teamSlug, email string) (*TeamMember, error)
+	UpdateTeamMemberRole(ctx context.Context, input UpdateTeamMemberRoleInput) (*TeamMember, error)
+	RemoveTeamMember(ctx context.Context, id string) error
+
+	// Token policies
+	GetTokenPolicy(ctx context.Context, accountSlug string) (*TokenPolicy, error)
+	SetTokenPolicy(ctx context.Context, input SetTokenPolicyInput) (*TokenPolicy, error)
+
+	// Trusted documents
+	ListTrustedDocuments(ctx context.Context, accountSlug, graphSlug, branchName, clientName string) ([]TrustedDocument, error)
+	UploadTrustedDocuments(ctx context.Context, input UploadTrustedDocumentsInput) error
+	DeleteTrustedDocuments(ctx context.Context, input DeleteTrustedDocumentsInput) error
+
+	// Viewer
+	GetViewer(ctx context.Context) (*Viewer, error)
+
+	// Webhooks
+	CreateWebhook(ctx context.Context, input CreateWebhookInput) (*Webhook, string, error)
+	UpdateWebhook(ctx context.Context, input UpdateWebhookInput) (*Webhook, error)
+	GetWebhook(ctx context.Context, id string) (*Webhook, error)
+	DeleteWebhook(ctx context.Context, id string) error
+}
+
+// Ensure the client satisfies the interface resources depend on.
+var _ API = (*Client)(nil)
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// APIKey represents an access token of an account. The token secret is only
+// returned when the key is created.
+type APIKey struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+}
+
+// CreateAPIKeyInput represents the input for creating an access token.
+// Empty Scopes grant every scope allowed by the account token policy.
+type CreateAPIKeyInput struct {
+	AccountSlug   string   `json:"accountSlug"`
+	Name          string   `json:"name"`
+	Scopes        []string `json:"scopes"`
+	ExpiresInDays *int64   `json:"expiresInDays"`
+}
+
+// CreateAPIKey creates an access token and returns it together with its secret
+func (c *Client) CreateAPIKey(ctx context.Context, input CreateAPIKeyInput) (*APIKey, string, error) {
+	query := `
+		mutation CreateAPIKey($input: ApiKeyCreateInput!) {
+			apiKeyCreate(input: $input) {
+				__typename
+				... on ApiKeyCreateSuccess {
+					apiKey {
+						id
+						name
+						scopes
+						createdAt
+						expiresAt
+						lastUsedAt
+					}
+					token
+				}
+				... on TokenPolicyViolationError {
+					message
+				}
+			}
+		}
+	`
+
+	if input.Scopes == nil {
+		input.Scopes = []string{}
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	created, err := execute[struct {
+		APIKey APIKey `json:"apiKey"`
+		Token  string `json:"token"`
+	}](ctx, c, operation{
+		action:  "create API key",
+		query:   query,
+		path:    []string{"apiKeyCreate"},
+		success: "ApiKeyCreateSuccess",
+		errors: map[string]string{
+			"TokenPolicyViolationError": "API key violates the account token policy: ${message}",
+		},
+	}, variables)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &created.APIKey, created.Token, nil
+}
+
+// GetAPIKey retrieves an access token by ID, without its secret
+func (c *Client) GetAPIKey(ctx context.Context, id string) (*APIKey, error) {
+	query := `
+		query GetAPIKey($id: ID!) {
+			node(id: $id) {
+				... on ApiKey {
+					id
+					name
+					scopes
+					createdAt
+					expiresAt
+					lastUsedAt
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	key, err := execute[*APIKey](ctx, c, operation{
+		action: "get API key",
+		query:  query,
+		path:   []string{"node"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if key == nil || key.ID == "" {
+		return nil, fmt.Errorf("API key not found")
+	}
+
+	return key, nil
+}
+
+// RevokeAPIKey revokes an access token, rejecting further requests made with it
+func (c *Client) RevokeAPIKey(ctx context.Context, id string) error {
+	query := `
+		mutation RevokeAPIKey($input: ApiKeyRevokeInput!) {
+			apiKeyRevoke(input: $input) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"id": id,
+		},
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "revoke API key",
+		query:   query,
+		path:    []string{"apiKeyRevoke"},
+		success: "ApiKeyRevokeSuccess",
+		errors: map[string]string{
+			"ApiKeyDoesNotExistError": "API key does not exist",
+		},
+	}, variables)
+
+	return err
+}
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := CreateAPIKeyInput{AccountSlug: "my-account", Name: "ci"}
+
+	server.Handle("CreateAPIKey", `{"apiKeyCreate": {"__typename": "ApiKeyCreateSuccess", "apiKey": {
+		"id": "key-1", "name": "ci", "scopes": [], "createdAt": "2024-01-15T10:30:00Z", "expiresAt": null, "lastUsedAt": null
+	}, "token": "gb_secret"}}`)
+	key, token, err := c.CreateAPIKey(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if key.ID != "key-1" || token != "gb_secret" || key.LastUsedAt != nil {
+		t.Errorf("unexpected API key: %+v, token %q", key, token)
+	}
+
+	// Missing scopes are sent as an empty list, granting every allowed scope
+	sent := server.LastRequest("CreateAPIKey").Variables["input"].(map[string]interface{})
+	if scopes, ok := sent["scopes"].([]interface{}); !ok || len(scopes) != 0 {
+		t.Errorf("expected empty scopes, got %v", sent["scopes"])
+	}
+
+	server.Handle("CreateAPIKey", `{"apiKeyCreate": {"__typename": "TokenPolicyViolationError", "message": "lifetime exceeds 90 days"}}`)
+	if _, _, err := c.CreateAPIKey(ctx, input); err == nil || errorMessage(err) != "API key violates the account token policy: lifetime exceeds 90 days" {
+		t.Errorf("expected token policy error, got %v", err)
+	}
+}
+
+func TestGetAPIKey(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetAPIKey", `{"node": {
+		"id": "key-1", "name": "ci", "scopes": ["graph:read"], "createdAt": "2024-01-15T10:30:00Z",
+		"expiresAt": "2024-04-14T10:30:00Z", "lastUsedAt": "2024-02-01T08:00:00Z"
+	}}`)
+	key, err := c.GetAPIKey(ctx, "key-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if key.ExpiresAt == nil || key.LastUsedAt == nil || len(key.Scopes) != 1 {
+		t.Errorf("unexpected API key: %+v", key)
+	}
+
+	server.Handle("GetAPIKey", `{"node": null}`)
+	if _, err := c.GetAPIKey(ctx, "missing"); err == nil || errorMessage(err) != "API key not found" {
+		t.Errorf("expected API key not found, got %v", err)
+	}
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("RevokeAPIKey", `{"apiKeyRevoke": {"__typename": "ApiKeyRevokeSuccess"}}`)
+	if err := c.RevokeAPIKey(ctx, "key-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("RevokeAPIKey", `{"apiKeyRevoke": {"__typename": "ApiKeyDoesNotExistError"}}`)
+	if err := c.RevokeAPIKey(ctx, "key-1"); err == nil || errorMessage(err) != "API key does not exist" {
+		t.Errorf("expected API key does not exist, got %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// AuditLogActor represents who performed an audited action. Email is nil when
+// the action was performed with an access token rather than by a user.
+type AuditLogActor struct {
+	ID    string  `json:"id"`
+	Email *string `json:"email"`
+}
+
+// AuditLogEntry represents a single audited action on an account
+type AuditLogEntry struct {
+	ID           string        `json:"id"`
+	Action       string        `json:"action"`
+	Actor        AuditLogActor `json:"actor"`
+	ResourceType string        `json:"resourceType"`
+	ResourceID   string        `json:"resourceId"`
+	ResourceName *string       `json:"resourceName"`
+	CreatedAt    time.Time     `json:"createdAt"`
+}
+
+// AuditLogFilter narrows the audit log entries returned by ListAuditLogs.
+// Nil times leave that side of the range open, and an empty Actions list
+// matches every action.
+type AuditLogFilter struct {
+	Since   *time.Time `json:"since,omitempty"`
+	Until   *time.Time `json:"until,omitempty"`
+	Actions []string   `json:"actions,omitempty"`
+}
+
+// ListAuditLogs retrieves every audit log entry of an account matching the
+// filter, newest first
+func (c *Client) ListAuditLogs(ctx context.Context, accountSlug string, filter AuditLogFilter) ([]AuditLogEntry, error) {
+	query := `
+		query ListAuditLogs($accountSlug: String!, $filter: AuditLogFilter!, $first: Int!, $after: String) {
+			accountBySlug(slug: $accountSlug) {
+				auditLogs(filter: $filter, first: $first, after: $after) {
+					edges {
+						node {
+							id
+							action
+							actor {
+								id
+								email
+							}
+							resourceType
+							resourceId
+							resourceName
+							createdAt
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"filter":      filter,
+	}
+
+	nodes, err := c.PaginateAll(ctx, query, variables, []string{"accountBySlug", "auditLogs"}, defaultPageSize)
+	if errors.Is(err, errConnectionNotFound) {
+		return nil, fmt.Errorf("account not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit logs: %w", err)
+	}
+
+	return decodeNodes[AuditLogEntry](nodes)
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/mockgraphql"
+)
+
+func TestListAuditLogs(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	pages := map[string]string{
+		"": `{"accountBySlug": {"auditLogs": {
+			"edges": [{"node": {"id": "log-2", "action": "BRANCH_DELETED", "actor": {"id": "user-1", "email": "jane@example.com"},
+				"resourceType": "BRANCH", "resourceId": "branch-1", "resourceName": "feature", "createdAt": "2024-03-02T10:00:00Z"}}],
+			"pageInfo": {"hasNextPage": true, "endCursor": "c1"}
+		}}}`,
+		"c1": `{"accountBySlug": {"auditLogs": {
+			"edges": [{"node": {"id": "log-1", "action": "GRAPH_CREATED", "actor": {"id": "token-1", "email": null},
+				"resourceType": "GRAPH", "resourceId": "graph-1", "resourceName": null, "createdAt": "2024-03-01T10:00:00Z"}}],
+			"pageInfo": {"hasNextPage": false, "endCursor": "c2"}
+		}}}`,
+	}
+	server.HandleFunc("ListAuditLogs", func(req mockgraphql.Request) mockgraphql.Response {
+		after, _ := req.Variables["after"].(string)
+		return mockgraphql.Response{Data: pages[after]}
+	})
+
+	since := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	entries, err := c.ListAuditLogs(ctx, "my-account", AuditLogFilter{Since: &since, Actions: []string{"GRAPH_CREATED", "BRANCH_DELETED"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 2 || entries[0].ID != "log-2" || entries[1].Actor.Email != nil {
+		t.Errorf("unexpected entries: %+v", entries)
+	}
+
+	filter, _ := server.LastRequest("ListAuditLogs").Variables["filter"].(map[string]interface{})
+	if filter["since"] != "2024-03-01T00:00:00Z" || len(filter["actions"].([]interface{})) != 2 {
+		t.Errorf("unexpected filter: %v", filter)
+	}
+	if _, ok := filter["until"]; ok {
+		t.Errorf("expected open ended range, got %v", filter)
+	}
+
+	server.Handle("ListAuditLogs", `{"accountBySlug": null}`)
+	if _, err := c.ListAuditLogs(ctx, "missing", AuditLogFilter{}); err == nil || errorMessage(err) != "account not found" {
+		t.Errorf("expected account not found, got %v", err)
+	}
+}
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// AutoBranchingRule represents a rule creating preview branches of a graph
+// for git branches whose name matches Pattern
+type AutoBranchingRule struct {
+	ID            string `json:"id"`
+	Pattern       string `json:"pattern"`
+	TTLHours      *int64 `json:"ttlHours"`
+	DeleteOnMerge bool   `json:"deleteOnMerge"`
+}
+
+// CreateAutoBranchingRuleInput represents the input for creating an auto-branching rule
+type CreateAutoBranchingRuleInput struct {
+	AccountSlug   string `json:"accountSlug"`
+	GraphSlug     string `json:"graphSlug"`
+	Pattern       string `json:"pattern"`
+	TTLHours      *int64 `json:"ttlHours"`
+	DeleteOnMerge bool   `json:"deleteOnMerge"`
+}
+
+// UpdateAutoBranchingRuleInput represents the input for replacing the settings of an auto-branching rule
+type UpdateAutoBranchingRuleInput struct {
+	ID            string `json:"id"`
+	Pattern       string `json:"pattern"`
+	TTLHours      *int64 `json:"ttlHours"`
+	DeleteOnMerge bool   `json:"deleteOnMerge"`
+}
+
+// autoBranchingRuleFields is the selection set shared by auto-branching rule queries
+const autoBranchingRuleFields = `
+	id
+	pattern
+	ttlHours
+	deleteOnMerge
+`
+
+// CreateAutoBranchingRule creates an auto-branching rule on a graph
+func (c *Client) CreateAutoBranchingRule(ctx context.Context, input CreateAutoBranchingRuleInput) (*AutoBranchingRule, error) {
+	query := `
+		mutation CreateAutoBranchingRule($input: AutoBranchingRuleCreateInput!) {
+			autoBranchingRuleCreate(input: $input) {
+				__typename
+				... on AutoBranchingRuleCreateSuccess {
+					autoBranchingRule {` + autoBranchingRuleFields + `}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*AutoBranchingRule](ctx, c, operation{
+		action:  "create auto-branching rule",
+		query:   query,
+		path:    []string{"autoBranchingRuleCreate"},
+		success: "AutoBranchingRuleCreateSuccess",
+		field:   "autoBranchingRule",
+		errors:  autoBranchingRuleErrors,
+	}, variables)
+}
+
+// UpdateAutoBranchingRule replaces the settings of an auto-branching rule
+func (c *Client) UpdateAutoBranchingRule(ctx context.Context, input UpdateAutoBranchingRuleInput) (*AutoBranchingRule, error) {
+	query := `
+		mutation UpdateAutoBranchingRule($input: AutoBranchingRuleUpdateInput!) {
+			autoBranchingRuleUpdate(input: $input) {
+				__typename
+				... on AutoBranchingRuleUpdateSuccess {
+					autoBranchingRule {` + autoBranchingRuleFields + `}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*AutoBranchingRule](ctx, c, operation{
+		action:  "update auto-branching rule",
+		query:   query,
+		path:    []string{"autoBranchingRuleUpdate"},
+		success: "AutoBranchingRuleUpdateSuccess",
+		field:   "autoBranchingRule",
+		errors:  autoBranchingRuleErrors,
+	}, variables)
+}
+
+// autoBranchingRuleErrors are the errors of the auto-branching rule create
+// and update mutations
+var autoBranchingRuleErrors = map[string]string{
+	"AutoBranchingRuleDoesNotExistError":  "auto-branching rule does not exist",
+	"AutoBranchingRuleAlreadyExistsError": "an auto-branching rule with pattern ${pattern} already exists",
+	"AutoBranchingPatternInvalidError":    "auto-branching pattern ${pattern} is invalid",
+	"GraphNotConnectedToRepositoryError":  "graph is not connected to a git repository",
+	"InvalidBranchTTLError":               "branch TTL exceeds the maximum allowed by the account plan",
+}
+
+// GetAutoBranchingRule retrieves an auto-branching rule by ID using the node query
+func (c *Client) GetAutoBranchingRule(ctx context.Context, id string) (*AutoBranchingRule, error) {
+	query := `
+		query GetAutoBranchingRule($id: ID!) {
+			node(id: $id) {
+				... on AutoBranchingRule {` + autoBranchingRuleFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	rule, err := execute[*AutoBranchingRule](ctx, c, operation{
+		action: "get auto-branching rule",
+		query:  query,
+		path:   []string{"node"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if rule == nil || rule.ID == "" {
+		return nil, fmt.Errorf("auto-branching rule not found")
+	}
+
+	return rule, nil
+}
+
+// DeleteAutoBranchingRule deletes an auto-branching rule. Preview branches
+// already created by the rule are kept.
+func (c *Client) DeleteAutoBranchingRule(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteAutoBranchingRule($id: ID!) {
+			autoBranchingRuleDelete(id: $id) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "delete auto-branching rule",
+		query:   query,
+		path:    []string{"autoBranchingRuleDelete"},
+		success: "AutoBranchingRuleDeleteSuccess",
+		errors: map[string]string{
+			"AutoBranchingRuleDoesNotExistError": "auto-branching rule does not exist",
+		},
+	}, variables)
+
+	return err
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+const testAutoBranchingRuleJSON = `{
+	"id": "rule-1",
+	"pattern": "feature/*",
+	"ttlHours": 72,
+	"deleteOnMerge": true
+}`
+
+func TestCreateAutoBranchingRule(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := CreateAutoBranchingRuleInput{
+		AccountSlug:   "my-account",
+		GraphSlug:     "my-graph",
+		Pattern:       "feature/*",
+		DeleteOnMerge: true,
+	}
+
+	server.Handle("CreateAutoBranchingRule", `{"autoBranchingRuleCreate": {"__typename": "AutoBranchingRuleCreateSuccess", "autoBranchingRule": `+testAutoBranchingRuleJSON+`}}`)
+	rule, err := c.CreateAutoBranchingRule(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rule.ID != "rule-1" || rule.TTLHours == nil || *rule.TTLHours != 72 || !rule.DeleteOnMerge {
+		t.Errorf("unexpected rule: %+v", rule)
+	}
+
+	// A missing TTL is sent as null, keeping preview branches until they are merged or deleted
+	sent := server.LastRequest("CreateAutoBranchingRule").Variables["input"].(map[string]interface{})
+	if ttl, ok := sent["ttlHours"]; !ok || ttl != nil {
+		t.Errorf("expected null ttlHours, got %v", sent["ttlHours"])
+	}
+
+	server.Handle("CreateAutoBranchingRule", `{"autoBranchingRuleCreate": {"__typename": "AutoBranchingPatternInvalidError", "pattern": "feature/["}}`)
+	if _, err := c.CreateAutoBranchingRule(ctx, input); err == nil || errorMessage(err) != "auto-branching pattern feature/[ is invalid" {
+		t.Errorf("expected invalid pattern error, got %v", err)
+	}
+}
+
+func TestUpdateAutoBranchingRule(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("UpdateAutoBranchingRule", `{"autoBranchingRuleUpdate": {"__typename": "AutoBranchingRuleUpdateSuccess", "autoBranchingRule": `+testAutoBranchingRuleJSON+`}}`)
+	if _, err := c.UpdateAutoBranchingRule(ctx, UpdateAutoBranchingRuleInput{ID: "rule-1", Pattern: "feature/*"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("UpdateAutoBranchingRule", `{"autoBranchingRuleUpdate": {"__typename": "AutoBranchingRuleDoesNotExistError"}}`)
+	if _, err := c.UpdateAutoBranchingRule(ctx, UpdateAutoBranchingRuleInput{ID: "rule-1"}); err == nil || errorMessage(err) != "auto-branching rule does not exist" {
+		t.Errorf("expected auto-branching rule does not exist, got %v", err)
+	}
+}
+
+func TestGetAutoBranchingRule(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetAutoBranchingRule", `{"node": `+testAutoBranchingRuleJSON+`}`)
+	if _, err := c.GetAutoBranchingRule(ctx, "rule-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("GetAutoBranchingRule", `{"node": null}`)
+	if _, err := c.GetAutoBranchingRule(ctx, "missing"); err == nil || errorMessage(err) != "auto-branching rule not found" {
+		t.Errorf("expected auto-branching rule not found, got %v", err)
+	}
+}
+
+func TestDeleteAutoBranchingRule(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("DeleteAutoBranchingRule", `{"autoBranchingRuleDelete": {"__typename": "AutoBranchingRuleDeleteSuccess"}}`)
+	if err := c.DeleteAutoBranchingRule(ctx, "rule-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("DeleteAutoBranchingRule", `{"autoBranchingRuleDelete": {"__typename": "AutoBranchingRuleDoesNotExistError"}}`)
+	if err := c.DeleteAutoBranchingRule(ctx, "rule-1"); err == nil || errorMessage(err) != "auto-branching rule does not exist" {
+		t.Errorf("expected auto-branching rule does not exist, got %v", err)
+	}
+}
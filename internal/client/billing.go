@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// InvoiceUsage represents the usage accrued by an account in the current billing period
+type InvoiceUsage struct {
+	PeriodStart       time.Time `json:"periodStart"`
+	PeriodEnd         time.Time `json:"periodEnd"`
+	RequestCount      int64     `json:"requestCount"`
+	SeatCount         int64     `json:"seatCount"`
+	DataTransferBytes int64     `json:"dataTransferBytes"`
+}
+
+// GetInvoiceUsage retrieves the usage of an account for the current billing period
+func (c *Client) GetInvoiceUsage(ctx context.Context, accountSlug string) (*InvoiceUsage, error) {
+	query := `
+		query GetInvoiceUsage($slug: String!) {
+			accountBySlug(slug: $slug) {
+				currentBillingPeriodUsage {
+					periodStart
+					periodEnd
+					requestCount
+					seatCount
+					dataTransferBytes
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"slug": accountSlug,
+	}
+
+	account, err := execute[*struct {
+		CurrentBillingPeriodUsage *InvoiceUsage `json:"currentBillingPeriodUsage"`
+	}](ctx, c, operation{
+		action: "get invoice usage",
+		query:  query,
+		path:   []string{"accountBySlug"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil {
+		return nil, fmt.Errorf("account not found")
+	}
+
+	if account.CurrentBillingPeriodUsage == nil {
+		return nil, fmt.Errorf("invoice usage not found")
+	}
+
+	return account.CurrentBillingPeriodUsage, nil
+}
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetInvoiceUsage(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetInvoiceUsage", `{"accountBySlug": {"currentBillingPeriodUsage": {
+		"periodStart": "2024-01-01T00:00:00Z",
+		"periodEnd": "2024-02-01T00:00:00Z",
+		"requestCount": 1500,
+		"seatCount": 3,
+		"dataTransferBytes": 1048576
+	}}}`)
+	usage, err := c.GetInvoiceUsage(ctx, "my-account")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if usage.RequestCount != 1500 || usage.SeatCount != 3 || usage.DataTransferBytes != 1048576 {
+		t.Errorf("unexpected usage: %+v", usage)
+	}
+
+	server.Handle("GetInvoiceUsage", `{"accountBySlug": {"currentBillingPeriodUsage": null}}`)
+	if _, err := c.GetInvoiceUsage(ctx, "my-account"); err == nil || errorMessage(err) != "invoice usage not found" {
+		t.Errorf("expected invoice usage not found, got %v", err)
+	}
+
+	server.Handle("GetInvoiceUsage", `{"accountBySlug": null}`)
+	if _, err := c.GetInvoiceUsage(ctx, "missing"); err == nil || errorMessage(err) != "account not found" {
+		t.Errorf("expected account not found, got %v", err)
+	}
+}
@@ -0,0 +1,128 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// BranchAlias represents a stable name that resolves to a branch of a graph
+type BranchAlias struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	BranchName string `json:"branchName"`
+}
+
+// SetBranchAliasInput represents the input for creating or repointing a branch alias
+type SetBranchAliasInput struct {
+	AccountSlug string `json:"accountSlug"`
+	GraphSlug   string `json:"graphSlug"`
+	Name        string `json:"name"`
+	BranchName  string `json:"branchName"`
+}
+
+// DeleteBranchAliasInput represents the input for deleting a branch alias
+type DeleteBranchAliasInput struct {
+	AccountSlug string `json:"accountSlug"`
+	GraphSlug   string `json:"graphSlug"`
+	Name        string `json:"name"`
+}
+
+// SetBranchAlias creates a branch alias, or points an existing alias at another branch
+func (c *Client) SetBranchAlias(ctx context.Context, input SetBranchAliasInput) (*BranchAlias, error) {
+	query := `
+		mutation SetBranchAlias($input: BranchAliasSetInput!) {
+			branchAliasSet(input: $input) {
+				__typename
+				... on BranchAliasSetSuccess {
+					branchAlias {
+						id
+						name
+						branchName
+					}
+				}
+				... on BranchAliasConflictsWithBranchError {
+					name
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*BranchAlias](ctx, c, operation{
+		action:  "set branch alias",
+		query:   query,
+		path:    []string{"branchAliasSet"},
+		success: "BranchAliasSetSuccess",
+		field:   "branchAlias",
+		errors: map[string]string{
+			"BranchAliasNameInvalidError":         "branch alias name is invalid",
+			"BranchAliasConflictsWithBranchError": "a branch named ${name} already exists",
+		},
+	}, variables)
+}
+
+// GetBranchAlias retrieves a branch alias of a graph by name
+func (c *Client) GetBranchAlias(ctx context.Context, accountSlug, graphSlug, name string) (*BranchAlias, error) {
+	query := `
+		query GetBranchAlias($accountSlug: String!, $graphSlug: String!, $name: String!) {
+			graphByAccountSlug(accountSlug: $accountSlug, graphSlug: $graphSlug) {
+				branchAlias(name: $name) {
+					id
+					name
+					branchName
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"graphSlug":   graphSlug,
+		"name":        name,
+	}
+
+	alias, err := execute[*BranchAlias](ctx, c, operation{
+		action: "get branch alias",
+		query:  query,
+		path:   []string{"graphByAccountSlug", "branchAlias"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if alias == nil {
+		return nil, fmt.Errorf("branch alias not found")
+	}
+
+	return alias, nil
+}
+
+// DeleteBranchAlias deletes a branch alias of a graph. The branch it points at is kept.
+func (c *Client) DeleteBranchAlias(ctx context.Context, input DeleteBranchAliasInput) error {
+	query := `
+		mutation DeleteBranchAlias($input: BranchAliasDeleteInput!) {
+			branchAliasDelete(input: $input) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "delete branch alias",
+		query:   query,
+		path:    []string{"branchAliasDelete"},
+		success: "BranchAliasDeleteSuccess",
+		errors: map[string]string{
+			"BranchAliasDoesNotExistError": "branch alias does not exist",
+		},
+	}, variables)
+
+	return err
+}
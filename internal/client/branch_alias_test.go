@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestSetBranchAlias(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := SetBranchAliasInput{AccountSlug: "my-account", GraphSlug: "my-graph", Name: "staging", BranchName: "preview-42"}
+
+	server.Handle("SetBranchAlias", `{"branchAliasSet": {"__typename": "BranchAliasSetSuccess", "branchAlias": {"id": "alias-1", "name": "staging", "branchName": "preview-42"}}}`)
+	alias, err := c.SetBranchAlias(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if alias.ID != "alias-1" || alias.BranchName != "preview-42" {
+		t.Errorf("unexpected alias: %+v", alias)
+	}
+	if sent := server.LastRequest("SetBranchAlias").Variables["input"].(map[string]interface{}); sent["branchName"] != "preview-42" {
+		t.Errorf("expected branchName to be sent, got %v", sent)
+	}
+
+	server.Handle("SetBranchAlias", `{"branchAliasSet": {"__typename": "BranchAliasConflictsWithBranchError", "name": "staging"}}`)
+	if _, err := c.SetBranchAlias(ctx, input); err == nil || errorMessage(err) != "a branch named staging already exists" {
+		t.Errorf("expected conflict error, got %v", err)
+	}
+
+	server.Handle("SetBranchAlias", `{"branchAliasSet": {"__typename": "BranchDoesNotExistError"}}`)
+	var branchErr *BranchDoesNotExistError
+	if _, err := c.SetBranchAlias(ctx, input); !errors.As(err, &branchErr) {
+		t.Errorf("expected branch does not exist error, got %v", err)
+	}
+}
+
+func TestGetBranchAlias(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetBranchAlias", `{"graphByAccountSlug": {"branchAlias": {"id": "alias-1", "name": "staging", "branchName": "preview-42"}}}`)
+	alias, err := c.GetBranchAlias(ctx, "my-account", "my-graph", "staging")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if alias.Name != "staging" || alias.BranchName != "preview-42" {
+		t.Errorf("unexpected alias: %+v", alias)
+	}
+
+	server.Handle("GetBranchAlias", `{"graphByAccountSlug": {"branchAlias": null}}`)
+	if _, err := c.GetBranchAlias(ctx, "my-account", "my-graph", "missing"); err == nil || errorMessage(err) != "branch alias not found" {
+		t.Errorf("expected branch alias not found, got %v", err)
+	}
+}
+
+func TestDeleteBranchAlias(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := DeleteBranchAliasInput{AccountSlug: "my-account", GraphSlug: "my-graph", Name: "staging"}
+
+	server.Handle("DeleteBranchAlias", `{"branchAliasDelete": {"__typename": "BranchAliasDeleteSuccess"}}`)
+	if err := c.DeleteBranchAlias(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("DeleteBranchAlias", `{"branchAliasDelete": {"__typename": "BranchAliasDoesNotExistError"}}`)
+	if err := c.DeleteBranchAlias(ctx, input); err == nil || errorMessage(err) != "branch alias does not exist" {
+		t.Errorf("expected branch alias does not exist, got %v", err)
+	}
+}
@@ -0,0 +1,147 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// BranchProtection represents the protection rules of a branch. While a branch
+// is protected, each action is only allowed for the listed API keys; an empty
+// list blocks the action for everyone.
+type BranchProtection struct {
+	PublishAPIKeyIDs []string `json:"publishApiKeyIds"`
+	DeleteAPIKeyIDs  []string `json:"deleteApiKeyIds"`
+	UpdateAPIKeyIDs  []string `json:"updateApiKeyIds"`
+	AllowAdminBypass bool     `json:"allowAdminBypass"`
+}
+
+// SetBranchProtectionInput represents the input for protecting a branch
+type SetBranchProtectionInput struct {
+	AccountSlug      string   `json:"accountSlug"`
+	GraphSlug        string   `json:"graphSlug"`
+	BranchName       string   `json:"branchName"`
+	PublishAPIKeyIDs []string `json:"publishApiKeyIds"`
+	DeleteAPIKeyIDs  []string `json:"deleteApiKeyIds"`
+	UpdateAPIKeyIDs  []string `json:"updateApiKeyIds"`
+	AllowAdminBypass bool     `json:"allowAdminBypass"`
+}
+
+// DeleteBranchProtectionInput represents the input for removing the protection of a branch
+type DeleteBranchProtectionInput struct {
+	AccountSlug string `json:"accountSlug"`
+	GraphSlug   string `json:"graphSlug"`
+	BranchName  string `json:"branchName"`
+}
+
+// branchProtectionFields is the selection set of a branch protection
+const branchProtectionFields = `
+	publishApiKeyIds
+	deleteApiKeyIds
+	updateApiKeyIds
+	allowAdminBypass
+`
+
+// GetBranchProtection retrieves the protection rules of a branch
+func (c *Client) GetBranchProtection(ctx context.Context, accountSlug, graphSlug, branchName string) (*BranchProtection, error) {
+	query := `
+		query GetBranchProtection($accountSlug: String!, $graphSlug: String!, $branchName: String!) {
+			branch(accountSlug: $accountSlug, graphSlug: $graphSlug, name: $branchName) {
+				protection {` + branchProtectionFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"graphSlug":   graphSlug,
+		"branchName":  branchName,
+	}
+
+	branch, err := execute[*struct {
+		Protection *BranchProtection `json:"protection"`
+	}](ctx, c, operation{
+		action: "get branch protection",
+		query:  query,
+		path:   []string{"branch"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if branch == nil {
+		return nil, fmt.Errorf("branch not found")
+	}
+
+	if branch.Protection == nil {
+		return nil, fmt.Errorf("branch protection not found")
+	}
+
+	return branch.Protection, nil
+}
+
+// SetBranchProtection protects a branch, replacing any existing rules
+func (c *Client) SetBranchProtection(ctx context.Context, input SetBranchProtectionInput) (*BranchProtection, error) {
+	query := `
+		mutation SetBranchProtection($input: BranchProtectionSetInput!) {
+			branchProtectionSet(input: $input) {
+				__typename
+				... on BranchProtectionSetSuccess {
+					branchProtection {` + branchProtectionFields + `}
+				}
+			}
+		}
+	`
+
+	if input.PublishAPIKeyIDs == nil {
+		input.PublishAPIKeyIDs = []string{}
+	}
+	if input.DeleteAPIKeyIDs == nil {
+		input.DeleteAPIKeyIDs = []string{}
+	}
+	if input.UpdateAPIKeyIDs == nil {
+		input.UpdateAPIKeyIDs = []string{}
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*BranchProtection](ctx, c, operation{
+		action:  "set branch protection",
+		query:   query,
+		path:    []string{"branchProtectionSet"},
+		success: "BranchProtectionSetSuccess",
+		field:   "branchProtection",
+		errors: map[string]string{
+			"ApiKeyDoesNotExistError": "API key does not exist",
+		},
+	}, variables)
+}
+
+// DeleteBranchProtection removes the protection of a branch, allowing every
+// action again
+func (c *Client) DeleteBranchProtection(ctx context.Context, input DeleteBranchProtectionInput) error {
+	query := `
+		mutation DeleteBranchProtection($input: BranchProtectionDeleteInput!) {
+			branchProtectionDelete(input: $input) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "delete branch protection",
+		query:   query,
+		path:    []string{"branchProtectionDelete"},
+		success: "BranchProtectionDeleteSuccess",
+		errors: map[string]string{
+			"BranchProtectionDoesNotExistError": "branch protection does not exist",
+		},
+	}, variables)
+
+	return err
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetBranchProtection(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetBranchProtection", `{"branch": {"protection": {
+		"publishApiKeyIds": ["key-1"],
+		"deleteApiKeyIds": [],
+		"updateApiKeyIds": ["key-1", "key-2"],
+		"allowAdminBypass": true
+	}}}`)
+	protection, err := c.GetBranchProtection(ctx, "my-account", "my-graph", "main")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(protection.PublishAPIKeyIDs) != 1 || len(protection.UpdateAPIKeyIDs) != 2 || !protection.AllowAdminBypass {
+		t.Errorf("unexpected protection: %+v", protection)
+	}
+
+	server.Handle("GetBranchProtection", `{"branch": {"protection": null}}`)
+	if _, err := c.GetBranchProtection(ctx, "my-account", "my-graph", "main"); err == nil || errorMessage(err) != "branch protection not found" {
+		t.Errorf("expected branch protection not found, got %v", err)
+	}
+
+	server.Handle("GetBranchProtection", `{"branch": null}`)
+	if _, err := c.GetBranchProtection(ctx, "my-account", "my-graph", "missing"); err == nil || errorMessage(err) != "branch not found" {
+		t.Errorf("expected branch not found, got %v", err)
+	}
+}
+
+func TestSetBranchProtection(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := SetBranchProtectionInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main", PublishAPIKeyIDs: []string{"key-1"}}
+
+	server.Handle("SetBranchProtection", `{"branchProtectionSet": {"__typename": "BranchProtectionSetSuccess", "branchProtection": {
+		"publishApiKeyIds": ["key-1"], "deleteApiKeyIds": [], "updateApiKeyIds": [], "allowAdminBypass": false
+	}}}`)
+	protection, err := c.SetBranchProtection(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(protection.PublishAPIKeyIDs) != 1 {
+		t.Errorf("unexpected protection: %+v", protection)
+	}
+
+	// Unset lists are sent as empty lists, blocking the action
+	var sent SetBranchProtectionInput
+	if err := server.LastRequest("SetBranchProtection").Input(&sent); err != nil {
+		t.Fatalf("unable to decode input: %s", err)
+	}
+	if sent.DeleteAPIKeyIDs == nil || sent.UpdateAPIKeyIDs == nil {
+		t.Errorf("expected empty lists to be sent, got %+v", sent)
+	}
+
+	server.Handle("SetBranchProtection", `{"branchProtectionSet": {"__typename": "ApiKeyDoesNotExistError"}}`)
+	if _, err := c.SetBranchProtection(ctx, input); err == nil || errorMessage(err) != "API key does not exist" {
+		t.Errorf("expected API key does not exist, got %v", err)
+	}
+}
+
+func TestDeleteBranchProtection(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := DeleteBranchProtectionInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main"}
+
+	server.Handle("DeleteBranchProtection", `{"branchProtectionDelete": {"__typename": "BranchProtectionDeleteSuccess"}}`)
+	if err := c.DeleteBranchProtection(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("DeleteBranchProtection", `{"branchProtectionDelete": {"__typename": "BranchProtectionDoesNotExistError"}}`)
+	if err := c.DeleteBranchProtection(ctx, input); err == nil || errorMessage(err) != "branch protection does not exist" {
+		t.Errorf("expected branch protection does not exist, got %v", err)
+	}
+}
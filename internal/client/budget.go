@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// BudgetEnforcement represents how a budget is enforced once exceeded
+type BudgetEnforcement string
+
+const (
+	// BudgetEnforcementSoft only sends notifications when the budget is exceeded
+	BudgetEnforcementSoft BudgetEnforcement = "SOFT"
+	// BudgetEnforcementHard rejects requests once the budget is exceeded
+	BudgetEnforcementHard BudgetEnforcement = "HARD"
+)
+
+// APIBudget represents monthly request and cost budgets for an account or graph
+type APIBudget struct {
+	ID                     string            `json:"id"`
+	MonthlyRequestLimit    *int64            `json:"monthlyRequestLimit"`
+	MonthlyCostLimit       *float64          `json:"monthlyCostLimit"`
+	Enforcement            BudgetEnforcement `json:"enforcement"`
+	NotificationChannelIDs []string          `json:"notificationChannelIds"`
+}
+
+// SetAPIBudgetInput represents the input for creating or replacing a budget.
+// Leaving GraphSlug empty sets the account-wide budget.
+type SetAPIBudgetInput struct {
+	AccountSlug            string            `json:"accountSlug"`
+	GraphSlug              string            `json:"graphSlug,omitempty"`
+	MonthlyRequestLimit    *int64            `json:"monthlyRequestLimit"`
+	MonthlyCostLimit       *float64          `json:"monthlyCostLimit"`
+	Enforcement            BudgetEnforcement `json:"enforcement"`
+	NotificationChannelIDs []string          `json:"notificationChannelIds"`
+}
+
+// DeleteAPIBudgetInput represents the input for deleting a budget
+type DeleteAPIBudgetInput struct {
+	AccountSlug string `json:"accountSlug"`
+	GraphSlug   string `json:"graphSlug,omitempty"`
+}
+
+// SetAPIBudget creates or replaces the budget of an account or graph
+func (c *Client) SetAPIBudget(ctx context.Context, input SetAPIBudgetInput) (*APIBudget, error) {
+	query := `
+		mutation SetAPIBudget($input: ApiBudgetSetInput!) {
+			apiBudgetSet(input: $input) {
+				__typename
+				... on ApiBudgetSetSuccess {
+					budget {
+						id
+						monthlyRequestLimit
+						monthlyCostLimit
+						enforcement
+						notificationChannelIds
+					}
+				}
+			}
+		}
+	`
+
+	if input.NotificationChannelIDs == nil {
+		input.NotificationChannelIDs = []string{}
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*APIBudget](ctx, c, operation{
+		action:  "set API budget",
+		query:   query,
+		path:    []string{"apiBudgetSet"},
+		success: "ApiBudgetSetSuccess",
+		field:   "budget",
+	}, variables)
+}
+
+// GetAPIBudget retrieves the budget of an account, or of a graph when graphSlug is set
+func (c *Client) GetAPIBudget(ctx context.Context, accountSlug, graphSlug string) (*APIBudget, error) {
+	query := `
+		query GetAPIBudget($accountSlug: String!, $graphSlug: String) {
+			apiBudget(accountSlug: $accountSlug, graphSlug: $graphSlug) {
+				id
+				monthlyRequestLimit
+				monthlyCostLimit
+				enforcement
+				notificationChannelIds
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+	}
+	if graphSlug != "" {
+		variables["graphSlug"] = graphSlug
+	}
+
+	budget, err := execute[*APIBudget](ctx, c, operation{
+		action: "get API budget",
+		query:  query,
+		path:   []string{"apiBudget"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if budget == nil {
+		return nil, fmt.Errorf("API budget not found")
+	}
+
+	return budget, nil
+}
+
+// DeleteAPIBudget removes the budget of an account or graph
+func (c *Client) DeleteAPIBudget(ctx context.Context, input DeleteAPIBudgetInput) error {
+	query := `
+		mutation DeleteAPIBudget($input: ApiBudgetDeleteInput!) {
+			apiBudgetDelete(input: $input) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "delete API budget",
+		query:   query,
+		path:    []string{"apiBudgetDelete"},
+		success: "ApiBudgetDeleteSuccess",
+		errors: map[string]string{
+			"ApiBudgetDoesNotExistError": "API budget does not exist",
+		},
+	}, variables)
+
+	return err
+}
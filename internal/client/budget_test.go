@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSetAPIBudget(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	limit := int64(1000000)
+
+	server.Handle("SetAPIBudget", `{"apiBudgetSet": {"__typename": "ApiBudgetSetSuccess", "budget": {
+		"id": "budget-1",
+		"monthlyRequestLimit": 1000000,
+		"monthlyCostLimit": null,
+		"enforcement": "HARD",
+		"notificationChannelIds": []
+	}}}`)
+	budget, err := c.SetAPIBudget(ctx, SetAPIBudgetInput{AccountSlug: "my-account", MonthlyRequestLimit: &limit, Enforcement: BudgetEnforcementHard})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if budget.MonthlyRequestLimit == nil || *budget.MonthlyRequestLimit != limit || budget.MonthlyCostLimit != nil {
+		t.Errorf("unexpected budget: %+v", budget)
+	}
+
+	// Account-wide budgets omit the graph slug, and channel IDs are never sent as null
+	input := server.LastRequest("SetAPIBudget").Variables["input"].(map[string]interface{})
+	if _, ok := input["graphSlug"]; ok {
+		t.Errorf("expected graphSlug to be omitted, got %v", input["graphSlug"])
+	}
+	if input["notificationChannelIds"] == nil {
+		t.Error("expected notificationChannelIds to be an empty list")
+	}
+
+	server.Handle("SetAPIBudget", `{"apiBudgetSet": {"__typename": "GraphDoesNotExistError"}}`)
+	if _, err := c.SetAPIBudget(ctx, SetAPIBudgetInput{AccountSlug: "my-account", GraphSlug: "missing"}); err == nil || errorMessage(err) != "graph does not exist" {
+		t.Errorf("expected graph does not exist, got %v", err)
+	}
+}
+
+func TestGetAPIBudget(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetAPIBudget", `{"apiBudget": {"id": "budget-1", "monthlyCostLimit": 250.5, "enforcement": "SOFT", "notificationChannelIds": ["channel-1"]}}`)
+	budget, err := c.GetAPIBudget(ctx, "my-account", "my-graph")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if budget.MonthlyCostLimit == nil || *budget.MonthlyCostLimit != 250.5 || len(budget.NotificationChannelIDs) != 1 {
+		t.Errorf("unexpected budget: %+v", budget)
+	}
+	if got := server.LastRequest("GetAPIBudget").Variables["graphSlug"]; got != "my-graph" {
+		t.Errorf("expected graphSlug variable, got %v", got)
+	}
+
+	server.Handle("GetAPIBudget", `{"apiBudget": null}`)
+	if _, err := c.GetAPIBudget(ctx, "my-account", ""); err == nil || errorMessage(err) != "API budget not found" {
+		t.Errorf("expected API budget not found, got %v", err)
+	}
+	if _, ok := server.LastRequest("GetAPIBudget").Variables["graphSlug"]; ok {
+		t.Error("expected graphSlug to be omitted for account budgets")
+	}
+}
+
+func TestDeleteAPIBudget(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("DeleteAPIBudget", `{"apiBudgetDelete": {"__typename": "ApiBudgetDeleteSuccess"}}`)
+	if err := c.DeleteAPIBudget(ctx, DeleteAPIBudgetInput{AccountSlug: "my-account"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("DeleteAPIBudget", `{"apiBudgetDelete": {"__typename": "ApiBudgetDoesNotExistError"}}`)
+	if err := c.DeleteAPIBudget(ctx, DeleteAPIBudgetInput{AccountSlug: "my-account"}); err == nil || errorMessage(err) != "API budget does not exist" {
+		t.Errorf("expected API budget does not exist, got %v", err)
+	}
+}
@@ -0,0 +1,176 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// branchKey identifies a branch in client-side caches
+type branchKey struct {
+	accountSlug string
+	graphSlug   string
+	branchName  string
+}
+
+// branchCache caches values loaded once per branch. The provider process lives
+// for a single Terraform operation, so entries are shared by all resources
+// refreshed during one plan or apply and are never stale across runs.
+type branchCache[T any] struct {
+	mu      sync.Mutex
+	entries map[branchKey]*branchCacheEntry[T]
+}
+
+// branchCacheEntry is a value being loaded or loaded for a branch
+type branchCacheEntry[T any] struct {
+	ready chan struct{}
+	value T
+	err   error
+}
+
+// load returns the cached value for key, calling fetch if it is not cached yet.
+// Concurrent callers for the same key wait for a single fetch. Failed fetches
+// are not cached, so the next call retries.
+func (c *branchCache[T]) load(key branchKey, fetch func() (T, error)) (T, error) {
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = map[branchKey]*branchCacheEntry[T]{}
+	}
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &branchCacheEntry[T]{ready: make(chan struct{})}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	if ok {
+		<-entry.ready
+		return entry.value, entry.err
+	}
+
+	entry.value, entry.err = fetch()
+	close(entry.ready)
+
+	if entry.err != nil {
+		c.invalidate(key)
+	}
+
+	return entry.value, entry.err
+}
+
+// invalidate drops the cached value for key, so the next load fetches it again
+func (c *branchCache[T]) invalidate(key branchKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+// ttlCache caches values by key for a limited time. Unlike branchCache it
+// expires entries, for lookups that are repeated many times during one apply
+// but whose results could change while a long-running apply is in progress.
+type ttlCache[K comparable, V any] struct {
+	ttl time.Duration
+	// now returns the current time, and is replaced in tests
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[K]*ttlCacheEntry[V]
+}
+
+// ttlCacheEntry is a value being loaded or loaded for a key
+type ttlCacheEntry[V any] struct {
+	ready   chan struct{}
+	value   V
+	err     error
+	expires time.Time
+}
+
+// newTTLCache returns an empty cache whose entries expire after ttl
+func newTTLCache[K comparable, V any](ttl time.Duration) *ttlCache[K, V] {
+	return &ttlCache[K, V]{ttl: ttl, now: time.Now}
+}
+
+// load returns the cached value for key, calling fetch if it is not cached
+// yet or has expired. Concurrent callers for the same key wait for a single
+// fetch. Failed fetches are not cached, so the next call retries. Without a
+// ttl, entries are dropped as soon as they are loaded, so the cache only
+// holds fetches in flight.
+func (c *ttlCache[K, V]) load(key K, fetch func() (V, error)) (V, error) {
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = map[K]*ttlCacheEntry[V]{}
+	}
+	entry, ok := c.entries[key]
+	if ok && c.expired(entry) {
+		ok = false
+	}
+	if !ok {
+		entry = &ttlCacheEntry[V]{ready: make(chan struct{})}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	if ok {
+		<-entry.ready
+		return entry.value, entry.err
+	}
+
+	value, err := fetch()
+
+	c.mu.Lock()
+	entry.value, entry.err = value, err
+	entry.expires = c.now().Add(c.ttl)
+	if (err != nil || c.ttl == 0) && c.entries[key] == entry {
+		delete(c.entries, key)
+	}
+	c.mu.Unlock()
+	close(entry.ready)
+
+	return value, err
+}
+
+// loadContext is like load, but each caller stops waiting once its own ctx is
+// done. The fetch keeps running for the other callers, so it must not depend
+// on the ctx of the caller that started it.
+func (c *ttlCache[K, V]) loadContext(ctx context.Context, key K, fetch func() (V, error)) (V, error) {
+	type result struct {
+		value V
+		err   error
+	}
+
+	done := make(chan result, 1)
+	go func() {
+		value, err := c.load(key, fetch)
+		done <- result{value, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.value, r.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// clear drops all entries, including those still being loaded, so later
+// calls fetch again instead of waiting for a fetch already in progress
+func (c *ttlCache[K, V]) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.entries)
+}
+
+// expired reports whether a loaded entry is past its expiry. Entries still
+// being loaded never expire, so concurrent callers share the fetch. The
+// caller must hold c.mu.
+func (c *ttlCache[K, V]) expired(entry *ttlCacheEntry[V]) bool {
+	select {
+	case <-entry.ready:
+		return !c.now().Before(entry.expires)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,361 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/mockgraphql"
+)
+
+// roundTripperFunc adapts a function to an http.RoundTripper
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestLookupSubgraphRoutingOverride(t *testing.T) {
+	var requests atomic.Int32
+
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		requests.Add(1)
+		body := `{"data": {"branch": {"subgraphRoutingOverrides": [
+			{"id": "1", "subgraphName": "products", "url": "https://products.example.com", "updatedAt": "2024-01-01T00:00:00Z"},
+			{"id": "2", "subgraphName": "reviews", "url": "https://reviews.example.com", "updatedAt": "2024-01-01T00:00:00Z"}
+		]}}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}, nil
+	})
+
+	c := NewClient("test", WithTransport(transport))
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for _, subgraphName := range []string{"products", "reviews", "products", "reviews"} {
+		wg.Add(1)
+		go func(subgraphName string) {
+			defer wg.Done()
+			override, err := c.LookupSubgraphRoutingOverride(ctx, "account", "graph", "main", subgraphName)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if override.SubgraphName != subgraphName {
+				t.Errorf("expected override for %q, got %q", subgraphName, override.SubgraphName)
+			}
+		}(subgraphName)
+	}
+	wg.Wait()
+
+	if got := requests.Load(); got != 1 {
+		t.Fatalf("expected 1 request for concurrent lookups of one branch, got %d", got)
+	}
+
+	if _, err := c.LookupSubgraphRoutingOverride(ctx, "account", "graph", "main", "accounts"); err == nil || errorMessage(err) != "routing override not found" {
+		t.Errorf("expected routing override not found, got %v", err)
+	}
+
+	if _, err := c.LookupSubgraphRoutingOverride(ctx, "account", "graph", "preview", "products"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if got := requests.Load(); got != 2 {
+		t.Errorf("expected 1 additional request for another branch, got %d total", got)
+	}
+
+	c.routingOverrides.invalidate(branchKey{accountSlug: "account", graphSlug: "graph", branchName: "main"})
+
+	if _, err := c.LookupSubgraphRoutingOverride(ctx, "account", "graph", "main", "products"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if got := requests.Load(); got != 3 {
+		t.Errorf("expected a new request after invalidation, got %d total", got)
+	}
+}
+
+func TestGetAccountBySlugCache(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c.accounts.now = func() time.Time { return now }
+
+	server.Handle("GetAccount", `{"accountBySlug": {"id": "account-1", "slug": "my-account", "name": "My Account"}}`)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			account, err := c.GetAccountBySlug(ctx, "my-account")
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if account.ID != "account-1" {
+				t.Errorf("unexpected account: %+v", account)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := len(server.Requests("GetAccount")); got != 1 {
+		t.Fatalf("expected 1 request for repeated lookups of one account, got %d", got)
+	}
+
+	// Callers get their own copy of the cached account
+	account, _ := c.GetAccountBySlug(ctx, "my-account")
+	account.Name = "Changed"
+	if account, _ := c.GetAccountBySlug(ctx, "my-account"); account.Name != "My Account" {
+		t.Errorf("expected cached account to be unchanged, got %+v", account)
+	}
+
+	now = now.Add(accountCacheTTL)
+	if _, err := c.GetAccountBySlug(ctx, "my-account"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := len(server.Requests("GetAccount")); got != 2 {
+		t.Errorf("expected a new request after the entry expired, got %d total", got)
+	}
+
+	// Failed lookups are retried
+	server.Handle("GetAccount", `{"accountBySlug": null}`)
+	for range 2 {
+		if _, err := c.GetAccountBySlug(ctx, "missing"); err == nil || errorMessage(err) != "account not found" {
+			t.Errorf("expected account not found, got %v", err)
+		}
+	}
+	if got := len(server.Requests("GetAccount")); got != 4 {
+		t.Errorf("expected failed lookups not to be cached, got %d requests", got)
+	}
+}
+
+func TestGetAccountBySlugCancellation(t *testing.T) {
+	c, server := newTestClient(t)
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	server.HandleFunc("GetAccount", func(mockgraphql.Request) mockgraphql.Response {
+		close(started)
+		<-release
+		return mockgraphql.Response{Data: `{"accountBySlug": {"id": "account-1", "slug": "my-account", "name": "My Account"}}`}
+	})
+
+	// The first caller starts the lookup, and a second one joins it
+	firstCtx, cancel := context.WithCancel(context.Background())
+	first := make(chan error, 1)
+	go func() {
+		_, err := c.GetAccountBySlug(firstCtx, "my-account")
+		first <- err
+	}()
+	<-started
+
+	second := make(chan error, 1)
+	go func() {
+		_, err := c.GetAccountBySlug(context.Background(), "my-account")
+		second <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	// Cancelling the first caller does not fail the lookup of the second
+	cancel()
+	if err := <-first; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the cancelled caller to fail with its own error, got %v", err)
+	}
+
+	close(release)
+	if err := <-second; err != nil {
+		t.Errorf("expected the other caller to get the account, got %v", err)
+	}
+	if got := len(server.Requests("GetAccount")); got != 1 {
+		t.Errorf("expected 1 request for both callers, got %d", got)
+	}
+}
+
+func TestExecuteQueryDeduplicatesReads(t *testing.T) {
+	var requests atomic.Int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if requests.Add(1) == 1 {
+			close(started)
+			<-release
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{"data": {"graphByAccountSlug": {"id": "graph-1", "slug": "my-graph"}}}`)),
+		}, nil
+	})
+
+	c := NewClient("test", WithTransport(transport))
+	ctx := context.Background()
+
+	getGraph := func(wg *sync.WaitGroup) {
+		defer wg.Done()
+		graph, err := c.GetGraph(ctx, "my-account", "my-graph")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if graph.ID != "graph-1" {
+			t.Errorf("unexpected graph: %+v", graph)
+		}
+	}
+
+	// Reads issued while an identical read is in flight share its response
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go getGraph(&wg)
+	<-started
+	for range 5 {
+		wg.Add(1)
+		go getGraph(&wg)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := requests.Load(); got != 1 {
+		t.Fatalf("expected 1 request for concurrent identical reads, got %d", got)
+	}
+
+	// Responses are not reused once the read completed
+	wg.Add(1)
+	getGraph(&wg)
+	if got := requests.Load(); got != 2 {
+		t.Errorf("expected a new request for a later read, got %d total", got)
+	}
+
+	// Reads with other variables are sent separately
+	if _, err := c.GetGraph(ctx, "my-account", "other-graph"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("expected a request for another graph, got %d total", got)
+	}
+
+	// Completed reads are not kept for the life of the client
+	if got := len(c.reads.entries); got != 0 {
+		t.Errorf("expected no shared reads once they completed, got %d", got)
+	}
+}
+
+func TestExecuteQuerySharedReadCancellation(t *testing.T) {
+	var requests atomic.Int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		requests.Add(1)
+		close(started)
+		<-release
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{"data": {"graphByAccountSlug": {"id": "graph-1", "slug": "my-graph"}}}`)),
+		}, nil
+	})
+
+	c := NewClient("test", WithTransport(transport))
+
+	// The first caller starts the read, and a second one joins it
+	firstCtx, cancel := context.WithCancel(context.Background())
+	first := make(chan error, 1)
+	go func() {
+		_, err := c.GetGraph(firstCtx, "my-account", "my-graph")
+		first <- err
+	}()
+	<-started
+
+	second := make(chan error, 1)
+	go func() {
+		graph, err := c.GetGraph(context.Background(), "my-account", "my-graph")
+		if err == nil && graph.ID != "graph-1" {
+			t.Errorf("unexpected graph: %+v", graph)
+		}
+		second <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	// Cancelling the first caller only stops it from waiting
+	cancel()
+	select {
+	case err := <-first:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected the cancelled caller to fail with its own error, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the cancelled caller to return without waiting for the read")
+	}
+
+	close(release)
+	if err := <-second; err != nil {
+		t.Errorf("expected the other caller to get the shared response, got %v", err)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected 1 request for both callers, got %d", got)
+	}
+}
+
+func TestExecuteQueryMutationBypassesSharedReads(t *testing.T) {
+	var reads atomic.Int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		response := `{"data": {"graphByAccountSlug": {"id": "graph-1", "slug": "my-graph"}}}`
+		if strings.Contains(string(body), `"input"`) {
+			response = `{"data": {"graphDelete": {"__typename": "GraphDeleteSuccess", "deletedId": "graph-1"}}}`
+		} else if reads.Add(1) == 1 {
+			close(started)
+			<-release
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(response)),
+		}, nil
+	})
+
+	c := NewClient("test", WithTransport(transport))
+	ctx := context.Background()
+
+	// A read started before the mutation is still in flight after it
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if _, err := c.GetGraph(ctx, "my-account", "my-graph"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}()
+	<-started
+
+	if err := c.DeleteGraph(ctx, "graph-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A read after the mutation is sent on its own instead of joining it
+	if _, err := c.GetGraph(ctx, "my-account", "my-graph"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := reads.Load(); got != 2 {
+		t.Errorf("expected a new read after the mutation, got %d reads", got)
+	}
+
+	close(release)
+	<-done
+}
@@ -3,45 +3,146 @@ package client
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"sync/atomic"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
-	DefaultAPIURL = "https://api.grafbase.com/graphql"
+	DefaultAPIURL         = "https://api.grafbase.com/graphql"
+	DefaultRequestTimeout = 30 * time.Second
+	DefaultUserAgent      = "terraform-provider-grafbase"
+
+	// requestIDHeader carries the ID of each API call, so that calls can be
+	// correlated with the logs of Grafbase
+	requestIDHeader = "X-Request-Id"
+
+	// accountCacheTTL is how long account lookups by slug are reused
+	accountCacheTTL = 5 * time.Minute
 )
 
-// Client represents a Grafbase API client
+// Client represents a Grafbase API client. A Client is safe for concurrent use
+// by multiple goroutines and should be shared, so that all requests reuse the
+// pooled connections of its transport.
 type Client struct {
 	httpClient *http.Client
 	apiURL     string
 	apiKey     string
+	userAgent  string
+	// telemetryDisabled omits the usage metadata of requests, such as the
+	// versions in the User-Agent and the trace context
+	telemetryDisabled bool
+
+	// routingOverrides caches the routing overrides of each branch for bulk reads
+	routingOverrides branchCache[[]SubgraphRoutingOverride]
+	// persistedQueriesUnsupported is set once the API reports it does not
+	// support persisted queries, so later requests send full queries directly
+	persistedQueriesUnsupported atomic.Bool
+
+	// accounts caches account lookups by slug, since every graph created or
+	// moved during an apply looks up the ID of its account
+	accounts *ttlCache[string, Account]
+	// reads shares the responses of identical read operations in flight at
+	// the same time. Entries expire as soon as they are loaded and are
+	// dropped by every mutation, so later reads always see the latest data,
+	// including that of writes since.
+	reads *ttlCache[string, *GraphQLResponse]
+
+	// tracer records a span per GraphQL operation; it is a no-op unless
+	// tracing was set up
+	tracer trace.Tracer
+	// traceParent is the span operations run outside of a trace are parented to
+	traceParent trace.SpanContext
+}
+
+// Option configures optional Client behavior
+type Option func(*Client)
+
+// WithTransport sets the HTTP transport used for API requests
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) {
+		c.httpClient.Transport = transport
+	}
+}
+
+// WithAPIURL sets the GraphQL endpoint API requests are sent to
+func WithAPIURL(apiURL string) Option {
+	return func(c *Client) {
+		c.apiURL = apiURL
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with API requests
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithTelemetryDisabled omits usage metadata from API requests. The User-Agent
+// is reduced to DefaultUserAgent, overriding WithUserAgent, and the trace
+// context of operations is not propagated to the API.
+func WithTelemetryDisabled() Option {
+	return func(c *Client) {
+		c.telemetryDisabled = true
+	}
+}
+
+// WithTimeout sets the maximum duration of a single API request
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.httpClient.Timeout = timeout
+	}
 }
 
 // NewClient creates a new Grafbase API client
-func NewClient(apiKey string) *Client {
-	return &Client{
+func NewClient(apiKey string, opts ...Option) *Client {
+	c := &Client{
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Transport: defaultTransport(),
+			Timeout:   DefaultRequestTimeout,
 		},
-		apiURL: DefaultAPIURL,
-		apiKey: apiKey,
+		apiURL:    DefaultAPIURL,
+		apiKey:    apiKey,
+		userAgent: DefaultUserAgent,
+		accounts:  newTTLCache[string, Account](accountCacheTTL),
+		reads:     newTTLCache[string, *GraphQLResponse](0),
+
+		tracer:      otel.Tracer(tracerName),
+		traceParent: environmentTraceParent(),
+	}
+
+	for _, opt := range opts {
+		opt(c)
 	}
+
+	return c
 }
 
 // GraphQLRequest represents a GraphQL request
 type GraphQLRequest struct {
-	Query     string                 `json:"query"`
-	Variables map[string]interface{} `json:"variables,omitempty"`
+	Query      string                 `json:"query,omitempty"`
+	Variables  map[string]interface{} `json:"variables,omitempty"`
+	Extensions *RequestExtensions     `json:"extensions,omitempty"`
 }
 
 // GraphQLResponse represents a GraphQL response
 type GraphQLResponse struct {
 	Data   json.RawMessage `json:"data"`
 	Errors []GraphQLError  `json:"errors,omitempty"`
+	// RequestID is the ID of the call that returned the response, which
+	// Grafbase support uses to look the call up
+	RequestID string `json:"-"`
 }
 
 // GraphQLError represents a GraphQL error
@@ -56,13 +157,110 @@ func (e GraphQLError) Error() string {
 	return e.Message
 }
 
-// ExecuteQuery executes a GraphQL query
+// ExecuteQuery executes a GraphQL query. Queries are sent as automatic
+// persisted queries: only the hash of the query is sent, and the full query
+// follows when the API has not seen the hash yet. Identical read operations
+// that are in flight at the same time share a single request.
 func (c *Client) ExecuteQuery(ctx context.Context, query string, variables map[string]interface{}) (*GraphQLResponse, error) {
+	if operationType(query) != "query" {
+		// Reads that started before the mutation completed may return data
+		// from before it, so later reads must not wait for them
+		defer c.reads.clear()
+		return c.executeQuery(ctx, query, variables)
+	}
+
+	// Refreshing many resources issues the same reads concurrently, such as
+	// the lookup of the graph they all belong to
+	key, err := json.Marshal(GraphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return c.executeQuery(ctx, query, variables)
+	}
+
+	// The shared read outlives the caller that started it when that caller
+	// is cancelled; the HTTP client timeout still bounds it
+	sharedCtx := context.WithoutCancel(ctx)
+	return c.reads.loadContext(ctx, string(key), func() (*GraphQLResponse, error) {
+		return c.executeQuery(sharedCtx, query, variables)
+	})
+}
+
+// executeQuery sends a GraphQL operation as described by ExecuteQuery,
+// without sharing it with concurrent callers
+func (c *Client) executeQuery(ctx context.Context, query string, variables map[string]interface{}) (*GraphQLResponse, error) {
 	request := GraphQLRequest{
 		Query:     query,
 		Variables: variables,
 	}
 
+	persisted := !c.persistedQueriesUnsupported.Load()
+	if persisted {
+		request.Query = ""
+		request.Extensions = persistedQueryExtensions(query)
+	}
+
+	// Never let the API key reach the logs, whichever field it ends up in
+	if c.apiKey != "" {
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, c.apiKey)
+		ctx = tflog.MaskMessageStrings(ctx, c.apiKey)
+	}
+	ctx = tflog.SetField(ctx, "graphql_operation", operationName(query))
+
+	// Both requests of a persisted query share the ID of the call
+	requestID := newRequestID()
+	ctx = tflog.SetField(ctx, "request_id", requestID)
+
+	ctx, span := c.startOperationSpan(ctx, query, requestID)
+	retries := 0
+
+	tflog.Debug(ctx, "Executing GraphQL operation")
+	tflog.Trace(ctx, "GraphQL operation variables", map[string]interface{}{
+		"graphql_variables": sanitizeVariables(variables),
+	})
+
+	graphqlResp, err := c.send(ctx, request, requestID)
+
+	if persisted && graphqlResp != nil {
+		switch persistedQueryErrorCode(graphqlResp) {
+		case persistedQueryNotFound:
+			tflog.Debug(ctx, "Persisted query not found, sending the full query")
+			request.Query = query
+			retries++
+			graphqlResp, err = c.send(ctx, request, requestID)
+		case persistedQueryNotSupported:
+			tflog.Debug(ctx, "Persisted queries are not supported, sending full queries from now on")
+			c.persistedQueriesUnsupported.Store(true)
+			request.Query = query
+			request.Extensions = nil
+			retries++
+			graphqlResp, err = c.send(ctx, request, requestID)
+		}
+	}
+
+	if err != nil {
+		endOperationSpan(span, retries, err)
+		return nil, err
+	}
+
+	if len(graphqlResp.Errors) > 0 {
+		tflog.Debug(ctx, "GraphQL operation returned errors", map[string]interface{}{
+			"graphql_errors": len(graphqlResp.Errors),
+		})
+		err := withRequestID(fmt.Errorf("GraphQL errors: %v", graphqlResp.Errors), graphqlResp.RequestID)
+		endOperationSpan(span, retries, err)
+		return graphqlResp, err
+	}
+
+	tflog.Debug(ctx, "GraphQL operation completed")
+	endOperationSpan(span, retries, nil)
+
+	return graphqlResp, nil
+}
+
+// send posts a single GraphQL request. The decoded response is also returned
+// alongside non-200 statuses when the body is a GraphQL response, since some
+// servers reject unknown persisted queries with a client error status. Errors
+// of requests that were sent carry the request ID.
+func (c *Client) send(ctx context.Context, request GraphQLRequest, requestID string) (*GraphQLResponse, error) {
 	requestBody, err := json.Marshal(request)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
@@ -74,33 +272,117 @@ func (c *Client) ExecuteQuery(ctx context.Context, query string, variables map[s
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
-	httpReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+	if c.telemetryDisabled {
+		httpReq.Header.Set("User-Agent", DefaultUserAgent)
+	} else {
+		httpReq.Header.Set("User-Agent", c.userAgent)
+	}
+	if requestID != "" {
+		httpReq.Header.Set(requestIDHeader, requestID)
+	}
+	if c.apiKey != "" {
+		httpReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+	}
+	// Let the API join the trace of the operation, when tracing is set up
+	if !c.telemetryDisabled {
+		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(httpReq.Header))
+	}
 
+	start := time.Now()
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		tflog.Debug(ctx, "GraphQL operation failed", map[string]interface{}{
+			"duration_ms": time.Since(start).Milliseconds(),
+			"error":       err.Error(),
+		})
+		return nil, withRequestID(fmt.Errorf("failed to execute request: %w", err), requestID)
 	}
 	defer resp.Body.Close()
 
+	ctx = tflog.SetField(ctx, "http_status", resp.StatusCode)
+	ctx = tflog.SetField(ctx, "duration_ms", time.Since(start).Milliseconds())
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attrStatusCode.Int(resp.StatusCode))
+	// The API may assign its own ID when it does not accept the one sent
+	if responseID := resp.Header.Get(requestIDHeader); responseID != "" && responseID != requestID {
+		ctx = tflog.SetField(ctx, "request_id", responseID)
+		span.SetAttributes(attrRequestID.String(responseID))
+		requestID = responseID
+	}
+
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, withRequestID(fmt.Errorf("failed to read response body: %w", err), requestID)
 	}
 
+	graphqlResp := GraphQLResponse{RequestID: requestID}
+	unmarshalErr := json.Unmarshal(body, &graphqlResp)
+
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+		tflog.Debug(ctx, "GraphQL operation failed")
+		statusErr := withRequestID(fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body)), requestID)
+		if unmarshalErr != nil || len(graphqlResp.Errors) == 0 {
+			return nil, statusErr
+		}
+		return &graphqlResp, statusErr
 	}
 
-	var graphqlResp GraphQLResponse
-	if err := json.Unmarshal(body, &graphqlResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	if unmarshalErr != nil {
+		return nil, withRequestID(fmt.Errorf("failed to unmarshal response: %w", unmarshalErr), requestID)
 	}
 
-	if len(graphqlResp.Errors) > 0 {
-		return &graphqlResp, fmt.Errorf("GraphQL errors: %v", graphqlResp.Errors)
+	return &graphqlResp, nil
+}
+
+// newRequestID returns a random ID for an API call
+func newRequestID() string {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		// The call is still made, only without an ID to correlate it with
+		return ""
 	}
 
-	return &graphqlResp, nil
+	return hex.EncodeToString(id)
+}
+
+// ExchangeOIDCTokenInput represents the input for exchanging an OIDC token
+type ExchangeOIDCTokenInput struct {
+	IDToken string `json:"idToken"`
+}
+
+// ExchangeOIDCToken exchanges an OIDC identity token issued by a trusted CI
+// provider for a short-lived Grafbase access token. The request is sent
+// without an Authorization header, so the client may be created with an
+// empty API key.
+func (c *Client) ExchangeOIDCToken(ctx context.Context, idToken string) (string, error) {
+	query := `
+		mutation ExchangeOIDCToken($input: AccessTokenExchangeInput!) {
+			accessTokenExchange(input: $input) {
+				__typename
+				... on AccessTokenExchangeSuccess {
+					accessToken
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": ExchangeOIDCTokenInput{
+			IDToken: idToken,
+		},
+	}
+
+	return execute[string](ctx, c, operation{
+		action:  "exchange OIDC token",
+		query:   query,
+		path:    []string{"accessTokenExchange"},
+		success: "AccessTokenExchangeSuccess",
+		field:   "accessToken",
+		errors: map[string]string{
+			"InvalidIdentityTokenError":     "OIDC token is invalid or expired",
+			"TrustedPublisherNotFoundError": "no trusted publisher matches the OIDC token",
+		},
+	}, variables)
 }
 
 // Graph represents a Grafbase graph
@@ -108,9 +390,36 @@ type Graph struct {
 	ID        string    `json:"id"`
 	Slug      string    `json:"slug"`
 	CreatedAt time.Time `json:"createdAt"`
-	Account   Account   `json:"account"`
+	// ProductionBranch is nil until the first branch of the graph is deployed
+	ProductionBranch *GraphProductionBranch `json:"productionBranch"`
+	// EndpointURL is the GraphQL endpoint of the production branch
+	EndpointURL  string  `json:"endpointUrl"`
+	DashboardURL string  `json:"dashboardUrl"`
+	Account      Account `json:"account"`
+}
+
+// GraphProductionBranch identifies the production branch of a graph
+type GraphProductionBranch struct {
+	Name string `json:"name"`
 }
 
+// graphFields is the selection set of graphs
+const graphFields = `
+	id
+	slug
+	createdAt
+	productionBranch {
+		name
+	}
+	endpointUrl
+	dashboardUrl
+	account {
+		id
+		slug
+		name
+	}
+`
+
 // Account represents a Grafbase account
 type Account struct {
 	ID   string `json:"id"`
@@ -125,7 +434,13 @@ type Branch struct {
 	Environment                    BranchEnvironment `json:"environment"`
 	OperationChecksEnabled         bool              `json:"operationChecksEnabled"`
 	OperationChecksIgnoreUsageData bool              `json:"operationChecksIgnoreUsageData"`
-	Graph                          Graph             `json:"graph"`
+	Regions                        []string          `json:"regions"`
+	// EndpointURL is the GraphQL endpoint serving the branch
+	EndpointURL string `json:"endpointUrl"`
+	// LatestDeployment is nil until the branch has been deployed. Only its ID
+	// and status are selected with the branch.
+	LatestDeployment *Deployment `json:"latestDeployment"`
+	Graph            Graph       `json:"graph"`
 }
 
 // BranchEnvironment represents the environment type of a branch
@@ -136,11 +451,14 @@ const (
 	BranchEnvironmentProduction BranchEnvironment = "PRODUCTION"
 )
 
-// CreateBranchInput represents the input for creating a branch
+// CreateBranchInput represents the input for creating a branch. When
+// SourceBranch is set, the new branch starts with the schema and
+// configuration of that branch.
 type CreateBranchInput struct {
-	AccountSlug string `json:"accountSlug"`
-	GraphSlug   string `json:"graphSlug"`
-	BranchName  string `json:"branchName"`
+	AccountSlug  string  `json:"accountSlug"`
+	GraphSlug    string  `json:"graphSlug"`
+	BranchName   string  `json:"branchName"`
+	SourceBranch *string `json:"sourceBranch"`
 }
 
 // DeleteBranchInput represents the input for deleting a branch
@@ -168,8 +486,29 @@ type GetAccountInput struct {
 	Slug string `json:"slug"`
 }
 
-// GetAccountBySlug retrieves an account by slug
+// GetAccountBySlug retrieves an account by slug. Successful lookups are cached
+// for a few minutes, so repeated lookups of the same account during one apply
+// only query the API once.
 func (c *Client) GetAccountBySlug(ctx context.Context, slug string) (*Account, error) {
+	// Concurrent lookups of the account wait for the same fetch, which must
+	// not fail for all of them when the caller that started it is cancelled
+	fetchCtx := context.WithoutCancel(ctx)
+	account, err := c.accounts.loadContext(ctx, slug, func() (Account, error) {
+		account, err := c.getAccountBySlug(fetchCtx, slug)
+		if err != nil {
+			return Account{}, err
+		}
+		return *account, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// getAccountBySlug queries an account by slug, bypassing the cache
+func (c *Client) getAccountBySlug(ctx context.Context, slug string) (*Account, error) {
 	query := `
 		query GetAccount($slug: String!) {
 			accountBySlug(slug: $slug) {
@@ -184,24 +523,20 @@ func (c *Client) GetAccountBySlug(ctx context.Context, slug string) (*Account, e
 		"slug": slug,
 	}
 
-	resp, err := c.ExecuteQuery(ctx, query, variables)
+	account, err := execute[*Account](ctx, c, operation{
+		action: "get account",
+		query:  query,
+		path:   []string{"accountBySlug"},
+	}, variables)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get account: %w", err)
-	}
-
-	var result struct {
-		AccountBySlug *Account `json:"accountBySlug"`
-	}
-
-	if err := json.Unmarshal(resp.Data, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal account response: %w", err)
+		return nil, err
 	}
 
-	if result.AccountBySlug == nil {
+	if account == nil {
 		return nil, fmt.Errorf("account not found")
 	}
 
-	return result.AccountBySlug, nil
+	return account, nil
 }
 
 // DeleteGraphInput represents the input for deleting a graph
@@ -214,33 +549,12 @@ func (c *Client) CreateGraph(ctx context.Context, input CreateGraphInput) (*Grap
 	query := `
 		mutation CreateGraph($input: GraphCreateInput!) {
 			graphCreate(input: $input) {
+				__typename
 				... on GraphCreateSuccess {
-					graph {
-						id
-						slug
-						createdAt
-						account {
-							id
-							slug
-							name
-						}
-					}
-				}
-				... on AccountDoesNotExistError {
-					__typename
-				}
-				... on DisabledAccountError {
-					__typename
-				}
-				... on SlugAlreadyExistsError {
-					__typename
-				}
-				... on SlugInvalidError {
-					__typename
+					graph {` + graphFields + `}
 				}
 				... on SlugTooLongError {
-			    	__typename
-			        maxLength
+					maxLength
 				}
 			}
 		}
@@ -250,50 +564,87 @@ func (c *Client) CreateGraph(ctx context.Context, input CreateGraphInput) (*Grap
 		"input": input,
 	}
 
-	resp, err := c.ExecuteQuery(ctx, query, variables)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create graph: %w", err)
-	}
+	return execute[*Graph](ctx, c, operation{
+		action:  "create graph",
+		query:   query,
+		path:    []string{"graphCreate"},
+		success: "GraphCreateSuccess",
+		field:   "graph",
+	}, variables)
+}
 
-	var result struct {
-		GraphCreate json.RawMessage `json:"graphCreate"`
-	}
+// UpdateGraphInput represents the input for updating a graph
+type UpdateGraphInput struct {
+	ID   string `json:"id"`
+	Slug string `json:"slug"`
+}
 
-	if err := json.Unmarshal(resp.Data, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal create response: %w", err)
-	}
+// UpdateGraph renames a graph in place, keeping its branches and analytics history
+func (c *Client) UpdateGraph(ctx context.Context, input UpdateGraphInput) (*Graph, error) {
+	query := `
+		mutation UpdateGraph($input: GraphUpdateInput!) {
+			graphUpdate(input: $input) {
+				__typename
+				... on GraphUpdateSuccess {
+					graph {` + graphFields + `}
+				}
+				... on SlugTooLongError {
+					maxLength
+				}
+			}
+		}
+	`
 
-	// Try to parse as success response
-	var successResp struct {
-		Graph Graph `json:"graph"`
-	}
-	if err := json.Unmarshal(result.GraphCreate, &successResp); err == nil && successResp.Graph.ID != "" {
-		return &successResp.Graph, nil
+	variables := map[string]interface{}{
+		"input": input,
 	}
 
-	// If not a success response, it's an error
-	var errorResp map[string]interface{}
-	if err := json.Unmarshal(result.GraphCreate, &errorResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+	return execute[*Graph](ctx, c, operation{
+		action:  "update graph",
+		query:   query,
+		path:    []string{"graphUpdate"},
+		success: "GraphUpdateSuccess",
+		field:   "graph",
+	}, variables)
+}
+
+// TransferGraphInput represents the input for transferring a graph to another account
+type TransferGraphInput struct {
+	ID        string `json:"id"`
+	AccountID string `json:"accountId"`
+}
+
+// TransferGraph moves a graph to another account, keeping its branches and analytics history
+func (c *Client) TransferGraph(ctx context.Context, input TransferGraphInput) (*Graph, error) {
+	query := `
+		mutation TransferGraph($input: GraphTransferInput!) {
+			graphTransfer(input: $input) {
+				__typename
+				... on GraphTransferSuccess {
+					graph {` + graphFields + `}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
 	}
 
-	return nil, fmt.Errorf("graph creation failed: %v", errorResp)
+	return execute[*Graph](ctx, c, operation{
+		action:  "transfer graph",
+		query:   query,
+		path:    []string{"graphTransfer"},
+		success: "GraphTransferSuccess",
+		field:   "graph",
+	}, variables)
 }
 
 // GetGraph retrieves a graph by account slug and graph slug
 func (c *Client) GetGraph(ctx context.Context, accountSlug, graphSlug string) (*Graph, error) {
 	query := `
 		query GetGraph($accountSlug: String!, $graphSlug: String!) {
-			graphByAccountSlug(accountSlug: $accountSlug, graphSlug: $graphSlug) {
-				id
-				slug
-				createdAt
-				account {
-					id
-					slug
-					name
-				}
-			}
+			graphByAccountSlug(accountSlug: $accountSlug, graphSlug: $graphSlug) {` + graphFields + `}
 		}
 	`
 
@@ -302,24 +653,20 @@ func (c *Client) GetGraph(ctx context.Context, accountSlug, graphSlug string) (*
 		"graphSlug":   graphSlug,
 	}
 
-	resp, err := c.ExecuteQuery(ctx, query, variables)
+	graph, err := execute[*Graph](ctx, c, operation{
+		action: "get graph",
+		query:  query,
+		path:   []string{"graphByAccountSlug"},
+	}, variables)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get graph: %w", err)
-	}
-
-	var result struct {
-		GraphByAccountSlug *Graph `json:"graphByAccountSlug"`
-	}
-
-	if err := json.Unmarshal(resp.Data, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal get response: %w", err)
+		return nil, err
 	}
 
-	if result.GraphByAccountSlug == nil {
+	if graph == nil {
 		return nil, fmt.Errorf("graph not found")
 	}
 
-	return result.GraphByAccountSlug, nil
+	return graph, nil
 }
 
 // GetGraphByID retrieves a graph by ID using the node query
@@ -327,16 +674,7 @@ func (c *Client) GetGraphByID(ctx context.Context, id string) (*Graph, error) {
 	query := `
 		query GetGraphByID($id: ID!) {
 			node(id: $id) {
-				... on Graph {
-					id
-					slug
-					createdAt
-					account {
-						id
-						slug
-						name
-					}
-				}
+				... on Graph {` + graphFields + `}
 			}
 		}
 	`
@@ -345,24 +683,21 @@ func (c *Client) GetGraphByID(ctx context.Context, id string) (*Graph, error) {
 		"id": id,
 	}
 
-	resp, err := c.ExecuteQuery(ctx, query, variables)
+	graph, err := execute[*Graph](ctx, c, operation{
+		action: "get graph by ID",
+		query:  query,
+		path:   []string{"node"},
+	}, variables)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get graph by ID: %w", err)
+		return nil, err
 	}
 
-	var result struct {
-		Node *Graph `json:"node"`
-	}
-
-	if err := json.Unmarshal(resp.Data, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal get by ID response: %w", err)
-	}
-
-	if result.Node == nil {
+	// Nodes of other types decode without an ID
+	if graph == nil || graph.ID == "" {
 		return nil, fmt.Errorf("graph not found")
 	}
 
-	return result.Node, nil
+	return graph, nil
 }
 
 // DeleteGraph deletes a graph
@@ -370,12 +705,7 @@ func (c *Client) DeleteGraph(ctx context.Context, id string) error {
 	query := `
 		mutation DeleteGraph($input: GraphDeleteInput!) {
 			graphDelete(input: $input) {
-				... on GraphDeleteSuccess {
-					query
-				}
-				... on GraphDoesNotExistError {
-					__typename
-				}
+				__typename
 			}
 		}
 	`
@@ -386,34 +716,14 @@ func (c *Client) DeleteGraph(ctx context.Context, id string) error {
 		},
 	}
 
-	resp, err := c.ExecuteQuery(ctx, query, variables)
-	if err != nil {
-		return fmt.Errorf("failed to delete graph: %w", err)
-	}
-
-	var result struct {
-		GraphDelete json.RawMessage `json:"graphDelete"`
-	}
-
-	if err := json.Unmarshal(resp.Data, &result); err != nil {
-		return fmt.Errorf("failed to unmarshal delete response: %w", err)
-	}
-
-	// Try to parse as success response
-	var successResp struct {
-		DeletedID string `json:"deletedId"`
-	}
-	if err := json.Unmarshal(result.GraphDelete, &successResp); err == nil && successResp.DeletedID != "" {
-		return nil
-	}
-
-	// If not a success response, it's an error
-	var errorResp map[string]interface{}
-	if err := json.Unmarshal(result.GraphDelete, &errorResp); err != nil {
-		return fmt.Errorf("failed to parse delete response: %w", err)
-	}
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "delete graph",
+		query:   query,
+		path:    []string{"graphDelete"},
+		success: "GraphDeleteSuccess",
+	}, variables)
 
-	return fmt.Errorf("graph deletion failed: %v", errorResp)
+	return err
 }
 
 // CreateBranch creates a new branch
@@ -421,6 +731,7 @@ func (c *Client) CreateBranch(ctx context.Context, input CreateBranchInput) (*Br
 	query := `
 		mutation CreateBranch($input: BranchCreateInput!, $accountSlug: String!, $graphSlug: String!, $branchName: String!) {
 			branchCreate(input: $input) {
+				__typename
 				... on Query {
 					branch(accountSlug: $accountSlug, graphSlug: $graphSlug, name: $branchName) {
 						id
@@ -428,21 +739,18 @@ func (c *Client) CreateBranch(ctx context.Context, input CreateBranchInput) (*Br
 						environment
 						operationChecksEnabled
 						operationChecksIgnoreUsageData
+						regions
+						endpointUrl
+						latestDeployment {
+							id
+							status
+						}
 						graph {
 							id
 							slug
 						}
 					}
 				}
-				... on BranchAlreadyExistsError {
-					__typename
-				}
-				... on GraphDoesNotExistError {
-					__typename
-				}
-				... on GraphNotSelfHostedError {
-					__typename
-				}
 			}
 		}
 	`
@@ -454,42 +762,13 @@ func (c *Client) CreateBranch(ctx context.Context, input CreateBranchInput) (*Br
 		"branchName":  input.BranchName,
 	}
 
-	resp, err := c.ExecuteQuery(ctx, query, variables)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create branch: %w", err)
-	}
-
-	var result struct {
-		BranchCreate json.RawMessage `json:"branchCreate"`
-	}
-
-	if err := json.Unmarshal(resp.Data, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal create response: %w", err)
-	}
-
-	// Try to parse as Query type (success response)
-	var successResp struct {
-		Branch Branch `json:"branch"`
-	}
-	if err := json.Unmarshal(result.BranchCreate, &successResp); err == nil && successResp.Branch.ID != "" {
-		return &successResp.Branch, nil
-	}
-
-	// If not a success response, it's an error
-	var errorResp map[string]interface{}
-	if err := json.Unmarshal(result.BranchCreate, &errorResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	if errorResp["__typename"] == "BranchAlreadyExistsError" {
-		return nil, fmt.Errorf("branch already exists")
-	} else if errorResp["__typename"] == "GraphDoesNotExistError" {
-		return nil, fmt.Errorf("graph does not exist")
-	} else if errorResp["__typename"] == "GraphNotSelfHostedError" {
-		return nil, fmt.Errorf("graph is not self-hosted")
-	}
-
-	return nil, fmt.Errorf("branch creation failed: %v", errorResp)
+	return execute[*Branch](ctx, c, operation{
+		action:  "create branch",
+		query:   query,
+		path:    []string{"branchCreate"},
+		success: "Query",
+		field:   "branch",
+	}, variables)
 }
 
 // GetBranch retrieves a branch by account slug, graph slug, and branch name
@@ -502,6 +781,12 @@ func (c *Client) GetBranch(ctx context.Context, accountSlug, graphSlug, branchNa
 				environment
 				operationChecksEnabled
 				operationChecksIgnoreUsageData
+				regions
+				endpointUrl
+				latestDeployment {
+					id
+					status
+				}
 				graph {
 					id
 					slug
@@ -516,78 +801,212 @@ func (c *Client) GetBranch(ctx context.Context, accountSlug, graphSlug, branchNa
 		"branchName":  branchName,
 	}
 
-	resp, err := c.ExecuteQuery(ctx, query, variables)
+	branch, err := execute[*Branch](ctx, c, operation{
+		action: "get branch",
+		query:  query,
+		path:   []string{"branch"},
+	}, variables)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get branch: %w", err)
+		return nil, err
 	}
 
-	var result struct {
-		Branch *Branch `json:"branch"`
+	if branch == nil {
+		return nil, fmt.Errorf("branch not found")
+	}
+
+	return branch, nil
+}
+
+// GetBranchByID retrieves a branch by ID using the node query
+func (c *Client) GetBranchByID(ctx context.Context, id string) (*Branch, error) {
+	query := `
+		query GetBranchByID($id: ID!) {
+			node(id: $id) {
+				... on Branch {
+					id
+					name
+					environment
+					operationChecksEnabled
+					operationChecksIgnoreUsageData
+					regions
+					endpointUrl
+					latestDeployment {
+						id
+						status
+					}
+					graph {
+						id
+						slug
+						account {
+							id
+							slug
+							name
+						}
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
 	}
 
-	if err := json.Unmarshal(resp.Data, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal get response: %w", err)
+	branch, err := execute[*Branch](ctx, c, operation{
+		action: "get branch by ID",
+		query:  query,
+		path:   []string{"node"},
+	}, variables)
+	if err != nil {
+		return nil, err
 	}
 
-	if result.Branch == nil {
+	// Nodes of other types decode without an ID
+	if branch == nil || branch.ID == "" {
 		return nil, fmt.Errorf("branch not found")
 	}
 
-	return result.Branch, nil
+	return branch, nil
 }
 
-// DeleteBranch deletes a branch
-func (c *Client) DeleteBranch(ctx context.Context, input DeleteBranchInput) error {
+// PromoteBranchInput represents the input for promoting a branch to production
+type PromoteBranchInput struct {
+	AccountSlug string `json:"accountSlug"`
+	GraphSlug   string `json:"graphSlug"`
+	BranchName  string `json:"branchName"`
+}
+
+// PromoteBranch makes a branch the production branch of its graph. The previous
+// production branch becomes a preview branch.
+func (c *Client) PromoteBranch(ctx context.Context, input PromoteBranchInput) (*Branch, error) {
 	query := `
-		mutation DeleteBranch($accountSlug: String!, $graphSlug: String!, $branchName: String!) {
-			branchDelete(accountSlug: $accountSlug, graphSlug: $graphSlug, branchName: $branchName) {
+		mutation PromoteBranch($input: BranchPromoteInput!, $accountSlug: String!, $graphSlug: String!, $branchName: String!) {
+			branchPromote(input: $input) {
+				__typename
 				... on Query {
-					__typename
-				}
-				... on BranchDoesNotExistError {
-					__typename
-				}
-				... on CannotDeleteProductionBranchError {
-					__typename
+					branch(accountSlug: $accountSlug, graphSlug: $graphSlug, name: $branchName) {
+						id
+						name
+						environment
+						operationChecksEnabled
+						operationChecksIgnoreUsageData
+						regions
+						endpointUrl
+						latestDeployment {
+							id
+							status
+						}
+						graph {
+							id
+							slug
+						}
+					}
 				}
 			}
 		}
 	`
 
 	variables := map[string]interface{}{
+		"input":       input,
 		"accountSlug": input.AccountSlug,
 		"graphSlug":   input.GraphSlug,
 		"branchName":  input.BranchName,
 	}
 
-	resp, err := c.ExecuteQuery(ctx, query, variables)
-	if err != nil {
-		return fmt.Errorf("failed to delete branch: %w", err)
-	}
+	return execute[*Branch](ctx, c, operation{
+		action:  "promote branch",
+		query:   query,
+		path:    []string{"branchPromote"},
+		success: "Query",
+		field:   "branch",
+	}, variables)
+}
 
-	var result struct {
-		BranchDelete json.RawMessage `json:"branchDelete"`
-	}
+// UpdateBranchRegionsInput represents the input for pinning the regions of a branch's managed gateway
+type UpdateBranchRegionsInput struct {
+	AccountSlug string   `json:"accountSlug"`
+	GraphSlug   string   `json:"graphSlug"`
+	BranchName  string   `json:"branchName"`
+	Regions     []string `json:"regions"`
+}
 
-	if err := json.Unmarshal(resp.Data, &result); err != nil {
-		return fmt.Errorf("failed to unmarshal delete response: %w", err)
-	}
+// UpdateBranchRegions sets the regions the managed gateway of a branch runs in
+func (c *Client) UpdateBranchRegions(ctx context.Context, input UpdateBranchRegionsInput) (*Branch, error) {
+	query := `
+		mutation UpdateBranchRegions($input: BranchRegionsUpdateInput!, $accountSlug: String!, $graphSlug: String!, $branchName: String!) {
+			branchRegionsUpdate(input: $input) {
+				__typename
+				... on Query {
+					branch(accountSlug: $accountSlug, graphSlug: $graphSlug, name: $branchName) {
+						id
+						name
+						environment
+						operationChecksEnabled
+						operationChecksIgnoreUsageData
+						regions
+						endpointUrl
+						latestDeployment {
+							id
+							status
+						}
+						graph {
+							id
+							slug
+						}
+					}
+				}
+				... on UnknownRegionError {
+					region
+				}
+			}
+		}
+	`
 
-	// Parse the response to check for errors
-	var deleteResp map[string]interface{}
-	if err := json.Unmarshal(result.BranchDelete, &deleteResp); err != nil {
-		return fmt.Errorf("failed to parse delete response: %w", err)
+	variables := map[string]interface{}{
+		"input":       input,
+		"accountSlug": input.AccountSlug,
+		"graphSlug":   input.GraphSlug,
+		"branchName":  input.BranchName,
 	}
 
-	typename := deleteResp["__typename"].(string)
-	if typename == "Query" {
-		// Success
-		return nil
-	} else if typename == "BranchDoesNotExistError" {
-		return fmt.Errorf("branch does not exist")
-	} else if typename == "CannotDeleteProductionBranchError" {
-		return fmt.Errorf("cannot delete production branch")
+	return execute[*Branch](ctx, c, operation{
+		action:  "update branch regions",
+		query:   query,
+		path:    []string{"branchRegionsUpdate"},
+		success: "Query",
+		field:   "branch",
+		errors: map[string]string{
+			"UnknownRegionError":   "unknown region ${region}",
+			"GraphNotManagedError": "regions can only be configured for graphs with a managed gateway",
+		},
+	}, variables)
+}
+
+// DeleteBranch deletes a branch
+func (c *Client) DeleteBranch(ctx context.Context, input DeleteBranchInput) error {
+	query := `
+		mutation DeleteBranch($accountSlug: String!, $graphSlug: String!, $branchName: String!) {
+			branchDelete(accountSlug: $accountSlug, graphSlug: $graphSlug, branchName: $branchName) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": input.AccountSlug,
+		"graphSlug":   input.GraphSlug,
+		"branchName":  input.BranchName,
 	}
 
-	return fmt.Errorf("branch deletion failed: %v", deleteResp)
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "delete branch",
+		query:   query,
+		path:    []string{"branchDelete"},
+		success: "Query",
+		errors: map[string]string{
+			"CannotDeleteProductionBranchError": "cannot delete production branch",
+		},
+	}, variables)
+
+	return err
 }
@@ -0,0 +1,472 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/mockgraphql"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// newTestClient returns a client sending its requests to a mock GraphQL server
+func newTestClient(t *testing.T) (*Client, *mockgraphql.Server) {
+	t.Helper()
+
+	server := mockgraphql.NewServer(t)
+
+	return NewClient("test-api-key", WithAPIURL(server.URL)), server
+}
+
+const testGraphJSON = `{
+	"id": "graph-1",
+	"slug": "my-graph",
+	"createdAt": "2024-01-15T10:30:00Z",
+	"productionBranch": {"name": "main"},
+	"endpointUrl": "https://my-graph-my-account.grafbase.app/graphql",
+	"dashboardUrl": "https://app.grafbase.com/my-account/my-graph",
+	"account": {"id": "account-1", "slug": "my-account", "name": "My Account"}
+}`
+
+const testBranchJSON = `{
+	"id": "branch-1",
+	"name": "main",
+	"environment": "PRODUCTION",
+	"operationChecksEnabled": true,
+	"operationChecksIgnoreUsageData": false,
+	"regions": ["iad", "fra"],
+	"endpointUrl": "https://my-graph-main-my-account.grafbase.app/graphql",
+	"latestDeployment": {"id": "deployment-1", "status": "HEALTHY"},
+	"graph": {"id": "graph-1", "slug": "my-graph"}
+}`
+
+func TestExecuteQuery(t *testing.T) {
+	c, server := newTestClient(t)
+	server.Handle("GetAccount", `{"accountBySlug": {"id": "account-1", "slug": "my-account", "name": "My Account"}}`)
+
+	if _, err := c.GetAccountBySlug(context.Background(), "my-account"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	req := server.LastRequest("GetAccount")
+	if got := req.Header.Get("Authorization"); got != "Bearer test-api-key" {
+		t.Errorf("expected bearer authorization, got %q", got)
+	}
+	if got := req.Header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("expected JSON content type, got %q", got)
+	}
+	if got := req.Variables["slug"]; got != "my-account" {
+		t.Errorf("expected slug variable, got %v", got)
+	}
+	if got := req.Header.Get("User-Agent"); got != DefaultUserAgent {
+		t.Errorf("expected default user agent, got %q", got)
+	}
+	if got := req.Header.Get("X-Request-Id"); len(got) != 32 {
+		t.Errorf("expected a request ID, got %q", got)
+	}
+}
+
+func TestExecuteQueryUserAgent(t *testing.T) {
+	server := mockgraphql.NewServer(t)
+	c := NewClient("test-api-key", WithAPIURL(server.URL), WithUserAgent("terraform-provider-grafbase/1.2.3 terraform/1.9.0"))
+
+	server.Handle("GetGraph", `{"graphByAccountSlug": `+testGraphJSON+`}`)
+	if _, err := c.GetGraph(context.Background(), "my-account", "my-graph"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.GetGraph(context.Background(), "my-account", "my-graph"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	requests := server.Requests("GetGraph")
+	if got := requests[0].Header.Get("User-Agent"); got != "terraform-provider-grafbase/1.2.3 terraform/1.9.0" {
+		t.Errorf("expected configured user agent, got %q", got)
+	}
+	if requests[0].Header.Get("X-Request-Id") == requests[1].Header.Get("X-Request-Id") {
+		t.Error("expected every call to have its own request ID")
+	}
+}
+
+func TestExecuteQueryTelemetryDisabled(t *testing.T) {
+	server := mockgraphql.NewServer(t)
+	c := NewClient("test-api-key", WithAPIURL(server.URL), WithUserAgent("terraform-provider-grafbase/1.2.3 terraform/1.9.0"), WithTelemetryDisabled())
+
+	previous := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTextMapPropagator(previous) })
+
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	server.Handle("GetGraph", `{"graphByAccountSlug": `+testGraphJSON+`}`)
+	if _, err := c.GetGraph(ctx, "my-account", "my-graph"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	req := server.LastRequest("GetGraph")
+	if got := req.Header.Get("User-Agent"); got != DefaultUserAgent {
+		t.Errorf("expected the user agent without versions, got %q", got)
+	}
+	if got := req.Header.Get("Traceparent"); got != "" {
+		t.Errorf("expected no trace context, got %q", got)
+	}
+	if req.Header.Get("X-Request-Id") == "" {
+		t.Error("expected the request ID to still be sent")
+	}
+}
+
+func TestExecuteQueryErrors(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.HandleErrors("GetAccount", "not authorized")
+	_, err := c.GetAccountBySlug(ctx, "my-account")
+	if err == nil || !strings.HasPrefix(err.Error(), "failed to get account: GraphQL errors: [not authorized]") {
+		t.Errorf("expected GraphQL error, got %v", err)
+	}
+	var requestErr *RequestError
+	if !errors.As(err, &requestErr) || requestErr.RequestID != server.LastRequest("GetAccount").Header.Get("X-Request-Id") {
+		t.Errorf("expected the error to carry the request ID, got %v", err)
+	}
+
+	server.HandleFunc("GetAccount", func(mockgraphql.Request) mockgraphql.Response {
+		return mockgraphql.Response{StatusCode: http.StatusUnauthorized}
+	})
+	if _, err := c.GetAccountBySlug(ctx, "my-account"); err == nil {
+		t.Error("expected error for unauthorized status")
+	}
+}
+
+func TestExecuteQueryErrorsUseServerRequestID(t *testing.T) {
+	c, server := newTestClient(t)
+
+	server.HandleFunc("GetAccount", func(mockgraphql.Request) mockgraphql.Response {
+		return mockgraphql.Response{
+			StatusCode: http.StatusForbidden,
+			Header:     http.Header{"X-Request-Id": []string{"server-id"}},
+		}
+	})
+	_, err := c.GetAccountBySlug(context.Background(), "my-account")
+	if err == nil || !strings.HasSuffix(err.Error(), "(request ID server-id)") {
+		t.Errorf("expected the server-assigned request ID in the error, got %v", err)
+	}
+}
+
+func TestExchangeOIDCToken(t *testing.T) {
+	server := mockgraphql.NewServer(t)
+	c := NewClient("", WithAPIURL(server.URL))
+	ctx := context.Background()
+
+	server.Handle("ExchangeOIDCToken", `{"accessTokenExchange": {"__typename": "AccessTokenExchangeSuccess", "accessToken": "short-lived"}}`)
+	token, err := c.ExchangeOIDCToken(ctx, "id-token")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token != "short-lived" {
+		t.Errorf("expected exchanged token, got %q", token)
+	}
+
+	req := server.LastRequest("ExchangeOIDCToken")
+	if got := req.Header.Get("Authorization"); got != "" {
+		t.Errorf("expected no authorization header, got %q", got)
+	}
+	var input ExchangeOIDCTokenInput
+	if err := req.Input(&input); err != nil || input.IDToken != "id-token" {
+		t.Errorf("expected ID token input, got %+v (%v)", input, err)
+	}
+
+	server.Handle("ExchangeOIDCToken", `{"accessTokenExchange": {"__typename": "TrustedPublisherNotFoundError"}}`)
+	if _, err := c.ExchangeOIDCToken(ctx, "id-token"); err == nil || errorMessage(err) != "no trusted publisher matches the OIDC token" {
+		t.Errorf("expected trusted publisher error, got %v", err)
+	}
+}
+
+func TestGetAccountBySlug(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetAccount", `{"accountBySlug": {"id": "account-1", "slug": "my-account", "name": "My Account"}}`)
+	account, err := c.GetAccountBySlug(ctx, "my-account")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if account.ID != "account-1" || account.Name != "My Account" {
+		t.Errorf("unexpected account: %+v", account)
+	}
+
+	server.Handle("GetAccount", `{"accountBySlug": null}`)
+	if _, err := c.GetAccountBySlug(ctx, "missing"); err == nil || errorMessage(err) != "account not found" {
+		t.Errorf("expected account not found, got %v", err)
+	}
+}
+
+func TestCreateGraph(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("CreateGraph", `{"graphCreate": {"__typename": "GraphCreateSuccess", "graph": `+testGraphJSON+`}}`)
+	graph, err := c.CreateGraph(ctx, CreateGraphInput{AccountID: "account-1", GraphSlug: "my-graph"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if graph.ID != "graph-1" || graph.Account.Slug != "my-account" {
+		t.Errorf("unexpected graph: %+v", graph)
+	}
+
+	var input CreateGraphInput
+	if err := server.LastRequest("CreateGraph").Input(&input); err != nil || input.GraphSlug != "my-graph" {
+		t.Errorf("unexpected input: %+v (%v)", input, err)
+	}
+
+	server.Handle("CreateGraph", `{"graphCreate": {"__typename": "SlugTooLongError", "maxLength": 48}}`)
+	_, err = c.CreateGraph(ctx, CreateGraphInput{AccountID: "account-1", GraphSlug: "my-graph"})
+	var slugErr *SlugTooLongError
+	if !errors.As(err, &slugErr) || slugErr.MaxLength != 48 {
+		t.Errorf("expected slug too long error, got %v", err)
+	}
+}
+
+func TestUpdateGraph(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("UpdateGraph", `{"graphUpdate": {"__typename": "GraphUpdateSuccess", "graph": `+testGraphJSON+`}}`)
+	if _, err := c.UpdateGraph(ctx, UpdateGraphInput{ID: "graph-1", Slug: "my-graph"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("UpdateGraph", `{"graphUpdate": {"__typename": "SlugAlreadyExistsError"}}`)
+	_, err := c.UpdateGraph(ctx, UpdateGraphInput{ID: "graph-1", Slug: "taken"})
+	var existsErr *SlugAlreadyExistsError
+	if !errors.As(err, &existsErr) {
+		t.Errorf("expected slug already exists error, got %v", err)
+	}
+}
+
+func TestTransferGraph(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("TransferGraph", `{"graphTransfer": {"__typename": "GraphTransferSuccess", "graph": {"id": "graph-1", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z",
+		"account": {"id": "account-2", "slug": "my-org", "name": "My Org"}}}}`)
+	graph, err := c.TransferGraph(ctx, TransferGraphInput{ID: "graph-1", AccountID: "account-2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if graph.Account.Slug != "my-org" {
+		t.Errorf("expected graph in my-org, got %+v", graph.Account)
+	}
+
+	var input TransferGraphInput
+	if err := server.LastRequest("TransferGraph").Input(&input); err != nil {
+		t.Fatalf("unable to decode input: %v", err)
+	}
+	if input.AccountID != "account-2" {
+		t.Errorf("expected transfer to account-2, got %+v", input)
+	}
+
+	server.Handle("TransferGraph", `{"graphTransfer": {"__typename": "GraphTransferNotAllowedError"}}`)
+	_, err = c.TransferGraph(ctx, TransferGraphInput{ID: "graph-1", AccountID: "account-3"})
+	var notAllowedErr *GraphTransferNotAllowedError
+	if !errors.As(err, &notAllowedErr) {
+		t.Errorf("expected graph transfer not allowed error, got %v", err)
+	}
+}
+
+func TestGetGraph(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetGraph", `{"graphByAccountSlug": `+testGraphJSON+`}`)
+	graph, err := c.GetGraph(ctx, "my-account", "my-graph")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if graph.Slug != "my-graph" || graph.CreatedAt.IsZero() {
+		t.Errorf("unexpected graph: %+v", graph)
+	}
+	if graph.ProductionBranch == nil || graph.ProductionBranch.Name != "main" || graph.EndpointURL != "https://my-graph-my-account.grafbase.app/graphql" {
+		t.Errorf("unexpected production branch and endpoint: %+v", graph)
+	}
+
+	server.Handle("GetGraph", `{"graphByAccountSlug": null}`)
+	if _, err := c.GetGraph(ctx, "my-account", "missing"); err == nil || errorMessage(err) != "graph not found" {
+		t.Errorf("expected graph not found, got %v", err)
+	}
+}
+
+func TestGetGraphByID(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetGraphByID", `{"node": `+testGraphJSON+`}`)
+	if _, err := c.GetGraphByID(ctx, "graph-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := server.LastRequest("GetGraphByID").Variables["id"]; got != "graph-1" {
+		t.Errorf("expected id variable, got %v", got)
+	}
+
+	server.Handle("GetGraphByID", `{"node": null}`)
+	if _, err := c.GetGraphByID(ctx, "missing"); err == nil || errorMessage(err) != "graph not found" {
+		t.Errorf("expected graph not found, got %v", err)
+	}
+
+	// IDs of other node types resolve to an empty object
+	server.Handle("GetGraphByID", `{"node": {}}`)
+	if _, err := c.GetGraphByID(ctx, "branch-1"); err == nil || errorMessage(err) != "graph not found" {
+		t.Errorf("expected graph not found, got %v", err)
+	}
+}
+
+func TestDeleteGraph(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("DeleteGraph", `{"graphDelete": {"__typename": "GraphDeleteSuccess", "deletedId": "graph-1"}}`)
+	if err := c.DeleteGraph(ctx, "graph-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("DeleteGraph", `{"graphDelete": {"__typename": "GraphDoesNotExistError"}}`)
+	if err := c.DeleteGraph(ctx, "graph-1"); err == nil {
+		t.Error("expected error for missing graph")
+	}
+}
+
+func TestCreateBranch(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("CreateBranch", `{"branchCreate": {"__typename": "Query", "branch": `+testBranchJSON+`}}`)
+	branch, err := c.CreateBranch(ctx, CreateBranchInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if branch.Environment != BranchEnvironmentProduction || len(branch.Regions) != 2 {
+		t.Errorf("unexpected branch: %+v", branch)
+	}
+	if got := server.LastRequest("CreateBranch").Variables["branchName"]; got != "main" {
+		t.Errorf("expected branchName variable, got %v", got)
+	}
+
+	sourceBranch := "main"
+	server.Handle("CreateBranch", `{"branchCreate": {"__typename": "SourceBranchDoesNotExistError"}}`)
+	_, err = c.CreateBranch(ctx, CreateBranchInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "feature", SourceBranch: &sourceBranch})
+	var sourceErr *SourceBranchDoesNotExistError
+	if !errors.As(err, &sourceErr) {
+		t.Errorf("expected source branch does not exist error, got %v", err)
+	}
+	if got := server.LastRequest("CreateBranch").Variables["input"].(map[string]interface{})["sourceBranch"]; got != "main" {
+		t.Errorf("expected sourceBranch main, got %v", got)
+	}
+
+	server.Handle("CreateBranch", `{"branchCreate": {"__typename": "BranchAlreadyExistsError"}}`)
+	_, err = c.CreateBranch(ctx, CreateBranchInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main"})
+	var existsErr *BranchAlreadyExistsError
+	if !errors.As(err, &existsErr) {
+		t.Errorf("expected branch already exists error, got %v", err)
+	}
+}
+
+func TestGetBranch(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetBranch", `{"branch": `+testBranchJSON+`}`)
+	branch, err := c.GetBranch(ctx, "my-account", "my-graph", "main")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if branch.Name != "main" || !branch.OperationChecksEnabled {
+		t.Errorf("unexpected branch: %+v", branch)
+	}
+	if branch.LatestDeployment == nil || branch.LatestDeployment.Status != DeploymentStatusHealthy {
+		t.Errorf("expected a healthy latest deployment, got %+v", branch.LatestDeployment)
+	}
+
+	server.Handle("GetBranch", `{"branch": null}`)
+	if _, err := c.GetBranch(ctx, "my-account", "my-graph", "missing"); err == nil || errorMessage(err) != "branch not found" {
+		t.Errorf("expected branch not found, got %v", err)
+	}
+}
+
+func TestGetBranchByID(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetBranchByID", `{"node": {"id": "branch-1", "name": "feature/login", "environment": "PREVIEW", "regions": [],
+		"graph": {"id": "graph-1", "slug": "my-graph", "account": {"id": "account-1", "slug": "my-account", "name": "My Account"}}}}`)
+	branch, err := c.GetBranchByID(ctx, "branch-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if branch.Name != "feature/login" || branch.Graph.Account.Slug != "my-account" {
+		t.Errorf("unexpected branch: %+v", branch)
+	}
+
+	// IDs of other node types resolve to an empty object
+	server.Handle("GetBranchByID", `{"node": {}}`)
+	if _, err := c.GetBranchByID(ctx, "graph-1"); err == nil || errorMessage(err) != "branch not found" {
+		t.Errorf("expected branch not found, got %v", err)
+	}
+}
+
+func TestPromoteBranch(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("PromoteBranch", `{"branchPromote": {"__typename": "Query", "branch": `+testBranchJSON+`}}`)
+	if _, err := c.PromoteBranch(ctx, PromoteBranchInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("PromoteBranch", `{"branchPromote": {"__typename": "BranchDoesNotExistError"}}`)
+	if _, err := c.PromoteBranch(ctx, PromoteBranchInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main"}); err == nil || errorMessage(err) != "branch does not exist" {
+		t.Errorf("expected branch does not exist, got %v", err)
+	}
+}
+
+func TestUpdateBranchRegions(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("UpdateBranchRegions", `{"branchRegionsUpdate": {"__typename": "Query", "branch": `+testBranchJSON+`}}`)
+	input := UpdateBranchRegionsInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main", Regions: []string{"iad", "fra"}}
+	if _, err := c.UpdateBranchRegions(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var sent UpdateBranchRegionsInput
+	if err := server.LastRequest("UpdateBranchRegions").Input(&sent); err != nil || len(sent.Regions) != 2 {
+		t.Errorf("unexpected input: %+v (%v)", sent, err)
+	}
+
+	server.Handle("UpdateBranchRegions", `{"branchRegionsUpdate": {"__typename": "UnknownRegionError", "region": "xyz"}}`)
+	if _, err := c.UpdateBranchRegions(ctx, input); err == nil || errorMessage(err) != "unknown region xyz" {
+		t.Errorf("expected unknown region error, got %v", err)
+	}
+}
+
+func TestDeleteBranch(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := DeleteBranchInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "feature"}
+
+	server.Handle("DeleteBranch", `{"branchDelete": {"__typename": "Query"}}`)
+	if err := c.DeleteBranch(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("DeleteBranch", `{"branchDelete": {"__typename": "CannotDeleteProductionBranchError"}}`)
+	if err := c.DeleteBranch(ctx, input); err == nil || errorMessage(err) != "cannot delete production branch" {
+		t.Errorf("expected production branch error, got %v", err)
+	}
+}
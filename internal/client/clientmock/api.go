@@ -0,0 +1,1214 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package clientmock
+
+import (
+	"context"
+	"time"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+)
+
+// API is a mock of client.API. Each method calls the field of the same name
+// with a Func suffix, and panics when the field is not set.
+type API struct {
+	CreateAccessTokenFunc                func(ctx context.Context, input client.CreateAccessTokenInput) (*client.AccessToken, error)
+	RevokeAccessTokenFunc                func(ctx context.Context, id string) error
+	CreateAPIKeyFunc                     func(ctx context.Context, input client.CreateAPIKeyInput) (*client.APIKey, string, error)
+	GetAPIKeyFunc                        func(ctx context.Context, id string) (*client.APIKey, error)
+	RevokeAPIKeyFunc                     func(ctx context.Context, id string) error
+	ListAuditLogsFunc                    func(ctx context.Context, accountSlug string, filter client.AuditLogFilter) ([]client.AuditLogEntry, error)
+	CreateAutoBranchingRuleFunc          func(ctx context.Context, input client.CreateAutoBranchingRuleInput) (*client.AutoBranchingRule, error)
+	UpdateAutoBranchingRuleFunc          func(ctx context.Context, input client.UpdateAutoBranchingRuleInput) (*client.AutoBranchingRule, error)
+	GetAutoBranchingRuleFunc             func(ctx context.Context, id string) (*client.AutoBranchingRule, error)
+	DeleteAutoBranchingRuleFunc          func(ctx context.Context, id string) error
+	GetInvoiceUsageFunc                  func(ctx context.Context, accountSlug string) (*client.InvoiceUsage, error)
+	SetBranchAliasFunc                   func(ctx context.Context, input client.SetBranchAliasInput) (*client.BranchAlias, error)
+	GetBranchAliasFunc                   func(ctx context.Context, accountSlug string, graphSlug string, name string) (*client.BranchAlias, error)
+	DeleteBranchAliasFunc                func(ctx context.Context, input client.DeleteBranchAliasInput) error
+	GetBranchProtectionFunc              func(ctx context.Context, accountSlug string, graphSlug string, branchName string) (*client.BranchProtection, error)
+	SetBranchProtectionFunc              func(ctx context.Context, input client.SetBranchProtectionInput) (*client.BranchProtection, error)
+	DeleteBranchProtectionFunc           func(ctx context.Context, input client.DeleteBranchProtectionInput) error
+	SetAPIBudgetFunc                     func(ctx context.Context, input client.SetAPIBudgetInput) (*client.APIBudget, error)
+	GetAPIBudgetFunc                     func(ctx context.Context, accountSlug string, graphSlug string) (*client.APIBudget, error)
+	DeleteAPIBudgetFunc                  func(ctx context.Context, input client.DeleteAPIBudgetInput) error
+	GetAccountBySlugFunc                 func(ctx context.Context, slug string) (*client.Account, error)
+	CreateGraphFunc                      func(ctx context.Context, input client.CreateGraphInput) (*client.Graph, error)
+	UpdateGraphFunc                      func(ctx context.Context, input client.UpdateGraphInput) (*client.Graph, error)
+	TransferGraphFunc                    func(ctx context.Context, input client.TransferGraphInput) (*client.Graph, error)
+	GetGraphFunc                         func(ctx context.Context, accountSlug string, graphSlug string) (*client.Graph, error)
+	GetGraphByIDFunc                     func(ctx context.Context, id string) (*client.Graph, error)
+	DeleteGraphFunc                      func(ctx context.Context, id string) error
+	CreateBranchFunc                     func(ctx context.Context, input client.CreateBranchInput) (*client.Branch, error)
+	GetBranchFunc                        func(ctx context.Context, accountSlug string, graphSlug string, branchName string) (*client.Branch, error)
+	GetBranchByIDFunc                    func(ctx context.Context, id string) (*client.Branch, error)
+	PromoteBranchFunc                    func(ctx context.Context, input client.PromoteBranchInput) (*client.Branch, error)
+	UpdateBranchRegionsFunc              func(ctx context.Context, input client.UpdateBranchRegionsInput) (*client.Branch, error)
+	DeleteBranchFunc                     func(ctx context.Context, input client.DeleteBranchInput) error
+	GetLatestBranchCompositionFunc       func(ctx context.Context, accountSlug string, graphSlug string, branchName string) (*client.Composition, error)
+	GetDefaultBranchSettingsFunc         func(ctx context.Context, accountSlug string, graphSlug string) (*client.DefaultBranchSettings, error)
+	SetDefaultBranchSettingsFunc         func(ctx context.Context, input client.SetDefaultBranchSettingsInput) (*client.DefaultBranchSettings, error)
+	GetLatestBranchDeploymentFunc        func(ctx context.Context, accountSlug string, graphSlug string, branchName string) (*client.Deployment, error)
+	CreateCustomDomainFunc               func(ctx context.Context, input client.CreateCustomDomainInput) (*client.CustomDomain, error)
+	GetCustomDomainFunc                  func(ctx context.Context, id string) (*client.CustomDomain, error)
+	DeleteCustomDomainFunc               func(ctx context.Context, id string) error
+	GetBranchEnvironmentVariablesFunc    func(ctx context.Context, accountSlug string, graphSlug string, branchName string) ([]client.EnvironmentVariable, error)
+	UpsertBranchEnvironmentVariablesFunc func(ctx context.Context, input client.UpsertBranchEnvironmentVariablesInput) error
+	DeleteBranchEnvironmentVariablesFunc func(ctx context.Context, input client.DeleteBranchEnvironmentVariablesInput) error
+	GetBranchFeatureFlagsFunc            func(ctx context.Context, accountSlug string, graphSlug string, branchName string) ([]client.FeatureFlag, error)
+	SetBranchFeatureFlagsFunc            func(ctx context.Context, input client.SetBranchFeatureFlagsInput) ([]client.FeatureFlag, error)
+	SetGatewayConfigFunc                 func(ctx context.Context, input client.SetGatewayConfigInput) (*client.GatewayConfig, error)
+	GetGatewayConfigFunc                 func(ctx context.Context, accountSlug string, graphSlug string, branchName string) (*client.GatewayConfig, error)
+	DeleteGatewayConfigFunc              func(ctx context.Context, input client.DeleteGatewayConfigInput) error
+	ListGatewayVersionsFunc              func(ctx context.Context) ([]client.GatewayVersion, error)
+	AddGraphCollaboratorFunc             func(ctx context.Context, input client.AddGraphCollaboratorInput) (*client.GraphCollaborator, error)
+	GetGraphCollaboratorFunc             func(ctx context.Context, id string) (*client.GraphCollaborator, error)
+	UpdateGraphCollaboratorRoleFunc      func(ctx context.Context, input client.UpdateGraphCollaboratorRoleInput) (*client.GraphCollaborator, error)
+	RemoveGraphCollaboratorFunc          func(ctx context.Context, id string) error
+	CreateGraphKeyFunc                   func(ctx context.Context, input client.CreateGraphKeyInput) (*client.GraphKey, string, error)
+	GetGraphKeyFunc                      func(ctx context.Context, id string) (*client.GraphKey, error)
+	RevokeGraphKeyFunc                   func(ctx context.Context, id string) error
+	GetGraphSettingsFunc                 func(ctx context.Context, accountSlug string, graphSlug string) (*client.GraphSettings, error)
+	SetGraphSettingsFunc                 func(ctx context.Context, input client.SetGraphSettingsInput) (*client.GraphSettings, error)
+	GetGraphUsageFunc                    func(ctx context.Context, accountSlug string, graphSlug string, from time.Time, to time.Time) (*client.GraphUsage, error)
+	GetMCPEndpointFunc                   func(ctx context.Context, accountSlug string, graphSlug string, branchName string) (*client.MCPEndpoint, error)
+	SetMCPEndpointFunc                   func(ctx context.Context, input client.SetMCPEndpointInput) (*client.MCPEndpoint, error)
+	InviteAccountMemberFunc              func(ctx context.Context, input client.InviteAccountMemberInput) (*client.AccountMember, error)
+	ListAccountMembersFunc               func(ctx context.Context, accountSlug string) ([]client.AccountMember, error)
+	GetAccountMemberFunc                 func(ctx context.Context, accountSlug string, email string) (*client.AccountMember, error)
+	UpdateAccountMemberRoleFunc          func(ctx context.Context, input client.UpdateAccountMemberRoleInput) (*client.AccountMember, error)
+	RemoveAccountMemberFunc              func(ctx context.Context, input client.RemoveAccountMemberInput) error
+	CreateNotificationChannelFunc        func(ctx context.Context, input client.CreateNotificationChannelInput) (*client.NotificationChannel, error)
+	UpdateNotificationChannelFunc        func(ctx context.Context, input client.UpdateNotificationChannelInput) (*client.NotificationChannel, error)
+	GetNotificationChannelFunc           func(ctx context.Context, id string) (*client.NotificationChannel, error)
+	DeleteNotificationChannelFunc        func(ctx context.Context, id string) error
+	GetOperationChecksConfigFunc         func(ctx context.Context, accountSlug string, graphSlug string, branchName string) (*client.OperationChecksConfig, error)
+	SetOperationChecksConfigFunc         func(ctx context.Context, input client.SetOperationChecksConfigInput) (*client.OperationChecksConfig, error)
+	CreateOperationCheckExceptionFunc    func(ctx context.Context, input client.CreateOperationCheckExceptionInput) (*client.OperationCheckException, error)
+	UpdateOperationCheckExceptionFunc    func(ctx context.Context, input client.UpdateOperationCheckExceptionInput) (*client.OperationCheckException, error)
+	GetOperationCheckExceptionFunc       func(ctx context.Context, id string) (*client.OperationCheckException, error)
+	DeleteOperationCheckExceptionFunc    func(ctx context.Context, id string) error
+	ListGraphsFunc                       func(ctx context.Context, accountSlug string) ([]client.Graph, error)
+	SearchGraphsFunc                     func(ctx context.Context, accountSlug string, filter client.GraphFilter) ([]client.Graph, error)
+	ListBranchesFunc                     func(ctx context.Context, accountSlug string, graphSlug string) ([]client.Branch, error)
+	ListSubgraphsFunc                    func(ctx context.Context, accountSlug string, graphSlug string, branchName string) ([]client.Subgraph, error)
+	ListRegionsFunc                      func(ctx context.Context) ([]client.Region, error)
+	CreateRequestLoggingRuleFunc         func(ctx context.Context, input client.CreateRequestLoggingRuleInput) (*client.RequestLoggingRule, error)
+	UpdateRequestLoggingRuleFunc         func(ctx context.Context, input client.UpdateRequestLoggingRuleInput) (*client.RequestLoggingRule, error)
+	GetRequestLoggingRuleFunc            func(ctx context.Context, id string) (*client.RequestLoggingRule, error)
+	DeleteRequestLoggingRuleFunc         func(ctx context.Context, id string) error
+	GetFederatedSchemaFunc               func(ctx context.Context, accountSlug string, graphSlug string, branchName string) (string, error)
+	GetSubgraphSchemaFunc                func(ctx context.Context, accountSlug string, graphSlug string, branchName string, subgraphName string) (*client.PublishedSubgraph, error)
+	DiffSubgraphSchemaFunc               func(ctx context.Context, accountSlug string, graphSlug string, branchName string, subgraphName string, schema string) ([]client.SchemaChange, error)
+	DeleteSubgraphFunc                   func(ctx context.Context, input client.DeleteSubgraphInput) error
+	PublishSubgraphFunc                  func(ctx context.Context, input client.PublishSubgraphInput) (*client.SchemaVersion, error)
+	CreateSchemaCheckFunc                func(ctx context.Context, input client.SchemaCheckInput) (*client.SchemaCheck, error)
+	GetLatestOperationCheckResultFunc    func(ctx context.Context, accountSlug string, graphSlug string, branchName string, subgraphName string) (*client.OperationCheckResult, error)
+	SetSchemaContractFunc                func(ctx context.Context, input client.SetSchemaContractInput) (*client.SchemaContract, error)
+	GetSchemaContractFunc                func(ctx context.Context, accountSlug string, graphSlug string, branchName string, name string) (*client.SchemaContract, error)
+	DeleteSchemaContractFunc             func(ctx context.Context, input client.DeleteSchemaContractInput) error
+	CreateSchemaProposalFunc             func(ctx context.Context, input client.CreateSchemaProposalInput) (*client.SchemaProposal, error)
+	GetSchemaProposalFunc                func(ctx context.Context, id string) (*client.SchemaProposal, error)
+	UpdateSchemaProposalFunc             func(ctx context.Context, input client.UpdateSchemaProposalInput) (*client.SchemaProposal, error)
+	CloseSchemaProposalFunc              func(ctx context.Context, id string) error
+	CreateSchemaRegistryMirrorFunc       func(ctx context.Context, input client.CreateSchemaRegistryMirrorInput) (*client.SchemaRegistryMirror, error)
+	UpdateSchemaRegistryMirrorFunc       func(ctx context.Context, input client.UpdateSchemaRegistryMirrorInput) (*client.SchemaRegistryMirror, error)
+	GetSchemaRegistryMirrorFunc          func(ctx context.Context, id string) (*client.SchemaRegistryMirror, error)
+	DeleteSchemaRegistryMirrorFunc       func(ctx context.Context, id string) error
+	SetSchemaTagFunc                     func(ctx context.Context, input client.SetSchemaTagInput) (*client.SchemaTag, error)
+	GetSchemaTagFunc                     func(ctx context.Context, accountSlug string, graphSlug string, name string) (*client.SchemaTag, error)
+	DeleteSchemaTagFunc                  func(ctx context.Context, input client.DeleteSchemaTagInput) error
+	SetSubgraphHeadersFunc               func(ctx context.Context, input client.SetSubgraphHeadersInput) (*client.SubgraphHeaders, error)
+	GetSubgraphHeadersFunc               func(ctx context.Context, accountSlug string, graphSlug string, branchName string, subgraphName string) (*client.SubgraphHeaders, error)
+	DeleteSubgraphHeadersFunc            func(ctx context.Context, input client.DeleteSubgraphHeadersInput) error
+	SetSubgraphRoutingOverrideFunc       func(ctx context.Context, input client.SetSubgraphRoutingOverrideInput) (*client.SubgraphRoutingOverride, error)
+	GetSubgraphRoutingOverrideFunc       func(ctx context.Context, accountSlug string, graphSlug string, branchName string, subgraphName string) (*client.SubgraphRoutingOverride, error)
+	DeleteSubgraphRoutingOverrideFunc    func(ctx context.Context, input client.DeleteSubgraphRoutingOverrideInput) error
+	ListSubgraphRoutingOverridesFunc     func(ctx context.Context, accountSlug string, graphSlug string, branchName string) ([]client.SubgraphRoutingOverride, error)
+	LookupSubgraphRoutingOverrideFunc    func(ctx context.Context, accountSlug string, graphSlug string, branchName string, subgraphName string) (*client.SubgraphRoutingOverride, error)
+	CreateTeamFunc                       func(ctx context.Context, input client.CreateTeamInput) (*client.Team, error)
+	UpdateTeamFunc                       func(ctx context.Context, input client.UpdateTeamInput) (*client.Team, error)
+	GetTeamFunc                          func(ctx context.Context, accountSlug string, teamSlug string) (*client.Team, error)
+	DeleteTeamFunc                       func(ctx context.Context, id string) error
+	AddTeamMemberFunc                    func(ctx context.Context, input client.AddTeamMemberInput) (*client.TeamMember, error)
+	ListTeamMembersFunc                  func(ctx context.Context, accountSlug string, teamSlug string) ([]client.TeamMember, error)
+	GetTeamMemberFunc                    func(ctx context.Context, accountSlug string, teamSlug string, email string) (*client.TeamMember, error)
+	UpdateTeamMemberRoleFunc             func(ctx context.Context, input client.UpdateTeamMemberRoleInput) (*client.TeamMember, error)
+	RemoveTeamMemberFunc                 func(ctx context.Context, id string) error
+	GetTokenPolicyFunc                   func(ctx context.Context, accountSlug string) (*client.TokenPolicy, error)
+	SetTokenPolicyFunc                   func(ctx context.Context, input client.SetTokenPolicyInput) (*client.TokenPolicy, error)
+	ListTrustedDocumentsFunc             func(ctx context.Context, accountSlug string, graphSlug string, branchName string, clientName string) ([]client.TrustedDocument, error)
+	UploadTrustedDocumentsFunc           func(ctx context.Context, input client.UploadTrustedDocumentsInput) error
+	DeleteTrustedDocumentsFunc           func(ctx context.Context, input client.DeleteTrustedDocumentsInput) error
+	GetViewerFunc                        func(ctx context.Context) (*client.Viewer, error)
+	CreateWebhookFunc                    func(ctx context.Context, input client.CreateWebhookInput) (*client.Webhook, string, error)
+	UpdateWebhookFunc                    func(ctx context.Context, input client.UpdateWebhookInput) (*client.Webhook, error)
+	GetWebhookFunc                       func(ctx context.Context, id string) (*client.Webhook, error)
+	DeleteWebhookFunc                    func(ctx context.Context, id string) error
+}
+
+var _ client.API = (*API)(nil)
+
+// CreateAccessToken calls CreateAccessTokenFunc.
+func (m *API) CreateAccessToken(ctx context.Context, input client.CreateAccessTokenInput) (*client.AccessToken, error) {
+	if m.CreateAccessTokenFunc == nil {
+		panic("clientmock: unexpected call to CreateAccessToken")
+	}
+	return m.CreateAccessTokenFunc(ctx, input)
+}
+
+// RevokeAccessToken calls RevokeAccessTokenFunc.
+func (m *API) RevokeAccessToken(ctx context.Context, id string) error {
+	if m.RevokeAccessTokenFunc == nil {
+		panic("clientmock: unexpected call to RevokeAccessToken")
+	}
+	return m.RevokeAccessTokenFunc(ctx, id)
+}
+
+// CreateAPIKey calls CreateAPIKeyFunc.
+func (m *API) CreateAPIKey(ctx context.Context, input client.CreateAPIKeyInput) (*client.APIKey, string, error) {
+	if m.CreateAPIKeyFunc == nil {
+		panic("clientmock: unexpected call to CreateAPIKey")
+	}
+	return m.CreateAPIKeyFunc(ctx, input)
+}
+
+// GetAPIKey calls GetAPIKeyFunc.
+func (m *API) GetAPIKey(ctx context.Context, id string) (*client.APIKey, error) {
+	if m.GetAPIKeyFunc == nil {
+		panic("clientmock: unexpected call to GetAPIKey")
+	}
+	return m.GetAPIKeyFunc(ctx, id)
+}
+
+// RevokeAPIKey calls RevokeAPIKeyFunc.
+func (m *API) RevokeAPIKey(ctx context.Context, id string) error {
+	if m.RevokeAPIKeyFunc == nil {
+		panic("clientmock: unexpected call to RevokeAPIKey")
+	}
+	return m.RevokeAPIKeyFunc(ctx, id)
+}
+
+// ListAuditLogs calls ListAuditLogsFunc.
+func (m *API) ListAuditLogs(ctx context.Context, accountSlug string, filter client.AuditLogFilter) ([]client.AuditLogEntry, error) {
+	if m.ListAuditLogsFunc == nil {
+		panic("clientmock: unexpected call to ListAuditLogs")
+	}
+	return m.ListAuditLogsFunc(ctx, accountSlug, filter)
+}
+
+// CreateAutoBranchingRule calls CreateAutoBranchingRuleFunc.
+func (m *API) CreateAutoBranchingRule(ctx context.Context, input client.CreateAutoBranchingRuleInput) (*client.AutoBranchingRule, error) {
+	if m.CreateAutoBranchingRuleFunc == nil {
+		panic("clientmock: unexpected call to CreateAutoBranchingRule")
+	}
+	return m.CreateAutoBranchingRuleFunc(ctx, input)
+}
+
+// UpdateAutoBranchingRule calls UpdateAutoBranchingRuleFunc.
+func (m *API) UpdateAutoBranchingRule(ctx context.Context, input client.UpdateAutoBranchingRuleInput) (*client.AutoBranchingRule, error) {
+	if m.UpdateAutoBranchingRuleFunc == nil {
+		panic("clientmock: unexpected call to UpdateAutoBranchingRule")
+	}
+	return m.UpdateAutoBranchingRuleFunc(ctx, input)
+}
+
+// GetAutoBranchingRule calls GetAutoBranchingRuleFunc.
+func (m *API) GetAutoBranchingRule(ctx context.Context, id string) (*client.AutoBranchingRule, error) {
+	if m.GetAutoBranchingRuleFunc == nil {
+		panic("clientmock: unexpected call to GetAutoBranchingRule")
+	}
+	return m.GetAutoBranchingRuleFunc(ctx, id)
+}
+
+// DeleteAutoBranchingRule calls DeleteAutoBranchingRuleFunc.
+func (m *API) DeleteAutoBranchingRule(ctx context.Context, id string) error {
+	if m.DeleteAutoBranchingRuleFunc == nil {
+		panic("clientmock: unexpected call to DeleteAutoBranchingRule")
+	}
+	return m.DeleteAutoBranchingRuleFunc(ctx, id)
+}
+
+// GetInvoiceUsage calls GetInvoiceUsageFunc.
+func (m *API) GetInvoiceUsage(ctx context.Context, accountSlug string) (*client.InvoiceUsage, error) {
+	if m.GetInvoiceUsageFunc == nil {
+		panic("clientmock: unexpected call to GetInvoiceUsage")
+	}
+	return m.GetInvoiceUsageFunc(ctx, accountSlug)
+}
+
+// SetBranchAlias calls SetBranchAliasFunc.
+func (m *API) SetBranchAlias(ctx context.Context, input client.SetBranchAliasInput) (*client.BranchAlias, error) {
+	if m.SetBranchAliasFunc == nil {
+		panic("clientmock: unexpected call to SetBranchAlias")
+	}
+	return m.SetBranchAliasFunc(ctx, input)
+}
+
+// GetBranchAlias calls GetBranchAliasFunc.
+func (m *API) GetBranchAlias(ctx context.Context, accountSlug string, graphSlug string, name string) (*client.BranchAlias, error) {
+	if m.GetBranchAliasFunc == nil {
+		panic("clientmock: unexpected call to GetBranchAlias")
+	}
+	return m.GetBranchAliasFunc(ctx, accountSlug, graphSlug, name)
+}
+
+// DeleteBranchAlias calls DeleteBranchAliasFunc.
+func (m *API) DeleteBranchAlias(ctx context.Context, input client.DeleteBranchAliasInput) error {
+	if m.DeleteBranchAliasFunc == nil {
+		panic("clientmock: unexpected call to DeleteBranchAlias")
+	}
+	return m.DeleteBranchAliasFunc(ctx, input)
+}
+
+// GetBranchProtection calls GetBranchProtectionFunc.
+func (m *API) GetBranchProtection(ctx context.Context, accountSlug string, graphSlug string, branchName string) (*client.BranchProtection, error) {
+	if m.GetBranchProtectionFunc == nil {
+		panic("clientmock: unexpected call to GetBranchProtection")
+	}
+	return m.GetBranchProtectionFunc(ctx, accountSlug, graphSlug, branchName)
+}
+
+// SetBranchProtection calls SetBranchProtectionFunc.
+func (m *API) SetBranchProtection(ctx context.Context, input client.SetBranchProtectionInput) (*client.BranchProtection, error) {
+	if m.SetBranchProtectionFunc == nil {
+		panic("clientmock: unexpected call to SetBranchProtection")
+	}
+	return m.SetBranchProtectionFunc(ctx, input)
+}
+
+// DeleteBranchProtection calls DeleteBranchProtectionFunc.
+func (m *API) DeleteBranchProtection(ctx context.Context, input client.DeleteBranchProtectionInput) error {
+	if m.DeleteBranchProtectionFunc == nil {
+		panic("clientmock: unexpected call to DeleteBranchProtection")
+	}
+	return m.DeleteBranchProtectionFunc(ctx, input)
+}
+
+// SetAPIBudget calls SetAPIBudgetFunc.
+func (m *API) SetAPIBudget(ctx context.Context, input client.SetAPIBudgetInput) (*client.APIBudget, error) {
+	if m.SetAPIBudgetFunc == nil {
+		panic("clientmock: unexpected call to SetAPIBudget")
+	}
+	return m.SetAPIBudgetFunc(ctx, input)
+}
+
+// GetAPIBudget calls GetAPIBudgetFunc.
+func (m *API) GetAPIBudget(ctx context.Context, accountSlug string, graphSlug string) (*client.APIBudget, error) {
+	if m.GetAPIBudgetFunc == nil {
+		panic("clientmock: unexpected call to GetAPIBudget")
+	}
+	return m.GetAPIBudgetFunc(ctx, accountSlug, graphSlug)
+}
+
+// DeleteAPIBudget calls DeleteAPIBudgetFunc.
+func (m *API) DeleteAPIBudget(ctx context.Context, input client.DeleteAPIBudgetInput) error {
+	if m.DeleteAPIBudgetFunc == nil {
+		panic("clientmock: unexpected call to DeleteAPIBudget")
+	}
+	return m.DeleteAPIBudgetFunc(ctx, input)
+}
+
+// GetAccountBySlug calls GetAccountBySlugFunc.
+func (m *API) GetAccountBySlug(ctx context.Context, slug string) (*client.Account, error) {
+	if m.GetAccountBySlugFunc == nil {
+		panic("clientmock: unexpected call to GetAccountBySlug")
+	}
+	return m.GetAccountBySlugFunc(ctx, slug)
+}
+
+// CreateGraph calls CreateGraphFunc.
+func (m *API) CreateGraph(ctx context.Context, input client.CreateGraphInput) (*client.Graph, error) {
+	if m.CreateGraphFunc == nil {
+		panic("clientmock: unexpected call to CreateGraph")
+	}
+	return m.CreateGraphFunc(ctx, input)
+}
+
+// UpdateGraph calls UpdateGraphFunc.
+func (m *API) UpdateGraph(ctx context.Context, input client.UpdateGraphInput) (*client.Graph, error) {
+	if m.UpdateGraphFunc == nil {
+		panic("clientmock: unexpected call to UpdateGraph")
+	}
+	return m.UpdateGraphFunc(ctx, input)
+}
+
+// TransferGraph calls TransferGraphFunc.
+func (m *API) TransferGraph(ctx context.Context, input client.TransferGraphInput) (*client.Graph, error) {
+	if m.TransferGraphFunc == nil {
+		panic("clientmock: unexpected call to TransferGraph")
+	}
+	return m.TransferGraphFunc(ctx, input)
+}
+
+// GetGraph calls GetGraphFunc.
+func (m *API) GetGraph(ctx context.Context, accountSlug string, graphSlug string) (*client.Graph, error) {
+	if m.GetGraphFunc == nil {
+		panic("clientmock: unexpected call to GetGraph")
+	}
+	return m.GetGraphFunc(ctx, accountSlug, graphSlug)
+}
+
+// GetGraphByID calls GetGraphByIDFunc.
+func (m *API) GetGraphByID(ctx context.Context, id string) (*client.Graph, error) {
+	if m.GetGraphByIDFunc == nil {
+		panic("clientmock: unexpected call to GetGraphByID")
+	}
+	return m.GetGraphByIDFunc(ctx, id)
+}
+
+// DeleteGraph calls DeleteGraphFunc.
+func (m *API) DeleteGraph(ctx context.Context, id string) error {
+	if m.DeleteGraphFunc == nil {
+		panic("clientmock: unexpected call to DeleteGraph")
+	}
+	return m.DeleteGraphFunc(ctx, id)
+}
+
+// CreateBranch calls CreateBranchFunc.
+func (m *API) CreateBranch(ctx context.Context, input client.CreateBranchInput) (*client.Branch, error) {
+	if m.CreateBranchFunc == nil {
+		panic("clientmock: unexpected call to CreateBranch")
+	}
+	return m.CreateBranchFunc(ctx, input)
+}
+
+// GetBranch calls GetBranchFunc.
+func (m *API) GetBranch(ctx context.Context, accountSlug string, graphSlug string, branchName string) (*client.Branch, error) {
+	if m.GetBranchFunc == nil {
+		panic("clientmock: unexpected call to GetBranch")
+	}
+	return m.GetBranchFunc(ctx, accountSlug, graphSlug, branchName)
+}
+
+// GetBranchByID calls GetBranchByIDFunc.
+func (m *API) GetBranchByID(ctx context.Context, id string) (*client.Branch, error) {
+	if m.GetBranchByIDFunc == nil {
+		panic("clientmock: unexpected call to GetBranchByID")
+	}
+	return m.GetBranchByIDFunc(ctx, id)
+}
+
+// PromoteBranch calls PromoteBranchFunc.
+func (m *API) PromoteBranch(ctx context.Context, input client.PromoteBranchInput) (*client.Branch, error) {
+	if m.PromoteBranchFunc == nil {
+		panic("clientmock: unexpected call to PromoteBranch")
+	}
+	return m.PromoteBranchFunc(ctx, input)
+}
+
+// UpdateBranchRegions calls UpdateBranchRegionsFunc.
+func (m *API) UpdateBranchRegions(ctx context.Context, input client.UpdateBranchRegionsInput) (*client.Branch, error) {
+	if m.UpdateBranchRegionsFunc == nil {
+		panic("clientmock: unexpected call to UpdateBranchRegions")
+	}
+	return m.UpdateBranchRegionsFunc(ctx, input)
+}
+
+// DeleteBranch calls DeleteBranchFunc.
+func (m *API) DeleteBranch(ctx context.Context, input client.DeleteBranchInput) error {
+	if m.DeleteBranchFunc == nil {
+		panic("clientmock: unexpected call to DeleteBranch")
+	}
+	return m.DeleteBranchFunc(ctx, input)
+}
+
+// GetLatestBranchComposition calls GetLatestBranchCompositionFunc.
+func (m *API) GetLatestBranchComposition(ctx context.Context, accountSlug string, graphSlug string, branchName string) (*client.Composition, error) {
+	if m.GetLatestBranchCompositionFunc == nil {
+		panic("clientmock: unexpected call to GetLatestBranchComposition")
+	}
+	return m.GetLatestBranchCompositionFunc(ctx, accountSlug, graphSlug, branchName)
+}
+
+// GetDefaultBranchSettings calls GetDefaultBranchSettingsFunc.
+func (m *API) GetDefaultBranchSettings(ctx context.Context, accountSlug string, graphSlug string) (*client.DefaultBranchSettings, error) {
+	if m.GetDefaultBranchSettingsFunc == nil {
+		panic("clientmock: unexpected call to GetDefaultBranchSettings")
+	}
+	return m.GetDefaultBranchSettingsFunc(ctx, accountSlug, graphSlug)
+}
+
+// SetDefaultBranchSettings calls SetDefaultBranchSettingsFunc.
+func (m *API) SetDefaultBranchSettings(ctx context.Context, input client.SetDefaultBranchSettingsInput) (*client.DefaultBranchSettings, error) {
+	if m.SetDefaultBranchSettingsFunc == nil {
+		panic("clientmock: unexpected call to SetDefaultBranchSettings")
+	}
+	return m.SetDefaultBranchSettingsFunc(ctx, input)
+}
+
+// GetLatestBranchDeployment calls GetLatestBranchDeploymentFunc.
+func (m *API) GetLatestBranchDeployment(ctx context.Context, accountSlug string, graphSlug string, branchName string) (*client.Deployment, error) {
+	if m.GetLatestBranchDeploymentFunc == nil {
+		panic("clientmock: unexpected call to GetLatestBranchDeployment")
+	}
+	return m.GetLatestBranchDeploymentFunc(ctx, accountSlug, graphSlug, branchName)
+}
+
+// CreateCustomDomain calls CreateCustomDomainFunc.
+func (m *API) CreateCustomDomain(ctx context.Context, input client.CreateCustomDomainInput) (*client.CustomDomain, error) {
+	if m.CreateCustomDomainFunc == nil {
+		panic("clientmock: unexpected call to CreateCustomDomain")
+	}
+	return m.CreateCustomDomainFunc(ctx, input)
+}
+
+// GetCustomDomain calls GetCustomDomainFunc.
+func (m *API) GetCustomDomain(ctx context.Context, id string) (*client.CustomDomain, error) {
+	if m.GetCustomDomainFunc == nil {
+		panic("clientmock: unexpected call to GetCustomDomain")
+	}
+	return m.GetCustomDomainFunc(ctx, id)
+}
+
+// DeleteCustomDomain calls DeleteCustomDomainFunc.
+func (m *API) DeleteCustomDomain(ctx context.Context, id string) error {
+	if m.DeleteCustomDomainFunc == nil {
+		panic("clientmock: unexpected call to DeleteCustomDomain")
+	}
+	return m.DeleteCustomDomainFunc(ctx, id)
+}
+
+// GetBranchEnvironmentVariables calls GetBranchEnvironmentVariablesFunc.
+func (m *API) GetBranchEnvironmentVariables(ctx context.Context, accountSlug string, graphSlug string, branchName string) ([]client.EnvironmentVariable, error) {
+	if m.GetBranchEnvironmentVariablesFunc == nil {
+		panic("clientmock: unexpected call to GetBranchEnvironmentVariables")
+	}
+	return m.GetBranchEnvironmentVariablesFunc(ctx, accountSlug, graphSlug, branchName)
+}
+
+// UpsertBranchEnvironmentVariables calls UpsertBranchEnvironmentVariablesFunc.
+func (m *API) UpsertBranchEnvironmentVariables(ctx context.Context, input client.UpsertBranchEnvironmentVariablesInput) error {
+	if m.UpsertBranchEnvironmentVariablesFunc == nil {
+		panic("clientmock: unexpected call to UpsertBranchEnvironmentVariables")
+	}
+	return m.UpsertBranchEnvironmentVariablesFunc(ctx, input)
+}
+
+// DeleteBranchEnvironmentVariables calls DeleteBranchEnvironmentVariablesFunc.
+func (m *API) DeleteBranchEnvironmentVariables(ctx context.Context, input client.DeleteBranchEnvironmentVariablesInput) error {
+	if m.DeleteBranchEnvironmentVariablesFunc == nil {
+		panic("clientmock: unexpected call to DeleteBranchEnvironmentVariables")
+	}
+	return m.DeleteBranchEnvironmentVariablesFunc(ctx, input)
+}
+
+// GetBranchFeatureFlags calls GetBranchFeatureFlagsFunc.
+func (m *API) GetBranchFeatureFlags(ctx context.Context, accountSlug string, graphSlug string, branchName string) ([]client.FeatureFlag, error) {
+	if m.GetBranchFeatureFlagsFunc == nil {
+		panic("clientmock: unexpected call to GetBranchFeatureFlags")
+	}
+	return m.GetBranchFeatureFlagsFunc(ctx, accountSlug, graphSlug, branchName)
+}
+
+// SetBranchFeatureFlags calls SetBranchFeatureFlagsFunc.
+func (m *API) SetBranchFeatureFlags(ctx context.Context, input client.SetBranchFeatureFlagsInput) ([]client.FeatureFlag, error) {
+	if m.SetBranchFeatureFlagsFunc == nil {
+		panic("clientmock: unexpected call to SetBranchFeatureFlags")
+	}
+	return m.SetBranchFeatureFlagsFunc(ctx, input)
+}
+
+// SetGatewayConfig calls SetGatewayConfigFunc.
+func (m *API) SetGatewayConfig(ctx context.Context, input client.SetGatewayConfigInput) (*client.GatewayConfig, error) {
+	if m.SetGatewayConfigFunc == nil {
+		panic("clientmock: unexpected call to SetGatewayConfig")
+	}
+	return m.SetGatewayConfigFunc(ctx, input)
+}
+
+// GetGatewayConfig calls GetGatewayConfigFunc.
+func (m *API) GetGatewayConfig(ctx context.Context, accountSlug string, graphSlug string, branchName string) (*client.GatewayConfig, error) {
+	if m.GetGatewayConfigFunc == nil {
+		panic("clientmock: unexpected call to GetGatewayConfig")
+	}
+	return m.GetGatewayConfigFunc(ctx, accountSlug, graphSlug, branchName)
+}
+
+// DeleteGatewayConfig calls DeleteGatewayConfigFunc.
+func (m *API) DeleteGatewayConfig(ctx context.Context, input client.DeleteGatewayConfigInput) error {
+	if m.DeleteGatewayConfigFunc == nil {
+		panic("clientmock: unexpected call to DeleteGatewayConfig")
+	}
+	return m.DeleteGatewayConfigFunc(ctx, input)
+}
+
+// ListGatewayVersions calls ListGatewayVersionsFunc.
+func (m *API) ListGatewayVersions(ctx context.Context) ([]client.GatewayVersion, error) {
+	if m.ListGatewayVersionsFunc == nil {
+		panic("clientmock: unexpected call to ListGatewayVersions")
+	}
+	return m.ListGatewayVersionsFunc(ctx)
+}
+
+// AddGraphCollaborator calls AddGraphCollaboratorFunc.
+func (m *API) AddGraphCollaborator(ctx context.Context, input client.AddGraphCollaboratorInput) (*client.GraphCollaborator, error) {
+	if m.AddGraphCollaboratorFunc == nil {
+		panic("clientmock: unexpected call to AddGraphCollaborator")
+	}
+	return m.AddGraphCollaboratorFunc(ctx, input)
+}
+
+// GetGraphCollaborator calls GetGraphCollaboratorFunc.
+func (m *API) GetGraphCollaborator(ctx context.Context, id string) (*client.GraphCollaborator, error) {
+	if m.GetGraphCollaboratorFunc == nil {
+		panic("clientmock: unexpected call to GetGraphCollaborator")
+	}
+	return m.GetGraphCollaboratorFunc(ctx, id)
+}
+
+// UpdateGraphCollaboratorRole calls UpdateGraphCollaboratorRoleFunc.
+func (m *API) UpdateGraphCollaboratorRole(ctx context.Context, input client.UpdateGraphCollaboratorRoleInput) (*client.GraphCollaborator, error) {
+	if m.UpdateGraphCollaboratorRoleFunc == nil {
+		panic("clientmock: unexpected call to UpdateGraphCollaboratorRole")
+	}
+	return m.UpdateGraphCollaboratorRoleFunc(ctx, input)
+}
+
+// RemoveGraphCollaborator calls RemoveGraphCollaboratorFunc.
+func (m *API) RemoveGraphCollaborator(ctx context.Context, id string) error {
+	if m.RemoveGraphCollaboratorFunc == nil {
+		panic("clientmock: unexpected call to RemoveGraphCollaborator")
+	}
+	return m.RemoveGraphCollaboratorFunc(ctx, id)
+}
+
+// CreateGraphKey calls CreateGraphKeyFunc.
+func (m *API) CreateGraphKey(ctx context.Context, input client.CreateGraphKeyInput) (*client.GraphKey, string, error) {
+	if m.CreateGraphKeyFunc == nil {
+		panic("clientmock: unexpected call to CreateGraphKey")
+	}
+	return m.CreateGraphKeyFunc(ctx, input)
+}
+
+// GetGraphKey calls GetGraphKeyFunc.
+func (m *API) GetGraphKey(ctx context.Context, id string) (*client.GraphKey, error) {
+	if m.GetGraphKeyFunc == nil {
+		panic("clientmock: unexpected call to GetGraphKey")
+	}
+	return m.GetGraphKeyFunc(ctx, id)
+}
+
+// RevokeGraphKey calls RevokeGraphKeyFunc.
+func (m *API) RevokeGraphKey(ctx context.Context, id string) error {
+	if m.RevokeGraphKeyFunc == nil {
+		panic("clientmock: unexpected call to RevokeGraphKey")
+	}
+	return m.RevokeGraphKeyFunc(ctx, id)
+}
+
+// GetGraphSettings calls GetGraphSettingsFunc.
+func (m *API) GetGraphSettings(ctx context.Context, accountSlug string, graphSlug string) (*client.GraphSettings, error) {
+	if m.GetGraphSettingsFunc == nil {
+		panic("clientmock: unexpected call to GetGraphSettings")
+	}
+	return m.GetGraphSettingsFunc(ctx, accountSlug, graphSlug)
+}
+
+// SetGraphSettings calls SetGraphSettingsFunc.
+func (m *API) SetGraphSettings(ctx context.Context, input client.SetGraphSettingsInput) (*client.GraphSettings, error) {
+	if m.SetGraphSettingsFunc == nil {
+		panic("clientmock: unexpected call to SetGraphSettings")
+	}
+	return m.SetGraphSettingsFunc(ctx, input)
+}
+
+// GetGraphUsage calls GetGraphUsageFunc.
+func (m *API) GetGraphUsage(ctx context.Context, accountSlug string, graphSlug string, from time.Time, to time.Time) (*client.GraphUsage, error) {
+	if m.GetGraphUsageFunc == nil {
+		panic("clientmock: unexpected call to GetGraphUsage")
+	}
+	return m.GetGraphUsageFunc(ctx, accountSlug, graphSlug, from, to)
+}
+
+// GetMCPEndpoint calls GetMCPEndpointFunc.
+func (m *API) GetMCPEndpoint(ctx context.Context, accountSlug string, graphSlug string, branchName string) (*client.MCPEndpoint, error) {
+	if m.GetMCPEndpointFunc == nil {
+		panic("clientmock: unexpected call to GetMCPEndpoint")
+	}
+	return m.GetMCPEndpointFunc(ctx, accountSlug, graphSlug, branchName)
+}
+
+// SetMCPEndpoint calls SetMCPEndpointFunc.
+func (m *API) SetMCPEndpoint(ctx context.Context, input client.SetMCPEndpointInput) (*client.MCPEndpoint, error) {
+	if m.SetMCPEndpointFunc == nil {
+		panic("clientmock: unexpected call to SetMCPEndpoint")
+	}
+	return m.SetMCPEndpointFunc(ctx, input)
+}
+
+// InviteAccountMember calls InviteAccountMemberFunc.
+func (m *API) InviteAccountMember(ctx context.Context, input client.InviteAccountMemberInput) (*client.AccountMember, error) {
+	if m.InviteAccountMemberFunc == nil {
+		panic("clientmock: unexpected call to InviteAccountMember")
+	}
+	return m.InviteAccountMemberFunc(ctx, input)
+}
+
+// ListAccountMembers calls ListAccountMembersFunc.
+func (m *API) ListAccountMembers(ctx context.Context, accountSlug string) ([]client.AccountMember, error) {
+	if m.ListAccountMembersFunc == nil {
+		panic("clientmock: unexpected call to ListAccountMembers")
+	}
+	return m.ListAccountMembersFunc(ctx, accountSlug)
+}
+
+// GetAccountMember calls GetAccountMemberFunc.
+func (m *API) GetAccountMember(ctx context.Context, accountSlug string, email string) (*client.AccountMember, error) {
+	if m.GetAccountMemberFunc == nil {
+		panic("clientmock: unexpected call to GetAccountMember")
+	}
+	return m.GetAccountMemberFunc(ctx, accountSlug, email)
+}
+
+// UpdateAccountMemberRole calls UpdateAccountMemberRoleFunc.
+func (m *API) UpdateAccountMemberRole(ctx context.Context, input client.UpdateAccountMemberRoleInput) (*client.AccountMember, error) {
+	if m.UpdateAccountMemberRoleFunc == nil {
+		panic("clientmock: unexpected call to UpdateAccountMemberRole")
+	}
+	return m.UpdateAccountMemberRoleFunc(ctx, input)
+}
+
+// RemoveAccountMember calls RemoveAccountMemberFunc.
+func (m *API) RemoveAccountMember(ctx context.Context, input client.RemoveAccountMemberInput) error {
+	if m.RemoveAccountMemberFunc == nil {
+		panic("clientmock: unexpected call to RemoveAccountMember")
+	}
+	return m.RemoveAccountMemberFunc(ctx, input)
+}
+
+// CreateNotificationChannel calls CreateNotificationChannelFunc.
+func (m *API) CreateNotificationChannel(ctx context.Context, input client.CreateNotificationChannelInput) (*client.NotificationChannel, error) {
+	if m.CreateNotificationChannelFunc == nil {
+		panic("clientmock: unexpected call to CreateNotificationChannel")
+	}
+	return m.CreateNotificationChannelFunc(ctx, input)
+}
+
+// UpdateNotificationChannel calls UpdateNotificationChannelFunc.
+func (m *API) UpdateNotificationChannel(ctx context.Context, input client.UpdateNotificationChannelInput) (*client.NotificationChannel, error) {
+	if m.UpdateNotificationChannelFunc == nil {
+		panic("clientmock: unexpected call to UpdateNotificationChannel")
+	}
+	return m.UpdateNotificationChannelFunc(ctx, input)
+}
+
+// GetNotificationChannel calls GetNotificationChannelFunc.
+func (m *API) GetNotificationChannel(ctx context.Context, id string) (*client.NotificationChannel, error) {
+	if m.GetNotificationChannelFunc == nil {
+		panic("clientmock: unexpected call to GetNotificationChannel")
+	}
+	return m.GetNotificationChannelFunc(ctx, id)
+}
+
+// DeleteNotificationChannel calls DeleteNotificationChannelFunc.
+func (m *API) DeleteNotificationChannel(ctx context.Context, id string) error {
+	if m.DeleteNotificationChannelFunc == nil {
+		panic("clientmock: unexpected call to DeleteNotificationChannel")
+	}
+	return m.DeleteNotificationChannelFunc(ctx, id)
+}
+
+// GetOperationChecksConfig calls GetOperationChecksConfigFunc.
+func (m *API) GetOperationChecksConfig(ctx context.Context, accountSlug string, graphSlug string, branchName string) (*client.OperationChecksConfig, error) {
+	if m.GetOperationChecksConfigFunc == nil {
+		panic("clientmock: unexpected call to GetOperationChecksConfig")
+	}
+	return m.GetOperationChecksConfigFunc(ctx, accountSlug, graphSlug, branchName)
+}
+
+// SetOperationChecksConfig calls SetOperationChecksConfigFunc.
+func (m *API) SetOperationChecksConfig(ctx context.Context, input client.SetOperationChecksConfigInput) (*client.OperationChecksConfig, error) {
+	if m.SetOperationChecksConfigFunc == nil {
+		panic("clientmock: unexpected call to SetOperationChecksConfig")
+	}
+	return m.SetOperationChecksConfigFunc(ctx, input)
+}
+
+// CreateOperationCheckException calls CreateOperationCheckExceptionFunc.
+func (m *API) CreateOperationCheckException(ctx context.Context, input client.CreateOperationCheckExceptionInput) (*client.OperationCheckException, error) {
+	if m.CreateOperationCheckExceptionFunc == nil {
+		panic("clientmock: unexpected call to CreateOperationCheckException")
+	}
+	return m.CreateOperationCheckExceptionFunc(ctx, input)
+}
+
+// UpdateOperationCheckException calls UpdateOperationCheckExceptionFunc.
+func (m *API) UpdateOperationCheckException(ctx context.Context, input client.UpdateOperationCheckExceptionInput) (*client.OperationCheckException, error) {
+	if m.UpdateOperationCheckExceptionFunc == nil {
+		panic("clientmock: unexpected call to UpdateOperationCheckException")
+	}
+	return m.UpdateOperationCheckExceptionFunc(ctx, input)
+}
+
+// GetOperationCheckException calls GetOperationCheckExceptionFunc.
+func (m *API) GetOperationCheckException(ctx context.Context, id string) (*client.OperationCheckException, error) {
+	if m.GetOperationCheckExceptionFunc == nil {
+		panic("clientmock: unexpected call to GetOperationCheckException")
+	}
+	return m.GetOperationCheckExceptionFunc(ctx, id)
+}
+
+// DeleteOperationCheckException calls DeleteOperationCheckExceptionFunc.
+func (m *API) DeleteOperationCheckException(ctx context.Context, id string) error {
+	if m.DeleteOperationCheckExceptionFunc == nil {
+		panic("clientmock: unexpected call to DeleteOperationCheckException")
+	}
+	return m.DeleteOperationCheckExceptionFunc(ctx, id)
+}
+
+// ListGraphs calls ListGraphsFunc.
+func (m *API) ListGraphs(ctx context.Context, accountSlug string) ([]client.Graph, error) {
+	if m.ListGraphsFunc == nil {
+		panic("clientmock: unexpected call to ListGraphs")
+	}
+	return m.ListGraphsFunc(ctx, accountSlug)
+}
+
+// SearchGraphs calls SearchGraphsFunc.
+func (m *API) SearchGraphs(ctx context.Context, accountSlug string, filter client.GraphFilter) ([]client.Graph, error) {
+	if m.SearchGraphsFunc == nil {
+		panic("clientmock: unexpected call to SearchGraphs")
+	}
+	return m.SearchGraphsFunc(ctx, accountSlug, filter)
+}
+
+// ListBranches calls ListBranchesFunc.
+func (m *API) ListBranches(ctx context.Context, accountSlug string, graphSlug string) ([]client.Branch, error) {
+	if m.ListBranchesFunc == nil {
+		panic("clientmock: unexpected call to ListBranches")
+	}
+	return m.ListBranchesFunc(ctx, accountSlug, graphSlug)
+}
+
+// ListSubgraphs calls ListSubgraphsFunc.
+func (m *API) ListSubgraphs(ctx context.Context, accountSlug string, graphSlug string, branchName string) ([]client.Subgraph, error) {
+	if m.ListSubgraphsFunc == nil {
+		panic("clientmock: unexpected call to ListSubgraphs")
+	}
+	return m.ListSubgraphsFunc(ctx, accountSlug, graphSlug, branchName)
+}
+
+// ListRegions calls ListRegionsFunc.
+func (m *API) ListRegions(ctx context.Context) ([]client.Region, error) {
+	if m.ListRegionsFunc == nil {
+		panic("clientmock: unexpected call to ListRegions")
+	}
+	return m.ListRegionsFunc(ctx)
+}
+
+// CreateRequestLoggingRule calls CreateRequestLoggingRuleFunc.
+func (m *API) CreateRequestLoggingRule(ctx context.Context, input client.CreateRequestLoggingRuleInput) (*client.RequestLoggingRule, error) {
+	if m.CreateRequestLoggingRuleFunc == nil {
+		panic("clientmock: unexpected call to CreateRequestLoggingRule")
+	}
+	return m.CreateRequestLoggingRuleFunc(ctx, input)
+}
+
+// UpdateRequestLoggingRule calls UpdateRequestLoggingRuleFunc.
+func (m *API) UpdateRequestLoggingRule(ctx context.Context, input client.UpdateRequestLoggingRuleInput) (*client.RequestLoggingRule, error) {
+	if m.UpdateRequestLoggingRuleFunc == nil {
+		panic("clientmock: unexpected call to UpdateRequestLoggingRule")
+	}
+	return m.UpdateRequestLoggingRuleFunc(ctx, input)
+}
+
+// GetRequestLoggingRule calls GetRequestLoggingRuleFunc.
+func (m *API) GetRequestLoggingRule(ctx context.Context, id string) (*client.RequestLoggingRule, error) {
+	if m.GetRequestLoggingRuleFunc == nil {
+		panic("clientmock: unexpected call to GetRequestLoggingRule")
+	}
+	return m.GetRequestLoggingRuleFunc(ctx, id)
+}
+
+// DeleteRequestLoggingRule calls DeleteRequestLoggingRuleFunc.
+func (m *API) DeleteRequestLoggingRule(ctx context.Context, id string) error {
+	if m.DeleteRequestLoggingRuleFunc == nil {
+		panic("clientmock: unexpected call to DeleteRequestLoggingRule")
+	}
+	return m.DeleteRequestLoggingRuleFunc(ctx, id)
+}
+
+// GetFederatedSchema calls GetFederatedSchemaFunc.
+func (m *API) GetFederatedSchema(ctx context.Context, accountSlug string, graphSlug string, branchName string) (string, error) {
+	if m.GetFederatedSchemaFunc == nil {
+		panic("clientmock: unexpected call to GetFederatedSchema")
+	}
+	return m.GetFederatedSchemaFunc(ctx, accountSlug, graphSlug, branchName)
+}
+
+// GetSubgraphSchema calls GetSubgraphSchemaFunc.
+func (m *API) GetSubgraphSchema(ctx context.Context, accountSlug string, graphSlug string, branchName string, subgraphName string) (*client.PublishedSubgraph, error) {
+	if m.GetSubgraphSchemaFunc == nil {
+		panic("clientmock: unexpected call to GetSubgraphSchema")
+	}
+	return m.GetSubgraphSchemaFunc(ctx, accountSlug, graphSlug, branchName, subgraphName)
+}
+
+// DiffSubgraphSchema calls DiffSubgraphSchemaFunc.
+func (m *API) DiffSubgraphSchema(ctx context.Context, accountSlug string, graphSlug string, branchName string, subgraphName string, schema string) ([]client.SchemaChange, error) {
+	if m.DiffSubgraphSchemaFunc == nil {
+		panic("clientmock: unexpected call to DiffSubgraphSchema")
+	}
+	return m.DiffSubgraphSchemaFunc(ctx, accountSlug, graphSlug, branchName, subgraphName, schema)
+}
+
+// DeleteSubgraph calls DeleteSubgraphFunc.
+func (m *API) DeleteSubgraph(ctx context.Context, input client.DeleteSubgraphInput) error {
+	if m.DeleteSubgraphFunc == nil {
+		panic("clientmock: unexpected call to DeleteSubgraph")
+	}
+	return m.DeleteSubgraphFunc(ctx, input)
+}
+
+// PublishSubgraph calls PublishSubgraphFunc.
+func (m *API) PublishSubgraph(ctx context.Context, input client.PublishSubgraphInput) (*client.SchemaVersion, error) {
+	if m.PublishSubgraphFunc == nil {
+		panic("clientmock: unexpected call to PublishSubgraph")
+	}
+	return m.PublishSubgraphFunc(ctx, input)
+}
+
+// CreateSchemaCheck calls CreateSchemaCheckFunc.
+func (m *API) CreateSchemaCheck(ctx context.Context, input client.SchemaCheckInput) (*client.SchemaCheck, error) {
+	if m.CreateSchemaCheckFunc == nil {
+		panic("clientmock: unexpected call to CreateSchemaCheck")
+	}
+	return m.CreateSchemaCheckFunc(ctx, input)
+}
+
+// GetLatestOperationCheckResult calls GetLatestOperationCheckResultFunc.
+func (m *API) GetLatestOperationCheckResult(ctx context.Context, accountSlug string, graphSlug string, branchName string, subgraphName string) (*client.OperationCheckResult, error) {
+	if m.GetLatestOperationCheckResultFunc == nil {
+		panic("clientmock: unexpected call to GetLatestOperationCheckResult")
+	}
+	return m.GetLatestOperationCheckResultFunc(ctx, accountSlug, graphSlug, branchName, subgraphName)
+}
+
+// SetSchemaContract calls SetSchemaContractFunc.
+func (m *API) SetSchemaContract(ctx context.Context, input client.SetSchemaContractInput) (*client.SchemaContract, error) {
+	if m.SetSchemaContractFunc == nil {
+		panic("clientmock: unexpected call to SetSchemaContract")
+	}
+	return m.SetSchemaContractFunc(ctx, input)
+}
+
+// GetSchemaContract calls GetSchemaContractFunc.
+func (m *API) GetSchemaContract(ctx context.Context, accountSlug string, graphSlug string, branchName string, name string) (*client.SchemaContract, error) {
+	if m.GetSchemaContractFunc == nil {
+		panic("clientmock: unexpected call to GetSchemaContract")
+	}
+	return m.GetSchemaContractFunc(ctx, accountSlug, graphSlug, branchName, name)
+}
+
+// DeleteSchemaContract calls DeleteSchemaContractFunc.
+func (m *API) DeleteSchemaContract(ctx context.Context, input client.DeleteSchemaContractInput) error {
+	if m.DeleteSchemaContractFunc == nil {
+		panic("clientmock: unexpected call to DeleteSchemaContract")
+	}
+	return m.DeleteSchemaContractFunc(ctx, input)
+}
+
+// CreateSchemaProposal calls CreateSchemaProposalFunc.
+func (m *API) CreateSchemaProposal(ctx context.Context, input client.CreateSchemaProposalInput) (*client.SchemaProposal, error) {
+	if m.CreateSchemaProposalFunc == nil {
+		panic("clientmock: unexpected call to CreateSchemaProposal")
+	}
+	return m.CreateSchemaProposalFunc(ctx, input)
+}
+
+// GetSchemaProposal calls GetSchemaProposalFunc.
+func (m *API) GetSchemaProposal(ctx context.Context, id string) (*client.SchemaProposal, error) {
+	if m.GetSchemaProposalFunc == nil {
+		panic("clientmock: unexpected call to GetSchemaProposal")
+	}
+	return m.GetSchemaProposalFunc(ctx, id)
+}
+
+// UpdateSchemaProposal calls UpdateSchemaProposalFunc.
+func (m *API) UpdateSchemaProposal(ctx context.Context, input client.UpdateSchemaProposalInput) (*client.SchemaProposal, error) {
+	if m.UpdateSchemaProposalFunc == nil {
+		panic("clientmock: unexpected call to UpdateSchemaProposal")
+	}
+	return m.UpdateSchemaProposalFunc(ctx, input)
+}
+
+// CloseSchemaProposal calls CloseSchemaProposalFunc.
+func (m *API) CloseSchemaProposal(ctx context.Context, id string) error {
+	if m.CloseSchemaProposalFunc == nil {
+		panic("clientmock: unexpected call to CloseSchemaProposal")
+	}
+	return m.CloseSchemaProposalFunc(ctx, id)
+}
+
+// CreateSchemaRegistryMirror calls CreateSchemaRegistryMirrorFunc.
+func (m *API) CreateSchemaRegistryMirror(ctx context.Context, input client.CreateSchemaRegistryMirrorInput) (*client.SchemaRegistryMirror, error) {
+	if m.CreateSchemaRegistryMirrorFunc == nil {
+		panic("clientmock: unexpected call to CreateSchemaRegistryMirror")
+	}
+	return m.CreateSchemaRegistryMirrorFunc(ctx, input)
+}
+
+// UpdateSchemaRegistryMirror calls UpdateSchemaRegistryMirrorFunc.
+func (m *API) UpdateSchemaRegistryMirror(ctx context.Context, input client.UpdateSchemaRegistryMirrorInput) (*client.SchemaRegistryMirror, error) {
+	if m.UpdateSchemaRegistryMirrorFunc == nil {
+		panic("clientmock: unexpected call to UpdateSchemaRegistryMirror")
+	}
+	return m.UpdateSchemaRegistryMirrorFunc(ctx, input)
+}
+
+// GetSchemaRegistryMirror calls GetSchemaRegistryMirrorFunc.
+func (m *API) GetSchemaRegistryMirror(ctx context.Context, id string) (*client.SchemaRegistryMirror, error) {
+	if m.GetSchemaRegistryMirrorFunc == nil {
+		panic("clientmock: unexpected call to GetSchemaRegistryMirror")
+	}
+	return m.GetSchemaRegistryMirrorFunc(ctx, id)
+}
+
+// DeleteSchemaRegistryMirror calls DeleteSchemaRegistryMirrorFunc.
+func (m *API) DeleteSchemaRegistryMirror(ctx context.Context, id string) error {
+	if m.DeleteSchemaRegistryMirrorFunc == nil {
+		panic("clientmock: unexpected call to DeleteSchemaRegistryMirror")
+	}
+	return m.DeleteSchemaRegistryMirrorFunc(ctx, id)
+}
+
+// SetSchemaTag calls SetSchemaTagFunc.
+func (m *API) SetSchemaTag(ctx context.Context, input client.SetSchemaTagInput) (*client.SchemaTag, error) {
+	if m.SetSchemaTagFunc == nil {
+		panic("clientmock: unexpected call to SetSchemaTag")
+	}
+	return m.SetSchemaTagFunc(ctx, input)
+}
+
+// GetSchemaTag calls GetSchemaTagFunc.
+func (m *API) GetSchemaTag(ctx context.Context, accountSlug string, graphSlug string, name string) (*client.SchemaTag, error) {
+	if m.GetSchemaTagFunc == nil {
+		panic("clientmock: unexpected call to GetSchemaTag")
+	}
+	return m.GetSchemaTagFunc(ctx, accountSlug, graphSlug, name)
+}
+
+// DeleteSchemaTag calls DeleteSchemaTagFunc.
+func (m *API) DeleteSchemaTag(ctx context.Context, input client.DeleteSchemaTagInput) error {
+	if m.DeleteSchemaTagFunc == nil {
+		panic("clientmock: unexpected call to DeleteSchemaTag")
+	}
+	return m.DeleteSchemaTagFunc(ctx, input)
+}
+
+// SetSubgraphHeaders calls SetSubgraphHeadersFunc.
+func (m *API) SetSubgraphHeaders(ctx context.Context, input client.SetSubgraphHeadersInput) (*client.SubgraphHeaders, error) {
+	if m.SetSubgraphHeadersFunc == nil {
+		panic("clientmock: unexpected call to SetSubgraphHeaders")
+	}
+	return m.SetSubgraphHeadersFunc(ctx, input)
+}
+
+// GetSubgraphHeaders calls GetSubgraphHeadersFunc.
+func (m *API) GetSubgraphHeaders(ctx context.Context, accountSlug string, graphSlug string, branchName string, subgraphName string) (*client.SubgraphHeaders, error) {
+	if m.GetSubgraphHeadersFunc == nil {
+		panic("clientmock: unexpected call to GetSubgraphHeaders")
+	}
+	return m.GetSubgraphHeadersFunc(ctx, accountSlug, graphSlug, branchName, subgraphName)
+}
+
+// DeleteSubgraphHeaders calls DeleteSubgraphHeadersFunc.
+func (m *API) DeleteSubgraphHeaders(ctx context.Context, input client.DeleteSubgraphHeadersInput) error {
+	if m.DeleteSubgraphHeadersFunc == nil {
+		panic("clientmock: unexpected call to DeleteSubgraphHeaders")
+	}
+	return m.DeleteSubgraphHeadersFunc(ctx, input)
+}
+
+// SetSubgraphRoutingOverride calls SetSubgraphRoutingOverrideFunc.
+func (m *API) SetSubgraphRoutingOverride(ctx context.Context, input client.SetSubgraphRoutingOverrideInput) (*client.SubgraphRoutingOverride, error) {
+	if m.SetSubgraphRoutingOverrideFunc == nil {
+		panic("clientmock: unexpected call to SetSubgraphRoutingOverride")
+	}
+	return m.SetSubgraphRoutingOverrideFunc(ctx, input)
+}
+
+// GetSubgraphRoutingOverride calls GetSubgraphRoutingOverrideFunc.
+func (m *API) GetSubgraphRoutingOverride(ctx context.Context, accountSlug string, graphSlug string, branchName string, subgraphName string) (*client.SubgraphRoutingOverride, error) {
+	if m.GetSubgraphRoutingOverrideFunc == nil {
+		panic("clientmock: unexpected call to GetSubgraphRoutingOverride")
+	}
+	return m.GetSubgraphRoutingOverrideFunc(ctx, accountSlug, graphSlug, branchName, subgraphName)
+}
+
+// DeleteSubgraphRoutingOverride calls DeleteSubgraphRoutingOverrideFunc.
+func (m *API) DeleteSubgraphRoutingOverride(ctx context.Context, input client.DeleteSubgraphRoutingOverrideInput) error {
+	if m.DeleteSubgraphRoutingOverrideFunc == nil {
+		panic("clientmock: unexpected call to DeleteSubgraphRoutingOverride")
+	}
+	return m.DeleteSubgraphRoutingOverrideFunc(ctx, input)
+}
+
+// ListSubgraphRoutingOverrides calls ListSubgraphRoutingOverridesFunc.
+func (m *API) ListSubgraphRoutingOverrides(ctx context.Context, accountSlug string, graphSlug string, branchName string) ([]client.SubgraphRoutingOverride, error) {
+	if m.ListSubgraphRoutingOverridesFunc == nil {
+		panic("clientmock: unexpected call to ListSubgraphRoutingOverrides")
+	}
+	return m.ListSubgraphRoutingOverridesFunc(ctx, accountSlug, graphSlug, branchName)
+}
+
+// LookupSubgraphRoutingOverride calls LookupSubgraphRoutingOverrideFunc.
+func (m *API) LookupSubgraphRoutingOverride(ctx context.Context, accountSlug string, graphSlug string, branchName string, subgraphName string) (*client.SubgraphRoutingOverride, error) {
+	if m.LookupSubgraphRoutingOverrideFunc == nil {
+		panic("clientmock: unexpected call to LookupSubgraphRoutingOverride")
+	}
+	return m.LookupSubgraphRoutingOverrideFunc(ctx, accountSlug, graphSlug, branchName, subgraphName)
+}
+
+// CreateTeam calls CreateTeamFunc.
+func (m *API) CreateTeam(ctx context.Context, input client.CreateTeamInput) (*client.Team, error) {
+	if m.CreateTeamFunc == nil {
+		panic("clientmock: unexpected call to CreateTeam")
+	}
+	return m.CreateTeamFunc(ctx, input)
+}
+
+// UpdateTeam calls UpdateTeamFunc.
+func (m *API) UpdateTeam(ctx context.Context, input client.UpdateTeamInput) (*client.Team, error) {
+	if m.UpdateTeamFunc == nil {
+		panic("clientmock: unexpected call to UpdateTeam")
+	}
+	return m.UpdateTeamFunc(ctx, input)
+}
+
+// GetTeam calls GetTeamFunc.
+func (m *API) GetTeam(ctx context.Context, accountSlug string, teamSlug string) (*client.Team, error) {
+	if m.GetTeamFunc == nil {
+		panic("clientmock: unexpected call to GetTeam")
+	}
+	return m.GetTeamFunc(ctx, accountSlug, teamSlug)
+}
+
+// DeleteTeam calls DeleteTeamFunc.
+func (m *API) DeleteTeam(ctx context.Context, id string) error {
+	if m.DeleteTeamFunc == nil {
+		panic("clientmock: unexpected call to DeleteTeam")
+	}
+	return m.DeleteTeamFunc(ctx, id)
+}
+
+// AddTeamMember calls AddTeamMemberFunc.
+func (m *API) AddTeamMember(ctx context.Context, input client.AddTeamMemberInput) (*client.TeamMember, error) {
+	if m.AddTeamMemberFunc == nil {
+		panic("clientmock: unexpected call to AddTeamMember")
+	}
+	return m.AddTeamMemberFunc(ctx, input)
+}
+
+// ListTeamMembers calls ListTeamMembersFunc.
+func (m *API) ListTeamMembers(ctx context.Context, accountSlug string, teamSlug string) ([]client.TeamMember, error) {
+	if m.ListTeamMembersFunc == nil {
+		panic("clientmock: unexpected call to ListTeamMembers")
+	}
+	return m.ListTeamMembersFunc(ctx, accountSlug, teamSlug)
+}
+
+// GetTeamMember calls GetTeamMemberFunc.
+func (m *API) GetTeamMember(ctx context.Context, accountSlug string, teamSlug string, email string) (*client.TeamMember, error) {
+	if m.GetTeamMemberFunc == nil {
+		panic("clientmock: unexpected call to GetTeamMember")
+	}
+	return m.GetTeamMemberFunc(ctx, accountSlug, teamSlug, email)
+}
+
+// UpdateTeamMemberRole calls UpdateTeamMemberRoleFunc.
+func (m *API) UpdateTeamMemberRole(ctx context.Context, input client.UpdateTeamMemberRoleInput) (*client.TeamMember, error) {
+	if m.UpdateTeamMemberRoleFunc == nil {
+		panic("clientmock: unexpected call to UpdateTeamMemberRole")
+	}
+	return m.UpdateTeamMemberRoleFunc(ctx, input)
+}
+
+// RemoveTeamMember calls RemoveTeamMemberFunc.
+func (m *API) RemoveTeamMember(ctx context.Context, id string) error {
+	if m.RemoveTeamMemberFunc == nil {
+		panic("clientmock: unexpected call to RemoveTeamMember")
+	}
+	return m.RemoveTeamMemberFunc(ctx, id)
+}
+
+// GetTokenPolicy calls GetTokenPolicyFunc.
+func (m *API) GetTokenPolicy(ctx context.Context, accountSlug string) (*client.TokenPolicy, error) {
+	if m.GetTokenPolicyFunc == nil {
+		panic("clientmock: unexpected call to GetTokenPolicy")
+	}
+	return m.GetTokenPolicyFunc(ctx, accountSlug)
+}
+
+// SetTokenPolicy calls SetTokenPolicyFunc.
+func (m *API) SetTokenPolicy(ctx context.Context, input client.SetTokenPolicyInput) (*client.TokenPolicy, error) {
+	if m.SetTokenPolicyFunc == nil {
+		panic("clientmock: unexpected call to SetTokenPolicy")
+	}
+	return m.SetTokenPolicyFunc(ctx, input)
+}
+
+// ListTrustedDocuments calls ListTrustedDocumentsFunc.
+func (m *API) ListTrustedDocuments(ctx context.Context, accountSlug string, graphSlug string, branchName string, clientName string) ([]client.TrustedDocument, error) {
+	if m.ListTrustedDocumentsFunc == nil {
+		panic("clientmock: unexpected call to ListTrustedDocuments")
+	}
+	return m.ListTrustedDocumentsFunc(ctx, accountSlug, graphSlug, branchName, clientName)
+}
+
+// UploadTrustedDocuments calls UploadTrustedDocumentsFunc.
+func (m *API) UploadTrustedDocuments(ctx context.Context, input client.UploadTrustedDocumentsInput) error {
+	if m.UploadTrustedDocumentsFunc == nil {
+		panic("clientmock: unexpected call to UploadTrustedDocuments")
+	}
+	return m.UploadTrustedDocumentsFunc(ctx, input)
+}
+
+// DeleteTrustedDocuments calls DeleteTrustedDocumentsFunc.
+func (m *API) DeleteTrustedDocuments(ctx context.Context, input client.DeleteTrustedDocumentsInput) error {
+	if m.DeleteTrustedDocumentsFunc == nil {
+		panic("clientmock: unexpected call to DeleteTrustedDocuments")
+	}
+	return m.DeleteTrustedDocumentsFunc(ctx, input)
+}
+
+// GetViewer calls GetViewerFunc.
+func (m *API) GetViewer(ctx context.Context) (*client.Viewer, error) {
+	if m.GetViewerFunc == nil {
+		panic("clientmock: unexpected call to GetViewer")
+	}
+	return m.GetViewerFunc(ctx)
+}
+
+// CreateWebhook calls CreateWebhookFunc.
+func (m *API) CreateWebhook(ctx context.Context, input client.CreateWebhookInput) (*client.Webhook, string, error) {
+	if m.CreateWebhookFunc == nil {
+		panic("clientmock: unexpected call to CreateWebhook")
+	}
+	return m.CreateWebhookFunc(ctx, input)
+}
+
+// UpdateWebhook calls UpdateWebhookFunc.
+func (m *API) UpdateWebhook(ctx context.Context, input client.UpdateWebhookInput) (*client.Webhook, error) {
+	if m.UpdateWebhookFunc == nil {
+		panic("clientmock: unexpected call to UpdateWebhook")
+	}
+	return m.UpdateWebhookFunc(ctx, input)
+}
+
+// GetWebhook calls GetWebhookFunc.
+func (m *API) GetWebhook(ctx context.Context, id string) (*client.Webhook, error) {
+	if m.GetWebhookFunc == nil {
+		panic("clientmock: unexpected call to GetWebhook")
+	}
+	return m.GetWebhookFunc(ctx, id)
+}
+
+// DeleteWebhook calls DeleteWebhookFunc.
+func (m *API) DeleteWebhook(ctx context.Context, id string) error {
+	if m.DeleteWebhookFunc == nil {
+		panic("clientmock: unexpected call to DeleteWebhook")
+	}
+	return m.DeleteWebhookFunc(ctx, id)
+}
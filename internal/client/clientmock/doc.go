@@ -0,0 +1,4 @@
+// Package clientmock provides a mock of client.API, so resource logic can be
+// tested without an HTTP server. api.go is regenerated by running go generate
+// in the client package after the interface changes.
+package clientmock
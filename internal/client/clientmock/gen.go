@@ -0,0 +1,138 @@
+//go:build ignore
+
+// gen generates api.go, a mock of the client.API interface. It is run by
+// go generate from the client package directory.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+)
+
+const clientImport = "github.com/grafbase/terraform-provider-grafbase/internal/client"
+
+func main() {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "api.go", nil, 0)
+	if err != nil {
+		log.Fatalf("unable to parse api.go: %s", err)
+	}
+
+	api := findInterface(file, "API")
+	if api == nil {
+		log.Fatal("api.go does not declare the API interface")
+	}
+
+	var fields, methods bytes.Buffer
+	for _, method := range api.Methods.List {
+		name := method.Names[0].Name
+		fn := method.Type.(*ast.FuncType)
+
+		params, args := paramList(fn.Params)
+		signature := fmt.Sprintf("(%s) %s", params, resultList(fn.Results))
+
+		fmt.Fprintf(&fields, "\t%sFunc func%s\n", name, signature)
+		fmt.Fprintf(&methods, "\n// %[1]s calls %[1]sFunc.\nfunc (m *API) %[1]s%[2]s {\n", name, signature)
+		fmt.Fprintf(&methods, "\tif m.%sFunc == nil {\n\t\tpanic(\"clientmock: unexpected call to %s\")\n\t}\n", name, name)
+		fmt.Fprintf(&methods, "\treturn m.%sFunc(%s)\n}\n", name, args)
+	}
+
+	var out bytes.Buffer
+	out.WriteString("// Code generated by gen.go; DO NOT EDIT.\n\npackage clientmock\n\nimport (\n\t\"context\"\n")
+	if strings.Contains(fields.String(), "time.") {
+		out.WriteString("\t\"time\"\n")
+	}
+	fmt.Fprintf(&out, "\n\t%q\n)\n\n", clientImport)
+	out.WriteString("// API is a mock of client.API. Each method calls the field of the same name\n")
+	out.WriteString("// with a Func suffix, and panics when the field is not set.\n")
+	fmt.Fprintf(&out, "type API struct {\n%s}\n\nvar _ client.API = (*API)(nil)\n", fields.String())
+	out.Write(methods.Bytes())
+
+	source, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatalf("unable to format generated mock: %s", err)
+	}
+
+	if err := os.WriteFile("clientmock/api.go", source, 0o644); err != nil {
+		log.Fatalf("unable to write generated mock: %s", err)
+	}
+}
+
+// findInterface returns the interface type declared with the given name
+func findInterface(file *ast.File, name string) *ast.InterfaceType {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			typeSpec, ok := spec.(*ast.TypeSpec)
+			if !ok || typeSpec.Name.Name != name {
+				continue
+			}
+			if iface, ok := typeSpec.Type.(*ast.InterfaceType); ok {
+				return iface
+			}
+		}
+	}
+	return nil
+}
+
+// paramList returns the parameter declarations of a method and the argument
+// list forwarding them
+func paramList(fields *ast.FieldList) (string, string) {
+	var params, args []string
+	for _, field := range fields.List {
+		for _, name := range field.Names {
+			params = append(params, name.Name+" "+typeString(field.Type))
+			args = append(args, name.Name)
+		}
+	}
+	return strings.Join(params, ", "), strings.Join(args, ", ")
+}
+
+// resultList returns the result declarations of a method
+func resultList(fields *ast.FieldList) string {
+	if fields == nil {
+		return ""
+	}
+	var results []string
+	for _, field := range fields.List {
+		results = append(results, typeString(field.Type))
+	}
+	if len(results) == 1 {
+		return results[0]
+	}
+	return "(" + strings.Join(results, ", ") + ")"
+}
+
+// typeString prints a type expression of the client package, qualifying the
+// types it declares
+func typeString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return "client." + t.Name
+		}
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + typeString(t.X)
+	case *ast.ArrayType:
+		return "[]" + typeString(t.Elt)
+	case *ast.MapType:
+		return "map[" + typeString(t.Key) + "]" + typeString(t.Value)
+	case *ast.SelectorExpr:
+		return typeString(t.X) + "." + t.Sel.Name
+	case *ast.InterfaceType:
+		return "interface{}"
+	}
+	log.Fatalf("unsupported type expression %T", expr)
+	return ""
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CompositionStatus represents the state of a federated schema composition
+type CompositionStatus string
+
+const (
+	CompositionStatusPending   CompositionStatus = "PENDING"
+	CompositionStatusSucceeded CompositionStatus = "SUCCEEDED"
+	CompositionStatusFailed    CompositionStatus = "FAILED"
+)
+
+// Composition represents a composition of the subgraphs of a branch into its federated schema
+type Composition struct {
+	ID        string             `json:"id"`
+	Status    CompositionStatus  `json:"status"`
+	Errors    []CompositionError `json:"errors"`
+	CreatedAt time.Time          `json:"createdAt"`
+}
+
+// CompositionError represents a single error reported by a failed composition
+type CompositionError struct {
+	Message string `json:"message"`
+}
+
+// GetLatestBranchComposition retrieves the most recent composition of a branch
+func (c *Client) GetLatestBranchComposition(ctx context.Context, accountSlug, graphSlug, branchName string) (*Composition, error) {
+	query := `
+		query GetLatestBranchComposition($accountSlug: String!, $graphSlug: String!, $branchName: String!) {
+			branch(accountSlug: $accountSlug, graphSlug: $graphSlug, name: $branchName) {
+				latestComposition {
+					id
+					status
+					errors {
+						message
+					}
+					createdAt
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"graphSlug":   graphSlug,
+		"branchName":  branchName,
+	}
+
+	branch, err := execute[*struct {
+		LatestComposition *Composition `json:"latestComposition"`
+	}](ctx, c, operation{
+		action: "get composition",
+		query:  query,
+		path:   []string{"branch"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if branch == nil {
+		return nil, fmt.Errorf("branch not found")
+	}
+
+	if branch.LatestComposition == nil {
+		return nil, fmt.Errorf("composition not found")
+	}
+
+	return branch.LatestComposition, nil
+}
@@ -0,0 +1,32 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetLatestBranchComposition(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetLatestBranchComposition", `{"branch": {"latestComposition": {
+		"id": "composition-1", "status": "FAILED", "errors": [{"message": "field Product.price has conflicting types"}], "createdAt": "2024-01-15T10:30:00Z"
+	}}}`)
+	composition, err := c.GetLatestBranchComposition(ctx, "my-account", "my-graph", "main")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if composition.Status != CompositionStatusFailed || len(composition.Errors) != 1 {
+		t.Errorf("unexpected composition: %+v", composition)
+	}
+
+	server.Handle("GetLatestBranchComposition", `{"branch": {"latestComposition": null}}`)
+	if _, err := c.GetLatestBranchComposition(ctx, "my-account", "my-graph", "main"); err == nil || errorMessage(err) != "composition not found" {
+		t.Errorf("expected composition not found, got %v", err)
+	}
+
+	server.Handle("GetLatestBranchComposition", `{"branch": null}`)
+	if _, err := c.GetLatestBranchComposition(ctx, "my-account", "my-graph", "missing"); err == nil || errorMessage(err) != "branch not found" {
+		t.Errorf("expected branch not found, got %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// DefaultBranchSettings represents the settings applied to new preview branches of a graph
+type DefaultBranchSettings struct {
+	OperationChecksEnabled      bool   `json:"operationChecksEnabled"`
+	InheritEnvironmentVariables bool   `json:"inheritEnvironmentVariables"`
+	TTLHours                    *int64 `json:"ttlHours"`
+}
+
+// SetDefaultBranchSettingsInput represents the input for replacing the default branch settings of a graph
+type SetDefaultBranchSettingsInput struct {
+	AccountSlug                 string `json:"accountSlug"`
+	GraphSlug                   string `json:"graphSlug"`
+	OperationChecksEnabled      bool   `json:"operationChecksEnabled"`
+	InheritEnvironmentVariables bool   `json:"inheritEnvironmentVariables"`
+	TTLHours                    *int64 `json:"ttlHours"`
+}
+
+// GetDefaultBranchSettings retrieves the settings applied to new preview branches of a graph
+func (c *Client) GetDefaultBranchSettings(ctx context.Context, accountSlug, graphSlug string) (*DefaultBranchSettings, error) {
+	query := `
+		query GetDefaultBranchSettings($accountSlug: String!, $graphSlug: String!) {
+			graphByAccountSlug(accountSlug: $accountSlug, graphSlug: $graphSlug) {
+				defaultBranchSettings {
+					operationChecksEnabled
+					inheritEnvironmentVariables
+					ttlHours
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"graphSlug":   graphSlug,
+	}
+
+	graph, err := execute[*struct {
+		DefaultBranchSettings DefaultBranchSettings `json:"defaultBranchSettings"`
+	}](ctx, c, operation{
+		action: "get default branch settings",
+		query:  query,
+		path:   []string{"graphByAccountSlug"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if graph == nil {
+		return nil, fmt.Errorf("graph not found")
+	}
+
+	return &graph.DefaultBranchSettings, nil
+}
+
+// SetDefaultBranchSettings replaces the settings applied to new preview
+// branches of a graph. Existing branches keep their settings.
+func (c *Client) SetDefaultBranchSettings(ctx context.Context, input SetDefaultBranchSettingsInput) (*DefaultBranchSettings, error) {
+	query := `
+		mutation SetDefaultBranchSettings($input: DefaultBranchSettingsSetInput!) {
+			defaultBranchSettingsSet(input: $input) {
+				__typename
+				... on DefaultBranchSettingsSetSuccess {
+					defaultBranchSettings {
+						operationChecksEnabled
+						inheritEnvironmentVariables
+						ttlHours
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*DefaultBranchSettings](ctx, c, operation{
+		action:  "set default branch settings",
+		query:   query,
+		path:    []string{"defaultBranchSettingsSet"},
+		success: "DefaultBranchSettingsSetSuccess",
+		field:   "defaultBranchSettings",
+		errors: map[string]string{
+			"InvalidBranchTTLError": "branch TTL exceeds the maximum allowed by the account plan",
+		},
+	}, variables)
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetDefaultBranchSettings(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetDefaultBranchSettings", `{"graphByAccountSlug": {"defaultBranchSettings": {
+		"operationChecksEnabled": true,
+		"inheritEnvironmentVariables": false,
+		"ttlHours": 72
+	}}}`)
+	settings, err := c.GetDefaultBranchSettings(ctx, "my-account", "my-graph")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !settings.OperationChecksEnabled || settings.InheritEnvironmentVariables || settings.TTLHours == nil || *settings.TTLHours != 72 {
+		t.Errorf("unexpected settings: %+v", settings)
+	}
+
+	server.Handle("GetDefaultBranchSettings", `{"graphByAccountSlug": null}`)
+	if _, err := c.GetDefaultBranchSettings(ctx, "my-account", "missing"); err == nil || errorMessage(err) != "graph not found" {
+		t.Errorf("expected graph not found, got %v", err)
+	}
+}
+
+func TestSetDefaultBranchSettings(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := SetDefaultBranchSettingsInput{AccountSlug: "my-account", GraphSlug: "my-graph", InheritEnvironmentVariables: true}
+
+	server.Handle("SetDefaultBranchSettings", `{"defaultBranchSettingsSet": {"__typename": "DefaultBranchSettingsSetSuccess", "defaultBranchSettings": {"operationChecksEnabled": false, "inheritEnvironmentVariables": true, "ttlHours": null}}}`)
+	settings, err := c.SetDefaultBranchSettings(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if settings.TTLHours != nil {
+		t.Errorf("expected branches without TTL, got %d", *settings.TTLHours)
+	}
+
+	// A missing TTL is sent as null so branches stop expiring
+	sent := server.LastRequest("SetDefaultBranchSettings").Variables["input"].(map[string]interface{})
+	if ttl, ok := sent["ttlHours"]; !ok || ttl != nil {
+		t.Errorf("expected ttlHours to be null, got %v", ttl)
+	}
+
+	server.Handle("SetDefaultBranchSettings", `{"defaultBranchSettingsSet": {"__typename": "GraphDoesNotExistError"}}`)
+	if _, err := c.SetDefaultBranchSettings(ctx, input); err == nil || errorMessage(err) != "graph does not exist" {
+		t.Errorf("expected graph does not exist, got %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DeploymentStatus represents the rollout state of a branch deployment
+type DeploymentStatus string
+
+const (
+	DeploymentStatusPending   DeploymentStatus = "PENDING"
+	DeploymentStatusDeploying DeploymentStatus = "DEPLOYING"
+	DeploymentStatusHealthy   DeploymentStatus = "HEALTHY"
+	DeploymentStatusFailed    DeploymentStatus = "FAILED"
+)
+
+// Deployment represents a rollout of a branch configuration to the gateway
+type Deployment struct {
+	ID           string           `json:"id"`
+	Status       DeploymentStatus `json:"status"`
+	ErrorMessage string           `json:"errorMessage"`
+	CreatedAt    time.Time        `json:"createdAt"`
+	FinishedAt   *time.Time       `json:"finishedAt"`
+	// Commit is nil for deployments not triggered from version control
+	Commit *DeploymentCommit `json:"commit"`
+}
+
+// DeploymentCommit describes the version control commit that triggered a deployment
+type DeploymentCommit struct {
+	SHA        string `json:"sha"`
+	Message    string `json:"message"`
+	AuthorName string `json:"authorName"`
+}
+
+// GetLatestBranchDeployment retrieves the most recent deployment of a branch
+func (c *Client) GetLatestBranchDeployment(ctx context.Context, accountSlug, graphSlug, branchName string) (*Deployment, error) {
+	query := `
+		query GetLatestBranchDeployment($accountSlug: String!, $graphSlug: String!, $branchName: String!) {
+			branch(accountSlug: $accountSlug, graphSlug: $graphSlug, name: $branchName) {
+				latestDeployment {
+					id
+					status
+					errorMessage
+					createdAt
+					finishedAt
+					commit {
+						sha
+						message
+						authorName
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"graphSlug":   graphSlug,
+		"branchName":  branchName,
+	}
+
+	branch, err := execute[*struct {
+		LatestDeployment *Deployment `json:"latestDeployment"`
+	}](ctx, c, operation{
+		action: "get deployment",
+		query:  query,
+		path:   []string{"branch"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if branch == nil {
+		return nil, fmt.Errorf("branch not found")
+	}
+
+	if branch.LatestDeployment == nil {
+		return nil, fmt.Errorf("deployment not found")
+	}
+
+	return branch.LatestDeployment, nil
+}
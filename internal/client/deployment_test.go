@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetLatestBranchDeployment(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetLatestBranchDeployment", `{"branch": {"latestDeployment": {
+		"id": "deployment-1", "status": "HEALTHY", "errorMessage": null,
+		"createdAt": "2024-01-15T10:30:00Z", "finishedAt": "2024-01-15T10:31:00Z",
+		"commit": {"sha": "4f2c1a9", "message": "Add products subgraph", "authorName": "Jane Doe"}
+	}}}`)
+	deployment, err := c.GetLatestBranchDeployment(ctx, "my-account", "my-graph", "main")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deployment.Status != DeploymentStatusHealthy || deployment.FinishedAt == nil {
+		t.Errorf("unexpected deployment: %+v", deployment)
+	}
+	if deployment.Commit == nil || deployment.Commit.SHA != "4f2c1a9" {
+		t.Errorf("unexpected deployment commit: %+v", deployment.Commit)
+	}
+
+	server.Handle("GetLatestBranchDeployment", `{"branch": {"latestDeployment": null}}`)
+	if _, err := c.GetLatestBranchDeployment(ctx, "my-account", "my-graph", "main"); err == nil || errorMessage(err) != "deployment not found" {
+		t.Errorf("expected deployment not found, got %v", err)
+	}
+
+	server.Handle("GetLatestBranchDeployment", `{"branch": null}`)
+	if _, err := c.GetLatestBranchDeployment(ctx, "my-account", "my-graph", "missing"); err == nil || errorMessage(err) != "branch not found" {
+		t.Errorf("expected branch not found, got %v", err)
+	}
+}
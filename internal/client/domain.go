@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CustomDomainStatus represents the verification state of a custom domain
+type CustomDomainStatus string
+
+const (
+	CustomDomainStatusPending  CustomDomainStatus = "PENDING"
+	CustomDomainStatusVerified CustomDomainStatus = "VERIFIED"
+	CustomDomainStatusFailed   CustomDomainStatus = "FAILED"
+)
+
+// CustomDomain represents a custom domain serving a graph branch
+type CustomDomain struct {
+	ID                string             `json:"id"`
+	Domain            string             `json:"domain"`
+	Status            CustomDomainStatus `json:"status"`
+	BranchName        string             `json:"branchName"`
+	ValidationRecords []DNSRecord        `json:"validationRecords"`
+	CreatedAt         time.Time          `json:"createdAt"`
+}
+
+// DNSRecord represents a DNS record required to verify a custom domain
+type DNSRecord struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// CreateCustomDomainInput represents the input for creating a custom domain
+type CreateCustomDomainInput struct {
+	AccountSlug string `json:"accountSlug"`
+	GraphSlug   string `json:"graphSlug"`
+	BranchName  string `json:"branchName,omitempty"`
+	Domain      string `json:"domain"`
+}
+
+// customDomainFields is the selection set shared by custom domain queries
+const customDomainFields = `
+	id
+	domain
+	status
+	branchName
+	validationRecords {
+		type
+		name
+		value
+	}
+	createdAt
+`
+
+// CreateCustomDomain creates a custom domain for a graph branch
+func (c *Client) CreateCustomDomain(ctx context.Context, input CreateCustomDomainInput) (*CustomDomain, error) {
+	query := `
+		mutation CreateCustomDomain($input: CustomDomainCreateInput!) {
+			customDomainCreate(input: $input) {
+				__typename
+				... on CustomDomainCreateSuccess {
+					customDomain {` + customDomainFields + `}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*CustomDomain](ctx, c, operation{
+		action:  "create custom domain",
+		query:   query,
+		path:    []string{"customDomainCreate"},
+		success: "CustomDomainCreateSuccess",
+		field:   "customDomain",
+		errors: map[string]string{
+			"DomainAlreadyExistsError": "domain already exists",
+			"DomainInvalidError":       "domain is invalid",
+		},
+	}, variables)
+}
+
+// GetCustomDomain retrieves a custom domain by ID using the node query
+func (c *Client) GetCustomDomain(ctx context.Context, id string) (*CustomDomain, error) {
+	query := `
+		query GetCustomDomain($id: ID!) {
+			node(id: $id) {
+				... on CustomDomain {` + customDomainFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	domain, err := execute[*CustomDomain](ctx, c, operation{
+		action: "get custom domain",
+		query:  query,
+		path:   []string{"node"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if domain == nil || domain.ID == "" {
+		return nil, fmt.Errorf("custom domain not found")
+	}
+
+	return domain, nil
+}
+
+// DeleteCustomDomain deletes a custom domain
+func (c *Client) DeleteCustomDomain(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteCustomDomain($id: ID!) {
+			customDomainDelete(id: $id) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "delete custom domain",
+		query:   query,
+		path:    []string{"customDomainDelete"},
+		success: "CustomDomainDeleteSuccess",
+		errors: map[string]string{
+			"CustomDomainDoesNotExistError": "custom domain does not exist",
+		},
+	}, variables)
+
+	return err
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+const testCustomDomainJSON = `{
+	"id": "domain-1",
+	"domain": "api.example.com",
+	"status": "PENDING",
+	"branchName": "main",
+	"validationRecords": [{"type": "CNAME", "name": "_acme-challenge.api.example.com", "value": "validation.grafbase.com"}],
+	"createdAt": "2024-01-15T10:30:00Z"
+}`
+
+func TestCreateCustomDomain(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := CreateCustomDomainInput{AccountSlug: "my-account", GraphSlug: "my-graph", Domain: "api.example.com"}
+
+	server.Handle("CreateCustomDomain", `{"customDomainCreate": {"__typename": "CustomDomainCreateSuccess", "customDomain": `+testCustomDomainJSON+`}}`)
+	domain, err := c.CreateCustomDomain(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if domain.Status != CustomDomainStatusPending || len(domain.ValidationRecords) != 1 {
+		t.Errorf("unexpected domain: %+v", domain)
+	}
+
+	server.Handle("CreateCustomDomain", `{"customDomainCreate": {"__typename": "DomainAlreadyExistsError"}}`)
+	if _, err := c.CreateCustomDomain(ctx, input); err == nil || errorMessage(err) != "domain already exists" {
+		t.Errorf("expected domain already exists, got %v", err)
+	}
+}
+
+func TestGetCustomDomain(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetCustomDomain", `{"node": `+testCustomDomainJSON+`}`)
+	domain, err := c.GetCustomDomain(ctx, "domain-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if domain.Domain != "api.example.com" {
+		t.Errorf("unexpected domain: %+v", domain)
+	}
+
+	// The node query resolves IDs of other types to an empty object
+	server.Handle("GetCustomDomain", `{"node": {}}`)
+	if _, err := c.GetCustomDomain(ctx, "graph-1"); err == nil || errorMessage(err) != "custom domain not found" {
+		t.Errorf("expected custom domain not found, got %v", err)
+	}
+}
+
+func TestDeleteCustomDomain(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("DeleteCustomDomain", `{"customDomainDelete": {"__typename": "CustomDomainDeleteSuccess"}}`)
+	if err := c.DeleteCustomDomain(ctx, "domain-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("DeleteCustomDomain", `{"customDomainDelete": {"__typename": "CustomDomainDoesNotExistError"}}`)
+	if err := c.DeleteCustomDomain(ctx, "domain-1"); err == nil || errorMessage(err) != "custom domain does not exist" {
+		t.Errorf("expected custom domain does not exist, got %v", err)
+	}
+}
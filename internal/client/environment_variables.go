@@ -0,0 +1,128 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// EnvironmentVariable represents an environment variable exposed to the gateway of a branch
+type EnvironmentVariable struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// UpsertBranchEnvironmentVariablesInput represents the input for creating or
+// updating several environment variables of a branch at once
+type UpsertBranchEnvironmentVariablesInput struct {
+	AccountSlug string                `json:"accountSlug"`
+	GraphSlug   string                `json:"graphSlug"`
+	BranchName  string                `json:"branchName"`
+	Variables   []EnvironmentVariable `json:"variables"`
+}
+
+// DeleteBranchEnvironmentVariablesInput represents the input for deleting
+// several environment variables of a branch at once
+type DeleteBranchEnvironmentVariablesInput struct {
+	AccountSlug string   `json:"accountSlug"`
+	GraphSlug   string   `json:"graphSlug"`
+	BranchName  string   `json:"branchName"`
+	Names       []string `json:"names"`
+}
+
+// GetBranchEnvironmentVariables retrieves every environment variable of a branch
+func (c *Client) GetBranchEnvironmentVariables(ctx context.Context, accountSlug, graphSlug, branchName string) ([]EnvironmentVariable, error) {
+	query := `
+		query GetBranchEnvironmentVariables($accountSlug: String!, $graphSlug: String!, $branchName: String!) {
+			branch(accountSlug: $accountSlug, graphSlug: $graphSlug, name: $branchName) {
+				environmentVariables {
+					name
+					value
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"graphSlug":   graphSlug,
+		"branchName":  branchName,
+	}
+
+	branch, err := execute[*struct {
+		EnvironmentVariables []EnvironmentVariable `json:"environmentVariables"`
+	}](ctx, c, operation{
+		action: "get branch environment variables",
+		query:  query,
+		path:   []string{"branch"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if branch == nil {
+		return nil, fmt.Errorf("branch not found")
+	}
+
+	return branch.EnvironmentVariables, nil
+}
+
+// UpsertBranchEnvironmentVariables creates or updates the given environment
+// variables of a branch in a single request. Other variables are left untouched.
+func (c *Client) UpsertBranchEnvironmentVariables(ctx context.Context, input UpsertBranchEnvironmentVariablesInput) error {
+	query := `
+		mutation UpsertBranchEnvironmentVariables($input: BranchEnvironmentVariablesUpsertInput!) {
+			branchEnvironmentVariablesUpsert(input: $input) {
+				__typename
+				... on InvalidEnvironmentVariableNameError {
+					name
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "upsert branch environment variables",
+		query:   query,
+		path:    []string{"branchEnvironmentVariablesUpsert"},
+		success: "BranchEnvironmentVariablesUpsertSuccess",
+		errors:  environmentVariablesErrors,
+	}, variables)
+
+	return err
+}
+
+// DeleteBranchEnvironmentVariables deletes the given environment variables of
+// a branch in a single request. Names that are not set are ignored.
+func (c *Client) DeleteBranchEnvironmentVariables(ctx context.Context, input DeleteBranchEnvironmentVariablesInput) error {
+	query := `
+		mutation DeleteBranchEnvironmentVariables($input: BranchEnvironmentVariablesDeleteInput!) {
+			branchEnvironmentVariablesDelete(input: $input) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "delete branch environment variables",
+		query:   query,
+		path:    []string{"branchEnvironmentVariablesDelete"},
+		success: "BranchEnvironmentVariablesDeleteSuccess",
+		errors:  environmentVariablesErrors,
+	}, variables)
+
+	return err
+}
+
+// environmentVariablesErrors are the errors of the environment variable upsert
+// and delete mutations
+var environmentVariablesErrors = map[string]string{
+	"InvalidEnvironmentVariableNameError": `invalid environment variable name "${name}"`,
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetBranchEnvironmentVariables(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetBranchEnvironmentVariables", `{"branch": {"environmentVariables": [{"name": "UPSTREAM_TOKEN", "value": "secret"}]}}`)
+	variables, err := c.GetBranchEnvironmentVariables(ctx, "my-account", "my-graph", "main")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(variables) != 1 || variables[0].Name != "UPSTREAM_TOKEN" || variables[0].Value != "secret" {
+		t.Errorf("unexpected variables: %+v", variables)
+	}
+
+	server.Handle("GetBranchEnvironmentVariables", `{"branch": null}`)
+	if _, err := c.GetBranchEnvironmentVariables(ctx, "my-account", "my-graph", "missing"); err == nil || errorMessage(err) != "branch not found" {
+		t.Errorf("expected branch not found, got %v", err)
+	}
+}
+
+func TestUpsertBranchEnvironmentVariables(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := UpsertBranchEnvironmentVariablesInput{
+		AccountSlug: "my-account",
+		GraphSlug:   "my-graph",
+		BranchName:  "main",
+		Variables:   []EnvironmentVariable{{Name: "UPSTREAM_TOKEN", Value: "secret"}},
+	}
+
+	server.Handle("UpsertBranchEnvironmentVariables", `{"branchEnvironmentVariablesUpsert": {"__typename": "BranchEnvironmentVariablesUpsertSuccess"}}`)
+	if err := c.UpsertBranchEnvironmentVariables(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("UpsertBranchEnvironmentVariables", `{"branchEnvironmentVariablesUpsert": {"__typename": "InvalidEnvironmentVariableNameError", "name": "1TOKEN"}}`)
+	if err := c.UpsertBranchEnvironmentVariables(ctx, input); err == nil || errorMessage(err) != `invalid environment variable name "1TOKEN"` {
+		t.Errorf("expected invalid name error, got %v", err)
+	}
+}
+
+func TestDeleteBranchEnvironmentVariables(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := DeleteBranchEnvironmentVariablesInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main", Names: []string{"UPSTREAM_TOKEN"}}
+
+	server.Handle("DeleteBranchEnvironmentVariables", `{"branchEnvironmentVariablesDelete": {"__typename": "BranchEnvironmentVariablesDeleteSuccess"}}`)
+	if err := c.DeleteBranchEnvironmentVariables(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("DeleteBranchEnvironmentVariables", `{"branchEnvironmentVariablesDelete": {"__typename": "BranchDoesNotExistError"}}`)
+	if err := c.DeleteBranchEnvironmentVariables(ctx, input); err == nil || errorMessage(err) != "branch does not exist" {
+		t.Errorf("expected branch does not exist, got %v", err)
+	}
+}
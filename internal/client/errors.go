@@ -0,0 +1,179 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// UnionError is implemented by the typed errors decoded from the error
+// members of mutation result unions, such as SlugTooLongError.
+type UnionError interface {
+	error
+	// Typename returns the GraphQL __typename of the error
+	Typename() string
+	// Field returns the mutation input field the error relates to, or an empty string
+	Field() string
+	// Summary returns a short title describing the error
+	Summary() string
+}
+
+// RequestError annotates an error of an API call with the ID of the call, so it
+// can be quoted to Grafbase support. The annotated error is still found by
+// errors.As, for example a typed UnionError.
+type RequestError struct {
+	RequestID string
+	Err       error
+}
+
+func (e *RequestError) Error() string { return fmt.Sprintf("%s (request ID %s)", e.Err, e.RequestID) }
+func (e *RequestError) Unwrap() error { return e.Err }
+
+// withRequestID annotates err with the request ID, unless either is empty
+func withRequestID(err error, requestID string) error {
+	if err == nil || requestID == "" {
+		return err
+	}
+
+	return &RequestError{RequestID: requestID, Err: err}
+}
+
+// AccountDoesNotExistError is returned when the account of a mutation input does not exist
+type AccountDoesNotExistError struct{}
+
+func (e *AccountDoesNotExistError) Error() string    { return "account does not exist" }
+func (e *AccountDoesNotExistError) Typename() string { return "AccountDoesNotExistError" }
+func (e *AccountDoesNotExistError) Field() string    { return "accountSlug" }
+func (e *AccountDoesNotExistError) Summary() string  { return "Account Not Found" }
+
+// DisabledAccountError is returned when the account of a mutation input is disabled
+type DisabledAccountError struct{}
+
+func (e *DisabledAccountError) Error() string    { return "account is disabled" }
+func (e *DisabledAccountError) Typename() string { return "DisabledAccountError" }
+func (e *DisabledAccountError) Field() string    { return "accountSlug" }
+func (e *DisabledAccountError) Summary() string  { return "Account Disabled" }
+
+// GraphDoesNotExistError is returned when the graph of a mutation input does not exist
+type GraphDoesNotExistError struct{}
+
+func (e *GraphDoesNotExistError) Error() string    { return "graph does not exist" }
+func (e *GraphDoesNotExistError) Typename() string { return "GraphDoesNotExistError" }
+func (e *GraphDoesNotExistError) Field() string    { return "graphSlug" }
+func (e *GraphDoesNotExistError) Summary() string  { return "Graph Not Found" }
+
+// GraphNotSelfHostedError is returned when an operation requires a self-hosted graph
+type GraphNotSelfHostedError struct{}
+
+func (e *GraphNotSelfHostedError) Error() string    { return "graph is not self-hosted" }
+func (e *GraphNotSelfHostedError) Typename() string { return "GraphNotSelfHostedError" }
+func (e *GraphNotSelfHostedError) Field() string    { return "graphSlug" }
+func (e *GraphNotSelfHostedError) Summary() string  { return "Graph Not Self-Hosted" }
+
+// GraphTransferNotAllowedError is returned when the caller cannot move a graph
+// to the target account, for example without owner access to both accounts
+type GraphTransferNotAllowedError struct{}
+
+func (e *GraphTransferNotAllowedError) Error() string {
+	return "graph transfer is not allowed, owner access to both accounts is required"
+}
+func (e *GraphTransferNotAllowedError) Typename() string { return "GraphTransferNotAllowedError" }
+func (e *GraphTransferNotAllowedError) Field() string    { return "accountSlug" }
+func (e *GraphTransferNotAllowedError) Summary() string  { return "Graph Transfer Not Allowed" }
+
+// BranchDoesNotExistError is returned when the branch of a mutation input does not exist
+type BranchDoesNotExistError struct{}
+
+func (e *BranchDoesNotExistError) Error() string    { return "branch does not exist" }
+func (e *BranchDoesNotExistError) Typename() string { return "BranchDoesNotExistError" }
+func (e *BranchDoesNotExistError) Field() string    { return "branchName" }
+func (e *BranchDoesNotExistError) Summary() string  { return "Branch Not Found" }
+
+// BranchAlreadyExistsError is returned when creating a branch whose name is taken
+type BranchAlreadyExistsError struct{}
+
+func (e *BranchAlreadyExistsError) Error() string    { return "branch already exists" }
+func (e *BranchAlreadyExistsError) Typename() string { return "BranchAlreadyExistsError" }
+func (e *BranchAlreadyExistsError) Field() string    { return "branchName" }
+func (e *BranchAlreadyExistsError) Summary() string  { return "Branch Already Exists" }
+
+// SourceBranchDoesNotExistError is returned when creating a branch from a
+// source branch that does not exist
+type SourceBranchDoesNotExistError struct{}
+
+func (e *SourceBranchDoesNotExistError) Error() string    { return "source branch does not exist" }
+func (e *SourceBranchDoesNotExistError) Typename() string { return "SourceBranchDoesNotExistError" }
+func (e *SourceBranchDoesNotExistError) Field() string    { return "sourceBranch" }
+func (e *SourceBranchDoesNotExistError) Summary() string  { return "Source Branch Not Found" }
+
+// SlugAlreadyExistsError is returned when a slug is already used in the account
+type SlugAlreadyExistsError struct{}
+
+func (e *SlugAlreadyExistsError) Error() string    { return "slug already exists" }
+func (e *SlugAlreadyExistsError) Typename() string { return "SlugAlreadyExistsError" }
+func (e *SlugAlreadyExistsError) Field() string    { return "slug" }
+func (e *SlugAlreadyExistsError) Summary() string  { return "Slug Already Exists" }
+
+// SlugInvalidError is returned when a slug contains disallowed characters
+type SlugInvalidError struct{}
+
+func (e *SlugInvalidError) Error() string    { return "slug is invalid" }
+func (e *SlugInvalidError) Typename() string { return "SlugInvalidError" }
+func (e *SlugInvalidError) Field() string    { return "slug" }
+func (e *SlugInvalidError) Summary() string  { return "Invalid Slug" }
+
+// SlugTooLongError is returned when a slug exceeds the maximum length
+type SlugTooLongError struct {
+	MaxLength int `json:"maxLength"`
+}
+
+func (e *SlugTooLongError) Error() string {
+	return fmt.Sprintf("slug is too long, the maximum length is %d", e.MaxLength)
+}
+func (e *SlugTooLongError) Typename() string { return "SlugTooLongError" }
+func (e *SlugTooLongError) Field() string    { return "slug" }
+func (e *SlugTooLongError) Summary() string  { return "Slug Too Long" }
+
+// decodeUnionError decodes the error member of a mutation result union into a
+// typed UnionError. It returns nil when the __typename is not a known error type.
+func decodeUnionError(raw json.RawMessage) UnionError {
+	var discriminator struct {
+		Typename string `json:"__typename"`
+	}
+	if err := json.Unmarshal(raw, &discriminator); err != nil {
+		return nil
+	}
+
+	var unionErr UnionError
+	switch discriminator.Typename {
+	case "AccountDoesNotExistError":
+		unionErr = &AccountDoesNotExistError{}
+	case "DisabledAccountError":
+		unionErr = &DisabledAccountError{}
+	case "GraphDoesNotExistError":
+		unionErr = &GraphDoesNotExistError{}
+	case "GraphNotSelfHostedError":
+		unionErr = &GraphNotSelfHostedError{}
+	case "GraphTransferNotAllowedError":
+		unionErr = &GraphTransferNotAllowedError{}
+	case "BranchDoesNotExistError":
+		unionErr = &BranchDoesNotExistError{}
+	case "BranchAlreadyExistsError":
+		unionErr = &BranchAlreadyExistsError{}
+	case "SourceBranchDoesNotExistError":
+		unionErr = &SourceBranchDoesNotExistError{}
+	case "SlugAlreadyExistsError":
+		unionErr = &SlugAlreadyExistsError{}
+	case "SlugInvalidError":
+		unionErr = &SlugInvalidError{}
+	case "SlugTooLongError":
+		unionErr = &SlugTooLongError{}
+	default:
+		return nil
+	}
+
+	if err := json.Unmarshal(raw, unionErr); err != nil {
+		return nil
+	}
+
+	return unionErr
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// errorMessage returns the message of err without the request ID that is
+// added to the errors of API calls
+func errorMessage(err error) string {
+	var requestErr *RequestError
+	if errors.As(err, &requestErr) {
+		return requestErr.Err.Error()
+	}
+
+	return err.Error()
+}
+
+func TestDecodeUnionError(t *testing.T) {
+	tests := []struct {
+		name             string
+		raw              string
+		expectedTypename string
+		expectedMessage  string
+		expectedField    string
+	}{
+		{
+			name:             "slug too long",
+			raw:              `{"__typename": "SlugTooLongError", "maxLength": 48}`,
+			expectedTypename: "SlugTooLongError",
+			expectedMessage:  "slug is too long, the maximum length is 48",
+			expectedField:    "slug",
+		},
+		{
+			name:             "graph does not exist",
+			raw:              `{"__typename": "GraphDoesNotExistError"}`,
+			expectedTypename: "GraphDoesNotExistError",
+			expectedMessage:  "graph does not exist",
+			expectedField:    "graphSlug",
+		},
+		{
+			name: "unknown typename",
+			raw:  `{"__typename": "SomethingNewError"}`,
+		},
+		{
+			name: "success member",
+			raw:  `{"graph": {"id": "1"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unionErr := decodeUnionError(json.RawMessage(tt.raw))
+
+			if tt.expectedTypename == "" {
+				if unionErr != nil {
+					t.Fatalf("expected no error, got %v", unionErr)
+				}
+				return
+			}
+
+			if unionErr == nil {
+				t.Fatalf("expected %s, got nil", tt.expectedTypename)
+			}
+			if unionErr.Typename() != tt.expectedTypename {
+				t.Errorf("expected typename %q, got %q", tt.expectedTypename, unionErr.Typename())
+			}
+			if unionErr.Error() != tt.expectedMessage {
+				t.Errorf("expected message %q, got %q", tt.expectedMessage, unionErr.Error())
+			}
+			if unionErr.Field() != tt.expectedField {
+				t.Errorf("expected field %q, got %q", tt.expectedField, unionErr.Field())
+			}
+		})
+	}
+}
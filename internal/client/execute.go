@@ -0,0 +1,129 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// operation describes a GraphQL operation run by execute
+type operation struct {
+	// action describes the operation in error messages, such as "create graph"
+	action string
+	// query is the GraphQL document of the operation
+	query string
+	// path lists the fields leading from the response data to the decoded
+	// value, such as ["graphByAccountSlug"]. A null or missing object on the
+	// path decodes to the zero value, so getters can report "not found".
+	path []string
+	// success is the __typename of the success member when the value at path
+	// is a result union. Any other member of the union is returned as an error.
+	success string
+	// field is the field of the success member to decode, or empty to decode
+	// the whole member. A success member without the field is an error.
+	field string
+	// errors maps the __typename of union error members to an error message.
+	// Messages are expanded with the fields of the member, so
+	// "schema tag ${name} does not exist" reports the name of the tag. Members
+	// not listed are decoded with decodeUnionError.
+	errors map[string]string
+}
+
+// execute runs a GraphQL operation and decodes the value at the path of the
+// operation into T. When the operation is a mutation returning a result union,
+// the success member is decoded and error members are returned as errors.
+func execute[T any](ctx context.Context, c *Client, op operation, variables map[string]interface{}) (T, error) {
+	var value T
+
+	resp, err := c.ExecuteQuery(ctx, op.query, variables)
+	if err != nil {
+		return value, fmt.Errorf("failed to %s: %w", op.action, err)
+	}
+
+	raw, err := valueAt(resp.Data, op.path)
+	if err != nil {
+		return value, withRequestID(fmt.Errorf("failed to decode %s response: %w", op.action, err), resp.RequestID)
+	}
+
+	if op.success != "" {
+		if err := op.unionError(raw); err != nil {
+			return value, withRequestID(err, resp.RequestID)
+		}
+		if op.field != "" {
+			member := raw
+			if raw, err = valueAt(member, []string{op.field}); err != nil {
+				return value, withRequestID(fmt.Errorf("failed to decode %s response: %w", op.action, err), resp.RequestID)
+			}
+			if isNull(raw) {
+				return value, withRequestID(fmt.Errorf("failed to %s: unexpected result %s", op.action, string(member)), resp.RequestID)
+			}
+		}
+	}
+
+	if isNull(raw) {
+		return value, nil
+	}
+
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return value, withRequestID(fmt.Errorf("failed to decode %s response: %w", op.action, err), resp.RequestID)
+	}
+
+	return value, nil
+}
+
+// unionError returns the error reported by a member of a result union, or nil
+// for the success member
+func (op operation) unionError(member json.RawMessage) error {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(member, &fields); err != nil {
+		return fmt.Errorf("failed to decode %s response: %w", op.action, err)
+	}
+
+	typename, _ := fields["__typename"].(string)
+	if typename == op.success {
+		return nil
+	}
+
+	if message, ok := op.errors[typename]; ok {
+		return errors.New(os.Expand(message, func(key string) string {
+			if v, ok := fields[key]; ok && v != nil {
+				return fmt.Sprint(v)
+			}
+			return ""
+		}))
+	}
+
+	if unionErr := decodeUnionError(member); unionErr != nil {
+		return unionErr
+	}
+
+	return fmt.Errorf("failed to %s: unexpected result %s", op.action, string(member))
+}
+
+// valueAt returns the value found by following path from data. A null or
+// missing object on the path results in null.
+func valueAt(data json.RawMessage, path []string) (json.RawMessage, error) {
+	current := data
+	for _, field := range path {
+		if isNull(current) {
+			return nil, nil
+		}
+
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(current, &object); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s: %w", field, err)
+		}
+		current = object[field]
+	}
+
+	return current, nil
+}
+
+// isNull reports whether a raw JSON value is absent or null
+func isNull(raw json.RawMessage) bool {
+	trimmed := bytes.TrimSpace(raw)
+	return len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null"))
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type testThing struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+var getThing = operation{
+	action: "get thing",
+	query:  `query GetThing { account { thing { id name } } }`,
+	path:   []string{"account", "thing"},
+}
+
+var setThing = operation{
+	action:  "set thing",
+	query:   `mutation SetThing { thingSet { __typename ... on ThingSetSuccess { thing { id name } } } }`,
+	path:    []string{"thingSet"},
+	success: "ThingSetSuccess",
+	field:   "thing",
+	errors: map[string]string{
+		"ThingNameInvalidError": `thing name "${name}" is invalid`,
+	},
+}
+
+func TestExecute(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetThing", `{"account": {"thing": {"id": "thing-1", "name": "first"}}}`)
+	thing, err := execute[*testThing](ctx, c, getThing, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if thing == nil || thing.ID != "thing-1" {
+		t.Errorf("unexpected thing: %+v", thing)
+	}
+
+	// A null object anywhere on the path decodes to the zero value
+	for _, data := range []string{`{"account": {"thing": null}}`, `{"account": null}`, `{}`} {
+		server.Handle("GetThing", data)
+		thing, err := execute[*testThing](ctx, c, getThing, nil)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", data, err)
+		}
+		if thing != nil {
+			t.Errorf("expected no thing for %s, got %+v", data, thing)
+		}
+	}
+
+	server.HandleErrors("GetThing", "internal error")
+	_, err = execute[*testThing](ctx, c, getThing, nil)
+	want := fmt.Sprintf("failed to get thing: GraphQL errors: [internal error] (request ID %s)", server.LastRequest("GetThing").Header.Get("X-Request-Id"))
+	if err == nil || err.Error() != want {
+		t.Errorf("expected %q, got %v", want, err)
+	}
+}
+
+func TestExecuteUnion(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("SetThing", `{"thingSet": {"__typename": "ThingSetSuccess", "thing": {"id": "thing-1", "name": "first"}}}`)
+	thing, err := execute[*testThing](ctx, c, setThing, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if thing == nil || thing.Name != "first" {
+		t.Errorf("unexpected thing: %+v", thing)
+	}
+
+	tests := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{
+			name:     "listed error",
+			data:     `{"thingSet": {"__typename": "ThingNameInvalidError", "name": "no spaces"}}`,
+			expected: `thing name "no spaces" is invalid`,
+		},
+		{
+			name:     "typed error",
+			data:     `{"thingSet": {"__typename": "BranchDoesNotExistError"}}`,
+			expected: "branch does not exist",
+		},
+		{
+			name:     "unknown member",
+			data:     `{"thingSet": {"__typename": "SomethingNewError"}}`,
+			expected: `failed to set thing: unexpected result {"__typename":"SomethingNewError"}`,
+		},
+		{
+			name:     "success without field",
+			data:     `{"thingSet": {"__typename": "ThingSetSuccess", "thing": null}}`,
+			expected: `failed to set thing: unexpected result {"__typename":"ThingSetSuccess","thing":null}`,
+		},
+		{
+			name:     "null result",
+			data:     `{"thingSet": null}`,
+			expected: "failed to set thing: unexpected result null",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server.Handle("SetThing", tt.data)
+			_, err := execute[*testThing](ctx, c, setThing, nil)
+			var requestErr *RequestError
+			if !errors.As(err, &requestErr) {
+				t.Fatalf("expected the error to carry a request ID, got %v", err)
+			}
+			if requestErr.RequestID == "" || requestErr.Err.Error() != tt.expected {
+				t.Errorf("expected %q with a request ID, got %v", tt.expected, err)
+			}
+		})
+	}
+
+	// Error members with a typed error keep their type for diagnostics
+	server.Handle("SetThing", `{"thingSet": {"__typename": "SlugTooLongError", "maxLength": 48}}`)
+	_, err = execute[*testThing](ctx, c, setThing, nil)
+	var slugErr *SlugTooLongError
+	if !errors.As(err, &slugErr) || slugErr.MaxLength != 48 {
+		t.Errorf("expected a SlugTooLongError, got %v", err)
+	}
+	var requestErr *RequestError
+	if !errors.As(err, &requestErr) || requestErr.RequestID == "" {
+		t.Errorf("expected the typed error to carry a request ID, got %v", err)
+	}
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// FeatureFlag represents a gateway feature flag toggle on a branch
+type FeatureFlag struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
+// SetBranchFeatureFlagsInput represents the input for replacing the feature flags of a branch
+type SetBranchFeatureFlagsInput struct {
+	AccountSlug  string        `json:"accountSlug"`
+	GraphSlug    string        `json:"graphSlug"`
+	BranchName   string        `json:"branchName"`
+	FeatureFlags []FeatureFlag `json:"featureFlags"`
+}
+
+// GetBranchFeatureFlags retrieves the gateway feature flags explicitly set on a branch
+func (c *Client) GetBranchFeatureFlags(ctx context.Context, accountSlug, graphSlug, branchName string) ([]FeatureFlag, error) {
+	query := `
+		query GetBranchFeatureFlags($accountSlug: String!, $graphSlug: String!, $branchName: String!) {
+			branch(accountSlug: $accountSlug, graphSlug: $graphSlug, name: $branchName) {
+				featureFlags {
+					name
+					enabled
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"graphSlug":   graphSlug,
+		"branchName":  branchName,
+	}
+
+	branch, err := execute[*struct {
+		FeatureFlags []FeatureFlag `json:"featureFlags"`
+	}](ctx, c, operation{
+		action: "get branch feature flags",
+		query:  query,
+		path:   []string{"branch"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if branch == nil {
+		return nil, fmt.Errorf("branch not found")
+	}
+
+	return branch.FeatureFlags, nil
+}
+
+// SetBranchFeatureFlags replaces the full set of gateway feature flags on a branch.
+// Flags missing from the input fall back to the gateway defaults.
+func (c *Client) SetBranchFeatureFlags(ctx context.Context, input SetBranchFeatureFlagsInput) ([]FeatureFlag, error) {
+	query := `
+		mutation SetBranchFeatureFlags($input: BranchFeatureFlagsSetInput!) {
+			branchFeatureFlagsSet(input: $input) {
+				__typename
+				... on BranchFeatureFlagsSetSuccess {
+					featureFlags {
+						name
+						enabled
+					}
+				}
+				... on UnknownFeatureFlagError {
+					name
+				}
+			}
+		}
+	`
+
+	if input.FeatureFlags == nil {
+		input.FeatureFlags = []FeatureFlag{}
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[[]FeatureFlag](ctx, c, operation{
+		action:  "set branch feature flags",
+		query:   query,
+		path:    []string{"branchFeatureFlagsSet"},
+		success: "BranchFeatureFlagsSetSuccess",
+		field:   "featureFlags",
+		errors: map[string]string{
+			"UnknownFeatureFlagError": `unknown feature flag "${name}"`,
+		},
+	}, variables)
+}
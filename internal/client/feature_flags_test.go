@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetBranchFeatureFlags(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetBranchFeatureFlags", `{"branch": {"featureFlags": [{"name": "entity_caching", "enabled": true}]}}`)
+	flags, err := c.GetBranchFeatureFlags(ctx, "my-account", "my-graph", "main")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(flags) != 1 || flags[0].Name != "entity_caching" || !flags[0].Enabled {
+		t.Errorf("unexpected flags: %+v", flags)
+	}
+
+	server.Handle("GetBranchFeatureFlags", `{"branch": null}`)
+	if _, err := c.GetBranchFeatureFlags(ctx, "my-account", "my-graph", "missing"); err == nil || errorMessage(err) != "branch not found" {
+		t.Errorf("expected branch not found, got %v", err)
+	}
+}
+
+func TestSetBranchFeatureFlags(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := SetBranchFeatureFlagsInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main"}
+
+	server.Handle("SetBranchFeatureFlags", `{"branchFeatureFlagsSet": {"__typename": "BranchFeatureFlagsSetSuccess", "featureFlags": []}}`)
+	flags, err := c.SetBranchFeatureFlags(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(flags) != 0 {
+		t.Errorf("expected no flags, got %+v", flags)
+	}
+	if input := server.LastRequest("SetBranchFeatureFlags").Variables["input"].(map[string]interface{}); input["featureFlags"] == nil {
+		t.Error("expected featureFlags to be an empty list")
+	}
+
+	server.Handle("SetBranchFeatureFlags", `{"branchFeatureFlagsSet": {"__typename": "UnknownFeatureFlagError", "name": "warp_drive"}}`)
+	if _, err := c.SetBranchFeatureFlags(ctx, input); err == nil || errorMessage(err) != `unknown feature flag "warp_drive"` {
+		t.Errorf("expected unknown feature flag error, got %v", err)
+	}
+}
@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// GatewayConfigFormat represents the format a gateway configuration is written in
+type GatewayConfigFormat string
+
+const (
+	GatewayConfigFormatTOML GatewayConfigFormat = "TOML"
+	GatewayConfigFormatJSON GatewayConfigFormat = "JSON"
+)
+
+// GatewayConfig represents the gateway configuration applied to a branch
+type GatewayConfig struct {
+	Version   int64               `json:"version"`
+	Format    GatewayConfigFormat `json:"format"`
+	Config    string              `json:"config"`
+	UpdatedAt time.Time           `json:"updatedAt"`
+	// GatewayVersion is the gateway runtime version the branch is pinned to,
+	// or nil when the branch follows automatic upgrades
+	GatewayVersion *string `json:"gatewayVersion"`
+}
+
+// GatewayVersion represents a gateway runtime version branches may be pinned to
+type GatewayVersion struct {
+	Version    string `json:"version"`
+	Deprecated bool   `json:"deprecated"`
+}
+
+// SetGatewayConfigInput represents the input for replacing the gateway configuration of a branch
+type SetGatewayConfigInput struct {
+	AccountSlug string              `json:"accountSlug"`
+	GraphSlug   string              `json:"graphSlug"`
+	BranchName  string              `json:"branchName"`
+	Format      GatewayConfigFormat `json:"format"`
+	Config      string              `json:"config"`
+	// GatewayVersion pins the gateway runtime version; nil follows automatic upgrades
+	GatewayVersion *string `json:"gatewayVersion"`
+}
+
+// DeleteGatewayConfigInput represents the input for removing the gateway configuration of a branch
+type DeleteGatewayConfigInput struct {
+	AccountSlug string `json:"accountSlug"`
+	GraphSlug   string `json:"graphSlug"`
+	BranchName  string `json:"branchName"`
+}
+
+// gatewayConfigFields is the selection set shared by gateway configuration queries
+const gatewayConfigFields = `
+	version
+	format
+	config
+	updatedAt
+	gatewayVersion
+`
+
+// SetGatewayConfig replaces the gateway configuration of a branch, creating a new version
+func (c *Client) SetGatewayConfig(ctx context.Context, input SetGatewayConfigInput) (*GatewayConfig, error) {
+	query := `
+		mutation SetGatewayConfig($input: GatewayConfigSetInput!) {
+			gatewayConfigSet(input: $input) {
+				__typename
+				... on GatewayConfigSetSuccess {
+					gatewayConfig {` + gatewayConfigFields + `}
+				}
+				... on GatewayConfigInvalidError {
+					message
+				}
+				... on GatewayVersionNotSupportedError {
+					version
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*GatewayConfig](ctx, c, operation{
+		action:  "set gateway config",
+		query:   query,
+		path:    []string{"gatewayConfigSet"},
+		success: "GatewayConfigSetSuccess",
+		field:   "gatewayConfig",
+		errors: map[string]string{
+			"GatewayConfigInvalidError":       "gateway config is invalid: ${message}",
+			"GatewayVersionNotSupportedError": "gateway version ${version} is not supported",
+		},
+	}, variables)
+}
+
+// GetGatewayConfig retrieves the gateway configuration applied to a branch
+func (c *Client) GetGatewayConfig(ctx context.Context, accountSlug, graphSlug, branchName string) (*GatewayConfig, error) {
+	query := `
+		query GetGatewayConfig($accountSlug: String!, $graphSlug: String!, $branchName: String!) {
+			branch(accountSlug: $accountSlug, graphSlug: $graphSlug, name: $branchName) {
+				gatewayConfig {` + gatewayConfigFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"graphSlug":   graphSlug,
+		"branchName":  branchName,
+	}
+
+	config, err := execute[*GatewayConfig](ctx, c, operation{
+		action: "get gateway config",
+		query:  query,
+		path:   []string{"branch", "gatewayConfig"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if config == nil {
+		return nil, fmt.Errorf("gateway config not found")
+	}
+
+	return config, nil
+}
+
+// DeleteGatewayConfig removes the gateway configuration of a branch, restoring the default settings
+func (c *Client) DeleteGatewayConfig(ctx context.Context, input DeleteGatewayConfigInput) error {
+	query := `
+		mutation DeleteGatewayConfig($input: GatewayConfigDeleteInput!) {
+			gatewayConfigDelete(input: $input) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "delete gateway config",
+		query:   query,
+		path:    []string{"gatewayConfigDelete"},
+		success: "GatewayConfigDeleteSuccess",
+		errors: map[string]string{
+			"GatewayConfigDoesNotExistError": "gateway config does not exist",
+		},
+	}, variables)
+
+	return err
+}
+
+// ListGatewayVersions lists the gateway runtime versions branches may be pinned to
+func (c *Client) ListGatewayVersions(ctx context.Context) ([]GatewayVersion, error) {
+	query := `
+		query ListGatewayVersions {
+			gatewayVersions {
+				version
+				deprecated
+			}
+		}
+	`
+
+	return execute[[]GatewayVersion](ctx, c, operation{
+		action: "list gateway versions",
+		query:  query,
+		path:   []string{"gatewayVersions"},
+	}, nil)
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSetGatewayConfig(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := SetGatewayConfigInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main", Format: GatewayConfigFormatTOML, Config: "[graph]\n"}
+
+	server.Handle("SetGatewayConfig", `{"gatewayConfigSet": {"__typename": "GatewayConfigSetSuccess", "gatewayConfig": {"version": 2, "format": "TOML", "config": "[graph]\n", "updatedAt": "2024-01-15T10:30:00Z"}}}`)
+	config, err := c.SetGatewayConfig(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.Version != 2 || config.Format != GatewayConfigFormatTOML {
+		t.Errorf("unexpected config: %+v", config)
+	}
+
+	server.Handle("SetGatewayConfig", `{"gatewayConfigSet": {"__typename": "GatewayConfigInvalidError", "message": "unknown key"}}`)
+	if _, err := c.SetGatewayConfig(ctx, input); err == nil || errorMessage(err) != "gateway config is invalid: unknown key" {
+		t.Errorf("expected invalid config error, got %v", err)
+	}
+
+	version := "0.30.0"
+	input.GatewayVersion = &version
+	server.Handle("SetGatewayConfig", `{"gatewayConfigSet": {"__typename": "GatewayConfigSetSuccess", "gatewayConfig": {"version": 3, "format": "TOML", "config": "[graph]\n", "updatedAt": "2024-01-15T10:30:00Z", "gatewayVersion": "0.30.0"}}}`)
+	config, err = c.SetGatewayConfig(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.GatewayVersion == nil || *config.GatewayVersion != "0.30.0" {
+		t.Errorf("expected the pinned gateway version, got %+v", config)
+	}
+	if sent := server.LastRequest("SetGatewayConfig").Variables["input"].(map[string]interface{}); sent["gatewayVersion"] != "0.30.0" {
+		t.Errorf("expected gatewayVersion to be sent, got %v", sent)
+	}
+
+	server.Handle("SetGatewayConfig", `{"gatewayConfigSet": {"__typename": "GatewayVersionNotSupportedError", "version": "0.30.0"}}`)
+	if _, err := c.SetGatewayConfig(ctx, input); err == nil || errorMessage(err) != "gateway version 0.30.0 is not supported" {
+		t.Errorf("expected unsupported version error, got %v", err)
+	}
+}
+
+func TestGetGatewayConfig(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetGatewayConfig", `{"branch": {"gatewayConfig": {"version": 1, "format": "JSON", "config": "{}", "updatedAt": "2024-01-15T10:30:00Z"}}}`)
+	config, err := c.GetGatewayConfig(ctx, "my-account", "my-graph", "main")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.Format != GatewayConfigFormatJSON || config.Config != "{}" {
+		t.Errorf("unexpected config: %+v", config)
+	}
+
+	server.Handle("GetGatewayConfig", `{"branch": {"gatewayConfig": null}}`)
+	if _, err := c.GetGatewayConfig(ctx, "my-account", "my-graph", "main"); err == nil || errorMessage(err) != "gateway config not found" {
+		t.Errorf("expected gateway config not found, got %v", err)
+	}
+}
+
+func TestDeleteGatewayConfig(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := DeleteGatewayConfigInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main"}
+
+	server.Handle("DeleteGatewayConfig", `{"gatewayConfigDelete": {"__typename": "GatewayConfigDeleteSuccess"}}`)
+	if err := c.DeleteGatewayConfig(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("DeleteGatewayConfig", `{"gatewayConfigDelete": {"__typename": "GatewayConfigDoesNotExistError"}}`)
+	if err := c.DeleteGatewayConfig(ctx, input); err == nil || errorMessage(err) != "gateway config does not exist" {
+		t.Errorf("expected gateway config does not exist, got %v", err)
+	}
+}
+
+func TestListGatewayVersions(t *testing.T) {
+	c, server := newTestClient(t)
+
+	server.Handle("ListGatewayVersions", `{"gatewayVersions": [{"version": "0.31.0", "deprecated": false}, {"version": "0.29.0", "deprecated": true}]}`)
+	versions, err := c.ListGatewayVersions(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(versions) != 2 || versions[0].Version != "0.31.0" || !versions[1].Deprecated {
+		t.Errorf("unexpected versions: %+v", versions)
+	}
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+const testGraphCollaboratorJSON = `{
+	"id": "collaborator-1",
+	"userEmail": "dev@example.com",
+	"teamSlug": null,
+	"role": "EDITOR",
+	"pending": true
+}`
+
+func TestAddGraphCollaborator(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	email := "dev@example.com"
+	input := AddGraphCollaboratorInput{
+		AccountSlug: "my-account",
+		GraphSlug:   "my-graph",
+		UserEmail:   &email,
+		Role:        GraphCollaboratorRoleEditor,
+	}
+
+	server.Handle("AddGraphCollaborator", `{"graphCollaboratorAdd": {"__typename": "GraphCollaboratorAddSuccess",
+		"collaborator": `+testGraphCollaboratorJSON+`}}`)
+	collaborator, err := c.AddGraphCollaborator(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if collaborator.ID != "collaborator-1" || collaborator.UserEmail == nil || collaborator.TeamSlug != nil {
+		t.Errorf("unexpected graph collaborator: %+v", collaborator)
+	}
+
+	var sent map[string]interface{}
+	if err := server.LastRequest("AddGraphCollaborator").Input(&sent); err != nil {
+		t.Fatalf("failed to decode input: %v", err)
+	}
+	if sent["teamSlug"] != nil || sent["userEmail"] != "dev@example.com" {
+		t.Errorf("unexpected input: %v", sent)
+	}
+
+	server.Handle("AddGraphCollaborator", `{"graphCollaboratorAdd": {"__typename": "GraphCollaboratorAlreadyExistsError"}}`)
+	if _, err := c.AddGraphCollaborator(ctx, input); err == nil || errorMessage(err) != "graph collaborator already exists" {
+		t.Errorf("expected graph collaborator already exists, got %v", err)
+	}
+}
+
+func TestUpdateGraphCollaboratorRole(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("UpdateGraphCollaboratorRole", `{"graphCollaboratorRoleUpdate": {"__typename": "GraphCollaboratorRoleUpdateSuccess",
+		"collaborator": `+testGraphCollaboratorJSON+`}}`)
+	if _, err := c.UpdateGraphCollaboratorRole(ctx, UpdateGraphCollaboratorRoleInput{ID: "collaborator-1", Role: GraphCollaboratorRoleEditor}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("UpdateGraphCollaboratorRole", `{"graphCollaboratorRoleUpdate": {"__typename": "GraphCollaboratorDoesNotExistError"}}`)
+	if _, err := c.UpdateGraphCollaboratorRole(ctx, UpdateGraphCollaboratorRoleInput{ID: "missing"}); err == nil || errorMessage(err) != "graph collaborator does not exist" {
+		t.Errorf("expected graph collaborator does not exist, got %v", err)
+	}
+}
+
+func TestGetGraphCollaborator(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetGraphCollaborator", `{"node": `+testGraphCollaboratorJSON+`}`)
+	if _, err := c.GetGraphCollaborator(ctx, "collaborator-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("GetGraphCollaborator", `{"node": null}`)
+	if _, err := c.GetGraphCollaborator(ctx, "missing"); err == nil || errorMessage(err) != "graph collaborator not found" {
+		t.Errorf("expected graph collaborator not found, got %v", err)
+	}
+}
+
+func TestRemoveGraphCollaborator(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("RemoveGraphCollaborator", `{"graphCollaboratorRemove": {"__typename": "GraphCollaboratorRemoveSuccess"}}`)
+	if err := c.RemoveGraphCollaborator(ctx, "collaborator-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("RemoveGraphCollaborator", `{"graphCollaboratorRemove": {"__typename": "GraphCollaboratorDoesNotExistError"}}`)
+	if err := c.RemoveGraphCollaborator(ctx, "collaborator-1"); err == nil || errorMessage(err) != "graph collaborator does not exist" {
+		t.Errorf("expected graph collaborator does not exist, got %v", err)
+	}
+}
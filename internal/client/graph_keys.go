@@ -0,0 +1,128 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// GraphKey represents a publish key of a graph, which can only publish
+// subgraphs and run schema checks on that graph. The key secret is only
+// returned when the key is created.
+type GraphKey struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+}
+
+// CreateGraphKeyInput represents the input for creating a graph publish key
+type CreateGraphKeyInput struct {
+	AccountSlug string `json:"accountSlug"`
+	GraphSlug   string `json:"graphSlug"`
+	Name        string `json:"name"`
+}
+
+// graphKeyFields is the selection set shared by graph key queries
+const graphKeyFields = `
+	id
+	name
+	createdAt
+	lastUsedAt
+`
+
+// CreateGraphKey creates a publish key of a graph and returns it together with its secret
+func (c *Client) CreateGraphKey(ctx context.Context, input CreateGraphKeyInput) (*GraphKey, string, error) {
+	query := `
+		mutation CreateGraphKey($input: GraphKeyCreateInput!) {
+			graphKeyCreate(input: $input) {
+				__typename
+				... on GraphKeyCreateSuccess {
+					graphKey {` + graphKeyFields + `}
+					secret
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	created, err := execute[struct {
+		GraphKey GraphKey `json:"graphKey"`
+		Secret   string   `json:"secret"`
+	}](ctx, c, operation{
+		action:  "create graph key",
+		query:   query,
+		path:    []string{"graphKeyCreate"},
+		success: "GraphKeyCreateSuccess",
+		errors: map[string]string{
+			"GraphDoesNotExistError": "graph does not exist",
+		},
+	}, variables)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &created.GraphKey, created.Secret, nil
+}
+
+// GetGraphKey retrieves a graph publish key by ID, without its secret
+func (c *Client) GetGraphKey(ctx context.Context, id string) (*GraphKey, error) {
+	query := `
+		query GetGraphKey($id: ID!) {
+			node(id: $id) {
+				... on GraphKey {` + graphKeyFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	key, err := execute[*GraphKey](ctx, c, operation{
+		action: "get graph key",
+		query:  query,
+		path:   []string{"node"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if key == nil || key.ID == "" {
+		return nil, fmt.Errorf("graph key not found")
+	}
+
+	return key, nil
+}
+
+// RevokeGraphKey revokes a graph publish key, rejecting further publishes made with it
+func (c *Client) RevokeGraphKey(ctx context.Context, id string) error {
+	query := `
+		mutation RevokeGraphKey($input: GraphKeyRevokeInput!) {
+			graphKeyRevoke(input: $input) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"id": id,
+		},
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "revoke graph key",
+		query:   query,
+		path:    []string{"graphKeyRevoke"},
+		success: "GraphKeyRevokeSuccess",
+		errors: map[string]string{
+			"GraphKeyDoesNotExistError": "graph key does not exist",
+		},
+	}, variables)
+
+	return err
+}
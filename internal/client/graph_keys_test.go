@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCreateGraphKey(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := CreateGraphKeyInput{AccountSlug: "my-account", GraphSlug: "my-graph", Name: "ci"}
+
+	server.Handle("CreateGraphKey", `{"graphKeyCreate": {"__typename": "GraphKeyCreateSuccess", "graphKey": {
+		"id": "graph-key-1", "name": "ci", "createdAt": "2024-01-15T10:30:00Z", "lastUsedAt": null
+	}, "secret": "gbpk_secret"}}`)
+	key, secret, err := c.CreateGraphKey(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if key.ID != "graph-key-1" || secret != "gbpk_secret" || key.LastUsedAt != nil {
+		t.Errorf("unexpected graph key: %+v, secret %q", key, secret)
+	}
+
+	sent := server.LastRequest("CreateGraphKey").Variables["input"].(map[string]interface{})
+	if sent["graphSlug"] != "my-graph" || sent["name"] != "ci" {
+		t.Errorf("unexpected create input: %v", sent)
+	}
+
+	server.Handle("CreateGraphKey", `{"graphKeyCreate": {"__typename": "GraphDoesNotExistError"}}`)
+	if _, _, err := c.CreateGraphKey(ctx, input); err == nil || errorMessage(err) != "graph does not exist" {
+		t.Errorf("expected graph does not exist, got %v", err)
+	}
+}
+
+func TestGetGraphKey(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetGraphKey", `{"node": {
+		"id": "graph-key-1", "name": "ci", "createdAt": "2024-01-15T10:30:00Z", "lastUsedAt": "2024-02-01T08:00:00Z"
+	}}`)
+	key, err := c.GetGraphKey(ctx, "graph-key-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if key.LastUsedAt == nil {
+		t.Errorf("unexpected graph key: %+v", key)
+	}
+
+	server.Handle("GetGraphKey", `{"node": null}`)
+	if _, err := c.GetGraphKey(ctx, "missing"); err == nil || errorMessage(err) != "graph key not found" {
+		t.Errorf("expected graph key not found, got %v", err)
+	}
+}
+
+func TestRevokeGraphKey(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("RevokeGraphKey", `{"graphKeyRevoke": {"__typename": "GraphKeyRevokeSuccess"}}`)
+	if err := c.RevokeGraphKey(ctx, "graph-key-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("RevokeGraphKey", `{"graphKeyRevoke": {"__typename": "GraphKeyDoesNotExistError"}}`)
+	if err := c.RevokeGraphKey(ctx, "graph-key-1"); err == nil || errorMessage(err) != "graph key does not exist" {
+		t.Errorf("expected graph key does not exist, got %v", err)
+	}
+}
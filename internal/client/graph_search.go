@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// GraphFilter narrows the graphs returned by SearchGraphs. An empty
+// SlugPrefix matches every slug, and nil times leave that side of the
+// creation date range open.
+type GraphFilter struct {
+	SlugPrefix    string     `json:"slugPrefix,omitempty"`
+	CreatedAfter  *time.Time `json:"createdAfter,omitempty"`
+	CreatedBefore *time.Time `json:"createdBefore,omitempty"`
+}
+
+// SearchGraphs retrieves every graph of an account matching the filter
+func (c *Client) SearchGraphs(ctx context.Context, accountSlug string, filter GraphFilter) ([]Graph, error) {
+	query := `
+		query SearchGraphs($accountSlug: String!, $filter: GraphFilter!, $first: Int!, $after: String) {
+			accountBySlug(slug: $accountSlug) {
+				graphs(filter: $filter, first: $first, after: $after) {
+					edges {
+						node {` + graphFields + `}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"filter":      filter,
+	}
+
+	nodes, err := c.PaginateAll(ctx, query, variables, []string{"accountBySlug", "graphs"}, defaultPageSize)
+	if errors.Is(err, errConnectionNotFound) {
+		return nil, fmt.Errorf("account not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to search graphs: %w", err)
+	}
+
+	return decodeNodes[Graph](nodes)
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSearchGraphs(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("SearchGraphs", `{"accountBySlug": {"graphs": {"edges": [{"node": `+testGraphJSON+`}], "pageInfo": {"hasNextPage": false, "endCursor": null}}}}`)
+	after := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	graphs, err := c.SearchGraphs(ctx, "my-account", GraphFilter{SlugPrefix: "preview-", CreatedAfter: &after})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(graphs) != 1 {
+		t.Fatalf("expected one graph, got %+v", graphs)
+	}
+
+	filter, _ := server.LastRequest("SearchGraphs").Variables["filter"].(map[string]interface{})
+	if filter["slugPrefix"] != "preview-" || filter["createdAfter"] != "2024-01-01T00:00:00Z" {
+		t.Errorf("unexpected filter: %v", filter)
+	}
+	if _, ok := filter["createdBefore"]; ok {
+		t.Errorf("expected open ended range, got %v", filter)
+	}
+
+	server.Handle("SearchGraphs", `{"accountBySlug": null}`)
+	if _, err := c.SearchGraphs(ctx, "missing", GraphFilter{}); err == nil || errorMessage(err) != "account not found" {
+		t.Errorf("expected account not found, got %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// OperationCheckPolicy represents how failing operation checks affect schema checks of a graph
+type OperationCheckPolicy string
+
+const (
+	// OperationCheckPolicyBlock fails schema checks with failing operation checks
+	OperationCheckPolicyBlock OperationCheckPolicy = "BLOCK"
+	// OperationCheckPolicyWarn reports failing operation checks as warnings
+	OperationCheckPolicyWarn OperationCheckPolicy = "WARN"
+)
+
+// GraphSettings represents the graph-wide settings of a graph
+type GraphSettings struct {
+	AnalyticsRetentionDays int64                `json:"analyticsRetentionDays"`
+	RequestLoggingEnabled  bool                 `json:"requestLoggingEnabled"`
+	OperationCheckPolicy   OperationCheckPolicy `json:"operationCheckPolicy"`
+}
+
+// SetGraphSettingsInput represents the input for replacing the settings of a
+// graph. A nil AnalyticsRetentionDays restores the retention of the account plan.
+type SetGraphSettingsInput struct {
+	AccountSlug            string               `json:"accountSlug"`
+	GraphSlug              string               `json:"graphSlug"`
+	AnalyticsRetentionDays *int64               `json:"analyticsRetentionDays"`
+	RequestLoggingEnabled  bool                 `json:"requestLoggingEnabled"`
+	OperationCheckPolicy   OperationCheckPolicy `json:"operationCheckPolicy"`
+}
+
+// graphSettingsFields is the selection set of graph settings
+const graphSettingsFields = `
+	analyticsRetentionDays
+	requestLoggingEnabled
+	operationCheckPolicy
+`
+
+// GetGraphSettings retrieves the graph-wide settings of a graph
+func (c *Client) GetGraphSettings(ctx context.Context, accountSlug, graphSlug string) (*GraphSettings, error) {
+	query := `
+		query GetGraphSettings($accountSlug: String!, $graphSlug: String!) {
+			graphByAccountSlug(accountSlug: $accountSlug, graphSlug: $graphSlug) {
+				settings {` + graphSettingsFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"graphSlug":   graphSlug,
+	}
+
+	graph, err := execute[*struct {
+		Settings GraphSettings `json:"settings"`
+	}](ctx, c, operation{
+		action: "get graph settings",
+		query:  query,
+		path:   []string{"graphByAccountSlug"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if graph == nil {
+		return nil, fmt.Errorf("graph not found")
+	}
+
+	return &graph.Settings, nil
+}
+
+// SetGraphSettings replaces the graph-wide settings of a graph
+func (c *Client) SetGraphSettings(ctx context.Context, input SetGraphSettingsInput) (*GraphSettings, error) {
+	query := `
+		mutation SetGraphSettings($input: GraphSettingsSetInput!) {
+			graphSettingsSet(input: $input) {
+				__typename
+				... on GraphSettingsSetSuccess {
+					settings {` + graphSettingsFields + `}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*GraphSettings](ctx, c, operation{
+		action:  "set graph settings",
+		query:   query,
+		path:    []string{"graphSettingsSet"},
+		success: "GraphSettingsSetSuccess",
+		field:   "settings",
+		errors: map[string]string{
+			"InvalidAnalyticsRetentionError":  "analytics retention exceeds the maximum allowed by the account plan",
+			"RequestLoggingNotAvailableError": "request logging is not available on the account plan",
+		},
+	}, variables)
+}
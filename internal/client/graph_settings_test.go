@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetGraphSettings(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetGraphSettings", `{"graphByAccountSlug": {"settings": {
+		"analyticsRetentionDays": 90,
+		"requestLoggingEnabled": true,
+		"operationCheckPolicy": "WARN"
+	}}}`)
+	settings, err := c.GetGraphSettings(ctx, "my-account", "my-graph")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if settings.AnalyticsRetentionDays != 90 || !settings.RequestLoggingEnabled || settings.OperationCheckPolicy != OperationCheckPolicyWarn {
+		t.Errorf("unexpected settings: %+v", settings)
+	}
+
+	server.Handle("GetGraphSettings", `{"graphByAccountSlug": null}`)
+	if _, err := c.GetGraphSettings(ctx, "my-account", "missing"); err == nil || errorMessage(err) != "graph not found" {
+		t.Errorf("expected graph not found, got %v", err)
+	}
+}
+
+func TestSetGraphSettings(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := SetGraphSettingsInput{AccountSlug: "my-account", GraphSlug: "my-graph", OperationCheckPolicy: OperationCheckPolicyBlock}
+
+	server.Handle("SetGraphSettings", `{"graphSettingsSet": {"__typename": "GraphSettingsSetSuccess", "settings": {"analyticsRetentionDays": 30, "requestLoggingEnabled": false, "operationCheckPolicy": "BLOCK"}}}`)
+	settings, err := c.SetGraphSettings(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if settings.AnalyticsRetentionDays != 30 {
+		t.Errorf("expected the plan retention, got %d", settings.AnalyticsRetentionDays)
+	}
+
+	// A missing retention is sent as null to restore the plan retention
+	sent := server.LastRequest("SetGraphSettings").Variables["input"].(map[string]interface{})
+	if retention, ok := sent["analyticsRetentionDays"]; !ok || retention != nil {
+		t.Errorf("expected analyticsRetentionDays to be null, got %v", retention)
+	}
+
+	server.Handle("SetGraphSettings", `{"graphSettingsSet": {"__typename": "InvalidAnalyticsRetentionError"}}`)
+	if _, err := c.SetGraphSettings(ctx, input); err == nil || errorMessage(err) != "analytics retention exceeds the maximum allowed by the account plan" {
+		t.Errorf("expected invalid retention error, got %v", err)
+	}
+}
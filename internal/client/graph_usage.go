@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// BranchUsage represents the aggregated request metrics of a branch
+type BranchUsage struct {
+	BranchName   string   `json:"branchName"`
+	RequestCount int64    `json:"requestCount"`
+	ErrorCount   int64    `json:"errorCount"`
+	LatencyP95Ms *float64 `json:"latencyP95Ms"`
+}
+
+// GraphUsage represents the aggregated request metrics of a graph over a time window
+type GraphUsage struct {
+	From     time.Time     `json:"from"`
+	To       time.Time     `json:"to"`
+	Branches []BranchUsage `json:"branches"`
+}
+
+// GetGraphUsage retrieves the request metrics of every branch of a graph
+// between from and to
+func (c *Client) GetGraphUsage(ctx context.Context, accountSlug, graphSlug string, from, to time.Time) (*GraphUsage, error) {
+	query := `
+		query GetGraphUsage($accountSlug: String!, $graphSlug: String!, $from: DateTime!, $to: DateTime!) {
+			graphByAccountSlug(accountSlug: $accountSlug, graphSlug: $graphSlug) {
+				usage(from: $from, to: $to) {
+					from
+					to
+					branches {
+						branchName
+						requestCount
+						errorCount
+						latencyP95Ms
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"graphSlug":   graphSlug,
+		"from":        from,
+		"to":          to,
+	}
+
+	graph, err := execute[*struct {
+		Usage *GraphUsage `json:"usage"`
+	}](ctx, c, operation{
+		action: "get graph usage",
+		query:  query,
+		path:   []string{"graphByAccountSlug"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if graph == nil {
+		return nil, fmt.Errorf("graph not found")
+	}
+
+	if graph.Usage == nil {
+		return nil, fmt.Errorf("graph usage not found")
+	}
+
+	return graph.Usage, nil
+}
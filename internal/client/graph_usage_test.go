@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestGetGraphUsage(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC)
+
+	server.Handle("GetGraphUsage", `{"graphByAccountSlug": {"usage": {
+		"from": "2024-03-01T00:00:00Z",
+		"to": "2024-03-08T00:00:00Z",
+		"branches": [
+			{"branchName": "main", "requestCount": 1200, "errorCount": 12, "latencyP95Ms": 84.5},
+			{"branchName": "feature", "requestCount": 0, "errorCount": 0, "latencyP95Ms": null}
+		]
+	}}}`)
+	usage, err := c.GetGraphUsage(ctx, "my-account", "my-graph", from, to)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(usage.Branches) != 2 || *usage.Branches[0].LatencyP95Ms != 84.5 || usage.Branches[1].LatencyP95Ms != nil {
+		t.Errorf("unexpected usage: %+v", usage)
+	}
+	if got := server.LastRequest("GetGraphUsage").Variables["from"]; got != "2024-03-01T00:00:00Z" {
+		t.Errorf("expected RFC3339 from variable, got %v", got)
+	}
+
+	server.Handle("GetGraphUsage", `{"graphByAccountSlug": null}`)
+	if _, err := c.GetGraphUsage(ctx, "my-account", "missing", from, to); err == nil || errorMessage(err) != "graph not found" {
+		t.Errorf("expected graph not found, got %v", err)
+	}
+}
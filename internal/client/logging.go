@@ -0,0 +1,90 @@
+package client
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// operationNamePattern matches the operation type and name of a GraphQL document
+var operationNamePattern = regexp.MustCompile(`(query|mutation|subscription)\s+([_A-Za-z][_0-9A-Za-z]*)`)
+
+// sensitiveVariableKeys are substrings of variable names whose values are redacted from logs
+var sensitiveVariableKeys = []string{"token", "secret", "password", "key", "credential"}
+
+// redactedValue replaces sensitive values in log output
+const redactedValue = "***"
+
+// operationName extracts the operation name from a GraphQL document for logging
+func operationName(query string) string {
+	matches := operationNamePattern.FindStringSubmatch(query)
+	if matches == nil {
+		return "anonymous"
+	}
+
+	return matches[2]
+}
+
+// operationType extracts the operation type of a GraphQL document. Documents
+// without an operation type are shorthand queries.
+func operationType(query string) string {
+	matches := operationNamePattern.FindStringSubmatch(query)
+	if matches == nil {
+		return "query"
+	}
+
+	return matches[1]
+}
+
+// sanitizeVariables returns a copy of the GraphQL variables that is safe to log.
+// Input structs are normalized through their JSON representation and values of
+// sensitive keys are redacted at any nesting depth.
+func sanitizeVariables(variables map[string]interface{}) map[string]interface{} {
+	raw, err := json.Marshal(variables)
+	if err != nil {
+		return nil
+	}
+
+	var normalized map[string]interface{}
+	if err := json.Unmarshal(raw, &normalized); err != nil {
+		return nil
+	}
+
+	return redactSensitiveValues(normalized).(map[string]interface{})
+}
+
+// redactSensitiveValues walks decoded JSON and redacts values of sensitive keys
+func redactSensitiveValues(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(v))
+		for name, nested := range v {
+			if isSensitiveVariable(name) {
+				redacted[name] = redactedValue
+				continue
+			}
+			redacted[name] = redactSensitiveValues(nested)
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, nested := range v {
+			redacted[i] = redactSensitiveValues(nested)
+		}
+		return redacted
+	default:
+		return v
+	}
+}
+
+// isSensitiveVariable reports whether a variable name likely holds a secret
+func isSensitiveVariable(name string) bool {
+	lower := strings.ToLower(name)
+	for _, key := range sensitiveVariableKeys {
+		if strings.Contains(lower, key) {
+			return true
+		}
+	}
+
+	return false
+}
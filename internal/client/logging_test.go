@@ -0,0 +1,64 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOperationName(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{
+			name:     "named query",
+			query:    "query GetGraph($accountSlug: String!) { graph }",
+			expected: "GetGraph",
+		},
+		{
+			name:     "named mutation with leading whitespace",
+			query:    "\n\t\tmutation CreateBranch($input: BranchCreateInput!) { branchCreate }",
+			expected: "CreateBranch",
+		},
+		{
+			name:     "anonymous query",
+			query:    "{ viewer { id } }",
+			expected: "anonymous",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := operationName(tt.query); got != tt.expected {
+				t.Errorf("expected operation name %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSanitizeVariables(t *testing.T) {
+	variables := map[string]interface{}{
+		"accountSlug": "my-account",
+		"input": ExchangeOIDCTokenInput{
+			IDToken: "secret-jwt",
+		},
+		"headers": []interface{}{
+			map[string]interface{}{"name": "Authorization", "secretValue": "hunter2"},
+		},
+	}
+
+	expected := map[string]interface{}{
+		"accountSlug": "my-account",
+		"input": map[string]interface{}{
+			"idToken": redactedValue,
+		},
+		"headers": []interface{}{
+			map[string]interface{}{"name": "Authorization", "secretValue": redactedValue},
+		},
+	}
+
+	if got := sanitizeVariables(variables); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected sanitized variables %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// MCPAuthentication represents how clients of an MCP endpoint must authenticate
+type MCPAuthentication string
+
+const (
+	// MCPAuthenticationNone allows anonymous access to the MCP endpoint
+	MCPAuthenticationNone MCPAuthentication = "NONE"
+	// MCPAuthenticationAccessToken requires a Grafbase access token
+	MCPAuthenticationAccessToken MCPAuthentication = "ACCESS_TOKEN"
+	// MCPAuthenticationOAuth requires an OAuth token from the graph's identity provider
+	MCPAuthenticationOAuth MCPAuthentication = "OAUTH"
+)
+
+// MCPEndpoint represents the Model Context Protocol endpoint configuration of a branch
+type MCPEndpoint struct {
+	Enabled          bool              `json:"enabled"`
+	Path             string            `json:"path"`
+	Authentication   MCPAuthentication `json:"authentication"`
+	RequiredScopes   []string          `json:"requiredScopes"`
+	ExecuteMutations bool              `json:"executeMutations"`
+	URL              string            `json:"url"`
+}
+
+// SetMCPEndpointInput represents the input for configuring the MCP endpoint of a branch
+type SetMCPEndpointInput struct {
+	AccountSlug      string            `json:"accountSlug"`
+	GraphSlug        string            `json:"graphSlug"`
+	BranchName       string            `json:"branchName"`
+	Enabled          bool              `json:"enabled"`
+	Path             string            `json:"path,omitempty"`
+	Authentication   MCPAuthentication `json:"authentication,omitempty"`
+	RequiredScopes   []string          `json:"requiredScopes"`
+	ExecuteMutations bool              `json:"executeMutations"`
+}
+
+// GetMCPEndpoint retrieves the MCP endpoint configuration of a branch
+func (c *Client) GetMCPEndpoint(ctx context.Context, accountSlug, graphSlug, branchName string) (*MCPEndpoint, error) {
+	query := `
+		query GetMCPEndpoint($accountSlug: String!, $graphSlug: String!, $branchName: String!) {
+			branch(accountSlug: $accountSlug, graphSlug: $graphSlug, name: $branchName) {
+				mcpEndpoint {
+					enabled
+					path
+					authentication
+					requiredScopes
+					executeMutations
+					url
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"graphSlug":   graphSlug,
+		"branchName":  branchName,
+	}
+
+	branch, err := execute[*struct {
+		MCPEndpoint MCPEndpoint `json:"mcpEndpoint"`
+	}](ctx, c, operation{
+		action: "get MCP endpoint",
+		query:  query,
+		path:   []string{"branch"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if branch == nil {
+		return nil, fmt.Errorf("branch not found")
+	}
+
+	return &branch.MCPEndpoint, nil
+}
+
+// SetMCPEndpoint replaces the MCP endpoint configuration of a branch
+func (c *Client) SetMCPEndpoint(ctx context.Context, input SetMCPEndpointInput) (*MCPEndpoint, error) {
+	query := `
+		mutation SetMCPEndpoint($input: McpEndpointSetInput!) {
+			mcpEndpointSet(input: $input) {
+				__typename
+				... on McpEndpointSetSuccess {
+					mcpEndpoint {
+						enabled
+						path
+						authentication
+						requiredScopes
+						executeMutations
+						url
+					}
+				}
+			}
+		}
+	`
+
+	if input.RequiredScopes == nil {
+		input.RequiredScopes = []string{}
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*MCPEndpoint](ctx, c, operation{
+		action:  "set MCP endpoint",
+		query:   query,
+		path:    []string{"mcpEndpointSet"},
+		success: "McpEndpointSetSuccess",
+		field:   "mcpEndpoint",
+		errors: map[string]string{
+			"McpNotAvailableError": "MCP endpoints are not available for this graph",
+		},
+	}, variables)
+}
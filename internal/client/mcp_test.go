@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetMCPEndpoint(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetMCPEndpoint", `{"branch": {"mcpEndpoint": {
+		"enabled": true,
+		"path": "/mcp",
+		"authentication": "ACCESS_TOKEN",
+		"requiredScopes": ["read"],
+		"executeMutations": false,
+		"url": "https://my-graph.grafbase.app/mcp"
+	}}}`)
+	endpoint, err := c.GetMCPEndpoint(ctx, "my-account", "my-graph", "main")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !endpoint.Enabled || endpoint.Authentication != MCPAuthenticationAccessToken || endpoint.URL == "" {
+		t.Errorf("unexpected endpoint: %+v", endpoint)
+	}
+
+	server.Handle("GetMCPEndpoint", `{"branch": null}`)
+	if _, err := c.GetMCPEndpoint(ctx, "my-account", "my-graph", "missing"); err == nil || errorMessage(err) != "branch not found" {
+		t.Errorf("expected branch not found, got %v", err)
+	}
+}
+
+func TestSetMCPEndpoint(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := SetMCPEndpointInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main", Enabled: true}
+
+	server.Handle("SetMCPEndpoint", `{"mcpEndpointSet": {"__typename": "McpEndpointSetSuccess", "mcpEndpoint": {"enabled": true, "path": "/mcp", "authentication": "NONE", "requiredScopes": []}}}`)
+	endpoint, err := c.SetMCPEndpoint(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if endpoint.Path != "/mcp" {
+		t.Errorf("unexpected endpoint: %+v", endpoint)
+	}
+
+	server.Handle("SetMCPEndpoint", `{"mcpEndpointSet": {"__typename": "McpNotAvailableError"}}`)
+	if _, err := c.SetMCPEndpoint(ctx, input); err == nil || errorMessage(err) != "MCP endpoints are not available for this graph" {
+		t.Errorf("expected MCP not available error, got %v", err)
+	}
+}
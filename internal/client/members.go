@@ -0,0 +1,192 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AccountMemberRole represents the role of a member within an account
+type AccountMemberRole string
+
+const (
+	AccountMemberRoleOwner  AccountMemberRole = "OWNER"
+	AccountMemberRoleAdmin  AccountMemberRole = "ADMIN"
+	AccountMemberRoleMember AccountMemberRole = "MEMBER"
+)
+
+// AccountMember represents a member of an account. Invited members that have
+// not accepted their invite yet have no JoinedAt.
+type AccountMember struct {
+	ID       string            `json:"id"`
+	Email    string            `json:"email"`
+	Role     AccountMemberRole `json:"role"`
+	Pending  bool              `json:"pending"`
+	JoinedAt *time.Time        `json:"joinedAt"`
+}
+
+// InviteAccountMemberInput represents the input for inviting a member to an account
+type InviteAccountMemberInput struct {
+	AccountSlug string            `json:"accountSlug"`
+	Email       string            `json:"email"`
+	Role        AccountMemberRole `json:"role"`
+}
+
+// UpdateAccountMemberRoleInput represents the input for changing the role of a member
+type UpdateAccountMemberRoleInput struct {
+	AccountSlug string            `json:"accountSlug"`
+	MemberID    string            `json:"memberId"`
+	Role        AccountMemberRole `json:"role"`
+}
+
+// RemoveAccountMemberInput represents the input for removing a member from an account
+type RemoveAccountMemberInput struct {
+	AccountSlug string `json:"accountSlug"`
+	MemberID    string `json:"memberId"`
+}
+
+// accountMemberFields is the selection set shared by account member queries
+const accountMemberFields = `
+	id
+	email
+	role
+	pending
+	joinedAt
+`
+
+// InviteAccountMember invites a member to an account with the given role
+func (c *Client) InviteAccountMember(ctx context.Context, input InviteAccountMemberInput) (*AccountMember, error) {
+	query := `
+		mutation InviteAccountMember($input: AccountMemberInviteInput!) {
+			accountMemberInvite(input: $input) {
+				__typename
+				... on AccountMemberInviteSuccess {
+					member {` + accountMemberFields + `}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*AccountMember](ctx, c, operation{
+		action:  "invite account member",
+		query:   query,
+		path:    []string{"accountMemberInvite"},
+		success: "AccountMemberInviteSuccess",
+		field:   "member",
+		errors: map[string]string{
+			"AccountMemberAlreadyExistsError": "account member already exists",
+		},
+	}, variables)
+}
+
+// ListAccountMembers retrieves all members of an account, including pending invites
+func (c *Client) ListAccountMembers(ctx context.Context, accountSlug string) ([]AccountMember, error) {
+	query := `
+		query ListAccountMembers($slug: String!) {
+			accountBySlug(slug: $slug) {
+				members {` + accountMemberFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"slug": accountSlug,
+	}
+
+	account, err := execute[*struct {
+		Members []AccountMember `json:"members"`
+	}](ctx, c, operation{
+		action: "list account members",
+		query:  query,
+		path:   []string{"accountBySlug"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil {
+		return nil, fmt.Errorf("account not found")
+	}
+
+	return account.Members, nil
+}
+
+// GetAccountMember retrieves an account member by email address
+func (c *Client) GetAccountMember(ctx context.Context, accountSlug, email string) (*AccountMember, error) {
+	members, err := c.ListAccountMembers(ctx, accountSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	// Email addresses are matched case-insensitively, like the API does on invite
+	for _, member := range members {
+		if strings.EqualFold(member.Email, email) {
+			return &member, nil
+		}
+	}
+
+	return nil, fmt.Errorf("account member not found")
+}
+
+// UpdateAccountMemberRole changes the role of an account member
+func (c *Client) UpdateAccountMemberRole(ctx context.Context, input UpdateAccountMemberRoleInput) (*AccountMember, error) {
+	query := `
+		mutation UpdateAccountMemberRole($input: AccountMemberRoleUpdateInput!) {
+			accountMemberRoleUpdate(input: $input) {
+				__typename
+				... on AccountMemberRoleUpdateSuccess {
+					member {` + accountMemberFields + `}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*AccountMember](ctx, c, operation{
+		action:  "update account member role",
+		query:   query,
+		path:    []string{"accountMemberRoleUpdate"},
+		success: "AccountMemberRoleUpdateSuccess",
+		field:   "member",
+		errors: map[string]string{
+			"AccountMemberDoesNotExistError": "account member does not exist",
+			"LastOwnerError":                 "the last owner of an account cannot be demoted",
+		},
+	}, variables)
+}
+
+// RemoveAccountMember removes a member from an account, or revokes a pending invite
+func (c *Client) RemoveAccountMember(ctx context.Context, input RemoveAccountMemberInput) error {
+	query := `
+		mutation RemoveAccountMember($input: AccountMemberRemoveInput!) {
+			accountMemberRemove(input: $input) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "remove account member",
+		query:   query,
+		path:    []string{"accountMemberRemove"},
+		success: "AccountMemberRemoveSuccess",
+		errors: map[string]string{
+			"AccountMemberDoesNotExistError": "account member does not exist",
+			"LastOwnerError":                 "the last owner of an account cannot be removed",
+		},
+	}, variables)
+
+	return err
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+const testAccountMembersJSON = `{"accountBySlug": {"members": [
+	{"id": "member-1", "email": "Owner@Example.com", "role": "OWNER", "pending": false, "joinedAt": "2024-01-15T10:30:00Z"},
+	{"id": "member-2", "email": "invitee@example.com", "role": "MEMBER", "pending": true, "joinedAt": null}
+]}}`
+
+func TestInviteAccountMember(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := InviteAccountMemberInput{AccountSlug: "my-account", Email: "invitee@example.com", Role: AccountMemberRoleMember}
+
+	server.Handle("InviteAccountMember", `{"accountMemberInvite": {"__typename": "AccountMemberInviteSuccess", "member": {"id": "member-2", "email": "invitee@example.com", "role": "MEMBER", "pending": true, "joinedAt": null}}}`)
+	member, err := c.InviteAccountMember(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !member.Pending || member.JoinedAt != nil {
+		t.Errorf("unexpected member: %+v", member)
+	}
+
+	server.Handle("InviteAccountMember", `{"accountMemberInvite": {"__typename": "AccountMemberAlreadyExistsError"}}`)
+	if _, err := c.InviteAccountMember(ctx, input); err == nil || errorMessage(err) != "account member already exists" {
+		t.Errorf("expected account member already exists, got %v", err)
+	}
+}
+
+func TestListAccountMembers(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("ListAccountMembers", testAccountMembersJSON)
+	members, err := c.ListAccountMembers(ctx, "my-account")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(members) != 2 || members[0].JoinedAt == nil {
+		t.Errorf("unexpected members: %+v", members)
+	}
+
+	server.Handle("ListAccountMembers", `{"accountBySlug": null}`)
+	if _, err := c.ListAccountMembers(ctx, "missing"); err == nil || errorMessage(err) != "account not found" {
+		t.Errorf("expected account not found, got %v", err)
+	}
+}
+
+func TestGetAccountMember(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("ListAccountMembers", testAccountMembersJSON)
+	member, err := c.GetAccountMember(ctx, "my-account", "owner@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if member.ID != "member-1" {
+		t.Errorf("expected case-insensitive match on member-1, got %+v", member)
+	}
+
+	if _, err := c.GetAccountMember(ctx, "my-account", "stranger@example.com"); err == nil || errorMessage(err) != "account member not found" {
+		t.Errorf("expected account member not found, got %v", err)
+	}
+}
+
+func TestUpdateAccountMemberRole(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := UpdateAccountMemberRoleInput{AccountSlug: "my-account", MemberID: "member-1", Role: AccountMemberRoleAdmin}
+
+	server.Handle("UpdateAccountMemberRole", `{"accountMemberRoleUpdate": {"__typename": "AccountMemberRoleUpdateSuccess", "member": {"id": "member-1", "email": "owner@example.com", "role": "ADMIN"}}}`)
+	member, err := c.UpdateAccountMemberRole(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if member.Role != AccountMemberRoleAdmin {
+		t.Errorf("unexpected member: %+v", member)
+	}
+
+	server.Handle("UpdateAccountMemberRole", `{"accountMemberRoleUpdate": {"__typename": "LastOwnerError"}}`)
+	if _, err := c.UpdateAccountMemberRole(ctx, input); err == nil || errorMessage(err) != "the last owner of an account cannot be demoted" {
+		t.Errorf("expected last owner error, got %v", err)
+	}
+}
+
+func TestRemoveAccountMember(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := RemoveAccountMemberInput{AccountSlug: "my-account", MemberID: "member-2"}
+
+	server.Handle("RemoveAccountMember", `{"accountMemberRemove": {"__typename": "AccountMemberRemoveSuccess"}}`)
+	if err := c.RemoveAccountMember(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("RemoveAccountMember", `{"accountMemberRemove": {"__typename": "AccountMemberDoesNotExistError"}}`)
+	if err := c.RemoveAccountMember(ctx, input); err == nil || errorMessage(err) != "account member does not exist" {
+		t.Errorf("expected account member does not exist, got %v", err)
+	}
+}
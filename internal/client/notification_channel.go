@@ -0,0 +1,178 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// NotificationChannelType represents where a notification channel delivers events
+type NotificationChannelType string
+
+const (
+	// NotificationChannelTypeSlack posts events to a Slack incoming webhook
+	NotificationChannelTypeSlack NotificationChannelType = "SLACK"
+	// NotificationChannelTypeWebhook posts events as JSON to a generic HTTPS endpoint
+	NotificationChannelTypeWebhook NotificationChannelType = "WEBHOOK"
+)
+
+// NotificationChannel represents a channel notified of events of a graph, or
+// of a single branch when BranchName is set. The URL of the channel is a
+// credential for Slack and is never returned by the API.
+type NotificationChannel struct {
+	ID           string                  `json:"id"`
+	Name         string                  `json:"name"`
+	Type         NotificationChannelType `json:"type"`
+	BranchName   *string                 `json:"branchName"`
+	SlackChannel *string                 `json:"slackChannel"`
+	Events       []WebhookEvent          `json:"events"`
+}
+
+// CreateNotificationChannelInput represents the input for creating a notification channel
+type CreateNotificationChannelInput struct {
+	AccountSlug  string                  `json:"accountSlug"`
+	GraphSlug    string                  `json:"graphSlug"`
+	BranchName   *string                 `json:"branchName"`
+	Name         string                  `json:"name"`
+	Type         NotificationChannelType `json:"type"`
+	URL          string                  `json:"url"`
+	SlackChannel *string                 `json:"slackChannel"`
+	Events       []WebhookEvent          `json:"events"`
+}
+
+// UpdateNotificationChannelInput represents the input for replacing the
+// settings of a notification channel
+type UpdateNotificationChannelInput struct {
+	ID           string         `json:"id"`
+	Name         string         `json:"name"`
+	URL          string         `json:"url"`
+	SlackChannel *string        `json:"slackChannel"`
+	Events       []WebhookEvent `json:"events"`
+}
+
+// notificationChannelFields is the selection set shared by notification channel queries
+const notificationChannelFields = `
+	id
+	name
+	type
+	branchName
+	slackChannel
+	events
+`
+
+// CreateNotificationChannel creates a notification channel for events of a graph or branch
+func (c *Client) CreateNotificationChannel(ctx context.Context, input CreateNotificationChannelInput) (*NotificationChannel, error) {
+	query := `
+		mutation CreateNotificationChannel($input: NotificationChannelCreateInput!) {
+			notificationChannelCreate(input: $input) {
+				__typename
+				... on NotificationChannelCreateSuccess {
+					notificationChannel {` + notificationChannelFields + `}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*NotificationChannel](ctx, c, operation{
+		action:  "create notification channel",
+		query:   query,
+		path:    []string{"notificationChannelCreate"},
+		success: "NotificationChannelCreateSuccess",
+		field:   "notificationChannel",
+		errors:  notificationChannelErrors,
+	}, variables)
+}
+
+// UpdateNotificationChannel replaces the settings of a notification channel
+func (c *Client) UpdateNotificationChannel(ctx context.Context, input UpdateNotificationChannelInput) (*NotificationChannel, error) {
+	query := `
+		mutation UpdateNotificationChannel($input: NotificationChannelUpdateInput!) {
+			notificationChannelUpdate(input: $input) {
+				__typename
+				... on NotificationChannelUpdateSuccess {
+					notificationChannel {` + notificationChannelFields + `}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*NotificationChannel](ctx, c, operation{
+		action:  "update notification channel",
+		query:   query,
+		path:    []string{"notificationChannelUpdate"},
+		success: "NotificationChannelUpdateSuccess",
+		field:   "notificationChannel",
+		errors:  notificationChannelErrors,
+	}, variables)
+}
+
+// notificationChannelErrors are the errors of the notification channel create
+// and update mutations
+var notificationChannelErrors = map[string]string{
+	"NotificationChannelDoesNotExistError": "notification channel does not exist",
+	"InvalidNotificationChannelUrlError":   "notification channel URL is not valid for the channel type",
+}
+
+// GetNotificationChannel retrieves a notification channel by ID using the node query
+func (c *Client) GetNotificationChannel(ctx context.Context, id string) (*NotificationChannel, error) {
+	query := `
+		query GetNotificationChannel($id: ID!) {
+			node(id: $id) {
+				... on NotificationChannel {` + notificationChannelFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	channel, err := execute[*NotificationChannel](ctx, c, operation{
+		action: "get notification channel",
+		query:  query,
+		path:   []string{"node"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if channel == nil || channel.ID == "" {
+		return nil, fmt.Errorf("notification channel not found")
+	}
+
+	return channel, nil
+}
+
+// DeleteNotificationChannel deletes a notification channel
+func (c *Client) DeleteNotificationChannel(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteNotificationChannel($id: ID!) {
+			notificationChannelDelete(id: $id) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "delete notification channel",
+		query:   query,
+		path:    []string{"notificationChannelDelete"},
+		success: "NotificationChannelDeleteSuccess",
+		errors: map[string]string{
+			"NotificationChannelDoesNotExistError": "notification channel does not exist",
+		},
+	}, variables)
+
+	return err
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+const testNotificationChannelJSON = `{
+	"id": "channel-1",
+	"name": "schema alerts",
+	"type": "SLACK",
+	"branchName": "main",
+	"slackChannel": "#graphql",
+	"events": ["SCHEMA_CHECK_FAILED"]
+}`
+
+func TestCreateNotificationChannel(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	branchName := "main"
+	input := CreateNotificationChannelInput{
+		AccountSlug: "my-account",
+		GraphSlug:   "my-graph",
+		BranchName:  &branchName,
+		Name:        "schema alerts",
+		Type:        NotificationChannelTypeSlack,
+		URL:         "https://hooks.slack.com/services/T000/B000/XXXX",
+		Events:      []WebhookEvent{WebhookEventSchemaCheckFailed},
+	}
+
+	server.Handle("CreateNotificationChannel", `{"notificationChannelCreate": {"__typename": "NotificationChannelCreateSuccess",
+		"notificationChannel": `+testNotificationChannelJSON+`}}`)
+	channel, err := c.CreateNotificationChannel(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if channel.ID != "channel-1" || channel.BranchName == nil || *channel.BranchName != "main" {
+		t.Errorf("unexpected notification channel: %+v", channel)
+	}
+
+	server.Handle("CreateNotificationChannel", `{"notificationChannelCreate": {"__typename": "InvalidNotificationChannelUrlError"}}`)
+	if _, err := c.CreateNotificationChannel(ctx, input); err == nil || errorMessage(err) != "notification channel URL is not valid for the channel type" {
+		t.Errorf("expected invalid URL error, got %v", err)
+	}
+}
+
+func TestUpdateNotificationChannel(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("UpdateNotificationChannel", `{"notificationChannelUpdate": {"__typename": "NotificationChannelUpdateSuccess",
+		"notificationChannel": `+testNotificationChannelJSON+`}}`)
+	if _, err := c.UpdateNotificationChannel(ctx, UpdateNotificationChannelInput{ID: "channel-1", Name: "schema alerts"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("UpdateNotificationChannel", `{"notificationChannelUpdate": {"__typename": "NotificationChannelDoesNotExistError"}}`)
+	if _, err := c.UpdateNotificationChannel(ctx, UpdateNotificationChannelInput{ID: "missing"}); err == nil || errorMessage(err) != "notification channel does not exist" {
+		t.Errorf("expected notification channel does not exist, got %v", err)
+	}
+}
+
+func TestGetNotificationChannel(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetNotificationChannel", `{"node": `+testNotificationChannelJSON+`}`)
+	if _, err := c.GetNotificationChannel(ctx, "channel-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("GetNotificationChannel", `{"node": null}`)
+	if _, err := c.GetNotificationChannel(ctx, "missing"); err == nil || errorMessage(err) != "notification channel not found" {
+		t.Errorf("expected notification channel not found, got %v", err)
+	}
+}
+
+func TestDeleteNotificationChannel(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("DeleteNotificationChannel", `{"notificationChannelDelete": {"__typename": "NotificationChannelDeleteSuccess"}}`)
+	if err := c.DeleteNotificationChannel(ctx, "channel-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("DeleteNotificationChannel", `{"notificationChannelDelete": {"__typename": "NotificationChannelDoesNotExistError"}}`)
+	if err := c.DeleteNotificationChannel(ctx, "channel-1"); err == nil || errorMessage(err) != "notification channel does not exist" {
+		t.Errorf("expected notification channel does not exist, got %v", err)
+	}
+}
@@ -0,0 +1,164 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// OperationCheckException represents an allowed breaking change of a branch.
+// Operation checks ignore changes to SchemaPath until ExpiresAt.
+type OperationCheckException struct {
+	ID         string    `json:"id"`
+	BranchName string    `json:"branchName"`
+	SchemaPath string    `json:"schemaPath"`
+	Reason     *string   `json:"reason"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+// CreateOperationCheckExceptionInput represents the input for creating an operation check exception
+type CreateOperationCheckExceptionInput struct {
+	AccountSlug string    `json:"accountSlug"`
+	GraphSlug   string    `json:"graphSlug"`
+	BranchName  string    `json:"branchName"`
+	SchemaPath  string    `json:"schemaPath"`
+	Reason      *string   `json:"reason"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+// UpdateOperationCheckExceptionInput represents the input for replacing the reason and expiry of an operation check exception
+type UpdateOperationCheckExceptionInput struct {
+	ID        string    `json:"id"`
+	Reason    *string   `json:"reason"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// operationCheckExceptionFields is the selection set shared by operation check exception queries
+const operationCheckExceptionFields = `
+	id
+	branchName
+	schemaPath
+	reason
+	expiresAt
+`
+
+// CreateOperationCheckException allows breaking changes to a schema path on a branch until the exception expires
+func (c *Client) CreateOperationCheckException(ctx context.Context, input CreateOperationCheckExceptionInput) (*OperationCheckException, error) {
+	query := `
+		mutation CreateOperationCheckException($input: OperationCheckExceptionCreateInput!) {
+			operationCheckExceptionCreate(input: $input) {
+				__typename
+				... on OperationCheckExceptionCreateSuccess {
+					operationCheckException {` + operationCheckExceptionFields + `}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*OperationCheckException](ctx, c, operation{
+		action:  "create operation check exception",
+		query:   query,
+		path:    []string{"operationCheckExceptionCreate"},
+		success: "OperationCheckExceptionCreateSuccess",
+		field:   "operationCheckException",
+		errors:  operationCheckExceptionErrors,
+	}, variables)
+}
+
+// UpdateOperationCheckException replaces the reason and expiry of an operation check exception
+func (c *Client) UpdateOperationCheckException(ctx context.Context, input UpdateOperationCheckExceptionInput) (*OperationCheckException, error) {
+	query := `
+		mutation UpdateOperationCheckException($input: OperationCheckExceptionUpdateInput!) {
+			operationCheckExceptionUpdate(input: $input) {
+				__typename
+				... on OperationCheckExceptionUpdateSuccess {
+					operationCheckException {` + operationCheckExceptionFields + `}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*OperationCheckException](ctx, c, operation{
+		action:  "update operation check exception",
+		query:   query,
+		path:    []string{"operationCheckExceptionUpdate"},
+		success: "OperationCheckExceptionUpdateSuccess",
+		field:   "operationCheckException",
+		errors:  operationCheckExceptionErrors,
+	}, variables)
+}
+
+// operationCheckExceptionErrors are the errors of the operation check
+// exception create and update mutations
+var operationCheckExceptionErrors = map[string]string{
+	"OperationCheckExceptionDoesNotExistError":  "operation check exception does not exist",
+	"OperationCheckExceptionAlreadyExistsError": "an operation check exception for ${schemaPath} already exists on the branch",
+	"OperationCheckExceptionExpiryInvalidError": "operation check exception expiry must be in the future and at most ${maxDays} days away",
+	"SchemaPathInvalidError":                    "schema path ${schemaPath} is invalid",
+}
+
+// GetOperationCheckException retrieves an operation check exception by ID using the node query
+func (c *Client) GetOperationCheckException(ctx context.Context, id string) (*OperationCheckException, error) {
+	query := `
+		query GetOperationCheckException($id: ID!) {
+			node(id: $id) {
+				... on OperationCheckException {` + operationCheckExceptionFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	exception, err := execute[*OperationCheckException](ctx, c, operation{
+		action: "get operation check exception",
+		query:  query,
+		path:   []string{"node"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if exception == nil || exception.ID == "" {
+		return nil, fmt.Errorf("operation check exception not found")
+	}
+
+	return exception, nil
+}
+
+// DeleteOperationCheckException deletes an operation check exception, so
+// operation checks report changes to its schema path again
+func (c *Client) DeleteOperationCheckException(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteOperationCheckException($id: ID!) {
+			operationCheckExceptionDelete(id: $id) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "delete operation check exception",
+		query:   query,
+		path:    []string{"operationCheckExceptionDelete"},
+		success: "OperationCheckExceptionDeleteSuccess",
+		errors: map[string]string{
+			"OperationCheckExceptionDoesNotExistError": "operation check exception does not exist",
+		},
+	}, variables)
+
+	return err
+}
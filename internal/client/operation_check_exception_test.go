@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+const testOperationCheckExceptionJSON = `{
+	"id": "exception-1",
+	"branchName": "main",
+	"schemaPath": "User.legacyId",
+	"reason": "Removed after the v2 migration",
+	"expiresAt": "2026-12-31T00:00:00Z"
+}`
+
+func TestCreateOperationCheckException(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := CreateOperationCheckExceptionInput{
+		AccountSlug: "my-account",
+		GraphSlug:   "my-graph",
+		BranchName:  "main",
+		SchemaPath:  "User.legacyId",
+		ExpiresAt:   time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	server.Handle("CreateOperationCheckException", `{"operationCheckExceptionCreate": {"__typename": "OperationCheckExceptionCreateSuccess", "operationCheckException": `+testOperationCheckExceptionJSON+`}}`)
+	exception, err := c.CreateOperationCheckException(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if exception.ID != "exception-1" || exception.Reason == nil || !exception.ExpiresAt.Equal(input.ExpiresAt) {
+		t.Errorf("unexpected operation check exception: %+v", exception)
+	}
+
+	sent := server.LastRequest("CreateOperationCheckException").Variables["input"].(map[string]interface{})
+	if sent["expiresAt"] != "2026-12-31T00:00:00Z" {
+		t.Errorf("expected expiresAt 2026-12-31T00:00:00Z, got %v", sent["expiresAt"])
+	}
+
+	server.Handle("CreateOperationCheckException", `{"operationCheckExceptionCreate": {"__typename": "OperationCheckExceptionExpiryInvalidError", "maxDays": 90}}`)
+	if _, err := c.CreateOperationCheckException(ctx, input); err == nil || errorMessage(err) != "operation check exception expiry must be in the future and at most 90 days away" {
+		t.Errorf("expected invalid expiry error, got %v", err)
+	}
+}
+
+func TestUpdateOperationCheckException(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := UpdateOperationCheckExceptionInput{ID: "exception-1", ExpiresAt: time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)}
+
+	server.Handle("UpdateOperationCheckException", `{"operationCheckExceptionUpdate": {"__typename": "OperationCheckExceptionUpdateSuccess", "operationCheckException": `+testOperationCheckExceptionJSON+`}}`)
+	if _, err := c.UpdateOperationCheckException(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("UpdateOperationCheckException", `{"operationCheckExceptionUpdate": {"__typename": "OperationCheckExceptionDoesNotExistError"}}`)
+	if _, err := c.UpdateOperationCheckException(ctx, input); err == nil || errorMessage(err) != "operation check exception does not exist" {
+		t.Errorf("expected operation check exception does not exist, got %v", err)
+	}
+}
+
+func TestGetOperationCheckException(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetOperationCheckException", `{"node": `+testOperationCheckExceptionJSON+`}`)
+	if _, err := c.GetOperationCheckException(ctx, "exception-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("GetOperationCheckException", `{"node": null}`)
+	if _, err := c.GetOperationCheckException(ctx, "missing"); err == nil || errorMessage(err) != "operation check exception not found" {
+		t.Errorf("expected operation check exception not found, got %v", err)
+	}
+}
+
+func TestDeleteOperationCheckException(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("DeleteOperationCheckException", `{"operationCheckExceptionDelete": {"__typename": "OperationCheckExceptionDeleteSuccess"}}`)
+	if err := c.DeleteOperationCheckException(ctx, "exception-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("DeleteOperationCheckException", `{"operationCheckExceptionDelete": {"__typename": "OperationCheckExceptionDoesNotExistError"}}`)
+	if err := c.DeleteOperationCheckException(ctx, "exception-1"); err == nil || errorMessage(err) != "operation check exception does not exist" {
+		t.Errorf("expected operation check exception does not exist, got %v", err)
+	}
+}
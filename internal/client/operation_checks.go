@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// OperationChecksConfig represents the operation check settings of a branch
+type OperationChecksConfig struct {
+	Enabled               bool     `json:"enabled"`
+	IgnoreUsageData       bool     `json:"ignoreUsageData"`
+	TimeWindowDays        int64    `json:"timeWindowDays"`
+	RequestCountThreshold int64    `json:"requestCountThreshold"`
+	ExcludedClients       []string `json:"excludedClients"`
+	ExcludedOperations    []string `json:"excludedOperations"`
+}
+
+// SetOperationChecksConfigInput represents the input for replacing the operation check settings of a branch
+type SetOperationChecksConfigInput struct {
+	AccountSlug           string   `json:"accountSlug"`
+	GraphSlug             string   `json:"graphSlug"`
+	BranchName            string   `json:"branchName"`
+	Enabled               bool     `json:"enabled"`
+	IgnoreUsageData       bool     `json:"ignoreUsageData"`
+	TimeWindowDays        int64    `json:"timeWindowDays,omitempty"`
+	RequestCountThreshold int64    `json:"requestCountThreshold,omitempty"`
+	ExcludedClients       []string `json:"excludedClients"`
+	ExcludedOperations    []string `json:"excludedOperations"`
+}
+
+// GetOperationChecksConfig retrieves the operation check settings of a branch
+func (c *Client) GetOperationChecksConfig(ctx context.Context, accountSlug, graphSlug, branchName string) (*OperationChecksConfig, error) {
+	query := `
+		query GetOperationChecksConfig($accountSlug: String!, $graphSlug: String!, $branchName: String!) {
+			branch(accountSlug: $accountSlug, graphSlug: $graphSlug, name: $branchName) {
+				operationChecksConfiguration {
+					enabled
+					ignoreUsageData
+					timeWindowDays
+					requestCountThreshold
+					excludedClients
+					excludedOperations
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"graphSlug":   graphSlug,
+		"branchName":  branchName,
+	}
+
+	branch, err := execute[*struct {
+		OperationChecksConfiguration OperationChecksConfig `json:"operationChecksConfiguration"`
+	}](ctx, c, operation{
+		action: "get operation checks configuration",
+		query:  query,
+		path:   []string{"branch"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if branch == nil {
+		return nil, fmt.Errorf("branch not found")
+	}
+
+	return &branch.OperationChecksConfiguration, nil
+}
+
+// SetOperationChecksConfig replaces the operation check settings of a branch.
+// Zero thresholds fall back to the API defaults.
+func (c *Client) SetOperationChecksConfig(ctx context.Context, input SetOperationChecksConfigInput) (*OperationChecksConfig, error) {
+	query := `
+		mutation SetOperationChecksConfig($input: OperationChecksConfigurationSetInput!) {
+			operationChecksConfigurationSet(input: $input) {
+				__typename
+				... on OperationChecksConfigurationSetSuccess {
+					operationChecksConfiguration {
+						enabled
+						ignoreUsageData
+						timeWindowDays
+						requestCountThreshold
+						excludedClients
+						excludedOperations
+					}
+				}
+			}
+		}
+	`
+
+	if input.ExcludedClients == nil {
+		input.ExcludedClients = []string{}
+	}
+	if input.ExcludedOperations == nil {
+		input.ExcludedOperations = []string{}
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*OperationChecksConfig](ctx, c, operation{
+		action:  "set operation checks configuration",
+		query:   query,
+		path:    []string{"operationChecksConfigurationSet"},
+		success: "OperationChecksConfigurationSetSuccess",
+		field:   "operationChecksConfiguration",
+		errors: map[string]string{
+			"InvalidTimeWindowError": "time window exceeds the usage data retention of the account",
+		},
+	}, variables)
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetOperationChecksConfig(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetOperationChecksConfig", `{"branch": {"operationChecksConfiguration": {
+		"enabled": true,
+		"ignoreUsageData": false,
+		"timeWindowDays": 7,
+		"requestCountThreshold": 10,
+		"excludedClients": ["internal"],
+		"excludedOperations": []
+	}}}`)
+	config, err := c.GetOperationChecksConfig(ctx, "my-account", "my-graph", "main")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !config.Enabled || config.TimeWindowDays != 7 || len(config.ExcludedClients) != 1 {
+		t.Errorf("unexpected config: %+v", config)
+	}
+
+	server.Handle("GetOperationChecksConfig", `{"branch": null}`)
+	if _, err := c.GetOperationChecksConfig(ctx, "my-account", "my-graph", "missing"); err == nil || errorMessage(err) != "branch not found" {
+		t.Errorf("expected branch not found, got %v", err)
+	}
+}
+
+func TestSetOperationChecksConfig(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := SetOperationChecksConfigInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main", Enabled: true}
+
+	server.Handle("SetOperationChecksConfig", `{"operationChecksConfigurationSet": {"__typename": "OperationChecksConfigurationSetSuccess", "operationChecksConfiguration": {"enabled": true, "timeWindowDays": 7, "requestCountThreshold": 1}}}`)
+	config, err := c.SetOperationChecksConfig(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.RequestCountThreshold != 1 {
+		t.Errorf("unexpected config: %+v", config)
+	}
+
+	// Zero thresholds are omitted so the API applies its defaults
+	sent := server.LastRequest("SetOperationChecksConfig").Variables["input"].(map[string]interface{})
+	if _, ok := sent["timeWindowDays"]; ok {
+		t.Errorf("expected timeWindowDays to be omitted, got %v", sent["timeWindowDays"])
+	}
+
+	server.Handle("SetOperationChecksConfig", `{"operationChecksConfigurationSet": {"__typename": "InvalidTimeWindowError"}}`)
+	if _, err := c.SetOperationChecksConfig(ctx, input); err == nil || errorMessage(err) != "time window exceeds the usage data retention of the account" {
+		t.Errorf("expected invalid time window error, got %v", err)
+	}
+}
@@ -0,0 +1,264 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// defaultPageSize is the number of nodes requested per page when listing
+const defaultPageSize = 100
+
+// errConnectionNotFound is returned by PaginateAll when an object on the path
+// to the connection is null, for example because the graph does not exist
+var errConnectionNotFound = errors.New("connection not found")
+
+// pageInfo is the page information of a connection
+type pageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	EndCursor   *string `json:"endCursor"`
+}
+
+// connection is a Relay style connection with raw nodes
+type connection struct {
+	Edges []struct {
+		Node json.RawMessage `json:"node"`
+	} `json:"edges"`
+	PageInfo pageInfo `json:"pageInfo"`
+}
+
+// PaginateAll runs a query selecting a Relay style connection until every page
+// has been fetched, and returns the raw nodes of all pages in order. The query
+// must declare the $first: Int! and $after: String variables and select
+// edges { node } and pageInfo { hasNextPage endCursor } on the connection.
+// pathToConnection lists the fields leading from the query root to the
+// connection, such as ["graphByAccountSlug", "branches"]. A perPage of zero or
+// less uses the default page size.
+func (c *Client) PaginateAll(ctx context.Context, query string, variables map[string]interface{}, pathToConnection []string, perPage int) ([]json.RawMessage, error) {
+	if len(pathToConnection) == 0 {
+		return nil, fmt.Errorf("path to connection must not be empty")
+	}
+
+	if perPage <= 0 {
+		perPage = defaultPageSize
+	}
+
+	pageVariables := make(map[string]interface{}, len(variables)+2)
+	for k, v := range variables {
+		pageVariables[k] = v
+	}
+	pageVariables["first"] = perPage
+	pageVariables["after"] = nil
+
+	var nodes []json.RawMessage
+	for {
+		resp, err := c.ExecuteQuery(ctx, query, pageVariables)
+		if err != nil {
+			return nil, err
+		}
+
+		page, err := connectionAt(resp.Data, pathToConnection)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, edge := range page.Edges {
+			nodes = append(nodes, edge.Node)
+		}
+
+		if !page.PageInfo.HasNextPage {
+			return nodes, nil
+		}
+
+		if page.PageInfo.EndCursor == nil || *page.PageInfo.EndCursor == "" {
+			return nil, fmt.Errorf("connection %v has a next page but no end cursor", pathToConnection)
+		}
+		if after, ok := pageVariables["after"].(string); ok && after == *page.PageInfo.EndCursor {
+			return nil, fmt.Errorf("connection %v returned the same end cursor twice", pathToConnection)
+		}
+		pageVariables["after"] = *page.PageInfo.EndCursor
+	}
+}
+
+// connectionAt decodes the connection found by following path from data
+func connectionAt(data json.RawMessage, path []string) (*connection, error) {
+	current := data
+	for _, field := range path {
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(current, &object); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s: %w", field, err)
+		}
+
+		if object == nil {
+			return nil, errConnectionNotFound
+		}
+
+		value, ok := object[field]
+		if !ok {
+			return nil, fmt.Errorf("response is missing field %s", field)
+		}
+		current = value
+	}
+
+	var page *connection
+	if err := json.Unmarshal(current, &page); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal connection: %w", err)
+	}
+
+	if page == nil {
+		return nil, errConnectionNotFound
+	}
+
+	return page, nil
+}
+
+// decodeNodes unmarshals the raw nodes returned by PaginateAll
+func decodeNodes[T any](nodes []json.RawMessage) ([]T, error) {
+	result := make([]T, 0, len(nodes))
+	for _, node := range nodes {
+		var v T
+		if err := json.Unmarshal(node, &v); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal node: %w", err)
+		}
+		result = append(result, v)
+	}
+
+	return result, nil
+}
+
+// ListGraphs retrieves every graph of an account
+func (c *Client) ListGraphs(ctx context.Context, accountSlug string) ([]Graph, error) {
+	query := `
+		query ListGraphs($accountSlug: String!, $first: Int!, $after: String) {
+			accountBySlug(slug: $accountSlug) {
+				graphs(first: $first, after: $after) {
+					edges {
+						node {` + graphFields + `}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+	}
+
+	nodes, err := c.PaginateAll(ctx, query, variables, []string{"accountBySlug", "graphs"}, defaultPageSize)
+	if errors.Is(err, errConnectionNotFound) {
+		return nil, fmt.Errorf("account not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list graphs: %w", err)
+	}
+
+	return decodeNodes[Graph](nodes)
+}
+
+// ListBranches retrieves every branch of a graph
+func (c *Client) ListBranches(ctx context.Context, accountSlug, graphSlug string) ([]Branch, error) {
+	query := `
+		query ListBranches($accountSlug: String!, $graphSlug: String!, $first: Int!, $after: String) {
+			graphByAccountSlug(accountSlug: $accountSlug, graphSlug: $graphSlug) {
+				branches(first: $first, after: $after) {
+					edges {
+						node {
+							id
+							name
+							environment
+							operationChecksEnabled
+							operationChecksIgnoreUsageData
+							regions
+							endpointUrl
+							latestDeployment {
+								id
+								status
+							}
+							graph {
+								id
+								slug
+								createdAt
+								account {
+									id
+									slug
+									name
+								}
+							}
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"graphSlug":   graphSlug,
+	}
+
+	nodes, err := c.PaginateAll(ctx, query, variables, []string{"graphByAccountSlug", "branches"}, defaultPageSize)
+	if errors.Is(err, errConnectionNotFound) {
+		return nil, fmt.Errorf("graph not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branches: %w", err)
+	}
+
+	return decodeNodes[Branch](nodes)
+}
+
+// Subgraph represents a subgraph published on a branch, without its schema
+type Subgraph struct {
+	Name        string    `json:"name"`
+	URL         string    `json:"url"`
+	PublishedAt time.Time `json:"publishedAt"`
+}
+
+// ListSubgraphs retrieves every subgraph published on a branch
+func (c *Client) ListSubgraphs(ctx context.Context, accountSlug, graphSlug, branchName string) ([]Subgraph, error) {
+	query := `
+		query ListSubgraphs($accountSlug: String!, $graphSlug: String!, $branchName: String!, $first: Int!, $after: String) {
+			branch(accountSlug: $accountSlug, graphSlug: $graphSlug, name: $branchName) {
+				subgraphs(first: $first, after: $after) {
+					edges {
+						node {
+							name
+							url
+							publishedAt
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"graphSlug":   graphSlug,
+		"branchName":  branchName,
+	}
+
+	nodes, err := c.PaginateAll(ctx, query, variables, []string{"branch", "subgraphs"}, defaultPageSize)
+	if errors.Is(err, errConnectionNotFound) {
+		return nil, fmt.Errorf("branch not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subgraphs: %w", err)
+	}
+
+	return decodeNodes[Subgraph](nodes)
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/mockgraphql"
+)
+
+// handleBranchPages serves the branches of a graph in pages keyed by the after cursor
+func handleBranchPages(server *mockgraphql.Server, pages map[string]string) {
+	server.HandleFunc("ListBranches", func(req mockgraphql.Request) mockgraphql.Response {
+		after, _ := req.Variables["after"].(string)
+		return mockgraphql.Response{Data: pages[after]}
+	})
+}
+
+func TestPaginateAll(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	handleBranchPages(server, map[string]string{
+		"": `{"graphByAccountSlug": {"branches": {
+			"edges": [{"node": {"name": "main"}}, {"node": {"name": "staging"}}],
+			"pageInfo": {"hasNextPage": true, "endCursor": "c2"}
+		}}}`,
+		"c2": `{"graphByAccountSlug": {"branches": {
+			"edges": [{"node": {"name": "feature"}}],
+			"pageInfo": {"hasNextPage": false, "endCursor": "c3"}
+		}}}`,
+	})
+	branches, err := c.ListBranches(ctx, "my-account", "my-graph")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var names []string
+	for _, branch := range branches {
+		names = append(names, branch.Name)
+	}
+	if fmt.Sprint(names) != "[main staging feature]" {
+		t.Errorf("unexpected branches: %v", names)
+	}
+
+	requests := server.Requests("ListBranches")
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(requests))
+	}
+	if requests[0].Variables["after"] != nil || requests[1].Variables["after"] != "c2" {
+		t.Errorf("unexpected cursors: %v, %v", requests[0].Variables["after"], requests[1].Variables["after"])
+	}
+	if requests[0].Variables["first"] != float64(defaultPageSize) || requests[1].Variables["graphSlug"] != "my-graph" {
+		t.Errorf("unexpected variables: %v", requests[1].Variables)
+	}
+}
+
+func TestPaginateAllErrors(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("ListBranches", `{"graphByAccountSlug": null}`)
+	if _, err := c.ListBranches(ctx, "my-account", "missing"); err == nil || errorMessage(err) != "graph not found" {
+		t.Errorf("expected graph not found, got %v", err)
+	}
+
+	// A server repeating its cursor must not paginate forever
+	server.Handle("ListBranches", `{"graphByAccountSlug": {"branches": {
+		"edges": [{"node": {"name": "main"}}],
+		"pageInfo": {"hasNextPage": true, "endCursor": "c1"}
+	}}}`)
+	if _, err := c.ListBranches(ctx, "my-account", "my-graph"); err == nil {
+		t.Error("expected an error for a repeated end cursor")
+	}
+
+	server.Handle("ListBranches", `{"graphByAccountSlug": {"branches": {
+		"edges": [],
+		"pageInfo": {"hasNextPage": true, "endCursor": null}
+	}}}`)
+	if _, err := c.ListBranches(ctx, "my-account", "my-graph"); err == nil {
+		t.Error("expected an error for a missing end cursor")
+	}
+
+	server.HandleErrors("ListBranches", "unauthorized")
+	if _, err := c.ListBranches(ctx, "my-account", "my-graph"); err == nil {
+		t.Error("expected GraphQL errors to be returned")
+	}
+}
+
+func TestListGraphs(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("ListGraphs", `{"accountBySlug": {"graphs": {
+		"edges": [{"node": {"id": "graph-1", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z", "account": {"id": "account-1", "slug": "my-account", "name": "My Account"}}}],
+		"pageInfo": {"hasNextPage": false, "endCursor": null}
+	}}}`)
+	graphs, err := c.ListGraphs(ctx, "my-account")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(graphs) != 1 || graphs[0].Slug != "my-graph" || graphs[0].Account.Slug != "my-account" {
+		t.Errorf("unexpected graphs: %+v", graphs)
+	}
+
+	server.Handle("ListGraphs", `{"accountBySlug": null}`)
+	if _, err := c.ListGraphs(ctx, "missing"); err == nil || errorMessage(err) != "account not found" {
+		t.Errorf("expected account not found, got %v", err)
+	}
+}
+
+func TestListSubgraphs(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("ListSubgraphs", `{"branch": {"subgraphs": {
+		"edges": [{"node": {"name": "products", "url": "https://products.example.com/graphql", "publishedAt": "2024-01-15T10:30:00Z"}}],
+		"pageInfo": {"hasNextPage": false, "endCursor": "c1"}
+	}}}`)
+	subgraphs, err := c.ListSubgraphs(ctx, "my-account", "my-graph", "main")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(subgraphs) != 1 || subgraphs[0].Name != "products" || subgraphs[0].PublishedAt.IsZero() {
+		t.Errorf("unexpected subgraphs: %+v", subgraphs)
+	}
+
+	server.Handle("ListSubgraphs", `{"branch": {"subgraphs": {"edges": [], "pageInfo": {"hasNextPage": false, "endCursor": null}}}}`)
+	subgraphs, err = c.ListSubgraphs(ctx, "my-account", "my-graph", "main")
+	if err != nil || subgraphs == nil || len(subgraphs) != 0 {
+		t.Errorf("expected an empty list, got %v, %v", subgraphs, err)
+	}
+
+	server.Handle("ListSubgraphs", `{"branch": null}`)
+	if _, err := c.ListSubgraphs(ctx, "my-account", "my-graph", "missing"); err == nil || errorMessage(err) != "branch not found" {
+		t.Errorf("expected branch not found, got %v", err)
+	}
+}
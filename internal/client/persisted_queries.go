@@ -0,0 +1,57 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+const (
+	// persistedQueryNotFound is the error code of a persisted query whose hash
+	// the API has not seen yet
+	persistedQueryNotFound = "PERSISTED_QUERY_NOT_FOUND"
+	// persistedQueryNotSupported is the error code of an API without persisted
+	// query support
+	persistedQueryNotSupported = "PERSISTED_QUERY_NOT_SUPPORTED"
+)
+
+// RequestExtensions are the extensions of a GraphQL request
+type RequestExtensions struct {
+	PersistedQuery *PersistedQuery `json:"persistedQuery,omitempty"`
+}
+
+// PersistedQuery identifies an automatic persisted query by the SHA-256 hash
+// of its document
+type PersistedQuery struct {
+	Version    int    `json:"version"`
+	SHA256Hash string `json:"sha256Hash"`
+}
+
+// persistedQueryExtensions returns the request extensions referencing query
+// by its hash
+func persistedQueryExtensions(query string) *RequestExtensions {
+	hash := sha256.Sum256([]byte(query))
+
+	return &RequestExtensions{
+		PersistedQuery: &PersistedQuery{
+			Version:    1,
+			SHA256Hash: hex.EncodeToString(hash[:]),
+		},
+	}
+}
+
+// persistedQueryErrorCode returns the persisted query error code of a
+// response, or an empty string if the response has no such error. Servers
+// report the code as an error extension, older ones only as the message.
+func persistedQueryErrorCode(resp *GraphQLResponse) string {
+	for _, err := range resp.Errors {
+		code, _ := err.Extensions["code"].(string)
+		switch {
+		case code == persistedQueryNotFound || err.Message == "PersistedQueryNotFound":
+			return persistedQueryNotFound
+		case code == persistedQueryNotSupported || err.Message == "PersistedQueryNotSupported":
+			return persistedQueryNotSupported
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestExecuteQueryPersistedQueries(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetBranch", `{"branch": `+testBranchJSON+`}`)
+
+	for range 3 {
+		if _, err := c.GetBranch(ctx, "my-account", "my-graph", "main"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	requests := server.Requests("GetBranch")
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(requests))
+	}
+	// The first request registers the query after a miss, later ones only send its hash
+	if requests[0].Persisted || !requests[1].Persisted || !requests[2].Persisted {
+		t.Errorf("expected only the first request to send the full query, got %v, %v, %v", requests[0].Persisted, requests[1].Persisted, requests[2].Persisted)
+	}
+	if requests[2].Variables["branchName"] != "main" {
+		t.Errorf("expected variables with persisted queries, got %v", requests[2].Variables)
+	}
+}
+
+func TestExecuteQueryPersistedQueriesNotSupported(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.RejectPersistedQueries()
+	server.Handle("GetBranch", `{"branch": `+testBranchJSON+`}`)
+
+	for range 2 {
+		if _, err := c.GetBranch(ctx, "my-account", "my-graph", "main"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	for _, req := range server.Requests("GetBranch") {
+		if req.Persisted {
+			t.Error("expected full queries once persisted queries are not supported")
+		}
+	}
+	if !c.persistedQueriesUnsupported.Load() {
+		t.Error("expected persisted queries to be disabled")
+	}
+}
+
+func TestExecuteQueryPersistedQueryNotFoundStatus(t *testing.T) {
+	var bodies []GraphQLRequest
+
+	// Some servers reject unknown hashes with a client error status
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		var body GraphQLRequest
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			return nil, err
+		}
+		bodies = append(bodies, body)
+
+		status, response := http.StatusOK, `{"data": {"accountBySlug": {"id": "account-1", "slug": "my-account", "name": "My Account"}}}`
+		if body.Query == "" {
+			status, response = http.StatusBadRequest, `{"errors": [{"message": "PersistedQueryNotFound"}]}`
+		}
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(response)),
+		}, nil
+	})
+
+	c := NewClient("test", WithTransport(transport))
+	if _, err := c.GetAccountBySlug(context.Background(), "my-account"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(bodies) != 2 {
+		t.Fatalf("expected a retry with the full query, got %d requests", len(bodies))
+	}
+	if bodies[1].Query == "" || bodies[1].Extensions == nil || bodies[1].Extensions.PersistedQuery.SHA256Hash != bodies[0].Extensions.PersistedQuery.SHA256Hash {
+		t.Errorf("expected the full query to be sent with its hash, got %+v", bodies[1])
+	}
+}
+
+func TestPersistedQueryErrorCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		errors   []GraphQLError
+		expected string
+	}{
+		{name: "no errors", expected: ""},
+		{name: "other error", errors: []GraphQLError{{Message: "not authorized"}}, expected: ""},
+		{name: "not found code", errors: []GraphQLError{{Message: "unknown query", Extensions: map[string]interface{}{"code": "PERSISTED_QUERY_NOT_FOUND"}}}, expected: persistedQueryNotFound},
+		{name: "not found message", errors: []GraphQLError{{Message: "PersistedQueryNotFound"}}, expected: persistedQueryNotFound},
+		{name: "not supported message", errors: []GraphQLError{{Message: "PersistedQueryNotSupported"}}, expected: persistedQueryNotSupported},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := persistedQueryErrorCode(&GraphQLResponse{Errors: tt.errors}); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package client
+
+import (
+	"context"
+)
+
+// Region represents a region or point of presence a managed gateway can run in
+type Region struct {
+	Code      string `json:"code"`
+	Name      string `json:"name"`
+	Continent string `json:"continent"`
+}
+
+// ListRegions retrieves the regions available for managed gateways
+func (c *Client) ListRegions(ctx context.Context) ([]Region, error) {
+	query := `
+		query ListRegions {
+			gatewayRegions {
+				code
+				name
+				continent
+			}
+		}
+	`
+
+	return execute[[]Region](ctx, c, operation{
+		action: "list regions",
+		query:  query,
+		path:   []string{"gatewayRegions"},
+	}, map[string]interface{}{})
+}
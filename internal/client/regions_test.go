@@ -0,0 +1,22 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestListRegions(t *testing.T) {
+	c, server := newTestClient(t)
+
+	server.Handle("ListRegions", `{"gatewayRegions": [
+		{"code": "iad", "name": "Ashburn, Virginia", "continent": "North America"},
+		{"code": "fra", "name": "Frankfurt", "continent": "Europe"}
+	]}`)
+	regions, err := c.ListRegions(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(regions) != 2 || regions[1].Code != "fra" {
+		t.Errorf("unexpected regions: %+v", regions)
+	}
+}
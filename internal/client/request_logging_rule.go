@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// RequestLoggingRule represents a rule enabling full request and response
+// logging for matching operations on a branch. Empty OperationNames or
+// ClientNames match every operation or client.
+type RequestLoggingRule struct {
+	ID                string   `json:"id"`
+	BranchName        string   `json:"branchName"`
+	Name              string   `json:"name"`
+	OperationNames    []string `json:"operationNames"`
+	ClientNames       []string `json:"clientNames"`
+	SampleRate        float64  `json:"sampleRate"`
+	RedactedHeaders   []string `json:"redactedHeaders"`
+	RedactedVariables []string `json:"redactedVariables"`
+	Enabled           bool     `json:"enabled"`
+}
+
+// CreateRequestLoggingRuleInput represents the input for creating a request logging rule
+type CreateRequestLoggingRuleInput struct {
+	AccountSlug       string   `json:"accountSlug"`
+	GraphSlug         string   `json:"graphSlug"`
+	BranchName        string   `json:"branchName"`
+	Name              string   `json:"name"`
+	OperationNames    []string `json:"operationNames"`
+	ClientNames       []string `json:"clientNames"`
+	SampleRate        float64  `json:"sampleRate"`
+	RedactedHeaders   []string `json:"redactedHeaders"`
+	RedactedVariables []string `json:"redactedVariables"`
+	Enabled           bool     `json:"enabled"`
+}
+
+// UpdateRequestLoggingRuleInput represents the input for replacing the settings of a request logging rule
+type UpdateRequestLoggingRuleInput struct {
+	ID                string   `json:"id"`
+	Name              string   `json:"name"`
+	OperationNames    []string `json:"operationNames"`
+	ClientNames       []string `json:"clientNames"`
+	SampleRate        float64  `json:"sampleRate"`
+	RedactedHeaders   []string `json:"redactedHeaders"`
+	RedactedVariables []string `json:"redactedVariables"`
+	Enabled           bool     `json:"enabled"`
+}
+
+// requestLoggingRuleFields is the selection set shared by request logging rule queries
+const requestLoggingRuleFields = `
+	id
+	branchName
+	name
+	operationNames
+	clientNames
+	sampleRate
+	redactedHeaders
+	redactedVariables
+	enabled
+`
+
+// CreateRequestLoggingRule creates a request logging rule on a branch
+func (c *Client) CreateRequestLoggingRule(ctx context.Context, input CreateRequestLoggingRuleInput) (*RequestLoggingRule, error) {
+	query := `
+		mutation CreateRequestLoggingRule($input: RequestLoggingRuleCreateInput!) {
+			requestLoggingRuleCreate(input: $input) {
+				__typename
+				... on RequestLoggingRuleCreateSuccess {
+					requestLoggingRule {` + requestLoggingRuleFields + `}
+				}
+			}
+		}
+	`
+
+	if input.OperationNames == nil {
+		input.OperationNames = []string{}
+	}
+	if input.ClientNames == nil {
+		input.ClientNames = []string{}
+	}
+	if input.RedactedHeaders == nil {
+		input.RedactedHeaders = []string{}
+	}
+	if input.RedactedVariables == nil {
+		input.RedactedVariables = []string{}
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*RequestLoggingRule](ctx, c, operation{
+		action:  "create request logging rule",
+		query:   query,
+		path:    []string{"requestLoggingRuleCreate"},
+		success: "RequestLoggingRuleCreateSuccess",
+		field:   "requestLoggingRule",
+		errors:  requestLoggingRuleErrors,
+	}, variables)
+}
+
+// UpdateRequestLoggingRule replaces the settings of a request logging rule
+func (c *Client) UpdateRequestLoggingRule(ctx context.Context, input UpdateRequestLoggingRuleInput) (*RequestLoggingRule, error) {
+	query := `
+		mutation UpdateRequestLoggingRule($input: RequestLoggingRuleUpdateInput!) {
+			requestLoggingRuleUpdate(input: $input) {
+				__typename
+				... on RequestLoggingRuleUpdateSuccess {
+					requestLoggingRule {` + requestLoggingRuleFields + `}
+				}
+			}
+		}
+	`
+
+	if input.OperationNames == nil {
+		input.OperationNames = []string{}
+	}
+	if input.ClientNames == nil {
+		input.ClientNames = []string{}
+	}
+	if input.RedactedHeaders == nil {
+		input.RedactedHeaders = []string{}
+	}
+	if input.RedactedVariables == nil {
+		input.RedactedVariables = []string{}
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*RequestLoggingRule](ctx, c, operation{
+		action:  "update request logging rule",
+		query:   query,
+		path:    []string{"requestLoggingRuleUpdate"},
+		success: "RequestLoggingRuleUpdateSuccess",
+		field:   "requestLoggingRule",
+		errors:  requestLoggingRuleErrors,
+	}, variables)
+}
+
+// requestLoggingRuleErrors are the errors of the request logging rule create
+// and update mutations
+var requestLoggingRuleErrors = map[string]string{
+	"RequestLoggingRuleDoesNotExistError": "request logging rule does not exist",
+	"RequestLoggingNotAvailableError":     "request logging is not available on the account plan",
+}
+
+// GetRequestLoggingRule retrieves a request logging rule by ID using the node query
+func (c *Client) GetRequestLoggingRule(ctx context.Context, id string) (*RequestLoggingRule, error) {
+	query := `
+		query GetRequestLoggingRule($id: ID!) {
+			node(id: $id) {
+				... on RequestLoggingRule {` + requestLoggingRuleFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	rule, err := execute[*RequestLoggingRule](ctx, c, operation{
+		action: "get request logging rule",
+		query:  query,
+		path:   []string{"node"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if rule == nil || rule.ID == "" {
+		return nil, fmt.Errorf("request logging rule not found")
+	}
+
+	return rule, nil
+}
+
+// DeleteRequestLoggingRule deletes a request logging rule
+func (c *Client) DeleteRequestLoggingRule(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteRequestLoggingRule($id: ID!) {
+			requestLoggingRuleDelete(id: $id) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "delete request logging rule",
+		query:   query,
+		path:    []string{"requestLoggingRuleDelete"},
+		success: "RequestLoggingRuleDeleteSuccess",
+		errors: map[string]string{
+			"RequestLoggingRuleDoesNotExistError": "request logging rule does not exist",
+		},
+	}, variables)
+
+	return err
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+const testRequestLoggingRuleJSON = `{
+	"id": "rule-1",
+	"branchName": "main",
+	"name": "debug checkout",
+	"operationNames": ["Checkout"],
+	"clientNames": [],
+	"sampleRate": 0.1,
+	"redactedHeaders": ["authorization"],
+	"redactedVariables": [],
+	"enabled": true
+}`
+
+func TestCreateRequestLoggingRule(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := CreateRequestLoggingRuleInput{
+		AccountSlug:    "my-account",
+		GraphSlug:      "my-graph",
+		BranchName:     "main",
+		Name:           "debug checkout",
+		OperationNames: []string{"Checkout"},
+		SampleRate:     0.1,
+		Enabled:        true,
+	}
+
+	server.Handle("CreateRequestLoggingRule", `{"requestLoggingRuleCreate": {"__typename": "RequestLoggingRuleCreateSuccess", "requestLoggingRule": `+testRequestLoggingRuleJSON+`}}`)
+	rule, err := c.CreateRequestLoggingRule(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rule.ID != "rule-1" || rule.SampleRate != 0.1 || len(rule.RedactedHeaders) != 1 {
+		t.Errorf("unexpected rule: %+v", rule)
+	}
+
+	// Missing lists are sent as empty lists, matching every client
+	sent := server.LastRequest("CreateRequestLoggingRule").Variables["input"].(map[string]interface{})
+	if clients, ok := sent["clientNames"].([]interface{}); !ok || len(clients) != 0 {
+		t.Errorf("expected empty clientNames, got %v", sent["clientNames"])
+	}
+
+	server.Handle("CreateRequestLoggingRule", `{"requestLoggingRuleCreate": {"__typename": "RequestLoggingNotAvailableError"}}`)
+	if _, err := c.CreateRequestLoggingRule(ctx, input); err == nil || errorMessage(err) != "request logging is not available on the account plan" {
+		t.Errorf("expected not available error, got %v", err)
+	}
+}
+
+func TestUpdateRequestLoggingRule(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("UpdateRequestLoggingRule", `{"requestLoggingRuleUpdate": {"__typename": "RequestLoggingRuleUpdateSuccess", "requestLoggingRule": `+testRequestLoggingRuleJSON+`}}`)
+	if _, err := c.UpdateRequestLoggingRule(ctx, UpdateRequestLoggingRuleInput{ID: "rule-1", Name: "debug checkout", SampleRate: 0.1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("UpdateRequestLoggingRule", `{"requestLoggingRuleUpdate": {"__typename": "RequestLoggingRuleDoesNotExistError"}}`)
+	if _, err := c.UpdateRequestLoggingRule(ctx, UpdateRequestLoggingRuleInput{ID: "rule-1"}); err == nil || errorMessage(err) != "request logging rule does not exist" {
+		t.Errorf("expected request logging rule does not exist, got %v", err)
+	}
+}
+
+func TestGetRequestLoggingRule(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetRequestLoggingRule", `{"node": `+testRequestLoggingRuleJSON+`}`)
+	if _, err := c.GetRequestLoggingRule(ctx, "rule-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("GetRequestLoggingRule", `{"node": null}`)
+	if _, err := c.GetRequestLoggingRule(ctx, "missing"); err == nil || errorMessage(err) != "request logging rule not found" {
+		t.Errorf("expected request logging rule not found, got %v", err)
+	}
+}
+
+func TestDeleteRequestLoggingRule(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("DeleteRequestLoggingRule", `{"requestLoggingRuleDelete": {"__typename": "RequestLoggingRuleDeleteSuccess"}}`)
+	if err := c.DeleteRequestLoggingRule(ctx, "rule-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("DeleteRequestLoggingRule", `{"requestLoggingRuleDelete": {"__typename": "RequestLoggingRuleDoesNotExistError"}}`)
+	if err := c.DeleteRequestLoggingRule(ctx, "rule-1"); err == nil || errorMessage(err) != "request logging rule does not exist" {
+		t.Errorf("expected request logging rule does not exist, got %v", err)
+	}
+}
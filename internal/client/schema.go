@@ -0,0 +1,182 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// GetFederatedSchema retrieves the currently composed federated graph SDL of a branch
+func (c *Client) GetFederatedSchema(ctx context.Context, accountSlug, graphSlug, branchName string) (string, error) {
+	query := `
+		query GetFederatedSchema($accountSlug: String!, $graphSlug: String!, $branchName: String!) {
+			branch(accountSlug: $accountSlug, graphSlug: $graphSlug, name: $branchName) {
+				federatedSchema
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"graphSlug":   graphSlug,
+		"branchName":  branchName,
+	}
+
+	branch, err := execute[*struct {
+		FederatedSchema *string `json:"federatedSchema"`
+	}](ctx, c, operation{
+		action: "get federated schema",
+		query:  query,
+		path:   []string{"branch"},
+	}, variables)
+	if err != nil {
+		return "", err
+	}
+
+	if branch == nil {
+		return "", fmt.Errorf("branch not found")
+	}
+
+	if branch.FederatedSchema == nil {
+		return "", fmt.Errorf("branch has no composed schema")
+	}
+
+	return *branch.FederatedSchema, nil
+}
+
+// PublishedSubgraph represents the schema currently published for a subgraph on a branch
+type PublishedSubgraph struct {
+	Name        string    `json:"name"`
+	URL         string    `json:"url"`
+	Schema      string    `json:"schema"`
+	PublishedAt time.Time `json:"publishedAt"`
+}
+
+// GetSubgraphSchema retrieves the currently published SDL of a subgraph on a branch
+func (c *Client) GetSubgraphSchema(ctx context.Context, accountSlug, graphSlug, branchName, subgraphName string) (*PublishedSubgraph, error) {
+	query := `
+		query GetSubgraphSchema($accountSlug: String!, $graphSlug: String!, $branchName: String!, $subgraphName: String!) {
+			branch(accountSlug: $accountSlug, graphSlug: $graphSlug, name: $branchName) {
+				subgraph(name: $subgraphName) {
+					name
+					url
+					schema
+					publishedAt
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug":  accountSlug,
+		"graphSlug":    graphSlug,
+		"branchName":   branchName,
+		"subgraphName": subgraphName,
+	}
+
+	branch, err := execute[*struct {
+		Subgraph *PublishedSubgraph `json:"subgraph"`
+	}](ctx, c, operation{
+		action: "get subgraph schema",
+		query:  query,
+		path:   []string{"branch"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if branch == nil {
+		return nil, fmt.Errorf("branch not found")
+	}
+
+	if branch.Subgraph == nil {
+		return nil, fmt.Errorf("subgraph not found")
+	}
+
+	return branch.Subgraph, nil
+}
+
+// SchemaChange represents a single difference between two subgraph schemas
+type SchemaChange struct {
+	Kind     string `json:"kind"`
+	Path     string `json:"path"`
+	Message  string `json:"message"`
+	Breaking bool   `json:"breaking"`
+}
+
+// DiffSubgraphSchema compares a candidate SDL against the schema currently published
+// for a subgraph on a branch. An unpublished subgraph is diffed against an empty schema.
+func (c *Client) DiffSubgraphSchema(ctx context.Context, accountSlug, graphSlug, branchName, subgraphName, schema string) ([]SchemaChange, error) {
+	query := `
+		query DiffSubgraphSchema($accountSlug: String!, $graphSlug: String!, $branchName: String!, $subgraphName: String!, $schema: String!) {
+			branch(accountSlug: $accountSlug, graphSlug: $graphSlug, name: $branchName) {
+				subgraphSchemaDiff(subgraphName: $subgraphName, schema: $schema) {
+					kind
+					path
+					message
+					breaking
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug":  accountSlug,
+		"graphSlug":    graphSlug,
+		"branchName":   branchName,
+		"subgraphName": subgraphName,
+		"schema":       schema,
+	}
+
+	branch, err := execute[*struct {
+		SubgraphSchemaDiff []SchemaChange `json:"subgraphSchemaDiff"`
+	}](ctx, c, operation{
+		action: "diff subgraph schema",
+		query:  query,
+		path:   []string{"branch"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if branch == nil {
+		return nil, fmt.Errorf("branch not found")
+	}
+
+	return branch.SubgraphSchemaDiff, nil
+}
+
+// DeleteSubgraphInput represents the input for deleting a published subgraph
+type DeleteSubgraphInput struct {
+	AccountSlug  string `json:"accountSlug"`
+	GraphSlug    string `json:"graphSlug"`
+	BranchName   string `json:"branchName"`
+	SubgraphName string `json:"subgraphName"`
+}
+
+// DeleteSubgraph removes a published subgraph from a branch, recomposing the branch without it
+func (c *Client) DeleteSubgraph(ctx context.Context, input DeleteSubgraphInput) error {
+	query := `
+		mutation DeleteSubgraph($input: SubgraphDeleteInput!) {
+			subgraphDelete(input: $input) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "delete subgraph",
+		query:   query,
+		path:    []string{"subgraphDelete"},
+		success: "SubgraphDeleteSuccess",
+		errors: map[string]string{
+			"SubgraphDoesNotExistError": "subgraph does not exist",
+		},
+	}, variables)
+
+	return err
+}
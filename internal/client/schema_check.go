@@ -0,0 +1,151 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// SchemaCheckInput represents the input for running a schema check
+type SchemaCheckInput struct {
+	AccountSlug  string `json:"accountSlug"`
+	GraphSlug    string `json:"graphSlug"`
+	Branch       string `json:"branch,omitempty"`
+	SubgraphName string `json:"subgraphName"`
+	Schema       string `json:"schema"`
+}
+
+// SchemaCheck represents the result of a schema check
+type SchemaCheck struct {
+	ID                     string             `json:"id"`
+	ErrorCount             int                `json:"errorCount"`
+	ValidationCheckErrors  []SchemaCheckError `json:"validationCheckErrors"`
+	CompositionCheckErrors []SchemaCheckError `json:"compositionCheckErrors"`
+	OperationCheckErrors   []SchemaCheckError `json:"operationCheckErrors"`
+	LintCheckErrors        []SchemaCheckError `json:"lintCheckErrors"`
+}
+
+// SchemaCheckError represents a single error or warning reported by a schema check
+type SchemaCheckError struct {
+	Title    string `json:"title,omitempty"`
+	Message  string `json:"message"`
+	Severity string `json:"severity,omitempty"`
+}
+
+// Severity levels reported by schema checks
+const (
+	SchemaCheckSeverityError   = "ERROR"
+	SchemaCheckSeverityWarning = "WARNING"
+)
+
+// CreateSchemaCheck runs validation, composition, operation, and lint checks
+// for a subgraph schema against a branch without publishing it
+func (c *Client) CreateSchemaCheck(ctx context.Context, input SchemaCheckInput) (*SchemaCheck, error) {
+	query := `
+		mutation CreateSchemaCheck($input: SchemaCheckCreateInput!) {
+			schemaCheckCreate(input: $input) {
+				__typename
+				... on SchemaCheck {
+					id
+					errorCount
+					validationCheckErrors {
+						message
+					}
+					compositionCheckErrors {
+						message
+					}
+					operationCheckErrors {
+						title
+						message
+						severity
+					}
+					lintCheckErrors {
+						message
+						severity
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*SchemaCheck](ctx, c, operation{
+		action:  "create schema check",
+		query:   query,
+		path:    []string{"schemaCheckCreate"},
+		success: "SchemaCheck",
+		errors: map[string]string{
+			"SubgraphNameMissingOnFederatedProjectError": "subgraph name is required for federated graphs",
+		},
+	}, variables)
+}
+
+// OperationCheckResult represents the outcome of the operation checks run for a subgraph schema
+type OperationCheckResult struct {
+	ID                 string              `json:"id"`
+	CreatedAt          time.Time           `json:"createdAt"`
+	ErrorCount         int                 `json:"errorCount"`
+	AffectedOperations []AffectedOperation `json:"affectedOperations"`
+	ImpactedClients    []string            `json:"impactedClients"`
+}
+
+// AffectedOperation represents a client operation broken by a schema change
+type AffectedOperation struct {
+	Name       string `json:"name"`
+	ClientName string `json:"clientName"`
+	Message    string `json:"message"`
+	Count      int    `json:"count"`
+}
+
+// GetLatestOperationCheckResult retrieves the most recent operation check result for a subgraph on a branch
+func (c *Client) GetLatestOperationCheckResult(ctx context.Context, accountSlug, graphSlug, branchName, subgraphName string) (*OperationCheckResult, error) {
+	query := `
+		query GetLatestOperationCheckResult($accountSlug: String!, $graphSlug: String!, $branchName: String!, $subgraphName: String!) {
+			branch(accountSlug: $accountSlug, graphSlug: $graphSlug, name: $branchName) {
+				latestOperationCheck(subgraphName: $subgraphName) {
+					id
+					createdAt
+					errorCount
+					affectedOperations {
+						name
+						clientName
+						message
+						count
+					}
+					impactedClients
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug":  accountSlug,
+		"graphSlug":    graphSlug,
+		"branchName":   branchName,
+		"subgraphName": subgraphName,
+	}
+
+	branch, err := execute[*struct {
+		LatestOperationCheck *OperationCheckResult `json:"latestOperationCheck"`
+	}](ctx, c, operation{
+		action: "get operation check result",
+		query:  query,
+		path:   []string{"branch"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if branch == nil {
+		return nil, fmt.Errorf("branch not found")
+	}
+
+	if branch.LatestOperationCheck == nil {
+		return nil, fmt.Errorf("operation check not found")
+	}
+
+	return branch.LatestOperationCheck, nil
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCreateSchemaCheck(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := SchemaCheckInput{AccountSlug: "my-account", GraphSlug: "my-graph", SubgraphName: "users", Schema: "type Query { me: User }"}
+
+	server.Handle("CreateSchemaCheck", `{"schemaCheckCreate": {
+		"__typename": "SchemaCheck",
+		"id": "check-1",
+		"errorCount": 1,
+		"validationCheckErrors": [],
+		"compositionCheckErrors": [{"message": "Type User is not defined"}],
+		"operationCheckErrors": [],
+		"lintCheckErrors": [{"message": "Field names should be camelCase", "severity": "WARNING"}]
+	}}`)
+	check, err := c.CreateSchemaCheck(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if check.ErrorCount != 1 || len(check.CompositionCheckErrors) != 1 || check.LintCheckErrors[0].Severity != SchemaCheckSeverityWarning {
+		t.Errorf("unexpected check: %+v", check)
+	}
+
+	server.Handle("CreateSchemaCheck", `{"schemaCheckCreate": {"__typename": "SubgraphNameMissingOnFederatedProjectError"}}`)
+	if _, err := c.CreateSchemaCheck(ctx, input); err == nil || errorMessage(err) != "subgraph name is required for federated graphs" {
+		t.Errorf("expected subgraph name error, got %v", err)
+	}
+}
+
+func TestGetLatestOperationCheckResult(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetLatestOperationCheckResult", `{"branch": {"latestOperationCheck": {
+		"id": "operation-check-1",
+		"createdAt": "2024-01-15T10:30:00Z",
+		"errorCount": 1,
+		"affectedOperations": [{"name": "GetMe", "clientName": "web", "message": "Field Query.me was removed", "count": 42}],
+		"impactedClients": ["web"]
+	}}}`)
+	result, err := c.GetLatestOperationCheckResult(ctx, "my-account", "my-graph", "main", "users")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.AffectedOperations) != 1 || result.AffectedOperations[0].Count != 42 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+
+	server.Handle("GetLatestOperationCheckResult", `{"branch": {"latestOperationCheck": null}}`)
+	if _, err := c.GetLatestOperationCheckResult(ctx, "my-account", "my-graph", "main", "users"); err == nil || errorMessage(err) != "operation check not found" {
+		t.Errorf("expected operation check not found, got %v", err)
+	}
+}
@@ -0,0 +1,147 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// SchemaContract represents a filtered variant of the federated schema of a
+// branch. Fields tagged with one of IncludeTags are kept, or all fields when
+// IncludeTags is empty, and fields tagged with one of ExcludeTags are removed.
+type SchemaContract struct {
+	ID                   string   `json:"id"`
+	Name                 string   `json:"name"`
+	IncludeTags          []string `json:"includeTags"`
+	ExcludeTags          []string `json:"excludeTags"`
+	HideUnreachableTypes bool     `json:"hideUnreachableTypes"`
+}
+
+// SetSchemaContractInput represents the input for creating or replacing a schema contract
+type SetSchemaContractInput struct {
+	AccountSlug          string   `json:"accountSlug"`
+	GraphSlug            string   `json:"graphSlug"`
+	BranchName           string   `json:"branchName"`
+	Name                 string   `json:"name"`
+	IncludeTags          []string `json:"includeTags"`
+	ExcludeTags          []string `json:"excludeTags"`
+	HideUnreachableTypes bool     `json:"hideUnreachableTypes"`
+}
+
+// DeleteSchemaContractInput represents the input for deleting a schema contract
+type DeleteSchemaContractInput struct {
+	AccountSlug string `json:"accountSlug"`
+	GraphSlug   string `json:"graphSlug"`
+	BranchName  string `json:"branchName"`
+	Name        string `json:"name"`
+}
+
+// schemaContractFields is the selection set shared by schema contract queries
+const schemaContractFields = `
+	id
+	name
+	includeTags
+	excludeTags
+	hideUnreachableTypes
+`
+
+// SetSchemaContract creates or replaces a schema contract of a branch
+func (c *Client) SetSchemaContract(ctx context.Context, input SetSchemaContractInput) (*SchemaContract, error) {
+	query := `
+		mutation SetSchemaContract($input: SchemaContractSetInput!) {
+			schemaContractSet(input: $input) {
+				__typename
+				... on SchemaContractSetSuccess {
+					schemaContract {` + schemaContractFields + `}
+				}
+				... on SchemaTagDoesNotExistError {
+					name
+				}
+			}
+		}
+	`
+
+	// The API expects lists, never null
+	if input.IncludeTags == nil {
+		input.IncludeTags = []string{}
+	}
+	if input.ExcludeTags == nil {
+		input.ExcludeTags = []string{}
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*SchemaContract](ctx, c, operation{
+		action:  "set schema contract",
+		query:   query,
+		path:    []string{"schemaContractSet"},
+		success: "SchemaContractSetSuccess",
+		field:   "schemaContract",
+		errors: map[string]string{
+			"BranchDoesNotExistError":        "branch does not exist",
+			"SchemaTagDoesNotExistError":     "schema tag ${name} is not registered on the graph",
+			"SchemaContractNameInvalidError": "schema contract name is invalid",
+		},
+	}, variables)
+}
+
+// GetSchemaContract retrieves a schema contract of a branch by name
+func (c *Client) GetSchemaContract(ctx context.Context, accountSlug, graphSlug, branchName, name string) (*SchemaContract, error) {
+	query := `
+		query GetSchemaContract($accountSlug: String!, $graphSlug: String!, $branchName: String!, $name: String!) {
+			branch(accountSlug: $accountSlug, graphSlug: $graphSlug, name: $branchName) {
+				schemaContract(name: $name) {` + schemaContractFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"graphSlug":   graphSlug,
+		"branchName":  branchName,
+		"name":        name,
+	}
+
+	contract, err := execute[*SchemaContract](ctx, c, operation{
+		action: "get schema contract",
+		query:  query,
+		path:   []string{"branch", "schemaContract"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if contract == nil {
+		return nil, fmt.Errorf("schema contract not found")
+	}
+
+	return contract, nil
+}
+
+// DeleteSchemaContract deletes a schema contract of a branch
+func (c *Client) DeleteSchemaContract(ctx context.Context, input DeleteSchemaContractInput) error {
+	query := `
+		mutation DeleteSchemaContract($input: SchemaContractDeleteInput!) {
+			schemaContractDelete(input: $input) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "delete schema contract",
+		query:   query,
+		path:    []string{"schemaContractDelete"},
+		success: "SchemaContractDeleteSuccess",
+		errors: map[string]string{
+			"SchemaContractDoesNotExistError": "schema contract does not exist",
+		},
+	}, variables)
+
+	return err
+}
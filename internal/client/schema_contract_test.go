@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSetSchemaContract(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := SetSchemaContractInput{
+		AccountSlug: "my-account",
+		GraphSlug:   "my-graph",
+		BranchName:  "main",
+		Name:        "public-api",
+		IncludeTags: []string{"public"},
+	}
+
+	server.Handle("SetSchemaContract", `{"schemaContractSet": {"__typename": "SchemaContractSetSuccess",
+		"schemaContract": {"id": "contract-1", "name": "public-api", "includeTags": ["public"], "excludeTags": [], "hideUnreachableTypes": false}}}`)
+	contract, err := c.SetSchemaContract(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if contract.ID != "contract-1" || len(contract.IncludeTags) != 1 {
+		t.Errorf("unexpected schema contract: %+v", contract)
+	}
+
+	var sent map[string]interface{}
+	if err := server.LastRequest("SetSchemaContract").Input(&sent); err != nil {
+		t.Fatalf("failed to decode input: %v", err)
+	}
+	if excludeTags, ok := sent["excludeTags"].([]interface{}); !ok || len(excludeTags) != 0 {
+		t.Errorf("expected an empty excludeTags list, got %v", sent["excludeTags"])
+	}
+
+	server.Handle("SetSchemaContract", `{"schemaContractSet": {"__typename": "SchemaTagDoesNotExistError", "name": "public"}}`)
+	if _, err := c.SetSchemaContract(ctx, input); err == nil || errorMessage(err) != "schema tag public is not registered on the graph" {
+		t.Errorf("expected unregistered tag error, got %v", err)
+	}
+}
+
+func TestGetSchemaContract(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetSchemaContract", `{"branch": {"schemaContract": {"id": "contract-1", "name": "public-api", "includeTags": ["public"], "excludeTags": [], "hideUnreachableTypes": true}}}`)
+	contract, err := c.GetSchemaContract(ctx, "my-account", "my-graph", "main", "public-api")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !contract.HideUnreachableTypes {
+		t.Errorf("unexpected schema contract: %+v", contract)
+	}
+
+	server.Handle("GetSchemaContract", `{"branch": {"schemaContract": null}}`)
+	if _, err := c.GetSchemaContract(ctx, "my-account", "my-graph", "main", "missing"); err == nil || errorMessage(err) != "schema contract not found" {
+		t.Errorf("expected schema contract not found, got %v", err)
+	}
+}
+
+func TestDeleteSchemaContract(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := DeleteSchemaContractInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main", Name: "public-api"}
+
+	server.Handle("DeleteSchemaContract", `{"schemaContractDelete": {"__typename": "SchemaContractDeleteSuccess"}}`)
+	if err := c.DeleteSchemaContract(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("DeleteSchemaContract", `{"schemaContractDelete": {"__typename": "SchemaContractDoesNotExistError"}}`)
+	if err := c.DeleteSchemaContract(ctx, input); err == nil || errorMessage(err) != "schema contract does not exist" {
+		t.Errorf("expected schema contract does not exist, got %v", err)
+	}
+}
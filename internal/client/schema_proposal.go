@@ -0,0 +1,199 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// SchemaProposalStatus represents the review status of a schema proposal
+type SchemaProposalStatus string
+
+const (
+	// SchemaProposalStatusOpen is a proposal awaiting review
+	SchemaProposalStatusOpen SchemaProposalStatus = "OPEN"
+	// SchemaProposalStatusApproved is a proposal approved by a reviewer
+	SchemaProposalStatusApproved SchemaProposalStatus = "APPROVED"
+	// SchemaProposalStatusRejected is a proposal rejected by a reviewer
+	SchemaProposalStatusRejected SchemaProposalStatus = "REJECTED"
+	// SchemaProposalStatusImplemented is a proposal whose schema was published
+	SchemaProposalStatusImplemented SchemaProposalStatus = "IMPLEMENTED"
+	// SchemaProposalStatusClosed is a proposal closed without being implemented
+	SchemaProposalStatusClosed SchemaProposalStatus = "CLOSED"
+)
+
+// SchemaProposal represents a proposed subgraph schema change under review for a branch
+type SchemaProposal struct {
+	ID           string               `json:"id"`
+	Title        string               `json:"title"`
+	Description  *string              `json:"description"`
+	Status       SchemaProposalStatus `json:"status"`
+	Branch       Branch               `json:"branch"`
+	SubgraphName string               `json:"subgraphName"`
+	Schema       string               `json:"schema"`
+	CreatedAt    time.Time            `json:"createdAt"`
+}
+
+// CreateSchemaProposalInput represents the input for opening a schema proposal
+type CreateSchemaProposalInput struct {
+	AccountSlug  string  `json:"accountSlug"`
+	GraphSlug    string  `json:"graphSlug"`
+	BranchName   string  `json:"branchName"`
+	Title        string  `json:"title"`
+	Description  *string `json:"description"`
+	SubgraphName string  `json:"subgraphName"`
+	Schema       string  `json:"schema"`
+}
+
+// UpdateSchemaProposalInput represents the input for revising an open schema proposal
+type UpdateSchemaProposalInput struct {
+	ID          string  `json:"id"`
+	Title       string  `json:"title"`
+	Description *string `json:"description"`
+	Schema      string  `json:"schema"`
+}
+
+// schemaProposalFields is the selection set of a schema proposal
+const schemaProposalFields = `
+	id
+	title
+	description
+	status
+	branch {
+		name
+		graph {
+			slug
+			account {
+				slug
+			}
+		}
+	}
+	subgraphName
+	schema
+	createdAt
+`
+
+// CreateSchemaProposal opens a schema proposal for review
+func (c *Client) CreateSchemaProposal(ctx context.Context, input CreateSchemaProposalInput) (*SchemaProposal, error) {
+	query := `
+		mutation CreateSchemaProposal($input: SchemaProposalCreateInput!) {
+			schemaProposalCreate(input: $input) {
+				__typename
+				... on SchemaProposalCreateSuccess {
+					schemaProposal {` + schemaProposalFields + `}
+				}
+				... on InvalidSchemaError {
+					message
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*SchemaProposal](ctx, c, operation{
+		action:  "create schema proposal",
+		query:   query,
+		path:    []string{"schemaProposalCreate"},
+		success: "SchemaProposalCreateSuccess",
+		field:   "schemaProposal",
+		errors:  schemaProposalErrors,
+	}, variables)
+}
+
+// GetSchemaProposal retrieves a schema proposal by ID using the node query
+func (c *Client) GetSchemaProposal(ctx context.Context, id string) (*SchemaProposal, error) {
+	query := `
+		query GetSchemaProposal($id: ID!) {
+			node(id: $id) {
+				... on SchemaProposal {` + schemaProposalFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	proposal, err := execute[*SchemaProposal](ctx, c, operation{
+		action: "get schema proposal",
+		query:  query,
+		path:   []string{"node"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	// Nodes of other types decode without an ID
+	if proposal == nil || proposal.ID == "" {
+		return nil, fmt.Errorf("schema proposal not found")
+	}
+
+	return proposal, nil
+}
+
+// UpdateSchemaProposal revises the title, description, and schema of an open schema proposal
+func (c *Client) UpdateSchemaProposal(ctx context.Context, input UpdateSchemaProposalInput) (*SchemaProposal, error) {
+	query := `
+		mutation UpdateSchemaProposal($input: SchemaProposalUpdateInput!) {
+			schemaProposalUpdate(input: $input) {
+				__typename
+				... on SchemaProposalUpdateSuccess {
+					schemaProposal {` + schemaProposalFields + `}
+				}
+				... on InvalidSchemaError {
+					message
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*SchemaProposal](ctx, c, operation{
+		action:  "update schema proposal",
+		query:   query,
+		path:    []string{"schemaProposalUpdate"},
+		success: "SchemaProposalUpdateSuccess",
+		field:   "schemaProposal",
+		errors:  schemaProposalErrors,
+	}, variables)
+}
+
+// CloseSchemaProposal closes an open schema proposal without implementing it
+func (c *Client) CloseSchemaProposal(ctx context.Context, id string) error {
+	query := `
+		mutation CloseSchemaProposal($input: SchemaProposalCloseInput!) {
+			schemaProposalClose(input: $input) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"id": id,
+		},
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "close schema proposal",
+		query:   query,
+		path:    []string{"schemaProposalClose"},
+		success: "SchemaProposalCloseSuccess",
+		errors:  schemaProposalErrors,
+	}, variables)
+
+	return err
+}
+
+// schemaProposalErrors are the errors of the schema proposal mutations
+var schemaProposalErrors = map[string]string{
+	"SchemaProposalDoesNotExistError": "schema proposal does not exist",
+	"SchemaProposalNotOpenError":      "schema proposal is no longer open",
+	"InvalidSchemaError":              "proposed schema is invalid: ${message}",
+}
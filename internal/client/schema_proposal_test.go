@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+const testSchemaProposalJSON = `{"id": "proposal-1", "title": "Add reviews", "description": null, "status": "OPEN", "branch": {"name": "main", "graph": {"slug": "my-graph", "account": {"slug": "my-account"}}}, "subgraphName": "reviews", "schema": "type Query { reviews: [String] }", "createdAt": "2024-01-15T10:30:00Z"}`
+
+func TestCreateSchemaProposal(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := CreateSchemaProposalInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main", Title: "Add reviews", SubgraphName: "reviews", Schema: "type Query { reviews: [String] }"}
+
+	server.Handle("CreateSchemaProposal", `{"schemaProposalCreate": {"__typename": "SchemaProposalCreateSuccess", "schemaProposal": `+testSchemaProposalJSON+`}}`)
+	proposal, err := c.CreateSchemaProposal(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if proposal.ID != "proposal-1" || proposal.Status != SchemaProposalStatusOpen || proposal.Description != nil || proposal.Branch.Graph.Account.Slug != "my-account" {
+		t.Errorf("unexpected proposal: %+v", proposal)
+	}
+
+	server.Handle("CreateSchemaProposal", `{"schemaProposalCreate": {"__typename": "InvalidSchemaError", "message": "unexpected token"}}`)
+	if _, err := c.CreateSchemaProposal(ctx, input); err == nil || errorMessage(err) != "proposed schema is invalid: unexpected token" {
+		t.Errorf("expected invalid schema error, got %v", err)
+	}
+}
+
+func TestGetSchemaProposal(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetSchemaProposal", `{"node": `+testSchemaProposalJSON+`}`)
+	if _, err := c.GetSchemaProposal(ctx, "proposal-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, data := range []string{`{"node": null}`, `{"node": {}}`} {
+		server.Handle("GetSchemaProposal", data)
+		if _, err := c.GetSchemaProposal(ctx, "graph-1"); err == nil || errorMessage(err) != "schema proposal not found" {
+			t.Errorf("expected schema proposal not found for %s, got %v", data, err)
+		}
+	}
+}
+
+func TestUpdateSchemaProposal(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := UpdateSchemaProposalInput{ID: "proposal-1", Title: "Add reviews", Schema: "type Query { reviews: [String] }"}
+
+	server.Handle("UpdateSchemaProposal", `{"schemaProposalUpdate": {"__typename": "SchemaProposalUpdateSuccess", "schemaProposal": `+testSchemaProposalJSON+`}}`)
+	if _, err := c.UpdateSchemaProposal(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("UpdateSchemaProposal", `{"schemaProposalUpdate": {"__typename": "SchemaProposalNotOpenError"}}`)
+	if _, err := c.UpdateSchemaProposal(ctx, input); err == nil || errorMessage(err) != "schema proposal is no longer open" {
+		t.Errorf("expected schema proposal is no longer open, got %v", err)
+	}
+}
+
+func TestCloseSchemaProposal(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("CloseSchemaProposal", `{"schemaProposalClose": {"__typename": "SchemaProposalCloseSuccess"}}`)
+	if err := c.CloseSchemaProposal(ctx, "proposal-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("CloseSchemaProposal", `{"schemaProposalClose": {"__typename": "SchemaProposalDoesNotExistError"}}`)
+	if err := c.CloseSchemaProposal(ctx, "proposal-1"); err == nil || errorMessage(err) != "schema proposal does not exist" {
+		t.Errorf("expected schema proposal does not exist, got %v", err)
+	}
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"time"
+)
+
+// PublishSubgraphInput represents the input for publishing a subgraph schema to a branch
+type PublishSubgraphInput struct {
+	AccountSlug  string  `json:"accountSlug"`
+	GraphSlug    string  `json:"graphSlug"`
+	BranchName   string  `json:"branchName"`
+	SubgraphName string  `json:"subgraphName"`
+	URL          *string `json:"url"`
+	Schema       string  `json:"schema"`
+	Message      *string `json:"message"`
+}
+
+// SchemaVersion represents a version of the federated schema of a branch,
+// created by a subgraph publish
+type SchemaVersion struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// PublishSubgraph publishes a subgraph schema to a branch, recomposing the
+// federated schema, and returns the resulting schema version
+func (c *Client) PublishSubgraph(ctx context.Context, input PublishSubgraphInput) (*SchemaVersion, error) {
+	query := `
+		mutation PublishSubgraph($input: PublishInput!) {
+			publish(input: $input) {
+				__typename
+				... on PublishSuccess {
+					schemaVersion {
+						id
+						createdAt
+					}
+				}
+				... on FederatedGraphCompositionError {
+					messages
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*SchemaVersion](ctx, c, operation{
+		action:  "publish subgraph",
+		query:   query,
+		path:    []string{"publish"},
+		success: "PublishSuccess",
+		field:   "schemaVersion",
+		errors: map[string]string{
+			"FederatedGraphCompositionError": "schema composition failed: ${messages}",
+		},
+	}, variables)
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestPublishSubgraph(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	message := "deploy 42"
+	input := PublishSubgraphInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main", SubgraphName: "products", Schema: "type Query { products: [String] }", Message: &message}
+
+	server.Handle("PublishSubgraph", `{"publish": {"__typename": "PublishSuccess", "schemaVersion": {"id": "version-1", "createdAt": "2024-01-15T10:30:00Z"}}}`)
+	version, err := c.PublishSubgraph(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version.ID != "version-1" {
+		t.Errorf("unexpected schema version: %+v", version)
+	}
+	sent := server.LastRequest("PublishSubgraph").Variables["input"].(map[string]interface{})
+	if sent["message"] != "deploy 42" || sent["url"] != nil {
+		t.Errorf("unexpected input: %v", sent)
+	}
+
+	server.Handle("PublishSubgraph", `{"publish": {"__typename": "FederatedGraphCompositionError", "messages": ["field Product.id is defined twice"]}}`)
+	if _, err := c.PublishSubgraph(ctx, input); err == nil || errorMessage(err) != "schema composition failed: [field Product.id is defined twice]" {
+		t.Errorf("expected composition error, got %v", err)
+	}
+
+	server.Handle("PublishSubgraph", `{"publish": {"__typename": "BranchDoesNotExistError"}}`)
+	var branchErr *BranchDoesNotExistError
+	if _, err := c.PublishSubgraph(ctx, input); !errors.As(err, &branchErr) {
+		t.Errorf("expected branch does not exist error, got %v", err)
+	}
+}
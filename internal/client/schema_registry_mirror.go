@@ -0,0 +1,191 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// SchemaRegistryMirrorKind represents the external registry a schema is mirrored to
+type SchemaRegistryMirrorKind string
+
+const (
+	SchemaRegistryMirrorKindApolloGraphOS SchemaRegistryMirrorKind = "APOLLO_GRAPHOS"
+	SchemaRegistryMirrorKindHive          SchemaRegistryMirrorKind = "HIVE"
+	SchemaRegistryMirrorKindS3            SchemaRegistryMirrorKind = "S3"
+)
+
+// SchemaRegistryMirror represents the mirroring of a branch's composed schema
+// to an external registry. Credentials are never returned by the API.
+type SchemaRegistryMirror struct {
+	ID         string                   `json:"id"`
+	BranchName string                   `json:"branchName"`
+	Kind       SchemaRegistryMirrorKind `json:"kind"`
+	Target     string                   `json:"target"`
+	Endpoint   *string                  `json:"endpoint"`
+	Enabled    bool                     `json:"enabled"`
+}
+
+// SchemaRegistryMirrorCredentials represents the credentials used to publish
+// to the external registry. Token is used by Apollo GraphOS and Hive, the
+// access key pair by S3.
+type SchemaRegistryMirrorCredentials struct {
+	Token           string `json:"token,omitempty"`
+	AccessKeyID     string `json:"accessKeyId,omitempty"`
+	SecretAccessKey string `json:"secretAccessKey,omitempty"`
+}
+
+// CreateSchemaRegistryMirrorInput represents the input for creating a schema registry mirror
+type CreateSchemaRegistryMirrorInput struct {
+	AccountSlug string                           `json:"accountSlug"`
+	GraphSlug   string                           `json:"graphSlug"`
+	BranchName  string                           `json:"branchName"`
+	Kind        SchemaRegistryMirrorKind         `json:"kind"`
+	Target      string                           `json:"target"`
+	Endpoint    *string                          `json:"endpoint"`
+	Enabled     bool                             `json:"enabled"`
+	Credentials *SchemaRegistryMirrorCredentials `json:"credentials"`
+}
+
+// UpdateSchemaRegistryMirrorInput represents the input for updating a schema
+// registry mirror. Nil Credentials keep the stored credentials.
+type UpdateSchemaRegistryMirrorInput struct {
+	ID          string                           `json:"id"`
+	Target      string                           `json:"target"`
+	Endpoint    *string                          `json:"endpoint"`
+	Enabled     bool                             `json:"enabled"`
+	Credentials *SchemaRegistryMirrorCredentials `json:"credentials,omitempty"`
+}
+
+// schemaRegistryMirrorFields is the selection set shared by schema registry mirror queries
+const schemaRegistryMirrorFields = `
+	id
+	branchName
+	kind
+	target
+	endpoint
+	enabled
+`
+
+// CreateSchemaRegistryMirror configures mirroring of a branch's composed schema to an external registry
+func (c *Client) CreateSchemaRegistryMirror(ctx context.Context, input CreateSchemaRegistryMirrorInput) (*SchemaRegistryMirror, error) {
+	query := `
+		mutation CreateSchemaRegistryMirror($input: SchemaRegistryMirrorCreateInput!) {
+			schemaRegistryMirrorCreate(input: $input) {
+				__typename
+				... on SchemaRegistryMirrorCreateSuccess {
+					schemaRegistryMirror {` + schemaRegistryMirrorFields + `}
+				}
+				... on InvalidRegistryCredentialsError {
+					message
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*SchemaRegistryMirror](ctx, c, operation{
+		action:  "create schema registry mirror",
+		query:   query,
+		path:    []string{"schemaRegistryMirrorCreate"},
+		success: "SchemaRegistryMirrorCreateSuccess",
+		field:   "schemaRegistryMirror",
+		errors:  schemaRegistryMirrorErrors,
+	}, variables)
+}
+
+// UpdateSchemaRegistryMirror updates the target, endpoint, status, or credentials of a schema registry mirror
+func (c *Client) UpdateSchemaRegistryMirror(ctx context.Context, input UpdateSchemaRegistryMirrorInput) (*SchemaRegistryMirror, error) {
+	query := `
+		mutation UpdateSchemaRegistryMirror($input: SchemaRegistryMirrorUpdateInput!) {
+			schemaRegistryMirrorUpdate(input: $input) {
+				__typename
+				... on SchemaRegistryMirrorUpdateSuccess {
+					schemaRegistryMirror {` + schemaRegistryMirrorFields + `}
+				}
+				... on InvalidRegistryCredentialsError {
+					message
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*SchemaRegistryMirror](ctx, c, operation{
+		action:  "update schema registry mirror",
+		query:   query,
+		path:    []string{"schemaRegistryMirrorUpdate"},
+		success: "SchemaRegistryMirrorUpdateSuccess",
+		field:   "schemaRegistryMirror",
+		errors:  schemaRegistryMirrorErrors,
+	}, variables)
+}
+
+// schemaRegistryMirrorErrors are the errors of the schema registry mirror
+// create and update mutations
+var schemaRegistryMirrorErrors = map[string]string{
+	"SchemaRegistryMirrorDoesNotExistError": "schema registry mirror does not exist",
+	"InvalidRegistryCredentialsError":       "registry rejected the credentials: ${message}",
+}
+
+// GetSchemaRegistryMirror retrieves a schema registry mirror by ID using the node query
+func (c *Client) GetSchemaRegistryMirror(ctx context.Context, id string) (*SchemaRegistryMirror, error) {
+	query := `
+		query GetSchemaRegistryMirror($id: ID!) {
+			node(id: $id) {
+				... on SchemaRegistryMirror {` + schemaRegistryMirrorFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	mirror, err := execute[*SchemaRegistryMirror](ctx, c, operation{
+		action: "get schema registry mirror",
+		query:  query,
+		path:   []string{"node"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if mirror == nil || mirror.ID == "" {
+		return nil, fmt.Errorf("schema registry mirror not found")
+	}
+
+	return mirror, nil
+}
+
+// DeleteSchemaRegistryMirror stops mirroring and deletes the stored credentials
+func (c *Client) DeleteSchemaRegistryMirror(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteSchemaRegistryMirror($id: ID!) {
+			schemaRegistryMirrorDelete(id: $id) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "delete schema registry mirror",
+		query:   query,
+		path:    []string{"schemaRegistryMirrorDelete"},
+		success: "SchemaRegistryMirrorDeleteSuccess",
+		errors: map[string]string{
+			"SchemaRegistryMirrorDoesNotExistError": "schema registry mirror does not exist",
+		},
+	}, variables)
+
+	return err
+}
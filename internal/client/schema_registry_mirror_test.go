@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+const testSchemaRegistryMirrorJSON = `{
+	"id": "mirror-1",
+	"branchName": "main",
+	"kind": "HIVE",
+	"target": "my-org/my-project/production",
+	"endpoint": null,
+	"enabled": true
+}`
+
+func TestCreateSchemaRegistryMirror(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := CreateSchemaRegistryMirrorInput{
+		AccountSlug: "my-account",
+		GraphSlug:   "my-graph",
+		BranchName:  "main",
+		Kind:        SchemaRegistryMirrorKindHive,
+		Target:      "my-org/my-project/production",
+		Enabled:     true,
+		Credentials: &SchemaRegistryMirrorCredentials{Token: "hive-token"},
+	}
+
+	server.Handle("CreateSchemaRegistryMirror", `{"schemaRegistryMirrorCreate": {"__typename": "SchemaRegistryMirrorCreateSuccess", "schemaRegistryMirror": `+testSchemaRegistryMirrorJSON+`}}`)
+	mirror, err := c.CreateSchemaRegistryMirror(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mirror.ID != "mirror-1" || mirror.Kind != SchemaRegistryMirrorKindHive || mirror.Endpoint != nil {
+		t.Errorf("unexpected mirror: %+v", mirror)
+	}
+
+	// Only the credentials of the registry kind are sent
+	credentials := server.LastRequest("CreateSchemaRegistryMirror").Variables["input"].(map[string]interface{})["credentials"].(map[string]interface{})
+	if len(credentials) != 1 || credentials["token"] != "hive-token" {
+		t.Errorf("unexpected credentials: %v", credentials)
+	}
+
+	server.Handle("CreateSchemaRegistryMirror", `{"schemaRegistryMirrorCreate": {"__typename": "InvalidRegistryCredentialsError", "message": "token expired"}}`)
+	if _, err := c.CreateSchemaRegistryMirror(ctx, input); err == nil || errorMessage(err) != "registry rejected the credentials: token expired" {
+		t.Errorf("expected invalid credentials error, got %v", err)
+	}
+}
+
+func TestUpdateSchemaRegistryMirror(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("UpdateSchemaRegistryMirror", `{"schemaRegistryMirrorUpdate": {"__typename": "SchemaRegistryMirrorUpdateSuccess", "schemaRegistryMirror": `+testSchemaRegistryMirrorJSON+`}}`)
+	if _, err := c.UpdateSchemaRegistryMirror(ctx, UpdateSchemaRegistryMirrorInput{ID: "mirror-1", Target: "my-org/my-project/production"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Without new credentials, the stored credentials are kept
+	if _, ok := server.LastRequest("UpdateSchemaRegistryMirror").Variables["input"].(map[string]interface{})["credentials"]; ok {
+		t.Error("expected credentials to be omitted")
+	}
+
+	server.Handle("UpdateSchemaRegistryMirror", `{"schemaRegistryMirrorUpdate": {"__typename": "SchemaRegistryMirrorDoesNotExistError"}}`)
+	if _, err := c.UpdateSchemaRegistryMirror(ctx, UpdateSchemaRegistryMirrorInput{ID: "mirror-1"}); err == nil || errorMessage(err) != "schema registry mirror does not exist" {
+		t.Errorf("expected schema registry mirror does not exist, got %v", err)
+	}
+}
+
+func TestGetSchemaRegistryMirror(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetSchemaRegistryMirror", `{"node": `+testSchemaRegistryMirrorJSON+`}`)
+	if _, err := c.GetSchemaRegistryMirror(ctx, "mirror-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("GetSchemaRegistryMirror", `{"node": null}`)
+	if _, err := c.GetSchemaRegistryMirror(ctx, "missing"); err == nil || errorMessage(err) != "schema registry mirror not found" {
+		t.Errorf("expected schema registry mirror not found, got %v", err)
+	}
+}
+
+func TestDeleteSchemaRegistryMirror(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("DeleteSchemaRegistryMirror", `{"schemaRegistryMirrorDelete": {"__typename": "SchemaRegistryMirrorDeleteSuccess"}}`)
+	if err := c.DeleteSchemaRegistryMirror(ctx, "mirror-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("DeleteSchemaRegistryMirror", `{"schemaRegistryMirrorDelete": {"__typename": "SchemaRegistryMirrorDoesNotExistError"}}`)
+	if err := c.DeleteSchemaRegistryMirror(ctx, "mirror-1"); err == nil || errorMessage(err) != "schema registry mirror does not exist" {
+		t.Errorf("expected schema registry mirror does not exist, got %v", err)
+	}
+}
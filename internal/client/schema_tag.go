@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// SchemaTag represents a tag that subgraphs may apply with the @tag directive,
+// and the contracts whose filters consume it
+type SchemaTag struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description *string  `json:"description"`
+	Contracts   []string `json:"contracts"`
+}
+
+// SetSchemaTagInput represents the input for creating or replacing a schema tag
+type SetSchemaTagInput struct {
+	AccountSlug string   `json:"accountSlug"`
+	GraphSlug   string   `json:"graphSlug"`
+	Name        string   `json:"name"`
+	Description *string  `json:"description"`
+	Contracts   []string `json:"contracts"`
+}
+
+// DeleteSchemaTagInput represents the input for deleting a schema tag
+type DeleteSchemaTagInput struct {
+	AccountSlug string `json:"accountSlug"`
+	GraphSlug   string `json:"graphSlug"`
+	Name        string `json:"name"`
+}
+
+// SetSchemaTag creates or replaces a schema tag of a graph
+func (c *Client) SetSchemaTag(ctx context.Context, input SetSchemaTagInput) (*SchemaTag, error) {
+	query := `
+		mutation SetSchemaTag($input: SchemaTagSetInput!) {
+			schemaTagSet(input: $input) {
+				__typename
+				... on SchemaTagSetSuccess {
+					schemaTag {
+						id
+						name
+						description
+						contracts
+					}
+				}
+				... on ContractDoesNotExistError {
+					name
+				}
+			}
+		}
+	`
+
+	if input.Contracts == nil {
+		input.Contracts = []string{}
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*SchemaTag](ctx, c, operation{
+		action:  "set schema tag",
+		query:   query,
+		path:    []string{"schemaTagSet"},
+		success: "SchemaTagSetSuccess",
+		field:   "schemaTag",
+		errors: map[string]string{
+			"ContractDoesNotExistError": "contract ${name} does not exist",
+			"SchemaTagNameInvalidError": "schema tag name is invalid",
+		},
+	}, variables)
+}
+
+// GetSchemaTag retrieves a schema tag of a graph by name
+func (c *Client) GetSchemaTag(ctx context.Context, accountSlug, graphSlug, name string) (*SchemaTag, error) {
+	query := `
+		query GetSchemaTag($accountSlug: String!, $graphSlug: String!, $name: String!) {
+			graphByAccountSlug(accountSlug: $accountSlug, graphSlug: $graphSlug) {
+				schemaTag(name: $name) {
+					id
+					name
+					description
+					contracts
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"graphSlug":   graphSlug,
+		"name":        name,
+	}
+
+	tag, err := execute[*SchemaTag](ctx, c, operation{
+		action: "get schema tag",
+		query:  query,
+		path:   []string{"graphByAccountSlug", "schemaTag"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if tag == nil {
+		return nil, fmt.Errorf("schema tag not found")
+	}
+
+	return tag, nil
+}
+
+// DeleteSchemaTag deletes a schema tag of a graph
+func (c *Client) DeleteSchemaTag(ctx context.Context, input DeleteSchemaTagInput) error {
+	query := `
+		mutation DeleteSchemaTag($input: SchemaTagDeleteInput!) {
+			schemaTagDelete(input: $input) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "delete schema tag",
+		query:   query,
+		path:    []string{"schemaTagDelete"},
+		success: "SchemaTagDeleteSuccess",
+		errors: map[string]string{
+			"SchemaTagDoesNotExistError": "schema tag does not exist",
+			"SchemaTagInUseError":        "schema tag is still used by a contract",
+		},
+	}, variables)
+
+	return err
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSetSchemaTag(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := SetSchemaTagInput{AccountSlug: "my-account", GraphSlug: "my-graph", Name: "public"}
+
+	server.Handle("SetSchemaTag", `{"schemaTagSet": {"__typename": "SchemaTagSetSuccess", "schemaTag": {"id": "tag-1", "name": "public", "description": null, "contracts": []}}}`)
+	tag, err := c.SetSchemaTag(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tag.Name != "public" || tag.Description != nil {
+		t.Errorf("unexpected tag: %+v", tag)
+	}
+	if sent := server.LastRequest("SetSchemaTag").Variables["input"].(map[string]interface{}); sent["contracts"] == nil {
+		t.Error("expected contracts to be an empty list")
+	}
+
+	server.Handle("SetSchemaTag", `{"schemaTagSet": {"__typename": "ContractDoesNotExistError", "name": "partners"}}`)
+	if _, err := c.SetSchemaTag(ctx, input); err == nil || errorMessage(err) != "contract partners does not exist" {
+		t.Errorf("expected contract does not exist, got %v", err)
+	}
+}
+
+func TestGetSchemaTag(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetSchemaTag", `{"graphByAccountSlug": {"schemaTag": {"id": "tag-1", "name": "public", "description": "Public API", "contracts": ["partners"]}}}`)
+	tag, err := c.GetSchemaTag(ctx, "my-account", "my-graph", "public")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tag.Description == nil || *tag.Description != "Public API" || len(tag.Contracts) != 1 {
+		t.Errorf("unexpected tag: %+v", tag)
+	}
+
+	server.Handle("GetSchemaTag", `{"graphByAccountSlug": {"schemaTag": null}}`)
+	if _, err := c.GetSchemaTag(ctx, "my-account", "my-graph", "missing"); err == nil || errorMessage(err) != "schema tag not found" {
+		t.Errorf("expected schema tag not found, got %v", err)
+	}
+}
+
+func TestDeleteSchemaTag(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := DeleteSchemaTagInput{AccountSlug: "my-account", GraphSlug: "my-graph", Name: "public"}
+
+	server.Handle("DeleteSchemaTag", `{"schemaTagDelete": {"__typename": "SchemaTagDeleteSuccess"}}`)
+	if err := c.DeleteSchemaTag(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("DeleteSchemaTag", `{"schemaTagDelete": {"__typename": "SchemaTagInUseError"}}`)
+	if err := c.DeleteSchemaTag(ctx, input); err == nil || errorMessage(err) != "schema tag is still used by a contract" {
+		t.Errorf("expected schema tag in use error, got %v", err)
+	}
+}
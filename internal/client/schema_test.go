@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetFederatedSchema(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetFederatedSchema", `{"branch": {"federatedSchema": "type Query { me: User }"}}`)
+	sdl, err := c.GetFederatedSchema(ctx, "my-account", "my-graph", "main")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sdl != "type Query { me: User }" {
+		t.Errorf("unexpected schema: %q", sdl)
+	}
+
+	server.Handle("GetFederatedSchema", `{"branch": {"federatedSchema": null}}`)
+	if _, err := c.GetFederatedSchema(ctx, "my-account", "my-graph", "main"); err == nil || errorMessage(err) != "branch has no composed schema" {
+		t.Errorf("expected no composed schema error, got %v", err)
+	}
+
+	server.Handle("GetFederatedSchema", `{"branch": null}`)
+	if _, err := c.GetFederatedSchema(ctx, "my-account", "my-graph", "missing"); err == nil || errorMessage(err) != "branch not found" {
+		t.Errorf("expected branch not found, got %v", err)
+	}
+}
+
+func TestGetSubgraphSchema(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetSubgraphSchema", `{"branch": {"subgraph": {
+		"name": "products", "url": "https://products.example.com/graphql",
+		"schema": "type Product { id: ID! }", "publishedAt": "2024-01-15T10:30:00Z"
+	}}}`)
+	subgraph, err := c.GetSubgraphSchema(ctx, "my-account", "my-graph", "main", "products")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if subgraph.Schema != "type Product { id: ID! }" || subgraph.PublishedAt.IsZero() {
+		t.Errorf("unexpected subgraph: %+v", subgraph)
+	}
+	if got := server.LastRequest("GetSubgraphSchema").Variables["subgraphName"]; got != "products" {
+		t.Errorf("expected subgraphName products, got %v", got)
+	}
+
+	server.Handle("GetSubgraphSchema", `{"branch": {"subgraph": null}}`)
+	if _, err := c.GetSubgraphSchema(ctx, "my-account", "my-graph", "main", "missing"); err == nil || errorMessage(err) != "subgraph not found" {
+		t.Errorf("expected subgraph not found, got %v", err)
+	}
+
+	server.Handle("GetSubgraphSchema", `{"branch": null}`)
+	if _, err := c.GetSubgraphSchema(ctx, "my-account", "my-graph", "missing", "products"); err == nil || errorMessage(err) != "branch not found" {
+		t.Errorf("expected branch not found, got %v", err)
+	}
+}
+
+func TestDiffSubgraphSchema(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("DiffSubgraphSchema", `{"branch": {"subgraphSchemaDiff": [
+		{"kind": "FIELD_REMOVED", "path": "Query.me", "message": "Field Query.me was removed", "breaking": true}
+	]}}`)
+	changes, err := c.DiffSubgraphSchema(ctx, "my-account", "my-graph", "main", "users", "type Query { users: [User] }")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 1 || !changes[0].Breaking {
+		t.Errorf("unexpected changes: %+v", changes)
+	}
+	if got := server.LastRequest("DiffSubgraphSchema").Variables["subgraphName"]; got != "users" {
+		t.Errorf("expected subgraphName variable, got %v", got)
+	}
+
+	server.Handle("DiffSubgraphSchema", `{"branch": null}`)
+	if _, err := c.DiffSubgraphSchema(ctx, "my-account", "my-graph", "missing", "users", ""); err == nil || errorMessage(err) != "branch not found" {
+		t.Errorf("expected branch not found, got %v", err)
+	}
+}
+
+func TestDeleteSubgraph(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := DeleteSubgraphInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main", SubgraphName: "products"}
+
+	server.Handle("DeleteSubgraph", `{"subgraphDelete": {"__typename": "SubgraphDeleteSuccess"}}`)
+	if err := c.DeleteSubgraph(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("DeleteSubgraph", `{"subgraphDelete": {"__typename": "SubgraphDoesNotExistError"}}`)
+	if err := c.DeleteSubgraph(ctx, input); err == nil || errorMessage(err) != "subgraph does not exist" {
+		t.Errorf("expected subgraph does not exist, got %v", err)
+	}
+}
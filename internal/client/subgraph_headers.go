@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// SubgraphHeader represents a static header the gateway sends to a subgraph
+type SubgraphHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// SubgraphHeaders represents the headers the gateway sends to a subgraph on a branch
+type SubgraphHeaders struct {
+	ID             string           `json:"id"`
+	SubgraphName   string           `json:"subgraphName"`
+	StaticHeaders  []SubgraphHeader `json:"staticHeaders"`
+	ForwardHeaders []string         `json:"forwardHeaders"`
+	UpdatedAt      time.Time        `json:"updatedAt"`
+}
+
+// SetSubgraphHeadersInput represents the input for replacing the headers of a subgraph
+type SetSubgraphHeadersInput struct {
+	AccountSlug    string           `json:"accountSlug"`
+	GraphSlug      string           `json:"graphSlug"`
+	BranchName     string           `json:"branchName"`
+	SubgraphName   string           `json:"subgraphName"`
+	StaticHeaders  []SubgraphHeader `json:"staticHeaders"`
+	ForwardHeaders []string         `json:"forwardHeaders"`
+}
+
+// DeleteSubgraphHeadersInput represents the input for removing the headers of a subgraph
+type DeleteSubgraphHeadersInput struct {
+	AccountSlug  string `json:"accountSlug"`
+	GraphSlug    string `json:"graphSlug"`
+	BranchName   string `json:"branchName"`
+	SubgraphName string `json:"subgraphName"`
+}
+
+// subgraphHeadersFields is the selection set of subgraph headers
+const subgraphHeadersFields = `
+	id
+	subgraphName
+	staticHeaders {
+		name
+		value
+	}
+	forwardHeaders
+	updatedAt
+`
+
+// SetSubgraphHeaders creates or replaces the headers the gateway sends to a subgraph on a branch
+func (c *Client) SetSubgraphHeaders(ctx context.Context, input SetSubgraphHeadersInput) (*SubgraphHeaders, error) {
+	query := `
+		mutation SetSubgraphHeaders($input: SubgraphHeadersSetInput!) {
+			subgraphHeadersSet(input: $input) {
+				__typename
+				... on SubgraphHeadersSetSuccess {
+					subgraphHeaders {` + subgraphHeadersFields + `}
+				}
+				... on InvalidHeaderNameError {
+					name
+				}
+			}
+		}
+	`
+
+	if input.StaticHeaders == nil {
+		input.StaticHeaders = []SubgraphHeader{}
+	}
+	if input.ForwardHeaders == nil {
+		input.ForwardHeaders = []string{}
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*SubgraphHeaders](ctx, c, operation{
+		action:  "set subgraph headers",
+		query:   query,
+		path:    []string{"subgraphHeadersSet"},
+		success: "SubgraphHeadersSetSuccess",
+		field:   "subgraphHeaders",
+		errors: map[string]string{
+			"SubgraphDoesNotExistError": "subgraph does not exist",
+			"InvalidHeaderNameError":    `header name "${name}" is invalid`,
+		},
+	}, variables)
+}
+
+// GetSubgraphHeaders retrieves the headers the gateway sends to a subgraph on a branch
+func (c *Client) GetSubgraphHeaders(ctx context.Context, accountSlug, graphSlug, branchName, subgraphName string) (*SubgraphHeaders, error) {
+	query := `
+		query GetSubgraphHeaders($accountSlug: String!, $graphSlug: String!, $branchName: String!, $subgraphName: String!) {
+			branch(accountSlug: $accountSlug, graphSlug: $graphSlug, name: $branchName) {
+				subgraphHeaders(subgraphName: $subgraphName) {` + subgraphHeadersFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug":  accountSlug,
+		"graphSlug":    graphSlug,
+		"branchName":   branchName,
+		"subgraphName": subgraphName,
+	}
+
+	headers, err := execute[*SubgraphHeaders](ctx, c, operation{
+		action: "get subgraph headers",
+		query:  query,
+		path:   []string{"branch", "subgraphHeaders"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if headers == nil {
+		return nil, fmt.Errorf("subgraph headers not found")
+	}
+
+	return headers, nil
+}
+
+// DeleteSubgraphHeaders removes the headers the gateway sends to a subgraph on a branch
+func (c *Client) DeleteSubgraphHeaders(ctx context.Context, input DeleteSubgraphHeadersInput) error {
+	query := `
+		mutation DeleteSubgraphHeaders($input: SubgraphHeadersDeleteInput!) {
+			subgraphHeadersDelete(input: $input) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "delete subgraph headers",
+		query:   query,
+		path:    []string{"subgraphHeadersDelete"},
+		success: "SubgraphHeadersDeleteSuccess",
+		errors: map[string]string{
+			"SubgraphHeadersDoesNotExistError": "subgraph headers configuration does not exist",
+		},
+	}, variables)
+
+	return err
+}
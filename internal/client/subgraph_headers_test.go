@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+const testSubgraphHeadersJSON = `{"id": "headers-1", "subgraphName": "products", "staticHeaders": [{"name": "Authorization", "value": "Bearer secret"}], "forwardHeaders": ["x-tenant-id"], "updatedAt": "2024-01-15T10:30:00Z"}`
+
+func TestSetSubgraphHeaders(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := SetSubgraphHeadersInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main", SubgraphName: "products"}
+
+	server.Handle("SetSubgraphHeaders", `{"subgraphHeadersSet": {"__typename": "SubgraphHeadersSetSuccess", "subgraphHeaders": `+testSubgraphHeadersJSON+`}}`)
+	headers, err := c.SetSubgraphHeaders(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(headers.StaticHeaders) != 1 || headers.StaticHeaders[0].Value != "Bearer secret" || len(headers.ForwardHeaders) != 1 {
+		t.Errorf("unexpected headers: %+v", headers)
+	}
+
+	var sent SetSubgraphHeadersInput
+	if err := server.LastRequest("SetSubgraphHeaders").Input(&sent); err != nil {
+		t.Fatalf("unable to decode input: %s", err)
+	}
+	if sent.StaticHeaders == nil || sent.ForwardHeaders == nil {
+		t.Errorf("expected empty header lists to be sent, got %+v", sent)
+	}
+
+	server.Handle("SetSubgraphHeaders", `{"subgraphHeadersSet": {"__typename": "InvalidHeaderNameError", "name": "bad header"}}`)
+	if _, err := c.SetSubgraphHeaders(ctx, input); err == nil || errorMessage(err) != `header name "bad header" is invalid` {
+		t.Errorf("expected invalid header name error, got %v", err)
+	}
+}
+
+func TestGetSubgraphHeaders(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetSubgraphHeaders", `{"branch": {"subgraphHeaders": `+testSubgraphHeadersJSON+`}}`)
+	if _, err := c.GetSubgraphHeaders(ctx, "my-account", "my-graph", "main", "products"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("GetSubgraphHeaders", `{"branch": {"subgraphHeaders": null}}`)
+	if _, err := c.GetSubgraphHeaders(ctx, "my-account", "my-graph", "main", "reviews"); err == nil || errorMessage(err) != "subgraph headers not found" {
+		t.Errorf("expected subgraph headers not found, got %v", err)
+	}
+}
+
+func TestDeleteSubgraphHeaders(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := DeleteSubgraphHeadersInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main", SubgraphName: "products"}
+
+	server.Handle("DeleteSubgraphHeaders", `{"subgraphHeadersDelete": {"__typename": "SubgraphHeadersDeleteSuccess"}}`)
+	if err := c.DeleteSubgraphHeaders(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("DeleteSubgraphHeaders", `{"subgraphHeadersDelete": {"__typename": "SubgraphHeadersDoesNotExistError"}}`)
+	if err := c.DeleteSubgraphHeaders(ctx, input); err == nil || errorMessage(err) != "subgraph headers configuration does not exist" {
+		t.Errorf("expected subgraph headers configuration does not exist, got %v", err)
+	}
+}
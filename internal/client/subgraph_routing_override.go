@@ -0,0 +1,209 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// SubgraphRoutingOverride represents a branch-specific routing URL for a subgraph
+type SubgraphRoutingOverride struct {
+	ID           string    `json:"id"`
+	SubgraphName string    `json:"subgraphName"`
+	URL          string    `json:"url"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// SetSubgraphRoutingOverrideInput represents the input for setting a routing override
+type SetSubgraphRoutingOverrideInput struct {
+	AccountSlug  string `json:"accountSlug"`
+	GraphSlug    string `json:"graphSlug"`
+	BranchName   string `json:"branchName"`
+	SubgraphName string `json:"subgraphName"`
+	URL          string `json:"url"`
+}
+
+// DeleteSubgraphRoutingOverrideInput represents the input for deleting a routing override
+type DeleteSubgraphRoutingOverrideInput struct {
+	AccountSlug  string `json:"accountSlug"`
+	GraphSlug    string `json:"graphSlug"`
+	BranchName   string `json:"branchName"`
+	SubgraphName string `json:"subgraphName"`
+}
+
+// SetSubgraphRoutingOverride creates or replaces the routing URL override for a subgraph on a branch
+func (c *Client) SetSubgraphRoutingOverride(ctx context.Context, input SetSubgraphRoutingOverrideInput) (*SubgraphRoutingOverride, error) {
+	query := `
+		mutation SetSubgraphRoutingOverride($input: SubgraphRoutingOverrideSetInput!) {
+			subgraphRoutingOverrideSet(input: $input) {
+				__typename
+				... on SubgraphRoutingOverrideSetSuccess {
+					routingOverride {
+						id
+						subgraphName
+						url
+						updatedAt
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	// The cached overrides of the branch are outdated once the mutation
+	// completes, successfully or not. Invalidating only then also drops what
+	// lookups running concurrently with the mutation loaded.
+	defer c.routingOverrides.invalidate(branchKey{accountSlug: input.AccountSlug, graphSlug: input.GraphSlug, branchName: input.BranchName})
+
+	return execute[*SubgraphRoutingOverride](ctx, c, operation{
+		action:  "set subgraph routing override",
+		query:   query,
+		path:    []string{"subgraphRoutingOverrideSet"},
+		success: "SubgraphRoutingOverrideSetSuccess",
+		field:   "routingOverride",
+		errors: map[string]string{
+			"SubgraphDoesNotExistError": "subgraph does not exist",
+			"InvalidUrlError":           "routing URL is invalid",
+		},
+	}, variables)
+}
+
+// GetSubgraphRoutingOverride retrieves the routing URL override for a subgraph on a branch
+func (c *Client) GetSubgraphRoutingOverride(ctx context.Context, accountSlug, graphSlug, branchName, subgraphName string) (*SubgraphRoutingOverride, error) {
+	query := `
+		query GetSubgraphRoutingOverride($accountSlug: String!, $graphSlug: String!, $branchName: String!, $subgraphName: String!) {
+			branch(accountSlug: $accountSlug, graphSlug: $graphSlug, name: $branchName) {
+				subgraphRoutingOverride(subgraphName: $subgraphName) {
+					id
+					subgraphName
+					url
+					updatedAt
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug":  accountSlug,
+		"graphSlug":    graphSlug,
+		"branchName":   branchName,
+		"subgraphName": subgraphName,
+	}
+
+	override, err := execute[*SubgraphRoutingOverride](ctx, c, operation{
+		action: "get subgraph routing override",
+		query:  query,
+		path:   []string{"branch", "subgraphRoutingOverride"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if override == nil {
+		return nil, fmt.Errorf("routing override not found")
+	}
+
+	return override, nil
+}
+
+// DeleteSubgraphRoutingOverride removes the routing URL override for a subgraph on a branch,
+// restoring the subgraph's registered URL
+func (c *Client) DeleteSubgraphRoutingOverride(ctx context.Context, input DeleteSubgraphRoutingOverrideInput) error {
+	query := `
+		mutation DeleteSubgraphRoutingOverride($input: SubgraphRoutingOverrideDeleteInput!) {
+			subgraphRoutingOverrideDelete(input: $input) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	// The cached overrides of the branch are outdated once the mutation
+	// completes, successfully or not. Invalidating only then also drops what
+	// lookups running concurrently with the mutation loaded.
+	defer c.routingOverrides.invalidate(branchKey{accountSlug: input.AccountSlug, graphSlug: input.GraphSlug, branchName: input.BranchName})
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "delete subgraph routing override",
+		query:   query,
+		path:    []string{"subgraphRoutingOverrideDelete"},
+		success: "SubgraphRoutingOverrideDeleteSuccess",
+		errors: map[string]string{
+			"SubgraphRoutingOverrideDoesNotExistError": "routing override does not exist",
+		},
+	}, variables)
+
+	return err
+}
+
+// ListSubgraphRoutingOverrides retrieves all routing URL overrides of a branch in a single request
+func (c *Client) ListSubgraphRoutingOverrides(ctx context.Context, accountSlug, graphSlug, branchName string) ([]SubgraphRoutingOverride, error) {
+	query := `
+		query ListSubgraphRoutingOverrides($accountSlug: String!, $graphSlug: String!, $branchName: String!) {
+			branch(accountSlug: $accountSlug, graphSlug: $graphSlug, name: $branchName) {
+				subgraphRoutingOverrides {
+					id
+					subgraphName
+					url
+					updatedAt
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"graphSlug":   graphSlug,
+		"branchName":  branchName,
+	}
+
+	branch, err := execute[*struct {
+		SubgraphRoutingOverrides []SubgraphRoutingOverride `json:"subgraphRoutingOverrides"`
+	}](ctx, c, operation{
+		action: "list subgraph routing overrides",
+		query:  query,
+		path:   []string{"branch"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if branch == nil {
+		return nil, fmt.Errorf("branch not found")
+	}
+
+	return branch.SubgraphRoutingOverrides, nil
+}
+
+// LookupSubgraphRoutingOverride retrieves the routing URL override for a subgraph
+// on a branch from the branch cache. The first lookup for a branch loads all of
+// its overrides with a single request, so refreshing many overrides of the same
+// branch does not issue one request per subgraph.
+func (c *Client) LookupSubgraphRoutingOverride(ctx context.Context, accountSlug, graphSlug, branchName, subgraphName string) (*SubgraphRoutingOverride, error) {
+	key := branchKey{accountSlug: accountSlug, graphSlug: graphSlug, branchName: branchName}
+
+	overrides, err := c.routingOverrides.load(key, func() ([]SubgraphRoutingOverride, error) {
+		return c.ListSubgraphRoutingOverrides(ctx, accountSlug, graphSlug, branchName)
+	})
+	if err != nil {
+		// A missing branch means the override is gone as well
+		if err.Error() == "branch not found" {
+			return nil, fmt.Errorf("routing override not found")
+		}
+		return nil, err
+	}
+
+	for _, override := range overrides {
+		if override.SubgraphName == subgraphName {
+			return &override, nil
+		}
+	}
+
+	return nil, fmt.Errorf("routing override not found")
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+const testRoutingOverrideJSON = `{"id": "override-1", "subgraphName": "products", "url": "https://products.example.com", "updatedAt": "2024-01-15T10:30:00Z"}`
+
+func TestSetSubgraphRoutingOverride(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := SetSubgraphRoutingOverrideInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main", SubgraphName: "products", URL: "https://products.example.com"}
+
+	server.Handle("SetSubgraphRoutingOverride", `{"subgraphRoutingOverrideSet": {"__typename": "SubgraphRoutingOverrideSetSuccess", "routingOverride": `+testRoutingOverrideJSON+`}}`)
+	override, err := c.SetSubgraphRoutingOverride(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if override.URL != "https://products.example.com" {
+		t.Errorf("unexpected override: %+v", override)
+	}
+
+	server.Handle("SetSubgraphRoutingOverride", `{"subgraphRoutingOverrideSet": {"__typename": "InvalidUrlError"}}`)
+	if _, err := c.SetSubgraphRoutingOverride(ctx, input); err == nil || errorMessage(err) != "routing URL is invalid" {
+		t.Errorf("expected invalid URL error, got %v", err)
+	}
+}
+
+func TestGetSubgraphRoutingOverride(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetSubgraphRoutingOverride", `{"branch": {"subgraphRoutingOverride": `+testRoutingOverrideJSON+`}}`)
+	if _, err := c.GetSubgraphRoutingOverride(ctx, "my-account", "my-graph", "main", "products"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("GetSubgraphRoutingOverride", `{"branch": {"subgraphRoutingOverride": null}}`)
+	if _, err := c.GetSubgraphRoutingOverride(ctx, "my-account", "my-graph", "main", "reviews"); err == nil || errorMessage(err) != "routing override not found" {
+		t.Errorf("expected routing override not found, got %v", err)
+	}
+}
+
+func TestDeleteSubgraphRoutingOverride(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := DeleteSubgraphRoutingOverrideInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main", SubgraphName: "products"}
+
+	server.Handle("DeleteSubgraphRoutingOverride", `{"subgraphRoutingOverrideDelete": {"__typename": "SubgraphRoutingOverrideDeleteSuccess"}}`)
+	if err := c.DeleteSubgraphRoutingOverride(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("DeleteSubgraphRoutingOverride", `{"subgraphRoutingOverrideDelete": {"__typename": "SubgraphRoutingOverrideDoesNotExistError"}}`)
+	if err := c.DeleteSubgraphRoutingOverride(ctx, input); err == nil || errorMessage(err) != "routing override does not exist" {
+		t.Errorf("expected routing override does not exist, got %v", err)
+	}
+}
+
+func TestListSubgraphRoutingOverrides(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("ListSubgraphRoutingOverrides", `{"branch": {"subgraphRoutingOverrides": [`+testRoutingOverrideJSON+`]}}`)
+	overrides, err := c.ListSubgraphRoutingOverrides(ctx, "my-account", "my-graph", "main")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(overrides) != 1 {
+		t.Errorf("unexpected overrides: %+v", overrides)
+	}
+
+	server.Handle("ListSubgraphRoutingOverrides", `{"branch": null}`)
+	if _, err := c.ListSubgraphRoutingOverrides(ctx, "my-account", "my-graph", "missing"); err == nil || errorMessage(err) != "branch not found" {
+		t.Errorf("expected branch not found, got %v", err)
+	}
+}
@@ -0,0 +1,331 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// TeamMemberRole represents the role of a member within a team
+type TeamMemberRole string
+
+const (
+	TeamMemberRoleMaintainer TeamMemberRole = "MAINTAINER"
+	TeamMemberRoleMember     TeamMemberRole = "MEMBER"
+)
+
+// Team represents a group of account members that is granted access to
+// graphs as a whole.
+type Team struct {
+	ID          string  `json:"id"`
+	Slug        string  `json:"slug"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+}
+
+// TeamMember represents an account member that belongs to a team
+type TeamMember struct {
+	ID    string         `json:"id"`
+	Email string         `json:"email"`
+	Role  TeamMemberRole `json:"role"`
+}
+
+// CreateTeamInput represents the input for creating a team
+type CreateTeamInput struct {
+	AccountSlug string  `json:"accountSlug"`
+	Slug        string  `json:"slug"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+}
+
+// UpdateTeamInput represents the input for replacing the settings of a team
+type UpdateTeamInput struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+}
+
+// AddTeamMemberInput represents the input for adding an account member to a team
+type AddTeamMemberInput struct {
+	AccountSlug string         `json:"accountSlug"`
+	TeamSlug    string         `json:"teamSlug"`
+	Email       string         `json:"email"`
+	Role        TeamMemberRole `json:"role"`
+}
+
+// UpdateTeamMemberRoleInput represents the input for changing the role of a team member
+type UpdateTeamMemberRoleInput struct {
+	ID   string         `json:"id"`
+	Role TeamMemberRole `json:"role"`
+}
+
+// teamFields is the selection set shared by team queries
+const teamFields = `
+	id
+	slug
+	name
+	description
+`
+
+// teamMemberFields is the selection set shared by team member queries
+const teamMemberFields = `
+	id
+	email
+	role
+`
+
+// CreateTeam creates a team in an account
+func (c *Client) CreateTeam(ctx context.Context, input CreateTeamInput) (*Team, error) {
+	query := `
+		mutation CreateTeam($input: TeamCreateInput!) {
+			teamCreate(input: $input) {
+				__typename
+				... on TeamCreateSuccess {
+					team {` + teamFields + `}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*Team](ctx, c, operation{
+		action:  "create team",
+		query:   query,
+		path:    []string{"teamCreate"},
+		success: "TeamCreateSuccess",
+		field:   "team",
+		errors:  teamErrors,
+	}, variables)
+}
+
+// UpdateTeam replaces the name and description of a team
+func (c *Client) UpdateTeam(ctx context.Context, input UpdateTeamInput) (*Team, error) {
+	query := `
+		mutation UpdateTeam($input: TeamUpdateInput!) {
+			teamUpdate(input: $input) {
+				__typename
+				... on TeamUpdateSuccess {
+					team {` + teamFields + `}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*Team](ctx, c, operation{
+		action:  "update team",
+		query:   query,
+		path:    []string{"teamUpdate"},
+		success: "TeamUpdateSuccess",
+		field:   "team",
+		errors:  teamErrors,
+	}, variables)
+}
+
+// teamErrors are the errors of the team create and update mutations
+var teamErrors = map[string]string{
+	"TeamAlreadyExistsError": "team already exists",
+	"TeamDoesNotExistError":  "team does not exist",
+}
+
+// GetTeam retrieves a team of an account by slug
+func (c *Client) GetTeam(ctx context.Context, accountSlug, teamSlug string) (*Team, error) {
+	query := `
+		query GetTeam($accountSlug: String!, $teamSlug: String!) {
+			teamBySlug(accountSlug: $accountSlug, teamSlug: $teamSlug) {` + teamFields + `}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"teamSlug":    teamSlug,
+	}
+
+	team, err := execute[*Team](ctx, c, operation{
+		action: "get team",
+		query:  query,
+		path:   []string{"teamBySlug"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if team == nil {
+		return nil, fmt.Errorf("team not found")
+	}
+
+	return team, nil
+}
+
+// DeleteTeam deletes a team. Graph access granted to the team is revoked with it.
+func (c *Client) DeleteTeam(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteTeam($id: ID!) {
+			teamDelete(id: $id) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "delete team",
+		query:   query,
+		path:    []string{"teamDelete"},
+		success: "TeamDeleteSuccess",
+		errors: map[string]string{
+			"TeamDoesNotExistError": "team does not exist",
+		},
+	}, variables)
+
+	return err
+}
+
+// AddTeamMember adds an account member to a team with the given role
+func (c *Client) AddTeamMember(ctx context.Context, input AddTeamMemberInput) (*TeamMember, error) {
+	query := `
+		mutation AddTeamMember($input: TeamMemberAddInput!) {
+			teamMemberAdd(input: $input) {
+				__typename
+				... on TeamMemberAddSuccess {
+					member {` + teamMemberFields + `}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*TeamMember](ctx, c, operation{
+		action:  "add team member",
+		query:   query,
+		path:    []string{"teamMemberAdd"},
+		success: "TeamMemberAddSuccess",
+		field:   "member",
+		errors:  teamMemberErrors,
+	}, variables)
+}
+
+// UpdateTeamMemberRole changes the role of a team member
+func (c *Client) UpdateTeamMemberRole(ctx context.Context, input UpdateTeamMemberRoleInput) (*TeamMember, error) {
+	query := `
+		mutation UpdateTeamMemberRole($input: TeamMemberRoleUpdateInput!) {
+			teamMemberRoleUpdate(input: $input) {
+				__typename
+				... on TeamMemberRoleUpdateSuccess {
+					member {` + teamMemberFields + `}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*TeamMember](ctx, c, operation{
+		action:  "update team member role",
+		query:   query,
+		path:    []string{"teamMemberRoleUpdate"},
+		success: "TeamMemberRoleUpdateSuccess",
+		field:   "member",
+		errors:  teamMemberErrors,
+	}, variables)
+}
+
+// teamMemberErrors are the errors of the team member add and role update
+// mutations
+var teamMemberErrors = map[string]string{
+	"TeamDoesNotExistError":          "team does not exist",
+	"AccountMemberDoesNotExistError": "team members must be members of the account",
+	"TeamMemberAlreadyExistsError":   "team member already exists",
+	"TeamMemberDoesNotExistError":    "team member does not exist",
+}
+
+// ListTeamMembers retrieves all members of a team
+func (c *Client) ListTeamMembers(ctx context.Context, accountSlug, teamSlug string) ([]TeamMember, error) {
+	query := `
+		query ListTeamMembers($accountSlug: String!, $teamSlug: String!) {
+			teamBySlug(accountSlug: $accountSlug, teamSlug: $teamSlug) {
+				members {` + teamMemberFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"teamSlug":    teamSlug,
+	}
+
+	team, err := execute[*struct {
+		Members []TeamMember `json:"members"`
+	}](ctx, c, operation{
+		action: "list team members",
+		query:  query,
+		path:   []string{"teamBySlug"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if team == nil {
+		return nil, fmt.Errorf("team not found")
+	}
+
+	return team.Members, nil
+}
+
+// GetTeamMember retrieves a team member by email address
+func (c *Client) GetTeamMember(ctx context.Context, accountSlug, teamSlug, email string) (*TeamMember, error) {
+	members, err := c.ListTeamMembers(ctx, accountSlug, teamSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	// Email addresses are matched case-insensitively, like account members
+	for _, member := range members {
+		if strings.EqualFold(member.Email, email) {
+			return &member, nil
+		}
+	}
+
+	return nil, fmt.Errorf("team member not found")
+}
+
+// RemoveTeamMember removes a member from a team. The member stays in the account.
+func (c *Client) RemoveTeamMember(ctx context.Context, id string) error {
+	query := `
+		mutation RemoveTeamMember($id: ID!) {
+			teamMemberRemove(id: $id) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "remove team member",
+		query:   query,
+		path:    []string{"teamMemberRemove"},
+		success: "TeamMemberRemoveSuccess",
+		errors: map[string]string{
+			"TeamMemberDoesNotExistError": "team member does not exist",
+		},
+	}, variables)
+
+	return err
+}
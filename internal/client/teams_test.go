@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+const testTeamJSON = `{
+	"id": "team-1",
+	"slug": "platform",
+	"name": "Platform",
+	"description": null
+}`
+
+func TestCreateTeam(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := CreateTeamInput{AccountSlug: "my-account", Slug: "platform", Name: "Platform"}
+
+	server.Handle("CreateTeam", `{"teamCreate": {"__typename": "TeamCreateSuccess", "team": `+testTeamJSON+`}}`)
+	team, err := c.CreateTeam(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if team.ID != "team-1" || team.Description != nil {
+		t.Errorf("unexpected team: %+v", team)
+	}
+
+	server.Handle("CreateTeam", `{"teamCreate": {"__typename": "TeamAlreadyExistsError"}}`)
+	if _, err := c.CreateTeam(ctx, input); err == nil || errorMessage(err) != "team already exists" {
+		t.Errorf("expected team already exists, got %v", err)
+	}
+}
+
+func TestGetTeam(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetTeam", `{"teamBySlug": `+testTeamJSON+`}`)
+	if _, err := c.GetTeam(ctx, "my-account", "platform"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("GetTeam", `{"teamBySlug": null}`)
+	if _, err := c.GetTeam(ctx, "my-account", "missing"); err == nil || errorMessage(err) != "team not found" {
+		t.Errorf("expected team not found, got %v", err)
+	}
+}
+
+func TestDeleteTeam(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("DeleteTeam", `{"teamDelete": {"__typename": "TeamDeleteSuccess"}}`)
+	if err := c.DeleteTeam(ctx, "team-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("DeleteTeam", `{"teamDelete": {"__typename": "TeamDoesNotExistError"}}`)
+	if err := c.DeleteTeam(ctx, "team-1"); err == nil || errorMessage(err) != "team does not exist" {
+		t.Errorf("expected team does not exist, got %v", err)
+	}
+}
+
+func TestAddTeamMember(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := AddTeamMemberInput{AccountSlug: "my-account", TeamSlug: "platform", Email: "dev@example.com", Role: TeamMemberRoleMember}
+
+	server.Handle("AddTeamMember", `{"teamMemberAdd": {"__typename": "TeamMemberAddSuccess",
+		"member": {"id": "team-member-1", "email": "dev@example.com", "role": "MEMBER"}}}`)
+	if _, err := c.AddTeamMember(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("AddTeamMember", `{"teamMemberAdd": {"__typename": "AccountMemberDoesNotExistError"}}`)
+	if _, err := c.AddTeamMember(ctx, input); err == nil || errorMessage(err) != "team members must be members of the account" {
+		t.Errorf("expected account membership error, got %v", err)
+	}
+}
+
+func TestGetTeamMember(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("ListTeamMembers", `{"teamBySlug": {"members": [{"id": "team-member-1", "email": "Dev@Example.com", "role": "MAINTAINER"}]}}`)
+	member, err := c.GetTeamMember(ctx, "my-account", "platform", "dev@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if member.Role != TeamMemberRoleMaintainer {
+		t.Errorf("unexpected team member: %+v", member)
+	}
+
+	if _, err := c.GetTeamMember(ctx, "my-account", "platform", "other@example.com"); err == nil || errorMessage(err) != "team member not found" {
+		t.Errorf("expected team member not found, got %v", err)
+	}
+
+	server.Handle("ListTeamMembers", `{"teamBySlug": null}`)
+	if _, err := c.GetTeamMember(ctx, "my-account", "missing", "dev@example.com"); err == nil || errorMessage(err) != "team not found" {
+		t.Errorf("expected team not found, got %v", err)
+	}
+}
+
+func TestRemoveTeamMember(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("RemoveTeamMember", `{"teamMemberRemove": {"__typename": "TeamMemberRemoveSuccess"}}`)
+	if err := c.RemoveTeamMember(ctx, "team-member-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("RemoveTeamMember", `{"teamMemberRemove": {"__typename": "TeamMemberDoesNotExistError"}}`)
+	if err := c.RemoveTeamMember(ctx, "team-member-1"); err == nil || errorMessage(err) != "team member does not exist" {
+		t.Errorf("expected team member does not exist, got %v", err)
+	}
+}
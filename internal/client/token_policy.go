@@ -0,0 +1,101 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// TokenPolicy represents the restrictions on access tokens created in an account
+type TokenPolicy struct {
+	MaxLifetimeDays       *int64   `json:"maxLifetimeDays"`
+	AllowedScopes         []string `json:"allowedScopes"`
+	PersonalTokensAllowed bool     `json:"personalTokensAllowed"`
+}
+
+// SetTokenPolicyInput represents the input for replacing the token policy of an
+// account. A nil MaxLifetimeDays removes the lifetime limit and empty
+// AllowedScopes allow every scope.
+type SetTokenPolicyInput struct {
+	AccountSlug           string   `json:"accountSlug"`
+	MaxLifetimeDays       *int64   `json:"maxLifetimeDays"`
+	AllowedScopes         []string `json:"allowedScopes"`
+	PersonalTokensAllowed bool     `json:"personalTokensAllowed"`
+}
+
+// GetTokenPolicy retrieves the token policy of an account
+func (c *Client) GetTokenPolicy(ctx context.Context, accountSlug string) (*TokenPolicy, error) {
+	query := `
+		query GetTokenPolicy($accountSlug: String!) {
+			accountBySlug(slug: $accountSlug) {
+				tokenPolicy {
+					maxLifetimeDays
+					allowedScopes
+					personalTokensAllowed
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+	}
+
+	account, err := execute[*struct {
+		TokenPolicy TokenPolicy `json:"tokenPolicy"`
+	}](ctx, c, operation{
+		action: "get token policy",
+		query:  query,
+		path:   []string{"accountBySlug"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil {
+		return nil, fmt.Errorf("account not found")
+	}
+
+	return &account.TokenPolicy, nil
+}
+
+// SetTokenPolicy replaces the token policy of an account. Existing tokens that
+// violate the new policy are not revoked, but cannot be renewed.
+func (c *Client) SetTokenPolicy(ctx context.Context, input SetTokenPolicyInput) (*TokenPolicy, error) {
+	query := `
+		mutation SetTokenPolicy($input: TokenPolicySetInput!) {
+			tokenPolicySet(input: $input) {
+				__typename
+				... on TokenPolicySetSuccess {
+					tokenPolicy {
+						maxLifetimeDays
+						allowedScopes
+						personalTokensAllowed
+					}
+				}
+				... on InvalidTokenScopeError {
+					scope
+				}
+			}
+		}
+	`
+
+	if input.AllowedScopes == nil {
+		input.AllowedScopes = []string{}
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*TokenPolicy](ctx, c, operation{
+		action:  "set token policy",
+		query:   query,
+		path:    []string{"tokenPolicySet"},
+		success: "TokenPolicySetSuccess",
+		field:   "tokenPolicy",
+		errors: map[string]string{
+			"InvalidTokenScopeError": `token scope "${scope}" is not valid`,
+			"NotAccountOwnerError":   "only account owners can change the token policy",
+		},
+	}, variables)
+}
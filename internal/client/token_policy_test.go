@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetTokenPolicy(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetTokenPolicy", `{"accountBySlug": {"tokenPolicy": {
+		"maxLifetimeDays": 90,
+		"allowedScopes": ["graph:read", "schema:publish"],
+		"personalTokensAllowed": false
+	}}}`)
+	policy, err := c.GetTokenPolicy(ctx, "my-account")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if policy.MaxLifetimeDays == nil || *policy.MaxLifetimeDays != 90 || len(policy.AllowedScopes) != 2 || policy.PersonalTokensAllowed {
+		t.Errorf("unexpected policy: %+v", policy)
+	}
+
+	server.Handle("GetTokenPolicy", `{"accountBySlug": null}`)
+	if _, err := c.GetTokenPolicy(ctx, "missing"); err == nil || errorMessage(err) != "account not found" {
+		t.Errorf("expected account not found, got %v", err)
+	}
+}
+
+func TestSetTokenPolicy(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := SetTokenPolicyInput{AccountSlug: "my-account", PersonalTokensAllowed: true}
+
+	server.Handle("SetTokenPolicy", `{"tokenPolicySet": {"__typename": "TokenPolicySetSuccess", "tokenPolicy": {"maxLifetimeDays": null, "allowedScopes": [], "personalTokensAllowed": true}}}`)
+	policy, err := c.SetTokenPolicy(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if policy.MaxLifetimeDays != nil {
+		t.Errorf("expected no lifetime limit, got %d", *policy.MaxLifetimeDays)
+	}
+
+	// Missing scopes are sent as an empty list, allowing every scope
+	sent := server.LastRequest("SetTokenPolicy").Variables["input"].(map[string]interface{})
+	if scopes, ok := sent["allowedScopes"].([]interface{}); !ok || len(scopes) != 0 {
+		t.Errorf("expected empty allowedScopes, got %v", sent["allowedScopes"])
+	}
+
+	server.Handle("SetTokenPolicy", `{"tokenPolicySet": {"__typename": "InvalidTokenScopeError", "scope": "everything"}}`)
+	if _, err := c.SetTokenPolicy(ctx, input); err == nil || errorMessage(err) != `token scope "everything" is not valid` {
+		t.Errorf("expected invalid scope error, got %v", err)
+	}
+}
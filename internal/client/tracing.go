@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName identifies the spans of the client in traces
+const tracerName = "github.com/grafbase/terraform-provider-grafbase/internal/client"
+
+// tracingServiceName is the service name of the provider in traces, unless
+// OTEL_SERVICE_NAME or OTEL_RESOURCE_ATTRIBUTES set another one
+const tracingServiceName = "terraform-provider-grafbase"
+
+// Span attributes set on each GraphQL operation
+const (
+	attrOperationName = attribute.Key("graphql.operation.name")
+	attrOperationType = attribute.Key("graphql.operation.type")
+	attrRequestID     = attribute.Key("grafbase.request_id")
+	attrRetryCount    = attribute.Key("grafbase.retry_count")
+	attrStatusCode    = attribute.Key("http.response.status_code")
+)
+
+// WithTracerProvider sets the tracer provider spans of API requests are
+// recorded with, instead of the global one
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *Client) {
+		c.tracer = provider.Tracer(tracerName)
+	}
+}
+
+// SetupTracing installs a global OpenTelemetry tracer provider exporting
+// spans over OTLP, configured by the standard OTEL_* environment variables.
+// Tracing is enabled when an OTLP endpoint is set with
+// OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT, unless
+// OTEL_SDK_DISABLED is true or OTEL_TRACES_EXPORTER is none. The returned
+// function flushes pending spans and must be called before the process exits.
+func SetupTracing(ctx context.Context) (func(context.Context) error, error) {
+	if !tracingEnabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := newTraceExporter(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace exporter: %w", err)
+	}
+
+	// Attributes from the environment take precedence over the default service name
+	res, err := resource.New(ctx,
+		resource.WithAttributes(attribute.String("service.name", tracingServiceName)),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+
+	// The sampler and batching settings are read from the environment as well
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return provider.Shutdown, nil
+}
+
+// tracingEnabled reports whether the environment asks for traces to be exported
+func tracingEnabled() bool {
+	if strings.EqualFold(os.Getenv("OTEL_SDK_DISABLED"), "true") {
+		return false
+	}
+	if exporter := os.Getenv("OTEL_TRACES_EXPORTER"); exporter != "" && exporter != "otlp" {
+		return false
+	}
+
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// newTraceExporter creates an OTLP exporter for the protocol set in the
+// environment. The exporters read the endpoint, headers, and TLS settings
+// from the environment themselves.
+func newTraceExporter(ctx context.Context) (*otlptrace.Exporter, error) {
+	protocol := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL")
+	if protocol == "" {
+		protocol = os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL")
+	}
+
+	switch protocol {
+	case "", "http/protobuf":
+		return otlptracehttp.New(ctx)
+	case "grpc":
+		return otlptracegrpc.New(ctx)
+	}
+
+	return nil, fmt.Errorf("unsupported OTLP protocol %q, expected grpc or http/protobuf", protocol)
+}
+
+// environmentTraceParent returns the span context passed to the provider in
+// the TRACEPARENT and TRACESTATE environment variables, so that API requests
+// show up under the span of the CI job that runs Terraform
+func environmentTraceParent() trace.SpanContext {
+	carrier := propagation.MapCarrier{
+		"traceparent": os.Getenv("TRACEPARENT"),
+		"tracestate":  os.Getenv("TRACESTATE"),
+	}
+
+	return trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(), carrier))
+}
+
+// startOperationSpan starts the span of a GraphQL operation. Operations run
+// outside of a trace are parented to the span from the environment, if any.
+func (c *Client) startOperationSpan(ctx context.Context, query, requestID string) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() && c.traceParent.IsValid() {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, c.traceParent)
+	}
+
+	name := operationName(query)
+	return c.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attrOperationName.String(name),
+			attrOperationType.String(operationType(query)),
+			attrRequestID.String(requestID),
+		),
+	)
+}
+
+// endOperationSpan records the outcome of a GraphQL operation on its span
+func endOperationSpan(span trace.Span, retries int, err error) {
+	span.SetAttributes(attrRetryCount.Int(retries))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else {
+		span.SetStatus(codes.Ok, "")
+	}
+	span.End()
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/mockgraphql"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// newTracedTestClient returns a client recording its spans
+func newTracedTestClient(t *testing.T) (*Client, *mockgraphql.Server, *tracetest.SpanRecorder) {
+	t.Helper()
+
+	server := mockgraphql.NewServer(t)
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	return NewClient("test-api-key", WithAPIURL(server.URL), WithTracerProvider(provider)), server, recorder
+}
+
+// spanAttribute returns the value of a span attribute
+func spanAttribute(span sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+
+	return attribute.Value{}
+}
+
+func TestExecuteQueryTracing(t *testing.T) {
+	c, server, recorder := newTracedTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetBranch", `{"branch": `+testBranchJSON+`}`)
+	for range 2 {
+		if _, err := c.GetBranch(ctx, "my-account", "my-graph", "main"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected a span per operation, got %d", len(spans))
+	}
+
+	span := spans[0]
+	if span.Name() != "GetBranch" || span.SpanKind() != trace.SpanKindClient {
+		t.Errorf("unexpected span %q of kind %v", span.Name(), span.SpanKind())
+	}
+	if got := spanAttribute(span, attrOperationType).AsString(); got != "query" {
+		t.Errorf("expected operation type query, got %q", got)
+	}
+	if got := spanAttribute(span, attrStatusCode).AsInt64(); got != 200 {
+		t.Errorf("expected status code 200, got %d", got)
+	}
+	if got := spanAttribute(spans[1], attrRequestID).AsString(); got != server.LastRequest("GetBranch").Header.Get(requestIDHeader) {
+		t.Errorf("expected the request ID of the call, got %q", got)
+	}
+	if span.Status().Code != codes.Ok {
+		t.Errorf("expected status ok, got %v", span.Status())
+	}
+
+	// The first call resends the full query after the persisted query miss
+	if got := spanAttribute(spans[0], attrRetryCount).AsInt64(); got != 1 {
+		t.Errorf("expected 1 retry on the first call, got %d", got)
+	}
+	if got := spanAttribute(spans[1], attrRetryCount).AsInt64(); got != 0 {
+		t.Errorf("expected no retry on the second call, got %d", got)
+	}
+}
+
+func TestExecuteQueryTracingError(t *testing.T) {
+	c, server, recorder := newTracedTestClient(t)
+
+	server.HandleErrors("GetBranch", "internal error")
+	if _, err := c.GetBranch(context.Background(), "my-account", "my-graph", "main"); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected a span per operation, got %d", len(spans))
+	}
+	if spans[0].Status().Code != codes.Error {
+		t.Errorf("expected status error, got %v", spans[0].Status())
+	}
+}
+
+func TestExecuteQueryTracingEnvironmentParent(t *testing.T) {
+	t.Setenv("TRACEPARENT", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	c, server, recorder := newTracedTestClient(t)
+
+	server.Handle("GetBranch", `{"branch": `+testBranchJSON+`}`)
+	if _, err := c.GetBranch(context.Background(), "my-account", "my-graph", "main"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	span := recorder.Ended()[0]
+	if got := span.Parent().TraceID().String(); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("expected the trace from the environment, got %s", got)
+	}
+	if got := span.Parent().SpanID().String(); got != "00f067aa0ba902b7" {
+		t.Errorf("expected the span from the environment as parent, got %s", got)
+	}
+}
+
+func TestTracingEnabled(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected bool
+	}{
+		{name: "no endpoint", env: map[string]string{}, expected: false},
+		{name: "endpoint", env: map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318"}, expected: true},
+		{name: "traces endpoint", env: map[string]string{"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "http://localhost:4318/v1/traces"}, expected: true},
+		{name: "sdk disabled", env: map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318", "OTEL_SDK_DISABLED": "true"}, expected: false},
+		{name: "exporter none", env: map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318", "OTEL_TRACES_EXPORTER": "none"}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"OTEL_EXPORTER_OTLP_ENDPOINT", "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "OTEL_SDK_DISABLED", "OTEL_TRACES_EXPORTER"} {
+				t.Setenv(name, tt.env[name])
+			}
+
+			if got := tracingEnabled(); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Connection pool settings. Terraform runs up to 10 resource operations in
+// parallel by default and every request goes to the same host, so idle
+// connections are kept per host well above that to avoid repeated TLS handshakes.
+const (
+	DefaultMaxIdleConnsPerHost = 32
+	DefaultIdleConnTimeout     = 90 * time.Second
+	DefaultKeepAlive           = 30 * time.Second
+)
+
+// TransportConfig represents the network settings used to reach the Grafbase API
+type TransportConfig struct {
+	// ProxyURL routes requests through an HTTP(S) proxy. When empty, the
+	// standard HTTP_PROXY, HTTPS_PROXY, and NO_PROXY environment variables apply.
+	ProxyURL string
+	// CACertPEM holds additional PEM encoded CA certificates trusted on top of
+	// the system pool, for proxies that terminate TLS with a private CA.
+	CACertPEM string
+	// InsecureSkipVerify disables TLS certificate verification.
+	InsecureSkipVerify bool
+}
+
+// defaultTransport returns an HTTP transport tuned for many concurrent
+// requests to the Grafbase API
+func defaultTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	transport.DialContext = (&net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: DefaultKeepAlive,
+	}).DialContext
+	transport.MaxIdleConnsPerHost = DefaultMaxIdleConnsPerHost
+	if transport.MaxIdleConns < DefaultMaxIdleConnsPerHost {
+		transport.MaxIdleConns = DefaultMaxIdleConnsPerHost
+	}
+	transport.IdleConnTimeout = DefaultIdleConnTimeout
+	transport.ForceAttemptHTTP2 = true
+
+	return transport
+}
+
+// NewTransport builds an HTTP transport from the given configuration
+func NewTransport(config TransportConfig) (*http.Transport, error) {
+	transport := defaultTransport()
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q: scheme and host are required", config.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// #nosec G402 -- explicitly requested by the provider configuration
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	if config.CACertPEM != "" {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM([]byte(config.CACertPEM)) {
+			return nil, fmt.Errorf("no valid PEM encoded certificates found in CA certificate")
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewTransport(t *testing.T) {
+	tests := []struct {
+		name          string
+		config        TransportConfig
+		expectedError bool
+	}{
+		{
+			name:   "default configuration",
+			config: TransportConfig{},
+		},
+		{
+			name:   "explicit proxy",
+			config: TransportConfig{ProxyURL: "http://proxy.internal:3128"},
+		},
+		{
+			name:          "proxy without scheme",
+			config:        TransportConfig{ProxyURL: "proxy.internal:3128"},
+			expectedError: true,
+		},
+		{
+			name:          "invalid CA certificate",
+			config:        TransportConfig{CACertPEM: "not a certificate"},
+			expectedError: true,
+		},
+		{
+			name:   "insecure skip verify",
+			config: TransportConfig{InsecureSkipVerify: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport, err := NewTransport(tt.config)
+
+			if tt.expectedError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if transport.TLSClientConfig.InsecureSkipVerify != tt.config.InsecureSkipVerify {
+				t.Errorf("expected InsecureSkipVerify %t, got %t", tt.config.InsecureSkipVerify, transport.TLSClientConfig.InsecureSkipVerify)
+			}
+
+			if transport.MaxIdleConnsPerHost != DefaultMaxIdleConnsPerHost {
+				t.Errorf("expected %d idle connections per host, got %d", DefaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+			}
+
+			if tt.config.ProxyURL != "" {
+				req, _ := http.NewRequest("POST", DefaultAPIURL, nil)
+				proxyURL, err := transport.Proxy(req)
+				if err != nil || proxyURL == nil || proxyURL.String() != tt.config.ProxyURL {
+					t.Errorf("expected proxy %q, got %v (err: %v)", tt.config.ProxyURL, proxyURL, err)
+				}
+			}
+		})
+	}
+}
+
+// blockingTransport never answers, so requests only end when they are cancelled
+type blockingTransport struct{}
+
+func (blockingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	<-req.Context().Done()
+	return nil, req.Context().Err()
+}
+
+func TestWithTimeout(t *testing.T) {
+	if timeout := NewClient("test").httpClient.Timeout; timeout != DefaultRequestTimeout {
+		t.Errorf("expected the default request timeout, got %s", timeout)
+	}
+
+	c := NewClient("test", WithTransport(blockingTransport{}), WithTimeout(20*time.Millisecond))
+
+	start := time.Now()
+	if _, err := c.ExecuteQuery(context.Background(), "query Viewer { viewer { id } }", nil); err == nil {
+		t.Fatal("expected the request to time out")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the request to stop at the timeout, took %s", elapsed)
+	}
+}
+
+func TestClientConcurrentRequests(t *testing.T) {
+	const workers = 10
+	const requestsPerWorker = 5
+
+	var mu sync.Mutex
+	connections := 0
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Keep requests in flight long enough for the workers to overlap
+		time.Sleep(5 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"__typename": "Query"}}`))
+	}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			mu.Lock()
+			connections++
+			mu.Unlock()
+		}
+	}
+	server.Start()
+	t.Cleanup(server.Close)
+
+	transport, err := NewTransport(TransportConfig{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	c := NewClient("test-api-key", WithAPIURL(server.URL), WithTransport(transport))
+
+	// Simulate Terraform's parallel resource operations sharing one client
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*requestsPerWorker)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < requestsPerWorker; j++ {
+				if _, err := c.ExecuteQuery(context.Background(), "query Typename { __typename }", nil); err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if connections > workers {
+		t.Errorf("expected at most %d connections for %d requests, got %d", workers, workers*requestsPerWorker, connections)
+	}
+}
+
+func TestNewClientPoolsConnections(t *testing.T) {
+	c := NewClient("test-api-key")
+
+	transport, ok := c.httpClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("expected *http.Transport, got %T", c.httpClient.Transport)
+	}
+	if transport.MaxIdleConnsPerHost != DefaultMaxIdleConnsPerHost {
+		t.Errorf("expected %d idle connections per host, got %d", DefaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+	}
+	if transport.IdleConnTimeout != DefaultIdleConnTimeout {
+		t.Errorf("expected idle timeout %s, got %s", DefaultIdleConnTimeout, transport.IdleConnTimeout)
+	}
+}
@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// TrustedDocument represents a trusted document stored on a branch. Only the
+// hash of the document text is returned, so refreshing does not download
+// every document.
+type TrustedDocument struct {
+	DocumentID string `json:"documentId"`
+	Hash       string `json:"hash"`
+}
+
+// TrustedDocumentInput represents a single document to upload
+type TrustedDocumentInput struct {
+	DocumentID   string `json:"documentId"`
+	DocumentText string `json:"documentText"`
+}
+
+// UploadTrustedDocumentsInput represents the input for uploading trusted documents
+type UploadTrustedDocumentsInput struct {
+	AccountSlug string                 `json:"accountSlug"`
+	GraphSlug   string                 `json:"graphSlug"`
+	BranchName  string                 `json:"branchName"`
+	ClientName  string                 `json:"clientName"`
+	Documents   []TrustedDocumentInput `json:"documents"`
+}
+
+// DeleteTrustedDocumentsInput represents the input for deleting trusted documents
+type DeleteTrustedDocumentsInput struct {
+	AccountSlug string   `json:"accountSlug"`
+	GraphSlug   string   `json:"graphSlug"`
+	BranchName  string   `json:"branchName"`
+	ClientName  string   `json:"clientName"`
+	DocumentIDs []string `json:"documentIds"`
+}
+
+// TrustedDocumentHash returns the hash the API reports for a document text,
+// the hex encoded SHA-256 digest of the text
+func TrustedDocumentHash(documentText string) string {
+	sum := sha256.Sum256([]byte(documentText))
+	return hex.EncodeToString(sum[:])
+}
+
+// ListTrustedDocuments retrieves the trusted documents of a client on a branch
+func (c *Client) ListTrustedDocuments(ctx context.Context, accountSlug, graphSlug, branchName, clientName string) ([]TrustedDocument, error) {
+	query := `
+		query ListTrustedDocuments($accountSlug: String!, $graphSlug: String!, $branchName: String!, $clientName: String!) {
+			branch(accountSlug: $accountSlug, graphSlug: $graphSlug, name: $branchName) {
+				trustedDocuments(clientName: $clientName) {
+					documentId
+					hash
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"accountSlug": accountSlug,
+		"graphSlug":   graphSlug,
+		"branchName":  branchName,
+		"clientName":  clientName,
+	}
+
+	branch, err := execute[*struct {
+		TrustedDocuments []TrustedDocument `json:"trustedDocuments"`
+	}](ctx, c, operation{
+		action: "list trusted documents",
+		query:  query,
+		path:   []string{"branch"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if branch == nil {
+		return nil, fmt.Errorf("branch not found")
+	}
+
+	return branch.TrustedDocuments, nil
+}
+
+// UploadTrustedDocuments uploads trusted documents for a client on a branch
+func (c *Client) UploadTrustedDocuments(ctx context.Context, input UploadTrustedDocumentsInput) error {
+	query := `
+		mutation UploadTrustedDocuments($input: TrustedDocumentsUploadInput!) {
+			trustedDocumentsUpload(input: $input) {
+				__typename
+				... on DocumentIdReusedError {
+					documentId
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "upload trusted documents",
+		query:   query,
+		path:    []string{"trustedDocumentsUpload"},
+		success: "TrustedDocumentsUploadSuccess",
+		errors: map[string]string{
+			"DocumentIdReusedError": "document ID ${documentId} is already used by a document with different text",
+		},
+	}, variables)
+
+	return err
+}
+
+// DeleteTrustedDocuments deletes trusted documents of a client on a branch
+func (c *Client) DeleteTrustedDocuments(ctx context.Context, input DeleteTrustedDocumentsInput) error {
+	query := `
+		mutation DeleteTrustedDocuments($input: TrustedDocumentsDeleteInput!) {
+			trustedDocumentsDelete(input: $input) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "delete trusted documents",
+		query:   query,
+		path:    []string{"trustedDocumentsDelete"},
+		success: "TrustedDocumentsDeleteSuccess",
+	}, variables)
+
+	return err
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTrustedDocumentHash(t *testing.T) {
+	// printf 'query { __typename }' | sha256sum
+	expected := "8995e953e895e960e470a1ee90e4b29520981980dcbc5e51ce0d7a2169b7049e"
+	if got := TrustedDocumentHash("query { __typename }"); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestListTrustedDocuments(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("ListTrustedDocuments", `{"branch": {"trustedDocuments": [{"documentId": "get-user", "hash": "abc"}]}}`)
+	documents, err := c.ListTrustedDocuments(ctx, "my-account", "my-graph", "main", "web")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(documents) != 1 || documents[0].DocumentID != "get-user" {
+		t.Errorf("unexpected documents: %+v", documents)
+	}
+	if got := server.LastRequest("ListTrustedDocuments").Variables["clientName"]; got != "web" {
+		t.Errorf("expected clientName variable, got %v", got)
+	}
+
+	server.Handle("ListTrustedDocuments", `{"branch": null}`)
+	if _, err := c.ListTrustedDocuments(ctx, "my-account", "my-graph", "missing", "web"); err == nil || errorMessage(err) != "branch not found" {
+		t.Errorf("expected branch not found, got %v", err)
+	}
+}
+
+func TestUploadTrustedDocuments(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := UploadTrustedDocumentsInput{
+		AccountSlug: "my-account",
+		GraphSlug:   "my-graph",
+		BranchName:  "main",
+		ClientName:  "web",
+		Documents:   []TrustedDocumentInput{{DocumentID: "get-user", DocumentText: "query GetUser { user { id } }"}},
+	}
+
+	server.Handle("UploadTrustedDocuments", `{"trustedDocumentsUpload": {"__typename": "TrustedDocumentsUploadSuccess"}}`)
+	if err := c.UploadTrustedDocuments(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("UploadTrustedDocuments", `{"trustedDocumentsUpload": {"__typename": "DocumentIdReusedError", "documentId": "get-user"}}`)
+	if err := c.UploadTrustedDocuments(ctx, input); err == nil || errorMessage(err) != "document ID get-user is already used by a document with different text" {
+		t.Errorf("expected document ID reused error, got %v", err)
+	}
+}
+
+func TestDeleteTrustedDocuments(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	input := DeleteTrustedDocumentsInput{AccountSlug: "my-account", GraphSlug: "my-graph", BranchName: "main", ClientName: "web", DocumentIDs: []string{"get-user"}}
+
+	server.Handle("DeleteTrustedDocuments", `{"trustedDocumentsDelete": {"__typename": "TrustedDocumentsDeleteSuccess"}}`)
+	if err := c.DeleteTrustedDocuments(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("DeleteTrustedDocuments", `{"trustedDocumentsDelete": {"__typename": "BranchDoesNotExistError"}}`)
+	if err := c.DeleteTrustedDocuments(ctx, input); err == nil || errorMessage(err) != "branch does not exist" {
+		t.Errorf("expected branch does not exist, got %v", err)
+	}
+}
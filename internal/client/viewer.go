@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// Viewer represents the principal authenticated by the API key
+type Viewer struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	// DefaultAccount is the account used by the dashboard when none is chosen.
+	// It is nil for keys scoped to no account.
+	DefaultAccount *ViewerAccount  `json:"defaultAccount"`
+	Accounts       []ViewerAccount `json:"accounts"`
+	// Scopes are the scopes granted to the API key or access token
+	Scopes []string `json:"scopes"`
+}
+
+// ViewerAccount represents an account the viewer is a member of
+type ViewerAccount struct {
+	ID   string            `json:"id"`
+	Slug string            `json:"slug"`
+	Name string            `json:"name"`
+	Role AccountMemberRole `json:"role"`
+}
+
+// GetViewer retrieves the principal authenticated by the API key, with its
+// account memberships and token scopes
+func (c *Client) GetViewer(ctx context.Context) (*Viewer, error) {
+	query := `
+		query GetViewer {
+			viewer {
+				id
+				name
+				email
+				defaultAccount {
+					id
+					slug
+					name
+					role
+				}
+				accounts {
+					id
+					slug
+					name
+					role
+				}
+				scopes
+			}
+		}
+	`
+
+	viewer, err := execute[*Viewer](ctx, c, operation{
+		action: "get viewer",
+		query:  query,
+		path:   []string{"viewer"},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if viewer == nil || viewer.ID == "" {
+		return nil, fmt.Errorf("viewer not found")
+	}
+
+	return viewer, nil
+}
+
+// ValidateCredentials checks that the API key authenticates a principal with
+// the cheapest possible query, so that a wrong key is reported before any
+// resource is changed.
+func (c *Client) ValidateCredentials(ctx context.Context) error {
+	query := `
+		query ValidateCredentials {
+			viewer {
+				id
+			}
+		}
+	`
+
+	viewer, err := execute[*struct {
+		ID string `json:"id"`
+	}](ctx, c, operation{
+		action: "validate credentials",
+		query:  query,
+		path:   []string{"viewer"},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	// The API answers unauthenticated requests with a null viewer
+	if viewer == nil || viewer.ID == "" {
+		return fmt.Errorf("the API key is not valid or has expired")
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/mockgraphql"
+)
+
+func TestValidateCredentials(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("ValidateCredentials", `{"viewer": {"id": "user-1"}}`)
+	if err := c.ValidateCredentials(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("ValidateCredentials", `{"viewer": null}`)
+	if err := c.ValidateCredentials(ctx); err == nil || errorMessage(err) != "the API key is not valid or has expired" {
+		t.Errorf("expected invalid API key error, got %v", err)
+	}
+
+	server.HandleFunc("ValidateCredentials", func(mockgraphql.Request) mockgraphql.Response {
+		return mockgraphql.Response{StatusCode: 401, Errors: []string{"Unauthorized"}}
+	})
+	if err := c.ValidateCredentials(ctx); err == nil || !strings.Contains(err.Error(), "401") {
+		t.Errorf("expected unauthorized error, got %v", err)
+	}
+}
+
+func TestGetViewer(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetViewer", `{"viewer": {"id": "user-1", "name": "Jane", "email": "jane@example.com",
+		"defaultAccount": {"id": "account-1", "slug": "my-account", "name": "My Account", "role": "OWNER"},
+		"accounts": [{"id": "account-1", "slug": "my-account", "name": "My Account", "role": "OWNER"}],
+		"scopes": ["graphs:write"]}}`)
+	viewer, err := c.GetViewer(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if viewer.DefaultAccount == nil || viewer.DefaultAccount.Slug != "my-account" || len(viewer.Accounts) != 1 || viewer.Accounts[0].Role != AccountMemberRoleOwner {
+		t.Errorf("unexpected viewer: %+v", viewer)
+	}
+
+	server.Handle("GetViewer", `{"viewer": null}`)
+	if _, err := c.GetViewer(ctx); err == nil || errorMessage(err) != "viewer not found" {
+		t.Errorf("expected viewer not found, got %v", err)
+	}
+}
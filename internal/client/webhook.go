@@ -0,0 +1,181 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// WebhookEvent represents an event a webhook is notified of
+type WebhookEvent string
+
+const (
+	// WebhookEventDeploymentFinished is sent when a branch deployment succeeds or fails
+	WebhookEventDeploymentFinished WebhookEvent = "DEPLOYMENT_FINISHED"
+	// WebhookEventSchemaCheckFailed is sent when a schema check reports errors
+	WebhookEventSchemaCheckFailed WebhookEvent = "SCHEMA_CHECK_FAILED"
+	// WebhookEventCompositionFailed is sent when a published subgraph fails to compose
+	WebhookEventCompositionFailed WebhookEvent = "COMPOSITION_FAILED"
+)
+
+// Webhook represents a webhook notified of events of a graph. The signing
+// secret is never returned by the API, only whether one is configured.
+type Webhook struct {
+	ID               string         `json:"id"`
+	URL              string         `json:"url"`
+	Events           []WebhookEvent `json:"events"`
+	SecretConfigured bool           `json:"secretConfigured"`
+}
+
+// CreateWebhookInput represents the input for creating a webhook. When Secret
+// is nil, Grafbase generates the signing secret.
+type CreateWebhookInput struct {
+	AccountSlug string         `json:"accountSlug"`
+	GraphSlug   string         `json:"graphSlug"`
+	URL         string         `json:"url"`
+	Secret      *string        `json:"secret"`
+	Events      []WebhookEvent `json:"events"`
+}
+
+// UpdateWebhookInput represents the input for replacing the settings of a
+// webhook. A nil Secret removes the signing secret.
+type UpdateWebhookInput struct {
+	ID     string         `json:"id"`
+	URL    string         `json:"url"`
+	Secret *string        `json:"secret"`
+	Events []WebhookEvent `json:"events"`
+}
+
+// webhookFields is the selection set shared by webhook queries
+const webhookFields = `
+	id
+	url
+	events
+	secretConfigured
+`
+
+// CreateWebhook registers a webhook for events of a graph and returns it
+// together with the secret its payloads are signed with, which is only
+// returned on creation
+func (c *Client) CreateWebhook(ctx context.Context, input CreateWebhookInput) (*Webhook, string, error) {
+	query := `
+		mutation CreateWebhook($input: WebhookCreateInput!) {
+			webhookCreate(input: $input) {
+				__typename
+				... on WebhookCreateSuccess {
+					webhook {` + webhookFields + `}
+					signingSecret
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	created, err := execute[struct {
+		Webhook       Webhook `json:"webhook"`
+		SigningSecret string  `json:"signingSecret"`
+	}](ctx, c, operation{
+		action:  "create webhook",
+		query:   query,
+		path:    []string{"webhookCreate"},
+		success: "WebhookCreateSuccess",
+		errors:  webhookErrors,
+	}, variables)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &created.Webhook, created.SigningSecret, nil
+}
+
+// UpdateWebhook replaces the URL, secret, and events of a webhook
+func (c *Client) UpdateWebhook(ctx context.Context, input UpdateWebhookInput) (*Webhook, error) {
+	query := `
+		mutation UpdateWebhook($input: WebhookUpdateInput!) {
+			webhookUpdate(input: $input) {
+				__typename
+				... on WebhookUpdateSuccess {
+					webhook {` + webhookFields + `}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	return execute[*Webhook](ctx, c, operation{
+		action:  "update webhook",
+		query:   query,
+		path:    []string{"webhookUpdate"},
+		success: "WebhookUpdateSuccess",
+		field:   "webhook",
+		errors:  webhookErrors,
+	}, variables)
+}
+
+// webhookErrors are the errors of the webhook create and update mutations
+var webhookErrors = map[string]string{
+	"WebhookDoesNotExistError": "webhook does not exist",
+	"InvalidWebhookUrlError":   "webhook URL must be a public HTTPS URL",
+}
+
+// GetWebhook retrieves a webhook by ID using the node query
+func (c *Client) GetWebhook(ctx context.Context, id string) (*Webhook, error) {
+	query := `
+		query GetWebhook($id: ID!) {
+			node(id: $id) {
+				... on Webhook {` + webhookFields + `}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	webhook, err := execute[*Webhook](ctx, c, operation{
+		action: "get webhook",
+		query:  query,
+		path:   []string{"node"},
+	}, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if webhook == nil || webhook.ID == "" {
+		return nil, fmt.Errorf("webhook not found")
+	}
+
+	return webhook, nil
+}
+
+// DeleteWebhook deletes a webhook
+func (c *Client) DeleteWebhook(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteWebhook($id: ID!) {
+			webhookDelete(id: $id) {
+				__typename
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	_, err := execute[struct{}](ctx, c, operation{
+		action:  "delete webhook",
+		query:   query,
+		path:    []string{"webhookDelete"},
+		success: "WebhookDeleteSuccess",
+		errors: map[string]string{
+			"WebhookDoesNotExistError": "webhook does not exist",
+		},
+	}, variables)
+
+	return err
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+const testWebhookJSON = `{
+	"id": "webhook-1",
+	"url": "https://hooks.example.com/grafbase",
+	"events": ["DEPLOYMENT_FINISHED", "COMPOSITION_FAILED"],
+	"secretConfigured": true
+}`
+
+func TestCreateWebhook(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+	secret := "signing-secret"
+	input := CreateWebhookInput{
+		AccountSlug: "my-account",
+		GraphSlug:   "my-graph",
+		URL:         "https://hooks.example.com/grafbase",
+		Secret:      &secret,
+		Events:      []WebhookEvent{WebhookEventDeploymentFinished, WebhookEventCompositionFailed},
+	}
+
+	server.Handle("CreateWebhook", `{"webhookCreate": {"__typename": "WebhookCreateSuccess", "webhook": `+testWebhookJSON+`, "signingSecret": "signing-secret"}}`)
+	webhook, signingSecret, err := c.CreateWebhook(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if webhook.ID != "webhook-1" || len(webhook.Events) != 2 || !webhook.SecretConfigured {
+		t.Errorf("unexpected webhook: %+v", webhook)
+	}
+	if signingSecret != "signing-secret" {
+		t.Errorf("expected the signing secret, got %q", signingSecret)
+	}
+
+	// Without a secret, the one generated by Grafbase is returned
+	input.Secret = nil
+	server.Handle("CreateWebhook", `{"webhookCreate": {"__typename": "WebhookCreateSuccess", "webhook": `+testWebhookJSON+`, "signingSecret": "whsec_generated"}}`)
+	if _, signingSecret, err := c.CreateWebhook(ctx, input); err != nil || signingSecret != "whsec_generated" {
+		t.Errorf("expected the generated signing secret, got %q, %v", signingSecret, err)
+	}
+	if secret, ok := server.LastRequest("CreateWebhook").Variables["input"].(map[string]interface{})["secret"]; !ok || secret != nil {
+		t.Errorf("expected null secret, got %v", secret)
+	}
+
+	server.Handle("CreateWebhook", `{"webhookCreate": {"__typename": "InvalidWebhookUrlError"}}`)
+	if _, _, err := c.CreateWebhook(ctx, input); err == nil || errorMessage(err) != "webhook URL must be a public HTTPS URL" {
+		t.Errorf("expected invalid URL error, got %v", err)
+	}
+}
+
+func TestUpdateWebhook(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("UpdateWebhook", `{"webhookUpdate": {"__typename": "WebhookUpdateSuccess", "webhook": `+testWebhookJSON+`}}`)
+	if _, err := c.UpdateWebhook(ctx, UpdateWebhookInput{ID: "webhook-1", URL: "https://hooks.example.com/grafbase"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A missing secret is sent as null, removing the signing secret
+	sent := server.LastRequest("UpdateWebhook").Variables["input"].(map[string]interface{})
+	if secret, ok := sent["secret"]; !ok || secret != nil {
+		t.Errorf("expected null secret, got %v", sent["secret"])
+	}
+
+	server.Handle("UpdateWebhook", `{"webhookUpdate": {"__typename": "WebhookDoesNotExistError"}}`)
+	if _, err := c.UpdateWebhook(ctx, UpdateWebhookInput{ID: "missing"}); err == nil || errorMessage(err) != "webhook does not exist" {
+		t.Errorf("expected webhook does not exist, got %v", err)
+	}
+}
+
+func TestGetWebhook(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("GetWebhook", `{"node": `+testWebhookJSON+`}`)
+	if _, err := c.GetWebhook(ctx, "webhook-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("GetWebhook", `{"node": null}`)
+	if _, err := c.GetWebhook(ctx, "missing"); err == nil || errorMessage(err) != "webhook not found" {
+		t.Errorf("expected webhook not found, got %v", err)
+	}
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	c, server := newTestClient(t)
+	ctx := context.Background()
+
+	server.Handle("DeleteWebhook", `{"webhookDelete": {"__typename": "WebhookDeleteSuccess"}}`)
+	if err := c.DeleteWebhook(ctx, "webhook-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.Handle("DeleteWebhook", `{"webhookDelete": {"__typename": "WebhookDoesNotExistError"}}`)
+	if err := c.DeleteWebhook(ctx, "webhook-1"); err == nil || errorMessage(err) != "webhook does not exist" {
+		t.Errorf("expected webhook does not exist, got %v", err)
+	}
+}
@@ -0,0 +1,272 @@
+// Package mockgraphql provides an in-process GraphQL server for unit tests.
+// Responses are registered per operation name, so tests can exercise the
+// client and resources without credentials or network access. Like the
+// Grafbase API, the server supports automatic persisted queries.
+package mockgraphql
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+)
+
+// operationNamePattern matches the operation type and name of a GraphQL document
+var operationNamePattern = regexp.MustCompile(`(query|mutation|subscription)\s+([_A-Za-z][_0-9A-Za-z]*)`)
+
+// Request is a GraphQL request received by the server
+type Request struct {
+	OperationName string
+	Query         string
+	Variables     map[string]interface{}
+	Header        http.Header
+	// Persisted is true when the query was sent as the hash of a persisted query
+	Persisted bool
+}
+
+// Input decodes the "input" variable of a mutation into v
+func (r Request) Input(v interface{}) error {
+	raw, err := json.Marshal(r.Variables["input"])
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(raw, v)
+}
+
+// Response is the GraphQL response returned for a request
+type Response struct {
+	// Data is the raw JSON of the data field
+	Data string
+	// Errors are the messages of the errors field
+	Errors []string
+	// StatusCode overrides the HTTP status, defaulting to 200
+	StatusCode int
+	// Header holds extra response headers
+	Header http.Header
+}
+
+// HandlerFunc computes the response to a request
+type HandlerFunc func(req Request) Response
+
+// Server is an httptest based GraphQL server matching requests on operation name
+type Server struct {
+	URL string
+
+	t        testing.TB
+	server   *httptest.Server
+	mu       sync.Mutex
+	handlers map[string]HandlerFunc
+	requests []Request
+
+	// persistedQueries maps the hashes of persisted queries to their documents
+	persistedQueries map[string]string
+	// rejectPersistedQueries makes the server behave like one without
+	// persisted query support
+	rejectPersistedQueries bool
+}
+
+// NewServer starts a server that is closed when the test finishes
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{
+		t:                t,
+		handlers:         map[string]HandlerFunc{},
+		persistedQueries: map[string]string{},
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL
+	t.Cleanup(s.server.Close)
+
+	return s
+}
+
+// Handle responds to every request of the operation with the given data JSON
+func (s *Server) Handle(operationName, data string) {
+	s.HandleFunc(operationName, func(Request) Response {
+		return Response{Data: data}
+	})
+}
+
+// HandleErrors responds to every request of the operation with GraphQL errors
+func (s *Server) HandleErrors(operationName string, messages ...string) {
+	s.HandleFunc(operationName, func(Request) Response {
+		return Response{Data: "null", Errors: messages}
+	})
+}
+
+// HandleFunc responds to requests of the operation with the result of fn,
+// replacing any previously registered handler
+func (s *Server) HandleFunc(operationName string, fn HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers[operationName] = fn
+}
+
+// RejectPersistedQueries makes the server answer requests sent as persisted
+// query hashes like a server without persisted query support
+func (s *Server) RejectPersistedQueries() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rejectPersistedQueries = true
+}
+
+// Requests returns the requests received for the operation, in order
+func (s *Server) Requests(operationName string) []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var requests []Request
+	for _, req := range s.requests {
+		if req.OperationName == operationName {
+			requests = append(requests, req)
+		}
+	}
+
+	return requests
+}
+
+// LastRequest returns the most recent request of the operation, failing the
+// test if the operation was never requested
+func (s *Server) LastRequest(operationName string) Request {
+	s.t.Helper()
+
+	requests := s.Requests(operationName)
+	if len(requests) == 0 {
+		s.t.Fatalf("no %s request received", operationName)
+	}
+
+	return requests[len(requests)-1]
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var payload struct {
+		Query      string                 `json:"query"`
+		Variables  map[string]interface{} `json:"variables"`
+		Extensions struct {
+			PersistedQuery *struct {
+				SHA256Hash string `json:"sha256Hash"`
+			} `json:"persistedQuery"`
+		} `json:"extensions"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		http.Error(w, fmt.Sprintf("invalid GraphQL request: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	req := Request{
+		Query:     payload.Query,
+		Variables: payload.Variables,
+		Header:    r.Header.Clone(),
+	}
+
+	if persistedQuery := payload.Extensions.PersistedQuery; persistedQuery != nil {
+		query, code := s.resolvePersistedQuery(payload.Query, persistedQuery.SHA256Hash)
+		if code != "" {
+			writeResponse(s.t, w, http.StatusOK, map[string]interface{}{
+				"data":   nil,
+				"errors": []map[string]interface{}{{"message": code, "extensions": map[string]string{"code": code}}},
+			})
+			return
+		}
+		req.Query = query
+		req.Persisted = payload.Query == ""
+	}
+	if matches := operationNamePattern.FindStringSubmatch(req.Query); matches != nil {
+		req.OperationName = matches[2]
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	handler, ok := s.handlers[req.OperationName]
+	s.mu.Unlock()
+
+	if !ok {
+		s.t.Errorf("unexpected GraphQL operation %q", req.OperationName)
+		handler = func(Request) Response {
+			return Response{Data: "null", Errors: []string{fmt.Sprintf("no handler for operation %s", req.OperationName)}}
+		}
+	}
+
+	resp := handler(req)
+	for name, values := range resp.Header {
+		for _, value := range values {
+			w.Header().Add(name, value)
+		}
+	}
+
+	statusCode := resp.StatusCode
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+
+	data := resp.Data
+	if data == "" {
+		data = "null"
+	}
+
+	errors := make([]map[string]string, 0, len(resp.Errors))
+	for _, message := range resp.Errors {
+		errors = append(errors, map[string]string{"message": message})
+	}
+
+	out := map[string]interface{}{
+		"data": json.RawMessage(data),
+	}
+	if len(errors) > 0 {
+		out["errors"] = errors
+	}
+
+	writeResponse(s.t, w, statusCode, out)
+}
+
+// resolvePersistedQuery returns the document of a persisted query, storing it
+// when the full query is sent along with its hash. A non-empty error code is
+// returned when the query cannot be resolved.
+func (s *Server) resolvePersistedQuery(query, hash string) (string, string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if query == "" {
+		if s.rejectPersistedQueries {
+			return "", "PERSISTED_QUERY_NOT_SUPPORTED"
+		}
+		stored, ok := s.persistedQueries[hash]
+		if !ok {
+			return "", "PERSISTED_QUERY_NOT_FOUND"
+		}
+		return stored, ""
+	}
+
+	sum := sha256.Sum256([]byte(query))
+	if hex.EncodeToString(sum[:]) != hash {
+		s.t.Errorf("persisted query hash %q does not match the query", hash)
+	}
+	s.persistedQueries[hash] = query
+
+	return query, ""
+}
+
+// writeResponse writes a JSON response body with the given status
+func writeResponse(t testing.TB, w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		t.Errorf("failed to write GraphQL response: %s", err)
+	}
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// defaultAccessTokenTTL is how long a minted access token is valid when no ttl is configured.
+	defaultAccessTokenTTL = time.Hour
+
+	// accessTokenIDKey is the private data key holding the ID of the minted token, so Close can revoke it.
+	accessTokenIDKey = "access_token_id"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &AccessTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &AccessTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &AccessTokenEphemeralResource{}
+
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &AccessTokenEphemeralResource{}
+}
+
+// AccessTokenEphemeralResource defines the ephemeral resource implementation.
+type AccessTokenEphemeralResource struct {
+	client client.API
+}
+
+// AccessTokenEphemeralResourceModel describes the ephemeral resource data model.
+type AccessTokenEphemeralResourceModel struct {
+	AccountSlug types.String `tfsdk:"account_slug"`
+	Scopes      types.Set    `tfsdk:"scopes"`
+	TTL         types.String `tfsdk:"ttl"`
+	ID          types.String `tfsdk:"id"`
+	Token       types.String `tfsdk:"token"`
+	ExpiresAt   RFC3339Value `tfsdk:"expires_at"`
+}
+
+func (e *AccessTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (e *AccessTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Mints a short-lived access token of an account during a Terraform run. The token is never " +
+			"stored in the plan or state, and is revoked when Terraform no longer needs it. Requires Terraform 1.10 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug the token grants access to",
+				Required:            true,
+			},
+			"scopes": schema.SetAttribute{
+				MarkdownDescription: "Scopes granted to the token. When unset, the token is granted every scope allowed by the account token policy.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"ttl": schema.StringAttribute{
+				MarkdownDescription: "How long the token is valid, as a Go duration string such as `15m`. Defaults to `1h`.",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Access token identifier",
+				Computed:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Token secret",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "Token expiry timestamp",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (e *AccessTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = client
+}
+
+func (e *AccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data AccessTokenEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(e.createAccessToken(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+
+	// Remember the token so Close can revoke it
+	tokenID, err := json.Marshal(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to encode access token ID: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, accessTokenIDKey, tokenID)...)
+}
+
+func (e *AccessTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	tokenID, diags := req.Private.GetKey(ctx, accessTokenIDKey)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || len(tokenID) == 0 {
+		return
+	}
+
+	var id string
+	if err := json.Unmarshal(tokenID, &id); err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to decode access token ID: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(e.revokeAccessToken(ctx, id)...)
+}
+
+// createAccessToken mints a token for the configuration and populates the
+// computed attributes of the model.
+func (e *AccessTokenEphemeralResource) createAccessToken(ctx context.Context, data *AccessTokenEphemeralResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ttl := defaultAccessTokenTTL
+	if !data.TTL.IsNull() {
+		var err error
+		ttl, err = time.ParseDuration(data.TTL.ValueString())
+		if err != nil || ttl < time.Minute {
+			diags.AddAttributeError(
+				path.Root("ttl"),
+				"Invalid TTL",
+				fmt.Sprintf("ttl must be a duration of at least one minute, such as \"15m\" or \"2h\", got: %s", data.TTL.ValueString()),
+			)
+			return diags
+		}
+	}
+
+	createInput := client.CreateAccessTokenInput{
+		AccountSlug: data.AccountSlug.ValueString(),
+		TTLSeconds:  int64(ttl / time.Second),
+	}
+	if !data.Scopes.IsNull() && !data.Scopes.IsUnknown() {
+		diags.Append(data.Scopes.ElementsAs(ctx, &createInput.Scopes, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	token, err := e.client.CreateAccessToken(ctx, createInput)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create access token: %s", err))
+		return diags
+	}
+
+	data.ID = types.StringValue(token.ID)
+	data.Token = types.StringValue(token.Token)
+	data.ExpiresAt = NewRFC3339Value(token.ExpiresAt)
+
+	return diags
+}
+
+// revokeAccessToken revokes a minted token. Tokens that already expired or
+// were revoked are ignored.
+func (e *AccessTokenEphemeralResource) revokeAccessToken(ctx context.Context, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := e.client.RevokeAccessToken(ctx, id)
+	if err != nil && !strings.Contains(err.Error(), "does not exist") {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to revoke access token: %s", err))
+	}
+
+	return diags
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/grafbase/terraform-provider-grafbase/internal/mockgraphql"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccAccessTokenEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			// Open and Close testing; ephemeral values never reach the state
+			{
+				Config: `
+ephemeral "grafbase_access_token" "test" {
+  account_slug = "test-account"
+  ttl          = "5m"
+}
+`,
+			},
+		},
+	})
+}
+
+// newMockAccessTokenEphemeralResource configures the ephemeral resource with a
+// client of a mock GraphQL server
+func newMockAccessTokenEphemeralResource(t *testing.T) (*AccessTokenEphemeralResource, *mockgraphql.Server) {
+	t.Helper()
+
+	server := mockgraphql.NewServer(t)
+	e := &AccessTokenEphemeralResource{}
+
+	resp := &ephemeral.ConfigureResponse{}
+	e.Configure(context.Background(), ephemeral.ConfigureRequest{
+		ProviderData: client.NewClient("test-api-key", client.WithAPIURL(server.URL)),
+	}, resp)
+	requireNoDiagnostics(t, resp.Diagnostics)
+
+	return e, server
+}
+
+func TestAccessTokenEphemeralResourceOpenClose(t *testing.T) {
+	e, server := newMockAccessTokenEphemeralResource(t)
+	ctx := context.Background()
+
+	server.Handle("CreateAccessToken", `{"accessTokenCreate": {"__typename": "AccessTokenCreateSuccess", "accessToken": {
+		"id": "token-1", "token": "gbt_secret", "expiresAt": "2024-01-15T10:45:00Z"
+	}}}`)
+	data := AccessTokenEphemeralResourceModel{
+		AccountSlug: types.StringValue("my-account"),
+		Scopes:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("publish")}),
+		TTL:         types.StringValue("15m"),
+	}
+	requireNoDiagnostics(t, e.createAccessToken(ctx, &data))
+	if data.ID.ValueString() != "token-1" || data.Token.ValueString() != "gbt_secret" {
+		t.Errorf("unexpected token: %+v", data)
+	}
+
+	var input client.CreateAccessTokenInput
+	if err := server.LastRequest("CreateAccessToken").Input(&input); err != nil {
+		t.Fatalf("unable to decode input: %s", err)
+	}
+	if input.TTLSeconds != 900 || len(input.Scopes) != 1 || input.Scopes[0] != "publish" {
+		t.Errorf("unexpected input: %+v", input)
+	}
+
+	// Tokens that already expired are not an error
+	server.Handle("RevokeAccessToken", `{"accessTokenRevoke": {"__typename": "AccessTokenDoesNotExistError"}}`)
+	requireNoDiagnostics(t, e.revokeAccessToken(ctx, "token-1"))
+	if got := server.LastRequest("RevokeAccessToken").Variables["input"].(map[string]interface{})["id"]; got != "token-1" {
+		t.Errorf("expected token-1 to be revoked, got %v", got)
+	}
+}
+
+func TestAccessTokenEphemeralResourceTTL(t *testing.T) {
+	e, server := newMockAccessTokenEphemeralResource(t)
+	ctx := context.Background()
+
+	server.Handle("CreateAccessToken", `{"accessTokenCreate": {"__typename": "AccessTokenCreateSuccess", "accessToken": {
+		"id": "token-1", "token": "gbt_secret", "expiresAt": "2024-01-15T11:30:00Z"
+	}}}`)
+	data := AccessTokenEphemeralResourceModel{
+		AccountSlug: types.StringValue("my-account"),
+		Scopes:      types.SetNull(types.StringType),
+		TTL:         types.StringNull(),
+	}
+	requireNoDiagnostics(t, e.createAccessToken(ctx, &data))
+	var input client.CreateAccessTokenInput
+	if err := server.LastRequest("CreateAccessToken").Input(&input); err != nil {
+		t.Fatalf("unable to decode input: %s", err)
+	}
+	if input.TTLSeconds != 3600 {
+		t.Errorf("expected default TTL of one hour, got %d seconds", input.TTLSeconds)
+	}
+
+	for _, ttl := range []string{"30s", "soon"} {
+		data.TTL = types.StringValue(ttl)
+		if diags := e.createAccessToken(ctx, &data); !diags.HasError() {
+			t.Errorf("expected ttl %q to be rejected", ttl)
+		}
+	}
+	if got := len(server.Requests("CreateAccessToken")); got != 1 {
+		t.Errorf("expected invalid TTLs to be rejected before any request, got %d requests", got)
+	}
+}
@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccountAPIBudgetResource{}
+var _ resource.ResourceWithImportState = &AccountAPIBudgetResource{}
+var _ resource.ResourceWithConfigValidators = &AccountAPIBudgetResource{}
+
+func NewAccountAPIBudgetResource() resource.Resource {
+	return &AccountAPIBudgetResource{}
+}
+
+// AccountAPIBudgetResource defines the resource implementation.
+type AccountAPIBudgetResource struct {
+	client client.API
+}
+
+// AccountAPIBudgetResourceModel describes the resource data model.
+type AccountAPIBudgetResourceModel struct {
+	ID                     types.String  `tfsdk:"id"`
+	AccountSlug            types.String  `tfsdk:"account_slug"`
+	GraphSlug              types.String  `tfsdk:"graph_slug"`
+	MonthlyRequestLimit    types.Int64   `tfsdk:"monthly_request_limit"`
+	MonthlyCostLimitUSD    types.Float64 `tfsdk:"monthly_cost_limit_usd"`
+	Enforcement            types.String  `tfsdk:"enforcement"`
+	NotificationChannelIDs types.List    `tfsdk:"notification_channel_ids"`
+}
+
+func (r *AccountAPIBudgetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_api_budget"
+}
+
+func (r *AccountAPIBudgetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages monthly request and cost budgets for an account, or for a single graph when `graph_slug` is set.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Budget identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug the budget applies to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug the budget applies to. When unset, the budget covers the whole account.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"monthly_request_limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests per billing month",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"monthly_cost_limit_usd": schema.Float64Attribute{
+				MarkdownDescription: "Maximum spend per billing month in US dollars",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"enforcement": schema.StringAttribute{
+				MarkdownDescription: "How the budget is enforced once exceeded: `SOFT` only notifies, `HARD` rejects further requests",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(client.BudgetEnforcementSoft), string(client.BudgetEnforcementHard)),
+				},
+			},
+			"notification_channel_ids": schema.ListAttribute{
+				MarkdownDescription: "Identifiers of the notification channels alerted when the budget is approached or exceeded",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *AccountAPIBudgetResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("monthly_request_limit"),
+			path.MatchRoot("monthly_cost_limit_usd"),
+		),
+	}
+}
+
+func (r *AccountAPIBudgetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AccountAPIBudgetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccountAPIBudgetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setInput, diags := data.setInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	budget, err := r.client.SetAPIBudget(ctx, setInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create API budget: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(data.fromBudget(ctx, budget)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitUntilCreated(ctx, "API budget", func(ctx context.Context) error {
+		_, err := r.client.GetAPIBudget(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString())
+		return err
+	}, "API budget not found")...)
+}
+
+func (r *AccountAPIBudgetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccountAPIBudgetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	budget, err := r.client.GetAPIBudget(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString())
+	if err != nil {
+		// If the budget is not found, remove it from state
+		if err.Error() == "API budget not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API budget: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	resp.Diagnostics.Append(data.fromBudget(ctx, budget)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountAPIBudgetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccountAPIBudgetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setInput, diags := data.setInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	budget, err := r.client.SetAPIBudget(ctx, setInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update API budget: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromBudget(ctx, budget)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountAPIBudgetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccountAPIBudgetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteInput := client.DeleteAPIBudgetInput{
+		AccountSlug: data.AccountSlug.ValueString(),
+		GraphSlug:   data.GraphSlug.ValueString(),
+	}
+
+	err := r.client.DeleteAPIBudget(ctx, deleteInput)
+	if err != nil {
+		// If the budget doesn't exist, consider it already deleted
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete API budget: %s", err))
+		return
+	}
+}
+
+func (r *AccountAPIBudgetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug" for account budgets or
+	// "account_slug/graph_slug" for graph budgets
+	accountSlug := req.ID
+	graphSlug := ""
+	if strings.Contains(req.ID, "/") {
+		var err error
+		accountSlug, graphSlug, err = parseImportID(req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug' or 'account_slug/graph_slug', got: %s", req.ID))
+			return
+		}
+	}
+
+	// Get the budget to populate the remaining attributes
+	budget, err := r.client.GetAPIBudget(ctx, accountSlug, graphSlug)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read API budget during import: %s", err))
+		return
+	}
+
+	data := AccountAPIBudgetResourceModel{
+		AccountSlug:            types.StringValue(accountSlug),
+		GraphSlug:              types.StringNull(),
+		NotificationChannelIDs: types.ListNull(types.StringType),
+	}
+	if graphSlug != "" {
+		data.GraphSlug = types.StringValue(graphSlug)
+	}
+	resp.Diagnostics.Append(data.fromBudget(ctx, budget)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setInput builds the client input for creating or replacing the budget.
+func (m AccountAPIBudgetResourceModel) setInput(ctx context.Context) (client.SetAPIBudgetInput, diag.Diagnostics) {
+	input := client.SetAPIBudgetInput{
+		AccountSlug:         m.AccountSlug.ValueString(),
+		GraphSlug:           m.GraphSlug.ValueString(),
+		MonthlyRequestLimit: m.MonthlyRequestLimit.ValueInt64Pointer(),
+		MonthlyCostLimit:    m.MonthlyCostLimitUSD.ValueFloat64Pointer(),
+		Enforcement:         client.BudgetEnforcement(m.Enforcement.ValueString()),
+	}
+
+	var diags diag.Diagnostics
+	if !m.NotificationChannelIDs.IsNull() && !m.NotificationChannelIDs.IsUnknown() {
+		diags = m.NotificationChannelIDs.ElementsAs(ctx, &input.NotificationChannelIDs, false)
+	}
+
+	return input, diags
+}
+
+// fromBudget maps an API budget onto the model. An empty channel list is kept
+// null when it was not configured, so omitting the attribute does not cause a diff.
+func (m *AccountAPIBudgetResourceModel) fromBudget(ctx context.Context, budget *client.APIBudget) diag.Diagnostics {
+	m.ID = types.StringValue(budget.ID)
+	m.MonthlyRequestLimit = types.Int64PointerValue(budget.MonthlyRequestLimit)
+	m.MonthlyCostLimitUSD = types.Float64PointerValue(budget.MonthlyCostLimit)
+	m.Enforcement = types.StringValue(string(budget.Enforcement))
+
+	if len(budget.NotificationChannelIDs) == 0 && m.NotificationChannelIDs.IsNull() {
+		return nil
+	}
+
+	channels, diags := types.ListValueFrom(ctx, types.StringType, budget.NotificationChannelIDs)
+	m.NotificationChannelIDs = channels
+
+	return diags
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAccountAPIBudgetResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAccountAPIBudgetResourceConfig(1000000, "SOFT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_account_api_budget.test", "account_slug", "test-account"),
+					resource.TestCheckResourceAttr("grafbase_account_api_budget.test", "monthly_request_limit", "1000000"),
+					resource.TestCheckResourceAttr("grafbase_account_api_budget.test", "enforcement", "SOFT"),
+					resource.TestCheckResourceAttrSet("grafbase_account_api_budget.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_account_api_budget.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-account",
+			},
+			// Update in place
+			{
+				Config: testAccAccountAPIBudgetResourceConfig(2000000, "HARD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_account_api_budget.test", "monthly_request_limit", "2000000"),
+					resource.TestCheckResourceAttr("grafbase_account_api_budget.test", "enforcement", "HARD"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountAPIBudgetResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAccountAPIBudgetResourceConfig(1000, "STRICT"),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			{
+				Config: `
+resource "grafbase_account_api_budget" "test" {
+  account_slug = "test-account"
+  enforcement  = "SOFT"
+}
+`,
+				ExpectError: regexp.MustCompile(`At least one attribute out of`),
+			},
+		},
+	})
+}
+
+func testAccAccountAPIBudgetResourceConfig(requestLimit int, enforcement string) string {
+	return fmt.Sprintf(`
+resource "grafbase_account_api_budget" "test" {
+  account_slug          = "test-account"
+  monthly_request_limit = %[1]d
+  enforcement           = %[2]q
+}
+`, requestLimit, enforcement)
+}
+
+func TestAccountAPIBudgetResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewAccountAPIBudgetResource)
+
+	server.Handle("SetAPIBudget", `{"apiBudgetSet": {"__typename": "ApiBudgetSetSuccess", "budget": {"id": "budget-1", "monthlyRequestLimit": 1000000, "monthlyCostLimit": null, "enforcement": "SOFT", "notificationChannelIds": []}}}`)
+	server.Handle("GetAPIBudget", `{"apiBudget": {"id": "budget-1", "monthlyRequestLimit": 1000000, "monthlyCostLimit": null, "enforcement": "SOFT", "notificationChannelIds": []}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug":          types.StringValue("my-account"),
+		"monthly_request_limit": types.Int64Value(1000000),
+		"enforcement":           types.StringValue("SOFT"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "budget-1" {
+		t.Errorf("expected id budget-1, got %q", got)
+	}
+
+	server.Handle("GetAPIBudget", `{"apiBudget": {"id": "budget-1", "monthlyRequestLimit": 1000000, "monthlyCostLimit": null, "enforcement": "SOFT", "notificationChannelIds": []}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if _, ok := server.LastRequest("GetAPIBudget").Variables["graphSlug"]; ok {
+		t.Error("expected an account budget to be read without a graph slug")
+	}
+
+	server.Handle("SetAPIBudget", `{"apiBudgetSet": {"__typename": "ApiBudgetSetSuccess", "budget": {"id": "budget-1", "monthlyRequestLimit": 2000000, "monthlyCostLimit": null, "enforcement": "HARD", "notificationChannelIds": []}}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"monthly_request_limit": types.Int64Value(2000000),
+		"enforcement":           types.StringValue("HARD"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "enforcement"); got != "HARD" {
+		t.Errorf("expected enforcement HARD, got %q", got)
+	}
+
+	server.Handle("DeleteAPIBudget", `{"apiBudgetDelete": {"__typename": "ApiBudgetDeleteSuccess"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	server.Handle("GetAPIBudget", `{"apiBudget": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected budget to be removed from state")
+	}
+}
+
+func TestAccountAPIBudgetResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewAccountAPIBudgetResource)
+
+	server.Handle("GetAPIBudget", `{"apiBudget": {"id": "budget-1", "monthlyCostLimit": 100, "enforcement": "SOFT", "notificationChannelIds": []}}`)
+	state, diags := importResource(t, r, "my-account/my-graph")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "graph_slug"); got != "my-graph" {
+		t.Errorf("expected graph slug my-graph, got %q", got)
+	}
+	if got := server.LastRequest("GetAPIBudget").Variables["graphSlug"]; got != "my-graph" {
+		t.Errorf("expected graph budget lookup, got %v", got)
+	}
+}
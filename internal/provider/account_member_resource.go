@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccountMemberResource{}
+var _ resource.ResourceWithImportState = &AccountMemberResource{}
+
+func NewAccountMemberResource() resource.Resource {
+	return &AccountMemberResource{}
+}
+
+// AccountMemberResource defines the resource implementation.
+type AccountMemberResource struct {
+	client client.API
+}
+
+// AccountMemberResourceModel describes the resource data model.
+type AccountMemberResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	AccountSlug types.String `tfsdk:"account_slug"`
+	Email       types.String `tfsdk:"email"`
+	Role        types.String `tfsdk:"role"`
+	Pending     types.Bool   `tfsdk:"pending"`
+	JoinedAt    RFC3339Value `tfsdk:"joined_at"`
+}
+
+func (r *AccountMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_member"
+}
+
+func (r *AccountMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages a member of a Grafbase account. Creating the resource sends an invite with the given role; " +
+			"destroying it removes the member or revokes the pending invite.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Account member identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug the member belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address the invite is sent to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role of the member: `OWNER`, `ADMIN`, or `MEMBER`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.AccountMemberRoleOwner),
+						string(client.AccountMemberRoleAdmin),
+						string(client.AccountMemberRoleMember),
+					),
+				},
+			},
+			"pending": schema.BoolAttribute{
+				MarkdownDescription: "Whether the invite has not been accepted yet",
+				Computed:            true,
+			},
+			"joined_at": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "Timestamp when the member accepted the invite. Null while the invite is pending.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AccountMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AccountMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccountMemberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Invite the member
+	inviteInput := client.InviteAccountMemberInput{
+		AccountSlug: data.AccountSlug.ValueString(),
+		Email:       data.Email.ValueString(),
+		Role:        client.AccountMemberRole(data.Role.ValueString()),
+	}
+
+	member, err := r.client.InviteAccountMember(ctx, inviteInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to invite account member: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.fromMember(member)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitUntilCreated(ctx, "account member", func(ctx context.Context) error {
+		_, err := r.client.GetAccountMember(ctx, data.AccountSlug.ValueString(), data.Email.ValueString())
+		return err
+	}, "account member not found")...)
+}
+
+func (r *AccountMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccountMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.client.GetAccountMember(ctx, data.AccountSlug.ValueString(), data.Email.ValueString())
+	if err != nil {
+		// If the member is not found, remove it from state
+		if err.Error() == "account member not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read account member: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	data.fromMember(member)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccountMemberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the role can change in place
+	updateInput := client.UpdateAccountMemberRoleInput{
+		AccountSlug: data.AccountSlug.ValueString(),
+		MemberID:    data.ID.ValueString(),
+		Role:        client.AccountMemberRole(data.Role.ValueString()),
+	}
+
+	member, err := r.client.UpdateAccountMemberRole(ctx, updateInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update account member role: %s", err))
+		return
+	}
+
+	data.fromMember(member)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccountMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	removeInput := client.RemoveAccountMemberInput{
+		AccountSlug: data.AccountSlug.ValueString(),
+		MemberID:    data.ID.ValueString(),
+	}
+
+	err := r.client.RemoveAccountMember(ctx, removeInput)
+	if err != nil {
+		// If the member was already removed, there is nothing left to delete
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove account member: %s", err))
+		return
+	}
+}
+
+func (r *AccountMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/email"
+	accountSlug, email, err := parseImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/email', got: %s", req.ID))
+		return
+	}
+
+	// Get the member to populate the remaining attributes
+	member, err := r.client.GetAccountMember(ctx, accountSlug, email)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read account member during import: %s", err))
+		return
+	}
+
+	data := AccountMemberResourceModel{
+		AccountSlug: types.StringValue(accountSlug),
+		Email:       types.StringValue(email),
+	}
+	data.fromMember(member)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fromMember maps an API account member onto the model. The configured email
+// is kept as is, since the API may normalize its case.
+func (m *AccountMemberResourceModel) fromMember(member *client.AccountMember) {
+	m.ID = types.StringValue(member.ID)
+	m.Role = types.StringValue(string(member.Role))
+	m.Pending = types.BoolValue(member.Pending)
+
+	m.JoinedAt = NewRFC3339PointerValue(member.JoinedAt)
+}
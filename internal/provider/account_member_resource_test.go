@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAccountMemberResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAccountMemberResourceConfig("MEMBER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_account_member.test", "account_slug", "test-account"),
+					resource.TestCheckResourceAttr("grafbase_account_member.test", "email", "terraform-acc@example.com"),
+					resource.TestCheckResourceAttr("grafbase_account_member.test", "role", "MEMBER"),
+					resource.TestCheckResourceAttr("grafbase_account_member.test", "pending", "true"),
+					resource.TestCheckResourceAttrSet("grafbase_account_member.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_account_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-account/terraform-acc@example.com",
+			},
+			// Update the role in place
+			{
+				Config: testAccAccountMemberResourceConfig("ADMIN"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_account_member.test", "role", "ADMIN"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountMemberResourceConfig(role string) string {
+	return fmt.Sprintf(`
+resource "grafbase_account_member" "test" {
+  account_slug = "test-account"
+  email        = "terraform-acc@example.com"
+  role         = %[1]q
+}
+`, role)
+}
+
+func TestAccountMemberResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewAccountMemberResource)
+
+	server.Handle("InviteAccountMember", `{"accountMemberInvite": {"__typename": "AccountMemberInviteSuccess", "member": {"id": "member-2", "email": "invitee@example.com", "role": "MEMBER", "pending": true, "joinedAt": null}}}`)
+	server.Handle("ListAccountMembers", `{"accountBySlug": {"members": [
+		{"id": "member-2", "email": "Invitee@Example.com", "role": "MEMBER", "pending": false, "joinedAt": "2024-01-15T10:30:00Z"}
+	]}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"email":        types.StringValue("invitee@example.com"),
+		"role":         types.StringValue("MEMBER"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "member-2" {
+		t.Errorf("expected id member-2, got %q", got)
+	}
+
+	server.Handle("ListAccountMembers", `{"accountBySlug": {"members": [
+		{"id": "member-2", "email": "Invitee@Example.com", "role": "MEMBER", "pending": false, "joinedAt": "2024-01-15T10:30:00Z"}
+	]}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "email"); got != "invitee@example.com" {
+		t.Errorf("expected configured email to be kept, got %q", got)
+	}
+	if got := stateString(t, state, "joined_at"); got != "2024-01-15T10:30:00Z" {
+		t.Errorf("expected joined_at to be set, got %q", got)
+	}
+
+	server.Handle("UpdateAccountMemberRole", `{"accountMemberRoleUpdate": {"__typename": "AccountMemberRoleUpdateSuccess", "member": {"id": "member-2", "email": "invitee@example.com", "role": "ADMIN", "pending": false, "joinedAt": "2024-01-15T10:30:00Z"}}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"role": types.StringValue("ADMIN"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := server.LastRequest("UpdateAccountMemberRole").Variables["input"].(map[string]interface{})["memberId"]; got != "member-2" {
+		t.Errorf("expected role update of member-2, got %v", got)
+	}
+
+	server.Handle("RemoveAccountMember", `{"accountMemberRemove": {"__typename": "AccountMemberDoesNotExistError"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	server.Handle("ListAccountMembers", `{"accountBySlug": {"members": []}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected member to be removed from state")
+	}
+}
+
+func TestAccountMemberResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewAccountMemberResource)
+
+	server.Handle("ListAccountMembers", `{"accountBySlug": {"members": [
+		{"id": "member-1", "email": "owner@example.com", "role": "OWNER", "pending": false, "joinedAt": "2024-01-15T10:30:00Z"}
+	]}}`)
+	state, diags := importResource(t, r, "my-account/owner@example.com")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "role"); got != "OWNER" {
+		t.Errorf("expected role OWNER, got %q", got)
+	}
+
+	if _, diags := importResource(t, r, "my-account"); !diags.HasError() {
+		t.Error("expected invalid import ID to be an error")
+	}
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AccountMembersDataSource{}
+
+func NewAccountMembersDataSource() datasource.DataSource {
+	return &AccountMembersDataSource{}
+}
+
+// AccountMembersDataSource defines the data source implementation.
+type AccountMembersDataSource struct {
+	client client.API
+}
+
+// AccountMembersDataSourceModel describes the data source data model.
+type AccountMembersDataSourceModel struct {
+	ID          types.String         `tfsdk:"id"`
+	AccountSlug types.String         `tfsdk:"account_slug"`
+	Members     []AccountMemberModel `tfsdk:"members"`
+}
+
+// AccountMemberModel describes a single member of an account.
+type AccountMemberModel struct {
+	ID       types.String `tfsdk:"id"`
+	Email    types.String `tfsdk:"email"`
+	Role     types.String `tfsdk:"role"`
+	Pending  types.Bool   `tfsdk:"pending"`
+	JoinedAt RFC3339Value `tfsdk:"joined_at"`
+}
+
+func (d *AccountMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_members"
+}
+
+func (d *AccountMembersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the members of a Grafbase account with their roles, including pending invites.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier, equal to the account slug",
+				Computed:            true,
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug to list members for",
+				Required:            true,
+			},
+			"members": schema.ListNestedAttribute{
+				MarkdownDescription: "Members of the account",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Account member identifier",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "Email address of the member",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "Role of the member: `OWNER`, `ADMIN`, or `MEMBER`",
+							Computed:            true,
+						},
+						"pending": schema.BoolAttribute{
+							MarkdownDescription: "Whether the invite has not been accepted yet",
+							Computed:            true,
+						},
+						"joined_at": schema.StringAttribute{
+							CustomType:          RFC3339Type{},
+							MarkdownDescription: "Timestamp when the member joined. Null while the invite is pending.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AccountMembersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AccountMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AccountMembersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := d.client.ListAccountMembers(ctx, data.AccountSlug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read account members: %s", err))
+		return
+	}
+
+	data.ID = data.AccountSlug
+
+	data.Members = make([]AccountMemberModel, 0, len(members))
+	for _, member := range members {
+		memberModel := AccountMemberModel{
+			ID:       types.StringValue(member.ID),
+			Email:    types.StringValue(member.Email),
+			Role:     types.StringValue(string(member.Role)),
+			Pending:  types.BoolValue(member.Pending),
+			JoinedAt: NewRFC3339PointerValue(member.JoinedAt),
+		}
+
+		data.Members = append(data.Members, memberModel)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
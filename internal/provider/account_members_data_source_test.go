@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAccountMembersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountMembersDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafbase_account_members.test", "id", "test-account"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.grafbase_account_members.test", "members.*", map[string]string{
+						"email":   "terraform-acc@example.com",
+						"role":    "MEMBER",
+						"pending": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountMembersDataSourceConfig() string {
+	return `
+resource "grafbase_account_member" "test" {
+  account_slug = "test-account"
+  email        = "terraform-acc@example.com"
+  role         = "MEMBER"
+}
+
+data "grafbase_account_members" "test" {
+  account_slug = grafbase_account_member.test.account_slug
+
+  depends_on = [grafbase_account_member.test]
+}
+`
+}
+
+func TestAccountMembersDataSourceRead(t *testing.T) {
+	d, server := newMockDataSource(t, NewAccountMembersDataSource)
+
+	server.Handle("ListAccountMembers", `{"accountBySlug": {"members": [
+		{"id": "member-1", "email": "owner@example.com", "role": "OWNER", "pending": false, "joinedAt": "2024-01-15T10:30:00Z"},
+		{"id": "member-2", "email": "invitee@example.com", "role": "MEMBER", "pending": true, "joinedAt": null}
+	]}}`)
+	state, diags := readDataSource(t, d, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "my-account" {
+		t.Errorf("expected id my-account, got %q", got)
+	}
+
+	server.Handle("ListAccountMembers", `{"accountBySlug": null}`)
+	if _, diags := readDataSource(t, d, map[string]attr.Value{
+		"account_slug": types.StringValue("missing"),
+	}); !diags.HasError() {
+		t.Error("expected a missing account to be an error")
+	}
+}
@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &APIKeyResource{}
+var _ resource.ResourceWithImportState = &APIKeyResource{}
+
+func NewAPIKeyResource() resource.Resource {
+	return &APIKeyResource{}
+}
+
+// APIKeyResource defines the resource implementation.
+type APIKeyResource struct {
+	client client.API
+}
+
+// APIKeyResourceModel describes the resource data model.
+type APIKeyResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	AccountSlug   types.String `tfsdk:"account_slug"`
+	Name          types.String `tfsdk:"name"`
+	Scopes        types.Set    `tfsdk:"scopes"`
+	ExpiresInDays types.Int64  `tfsdk:"expires_in_days"`
+	Keepers       types.Map    `tfsdk:"keepers"`
+	Token         types.String `tfsdk:"token"`
+	CreatedAt     RFC3339Value `tfsdk:"created_at"`
+	ExpiresAt     RFC3339Value `tfsdk:"expires_at"`
+	LastUsedAt    RFC3339Value `tfsdk:"last_used_at"`
+}
+
+func (r *APIKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *APIKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages an access token of an account. The token secret is only available after creation, " +
+			"so changing any argument, including `keepers`, revokes the token and creates a new one.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "API key identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug the token grants access to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the token, shown in the dashboard",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scopes": schema.SetAttribute{
+				MarkdownDescription: "Scopes granted to the token. When unset, the token is granted every scope allowed by the account token policy.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_in_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days the token is valid. When unset, the token does not expire unless the account token policy requires it.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"keepers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that rotate the token when changed, for example a timestamp from the " +
+					"`time_rotating` resource. The values are not sent to Grafbase.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Token secret. Not available for imported tokens.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "Token creation timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "Token expiry timestamp, or null when the token does not expire",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_used_at": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "Timestamp of the last request made with the token, or null when it was never used. Refreshed on every read.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *APIKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *APIKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data APIKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createInput := client.CreateAPIKeyInput{
+		AccountSlug:   data.AccountSlug.ValueString(),
+		Name:          data.Name.ValueString(),
+		ExpiresInDays: data.ExpiresInDays.ValueInt64Pointer(),
+	}
+	if !data.Scopes.IsNull() && !data.Scopes.IsUnknown() {
+		resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &createInput.Scopes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	key, token, err := r.client.CreateAPIKey(ctx, createInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create API key: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.Token = types.StringValue(token)
+	resp.Diagnostics.Append(data.fromAPIKey(ctx, key)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitUntilCreated(ctx, "API key", func(ctx context.Context) error {
+		_, err := r.client.GetAPIKey(ctx, data.ID.ValueString())
+		return err
+	}, "API key not found")...)
+}
+
+func (r *APIKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data APIKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := r.client.GetAPIKey(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the key was revoked outside Terraform, create a new one
+		if err.Error() == "API key not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API key: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	resp.Diagnostics.Append(data.fromAPIKey(ctx, key)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APIKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data APIKeyResourceModel
+
+	// Every argument requires replacement, so the plan only carries over the prior state
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APIKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data APIKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RevokeAPIKey(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the key is already gone, there is nothing left to revoke
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke API key: %s", err))
+		return
+	}
+}
+
+func (r *APIKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/key_id"
+	accountSlug, keyID, err := parseImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/key_id', got: %s", req.ID))
+		return
+	}
+
+	// Get the key to populate the remaining attributes
+	key, err := r.client.GetAPIKey(ctx, keyID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read API key during import: %s", err))
+		return
+	}
+
+	// The secret and the keepers cannot be recovered from the API
+	data := APIKeyResourceModel{
+		AccountSlug:   types.StringValue(accountSlug),
+		ExpiresInDays: types.Int64Null(),
+		Keepers:       types.MapNull(types.StringType),
+		Token:         types.StringNull(),
+	}
+	resp.Diagnostics.Append(data.fromAPIKey(ctx, key)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fromAPIKey maps an API key onto the model. An empty scope list grants every
+// allowed scope and is kept null, so omitting the attribute does not cause a diff.
+func (m *APIKeyResourceModel) fromAPIKey(ctx context.Context, key *client.APIKey) diag.Diagnostics {
+	m.ID = types.StringValue(key.ID)
+	m.Name = types.StringValue(key.Name)
+	m.CreatedAt = NewRFC3339Value(key.CreatedAt)
+	m.ExpiresAt = NewRFC3339PointerValue(key.ExpiresAt)
+	m.LastUsedAt = NewRFC3339PointerValue(key.LastUsedAt)
+
+	if len(key.Scopes) == 0 {
+		m.Scopes = types.SetNull(types.StringType)
+		return nil
+	}
+
+	scopes, diags := types.SetValueFrom(ctx, types.StringType, key.Scopes)
+	m.Scopes = scopes
+
+	return diags
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAPIKeyResource(t *testing.T) {
+	var firstToken string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAPIKeyResourceConfig("2024-01"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_api_key.test", "name", "terraform-test"),
+					resource.TestCheckResourceAttr("grafbase_api_key.test", "scopes.#", "1"),
+					resource.TestCheckResourceAttrSet("grafbase_api_key.test", "token"),
+					resource.TestCheckResourceAttrSet("grafbase_api_key.test", "created_at"),
+					resource.TestCheckResourceAttrSet("grafbase_api_key.test", "expires_at"),
+					resource.TestCheckResourceAttrWith("grafbase_api_key.test", "token", func(value string) error {
+						firstToken = value
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "grafbase_api_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     "test-account/",
+				ImportStateVerifyIgnore: []string{"token", "keepers", "expires_in_days"},
+			},
+			// Changing the keepers rotates the token
+			{
+				Config: testAccAPIKeyResourceConfig("2024-02"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("grafbase_api_key.test", "token", func(value string) error {
+						if value == firstToken {
+							return fmt.Errorf("expected the token to be rotated")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccAPIKeyResourceConfig(rotation string) string {
+	return fmt.Sprintf(`
+resource "grafbase_api_key" "test" {
+  account_slug    = "test-account"
+  name            = "terraform-test"
+  scopes          = ["schema:publish"]
+  expires_in_days = 30
+
+  keepers = {
+    rotation = %[1]q
+  }
+}
+`, rotation)
+}
+
+func TestAPIKeyResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewAPIKeyResource)
+
+	server.Handle("CreateAPIKey", `{"apiKeyCreate": {"__typename": "ApiKeyCreateSuccess", "apiKey": {
+		"id": "key-1", "name": "ci", "scopes": ["schema:publish"], "createdAt": "2024-01-15T10:30:00Z",
+		"expiresAt": "2024-02-14T10:30:00Z", "lastUsedAt": null
+	}, "token": "gb_secret"}}`)
+	server.Handle("GetAPIKey", `{"node": {
+		"id": "key-1", "name": "ci", "scopes": ["schema:publish"], "createdAt": "2024-01-15T10:30:00Z",
+		"expiresAt": "2024-02-14T10:30:00Z", "lastUsedAt": "2024-01-20T08:00:00Z"
+	}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug":    types.StringValue("my-account"),
+		"name":            types.StringValue("ci"),
+		"scopes":          types.SetValueMust(types.StringType, []attr.Value{types.StringValue("schema:publish")}),
+		"expires_in_days": types.Int64Value(30),
+		"keepers":         types.MapValueMust(types.StringType, map[string]attr.Value{"rotation": types.StringValue("2024-01")}),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "token"); got != "gb_secret" {
+		t.Errorf("expected token gb_secret, got %q", got)
+	}
+	if got := stateString(t, state, "last_used_at"); got != "" {
+		t.Errorf("expected last_used_at to be null, got %q", got)
+	}
+
+	// Keepers only drive replacement and are never sent to the API
+	if _, ok := server.LastRequest("CreateAPIKey").Variables["input"].(map[string]interface{})["keepers"]; ok {
+		t.Error("expected keepers not to be sent")
+	}
+
+	// Reading refreshes the usage timestamp and keeps the secret
+	server.Handle("GetAPIKey", `{"node": {
+		"id": "key-1", "name": "ci", "scopes": ["schema:publish"], "createdAt": "2024-01-15T10:30:00Z",
+		"expiresAt": "2024-02-14T10:30:00Z", "lastUsedAt": "2024-01-20T08:00:00Z"
+	}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "last_used_at"); got != "2024-01-20T08:00:00Z" {
+		t.Errorf("unexpected last_used_at %q", got)
+	}
+	if got := stateString(t, state, "token"); got != "gb_secret" {
+		t.Errorf("expected token to be kept, got %q", got)
+	}
+
+	server.Handle("RevokeAPIKey", `{"apiKeyRevoke": {"__typename": "ApiKeyRevokeSuccess"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+	if got := server.LastRequest("RevokeAPIKey").Variables["input"].(map[string]interface{})["id"]; got != "key-1" {
+		t.Errorf("expected key-1 to be revoked, got %v", got)
+	}
+
+	server.Handle("GetAPIKey", `{"node": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected API key to be removed from state")
+	}
+}
+
+func TestAPIKeyResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewAPIKeyResource)
+
+	server.Handle("GetAPIKey", `{"node": {
+		"id": "key-1", "name": "ci", "scopes": [], "createdAt": "2024-01-15T10:30:00Z",
+		"expiresAt": null, "lastUsedAt": null
+	}}`)
+	state, diags := importResource(t, r, "my-account/key-1")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "account_slug"); got != "my-account" {
+		t.Errorf("expected account_slug my-account, got %q", got)
+	}
+	if got := stateString(t, state, "token"); got != "" {
+		t.Errorf("expected token to be null, got %q", got)
+	}
+
+	if _, diags := importResource(t, r, "key-1"); !diags.HasError() {
+		t.Error("expected invalid import ID to be an error")
+	}
+}
@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AuditLogsDataSource{}
+
+func NewAuditLogsDataSource() datasource.DataSource {
+	return &AuditLogsDataSource{}
+}
+
+// AuditLogsDataSource defines the data source implementation.
+type AuditLogsDataSource struct {
+	client client.API
+}
+
+// AuditLogsDataSourceModel describes the data source data model.
+type AuditLogsDataSourceModel struct {
+	ID          types.String         `tfsdk:"id"`
+	AccountSlug types.String         `tfsdk:"account_slug"`
+	Since       types.String         `tfsdk:"since"`
+	Until       types.String         `tfsdk:"until"`
+	Actions     types.Set            `tfsdk:"actions"`
+	Entries     []AuditLogEntryModel `tfsdk:"entries"`
+}
+
+// AuditLogEntryModel describes a single audit log entry.
+type AuditLogEntryModel struct {
+	ID           types.String `tfsdk:"id"`
+	Action       types.String `tfsdk:"action"`
+	ActorID      types.String `tfsdk:"actor_id"`
+	ActorEmail   types.String `tfsdk:"actor_email"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	ResourceID   types.String `tfsdk:"resource_id"`
+	ResourceName types.String `tfsdk:"resource_name"`
+	CreatedAt    RFC3339Value `tfsdk:"created_at"`
+}
+
+func (d *AuditLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_logs"
+}
+
+func (d *AuditLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the audit log entries of a Grafbase account, such as who created or deleted graphs and branches, " +
+			"optionally narrowed to a time range and a set of actions.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier, equal to the account slug",
+				Computed:            true,
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug to list audit log entries for",
+				Required:            true,
+			},
+			"since": schema.StringAttribute{
+				MarkdownDescription: "Only return entries recorded at or after this RFC3339 timestamp",
+				Optional:            true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"until": schema.StringAttribute{
+				MarkdownDescription: "Only return entries recorded before this RFC3339 timestamp",
+				Optional:            true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"actions": schema.SetAttribute{
+				MarkdownDescription: "Only return entries with one of these actions, such as `GRAPH_CREATED` or `BRANCH_DELETED`. " +
+					"When unset, entries of every action are returned.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching audit log entries, newest first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Audit log entry identifier",
+							Computed:            true,
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "Audited action, such as `GRAPH_CREATED` or `BRANCH_DELETED`",
+							Computed:            true,
+						},
+						"actor_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the user or access token that performed the action",
+							Computed:            true,
+						},
+						"actor_email": schema.StringAttribute{
+							MarkdownDescription: "Email address of the user that performed the action. Null for actions performed with an access token.",
+							Computed:            true,
+						},
+						"resource_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the affected resource, such as `GRAPH` or `BRANCH`",
+							Computed:            true,
+						},
+						"resource_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the affected resource",
+							Computed:            true,
+						},
+						"resource_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the affected resource at the time of the action, when it has one",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							CustomType:          RFC3339Type{},
+							MarkdownDescription: "Timestamp when the action was performed",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AuditLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AuditLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AuditLogsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter client.AuditLogFilter
+
+	// The validators guarantee both timestamps parse
+	if !data.Since.IsNull() {
+		since, _ := time.Parse(time.RFC3339, data.Since.ValueString())
+		filter.Since = &since
+	}
+	if !data.Until.IsNull() {
+		until, _ := time.Parse(time.RFC3339, data.Until.ValueString())
+		filter.Until = &until
+	}
+	if filter.Since != nil && filter.Until != nil && !filter.Since.Before(*filter.Until) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("until"),
+			"Invalid Time Range",
+			fmt.Sprintf("until must be after since, got since %s and until %s.", data.Since.ValueString(), data.Until.ValueString()),
+		)
+		return
+	}
+
+	if !data.Actions.IsNull() {
+		resp.Diagnostics.Append(data.Actions.ElementsAs(ctx, &filter.Actions, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	entries, err := d.client.ListAuditLogs(ctx, data.AccountSlug.ValueString(), filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read audit logs: %s", err))
+		return
+	}
+
+	data.ID = data.AccountSlug
+
+	data.Entries = make([]AuditLogEntryModel, 0, len(entries))
+	for _, entry := range entries {
+		entryModel := AuditLogEntryModel{
+			ID:           types.StringValue(entry.ID),
+			Action:       types.StringValue(entry.Action),
+			ActorID:      types.StringValue(entry.Actor.ID),
+			ActorEmail:   types.StringPointerValue(entry.Actor.Email),
+			ResourceType: types.StringValue(entry.ResourceType),
+			ResourceID:   types.StringValue(entry.ResourceID),
+			ResourceName: types.StringPointerValue(entry.ResourceName),
+			CreatedAt:    NewRFC3339Value(entry.CreatedAt),
+		}
+
+		data.Entries = append(data.Entries, entryModel)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
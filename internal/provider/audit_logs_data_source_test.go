@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAuditLogsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuditLogsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafbase_audit_logs.test", "id", "test-account"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.grafbase_audit_logs.test", "entries.*", map[string]string{
+						"action":        "GRAPH_CREATED",
+						"resource_type": "GRAPH",
+						"resource_name": "test-audit-logs",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccAuditLogsDataSourceConfig() string {
+	return `
+resource "grafbase_graph" "test" {
+  account_slug = "test-account"
+  slug         = "test-audit-logs"
+}
+
+data "grafbase_audit_logs" "test" {
+  account_slug = grafbase_graph.test.account_slug
+  since        = grafbase_graph.test.created_at
+  actions      = ["GRAPH_CREATED"]
+}
+`
+}
+
+func TestAuditLogsDataSourceRead(t *testing.T) {
+	d, server := newMockDataSource(t, NewAuditLogsDataSource)
+
+	server.Handle("ListAuditLogs", `{"accountBySlug": {"auditLogs": {
+		"edges": [
+			{"node": {"id": "log-2", "action": "BRANCH_DELETED", "actor": {"id": "user-1", "email": "jane@example.com"},
+				"resourceType": "BRANCH", "resourceId": "branch-1", "resourceName": "feature", "createdAt": "2024-03-02T10:00:00Z"}},
+			{"node": {"id": "log-1", "action": "GRAPH_CREATED", "actor": {"id": "token-1", "email": null},
+				"resourceType": "GRAPH", "resourceId": "graph-1", "resourceName": "my-graph", "createdAt": "2024-03-01T10:00:00Z"}}
+		],
+		"pageInfo": {"hasNextPage": false, "endCursor": null}
+	}}}`)
+	state, diags := readDataSource(t, d, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"since":        types.StringValue("2024-03-01T00:00:00Z"),
+		"actions":      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("GRAPH_CREATED"), types.StringValue("BRANCH_DELETED")}),
+	})
+	requireNoDiagnostics(t, diags)
+
+	var entries []AuditLogEntryModel
+	requireNoDiagnostics(t, state.GetAttribute(context.Background(), path.Root("entries"), &entries))
+	if len(entries) != 2 || entries[0].ActorEmail.ValueString() != "jane@example.com" || !entries[1].ActorEmail.IsNull() {
+		t.Errorf("unexpected entries: %+v", entries)
+	}
+
+	filter, _ := server.LastRequest("ListAuditLogs").Variables["filter"].(map[string]interface{})
+	if filter["since"] != "2024-03-01T00:00:00Z" || len(filter["actions"].([]interface{})) != 2 {
+		t.Errorf("unexpected filter: %v", filter)
+	}
+
+	if _, diags := readDataSource(t, d, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"since":        types.StringValue("2024-03-02T00:00:00Z"),
+		"until":        types.StringValue("2024-03-01T00:00:00Z"),
+	}); !diags.HasError() {
+		t.Error("expected an inverted time range to be an error")
+	}
+
+	server.Handle("ListAuditLogs", `{"accountBySlug": null}`)
+	if _, diags := readDataSource(t, d, map[string]attr.Value{
+		"account_slug": types.StringValue("missing"),
+	}); !diags.HasError() {
+		t.Error("expected a missing account to be an error")
+	}
+}
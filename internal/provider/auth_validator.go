@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// authEnvVars are the environment variables that can supply credentials when
+// no authentication attribute is configured.
+var authEnvVars = []string{
+	"GRAFBASE_API_KEY",
+	"GRAFBASE_API_KEY_FILE",
+	"GRAFBASE_API_KEY_COMMAND",
+	"GRAFBASE_OIDC_TOKEN",
+	"GRAFBASE_OIDC_TOKEN_FILE",
+}
+
+var _ provider.ConfigValidator = authMethodsValidator{}
+
+// authMethodsValidator ensures at most one authentication method is configured,
+// and that credentials are available from the configuration or environment.
+type authMethodsValidator struct{}
+
+func (v authMethodsValidator) Description(ctx context.Context) string {
+	return "only one of api_key, api_key_file, api_key_command, oidc_token, or oidc_token_file can be set, and credentials must be configured or set in the environment"
+}
+
+func (v authMethodsValidator) MarkdownDescription(ctx context.Context) string {
+	return "only one of `api_key`, `api_key_file`, `api_key_command`, `oidc_token`, or `oidc_token_file` can be set, and credentials must be configured or set in the environment"
+}
+
+func (v authMethodsValidator) ValidateProvider(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var data GrafbaseProviderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateAuthMethods(data)...)
+}
+
+// validateAuthMethods reports conflicting or missing authentication settings.
+// Values that are unknown during validation are skipped, since they may be set
+// or unset once known.
+func validateAuthMethods(data GrafbaseProviderModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	attributes := []struct {
+		name  string
+		value attr.Value
+	}{
+		{"api_key", data.APIKey},
+		{"api_key_file", data.APIKeyFile},
+		{"api_key_command", data.APIKeyCommand},
+		{"oidc_token", data.OIDCToken},
+		{"oidc_token_file", data.OIDCTokenFile},
+	}
+
+	var configured []string
+	for _, attribute := range attributes {
+		if attribute.value.IsUnknown() {
+			return diags
+		}
+		if !attribute.value.IsNull() {
+			configured = append(configured, attribute.name)
+		}
+	}
+
+	if len(configured) > 1 {
+		diags.AddAttributeError(
+			path.Root(configured[1]),
+			"Conflicting Authentication Methods",
+			fmt.Sprintf("Only one of api_key, api_key_file, api_key_command, oidc_token, or oidc_token_file can be set, got: %s.", strings.Join(configured, ", ")),
+		)
+		return diags
+	}
+
+	if len(configured) == 1 {
+		return diags
+	}
+
+	for _, envVar := range authEnvVars {
+		if os.Getenv(envVar) != "" {
+			return diags
+		}
+	}
+
+	diags.AddError(
+		"Missing Authentication",
+		"No Grafbase credentials are configured. Set one of api_key, api_key_file, api_key_command, oidc_token, or oidc_token_file "+
+			"in the provider configuration, or one of the "+strings.Join(authEnvVars, ", ")+" environment variables.",
+	)
+
+	return diags
+}
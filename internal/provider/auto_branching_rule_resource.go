@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AutoBranchingRuleResource{}
+var _ resource.ResourceWithImportState = &AutoBranchingRuleResource{}
+
+func NewAutoBranchingRuleResource() resource.Resource {
+	return &AutoBranchingRuleResource{}
+}
+
+// AutoBranchingRuleResource defines the resource implementation.
+type AutoBranchingRuleResource struct {
+	client client.API
+}
+
+// AutoBranchingRuleResourceModel describes the resource data model.
+type AutoBranchingRuleResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	AccountSlug   types.String `tfsdk:"account_slug"`
+	GraphSlug     types.String `tfsdk:"graph_slug"`
+	Pattern       types.String `tfsdk:"pattern"`
+	TTLHours      types.Int64  `tfsdk:"ttl_hours"`
+	DeleteOnMerge types.Bool   `tfsdk:"delete_on_merge"`
+}
+
+func (r *AutoBranchingRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auto_branching_rule"
+}
+
+func (r *AutoBranchingRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Creates preview branches of a graph automatically for git branches matching a pattern, " +
+			"so the preview environment policy of a graph lives in Terraform.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Auto-branching rule identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph whose preview branches the rule creates",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"pattern": schema.StringAttribute{
+				MarkdownDescription: "Glob matched against git branch names, such as `feature/*`. A preview branch is created " +
+					"for each matching git branch on its first push.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"ttl_hours": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Hours after their last deployment at which preview branches created by the rule are deleted, at most `%d`. "+
+					"Omit to keep them until they are merged or deleted explicitly.", maxDefaultBranchTTLHours),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxDefaultBranchTTLHours),
+				},
+			},
+			"delete_on_merge": schema.BoolAttribute{
+				MarkdownDescription: "Whether preview branches created by the rule are deleted when their git branch is merged. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *AutoBranchingRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AutoBranchingRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AutoBranchingRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createInput := client.CreateAutoBranchingRuleInput{
+		AccountSlug:   data.AccountSlug.ValueString(),
+		GraphSlug:     data.GraphSlug.ValueString(),
+		Pattern:       data.Pattern.ValueString(),
+		TTLHours:      data.TTLHours.ValueInt64Pointer(),
+		DeleteOnMerge: data.DeleteOnMerge.ValueBool(),
+	}
+
+	rule, err := r.client.CreateAutoBranchingRule(ctx, createInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create auto-branching rule: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.fromRule(rule)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitUntilCreated(ctx, "auto-branching rule", func(ctx context.Context) error {
+		_, err := r.client.GetAutoBranchingRule(ctx, data.ID.ValueString())
+		return err
+	}, "auto-branching rule not found")...)
+}
+
+func (r *AutoBranchingRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AutoBranchingRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.GetAutoBranchingRule(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the rule is not found, remove it from state
+		if err.Error() == "auto-branching rule not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read auto-branching rule: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	data.fromRule(rule)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AutoBranchingRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AutoBranchingRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateInput := client.UpdateAutoBranchingRuleInput{
+		ID:            data.ID.ValueString(),
+		Pattern:       data.Pattern.ValueString(),
+		TTLHours:      data.TTLHours.ValueInt64Pointer(),
+		DeleteOnMerge: data.DeleteOnMerge.ValueBool(),
+	}
+
+	rule, err := r.client.UpdateAutoBranchingRule(ctx, updateInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update auto-branching rule: %s", err))
+		return
+	}
+
+	data.fromRule(rule)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AutoBranchingRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AutoBranchingRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAutoBranchingRule(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the rule is already gone, there is nothing left to delete
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete auto-branching rule: %s", err))
+		return
+	}
+}
+
+func (r *AutoBranchingRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug/rule_id"
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[2] == "" {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/rule_id', got: %s", req.ID))
+		return
+	}
+
+	// Get the rule to populate the remaining attributes
+	rule, err := r.client.GetAutoBranchingRule(ctx, parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read auto-branching rule during import: %s", err))
+		return
+	}
+
+	data := AutoBranchingRuleResourceModel{
+		AccountSlug: types.StringValue(parts[0]),
+		GraphSlug:   types.StringValue(parts[1]),
+	}
+	data.fromRule(rule)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fromRule maps an API auto-branching rule onto the model
+func (m *AutoBranchingRuleResourceModel) fromRule(rule *client.AutoBranchingRule) {
+	m.ID = types.StringValue(rule.ID)
+	m.Pattern = types.StringValue(rule.Pattern)
+	m.TTLHours = types.Int64PointerValue(rule.TTLHours)
+	m.DeleteOnMerge = types.BoolValue(rule.DeleteOnMerge)
+}
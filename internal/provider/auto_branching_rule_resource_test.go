@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAutoBranchingRuleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAutoBranchingRuleResourceConfig(72),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("grafbase_auto_branching_rule.test", "id"),
+					resource.TestCheckResourceAttr("grafbase_auto_branching_rule.test", "ttl_hours", "72"),
+					resource.TestCheckResourceAttr("grafbase_auto_branching_rule.test", "delete_on_merge", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_auto_branching_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["grafbase_auto_branching_rule.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found: grafbase_auto_branching_rule.test")
+					}
+					return fmt.Sprintf("test-account/test-graph/%s", rs.Primary.ID), nil
+				},
+			},
+			// Update in place
+			{
+				Config: testAccAutoBranchingRuleResourceConfig(24),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_auto_branching_rule.test", "ttl_hours", "24"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAutoBranchingRuleResourceConfig(ttlHours int) string {
+	return fmt.Sprintf(`
+resource "grafbase_auto_branching_rule" "test" {
+  account_slug = "test-account"
+  graph_slug   = "test-graph"
+  pattern      = "feature/*"
+  ttl_hours    = %[1]d
+}
+`, ttlHours)
+}
+
+func TestAutoBranchingRuleResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewAutoBranchingRuleResource)
+
+	server.Handle("CreateAutoBranchingRule", `{"autoBranchingRuleCreate": {"__typename": "AutoBranchingRuleCreateSuccess",
+		"autoBranchingRule": {"id": "rule-1", "pattern": "feature/*", "ttlHours": null, "deleteOnMerge": true}}}`)
+	server.Handle("GetAutoBranchingRule", `{"node": {"id": "rule-1", "pattern": "feature/*", "ttlHours": null, "deleteOnMerge": true}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug":    types.StringValue("my-account"),
+		"graph_slug":      types.StringValue("my-graph"),
+		"pattern":         types.StringValue("feature/*"),
+		"delete_on_merge": types.BoolValue(true),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "rule-1" {
+		t.Errorf("expected id rule-1, got %q", got)
+	}
+	var data AutoBranchingRuleResourceModel
+	requireNoDiagnostics(t, state.Get(t.Context(), &data))
+	if !data.TTLHours.IsNull() {
+		t.Errorf("expected ttl_hours to stay null, got %s", data.TTLHours)
+	}
+
+	server.Handle("GetAutoBranchingRule", `{"node": {"id": "rule-1", "pattern": "feature/*", "ttlHours": null, "deleteOnMerge": true}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+
+	server.Handle("UpdateAutoBranchingRule", `{"autoBranchingRuleUpdate": {"__typename": "AutoBranchingRuleUpdateSuccess",
+		"autoBranchingRule": {"id": "rule-1", "pattern": "feature/*", "ttlHours": 24, "deleteOnMerge": false}}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"ttl_hours":       types.Int64Value(24),
+		"delete_on_merge": types.BoolValue(false),
+	})
+	requireNoDiagnostics(t, diags)
+	var input map[string]interface{}
+	if err := server.LastRequest("UpdateAutoBranchingRule").Input(&input); err != nil {
+		t.Fatalf("failed to decode input: %v", err)
+	}
+	if input["id"] != "rule-1" || input["ttlHours"] != float64(24) || input["deleteOnMerge"] != false {
+		t.Errorf("unexpected update input: %v", input)
+	}
+
+	server.Handle("DeleteAutoBranchingRule", `{"autoBranchingRuleDelete": {"__typename": "AutoBranchingRuleDoesNotExistError"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	server.Handle("GetAutoBranchingRule", `{"node": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected auto-branching rule to be removed from state")
+	}
+}
+
+func TestAutoBranchingRuleResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewAutoBranchingRuleResource)
+
+	server.Handle("GetAutoBranchingRule", `{"node": {"id": "rule-1", "pattern": "release/*", "ttlHours": 72, "deleteOnMerge": true}}`)
+	state, diags := importResource(t, r, "my-account/my-graph/rule-1")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "pattern"); got != "release/*" {
+		t.Errorf("expected pattern release/*, got %q", got)
+	}
+
+	if _, diags := importResource(t, r, "rule-1"); !diags.HasError() {
+		t.Error("expected invalid import ID to be an error")
+	}
+}
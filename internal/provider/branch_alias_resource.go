@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BranchAliasResource{}
+var _ resource.ResourceWithImportState = &BranchAliasResource{}
+
+// branchAliasInputAttributes maps the fields of the branch alias mutation
+// inputs to the attributes they are configured by.
+var branchAliasInputAttributes = map[string]path.Path{
+	"accountSlug": path.Root("account_slug"),
+	"graphSlug":   path.Root("graph_slug"),
+	"branchName":  path.Root("branch_name"),
+}
+
+func NewBranchAliasResource() resource.Resource {
+	return &BranchAliasResource{}
+}
+
+// BranchAliasResource defines the resource implementation.
+type BranchAliasResource struct {
+	client client.API
+}
+
+// BranchAliasResourceModel describes the resource data model.
+type BranchAliasResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	AccountSlug types.String `tfsdk:"account_slug"`
+	GraphSlug   types.String `tfsdk:"graph_slug"`
+	Name        types.String `tfsdk:"name"`
+	BranchName  types.String `tfsdk:"branch_name"`
+}
+
+func (r *BranchAliasResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch_alias"
+}
+
+func (r *BranchAliasResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages a stable name, such as `staging`, that resolves to a branch of a graph. " +
+			"Changing `branch_name` repoints the alias in place, so cutting over to a new branch is a single apply.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Branch alias identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug the alias belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Alias name. It must not be the name of an existing branch of the graph.",
+				Required:            true,
+				Validators:          branchNameValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the branch the alias points at",
+				Required:            true,
+				Validators:          branchNameValidators(),
+			},
+		},
+	}
+}
+
+func (r *BranchAliasResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BranchAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BranchAliasResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias, err := r.client.SetBranchAlias(ctx, data.setInput())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "create branch alias", err, branchAliasInputAttributes)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.fromBranchAlias(alias)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitUntilCreated(ctx, "branch alias", func(ctx context.Context) error {
+		_, err := r.client.GetBranchAlias(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.Name.ValueString())
+		return err
+	}, "branch alias not found")...)
+}
+
+func (r *BranchAliasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BranchAliasResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias, err := r.client.GetBranchAlias(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// If the alias is not found, remove it from state
+		if err.Error() == "branch alias not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read branch alias: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	data.fromBranchAlias(alias)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BranchAliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BranchAliasResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Setting an existing alias repoints it without a window where it does not resolve
+	alias, err := r.client.SetBranchAlias(ctx, data.setInput())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "update branch alias", err, branchAliasInputAttributes)
+		return
+	}
+
+	data.fromBranchAlias(alias)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BranchAliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BranchAliasResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteInput := client.DeleteBranchAliasInput{
+		AccountSlug: data.AccountSlug.ValueString(),
+		GraphSlug:   data.GraphSlug.ValueString(),
+		Name:        data.Name.ValueString(),
+	}
+
+	err := r.client.DeleteBranchAlias(ctx, deleteInput)
+	if err != nil {
+		// If the alias doesn't exist, consider it already deleted
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete branch alias: %s", err))
+		return
+	}
+}
+
+func (r *BranchAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug/name"
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/name', got: %s", req.ID))
+		return
+	}
+
+	alias, err := r.client.GetBranchAlias(ctx, parts[0], parts[1], parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read branch alias during import: %s", err))
+		return
+	}
+
+	data := BranchAliasResourceModel{
+		AccountSlug: types.StringValue(parts[0]),
+		GraphSlug:   types.StringValue(parts[1]),
+	}
+	data.fromBranchAlias(alias)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setInput builds the client input for creating or repointing the alias.
+func (m BranchAliasResourceModel) setInput() client.SetBranchAliasInput {
+	return client.SetBranchAliasInput{
+		AccountSlug: m.AccountSlug.ValueString(),
+		GraphSlug:   m.GraphSlug.ValueString(),
+		Name:        m.Name.ValueString(),
+		BranchName:  m.BranchName.ValueString(),
+	}
+}
+
+// fromBranchAlias maps an API branch alias onto the model.
+func (m *BranchAliasResourceModel) fromBranchAlias(alias *client.BranchAlias) {
+	m.ID = types.StringValue(alias.ID)
+	m.Name = types.StringValue(alias.Name)
+	m.BranchName = types.StringValue(alias.BranchName)
+}
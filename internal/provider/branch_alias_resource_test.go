@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBranchAliasResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccBranchAliasResourceConfig("grafbase_branch.blue.name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_branch_alias.test", "name", "staging"),
+					resource.TestCheckResourceAttr("grafbase_branch_alias.test", "branch_name", "blue"),
+					resource.TestCheckResourceAttrSet("grafbase_branch_alias.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_branch_alias.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-account/test-graph/staging",
+			},
+			// Cut over to another branch in place
+			{
+				Config: testAccBranchAliasResourceConfig("grafbase_branch.green.name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_branch_alias.test", "branch_name", "green"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBranchAliasResourceConfig(branch string) string {
+	return fmt.Sprintf(`
+resource "grafbase_graph" "test" {
+  account_slug = "test-account"
+  slug         = "test-graph"
+}
+
+resource "grafbase_branch" "blue" {
+  account_slug = grafbase_graph.test.account_slug
+  graph_slug   = grafbase_graph.test.slug
+  name         = "blue"
+}
+
+resource "grafbase_branch" "green" {
+  account_slug = grafbase_graph.test.account_slug
+  graph_slug   = grafbase_graph.test.slug
+  name         = "green"
+}
+
+resource "grafbase_branch_alias" "test" {
+  account_slug = grafbase_graph.test.account_slug
+  graph_slug   = grafbase_graph.test.slug
+  name         = "staging"
+  branch_name  = %s
+}
+`, branch)
+}
+
+func TestBranchAliasResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewBranchAliasResource)
+
+	server.Handle("SetBranchAlias", `{"branchAliasSet": {"__typename": "BranchAliasSetSuccess", "branchAlias": {"id": "alias-1", "name": "staging", "branchName": "blue"}}}`)
+	server.Handle("GetBranchAlias", `{"graphByAccountSlug": {"branchAlias": {"id": "alias-1", "name": "staging", "branchName": "blue"}}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("my-graph"),
+		"name":         types.StringValue("staging"),
+		"branch_name":  types.StringValue("blue"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "alias-1" {
+		t.Errorf("expected id alias-1, got %q", got)
+	}
+
+	server.Handle("GetBranchAlias", `{"graphByAccountSlug": {"branchAlias": {"id": "alias-1", "name": "staging", "branchName": "blue"}}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+
+	server.Handle("SetBranchAlias", `{"branchAliasSet": {"__typename": "BranchAliasSetSuccess", "branchAlias": {"id": "alias-1", "name": "staging", "branchName": "green"}}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"branch_name": types.StringValue("green"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "branch_name"); got != "green" {
+		t.Errorf("expected alias to point at green, got %q", got)
+	}
+	if sent := server.LastRequest("SetBranchAlias").Variables["input"].(map[string]interface{}); sent["branchName"] != "green" {
+		t.Errorf("expected the alias to be repointed, got %v", sent)
+	}
+
+	server.Handle("DeleteBranchAlias", `{"branchAliasDelete": {"__typename": "BranchAliasDoesNotExistError"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	server.Handle("GetBranchAlias", `{"graphByAccountSlug": {"branchAlias": null}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected branch alias to be removed from state")
+	}
+}
+
+func TestBranchAliasResourceMissingBranch(t *testing.T) {
+	r, server := newMockResource(t, NewBranchAliasResource)
+
+	server.Handle("SetBranchAlias", `{"branchAliasSet": {"__typename": "BranchDoesNotExistError"}}`)
+	_, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("my-graph"),
+		"name":         types.StringValue("staging"),
+		"branch_name":  types.StringValue("missing"),
+	})
+	if !diags.HasError() {
+		t.Fatal("expected an error for a missing branch")
+	}
+	if errDiag, ok := diags.Errors()[0].(diag.DiagnosticWithPath); !ok || !errDiag.Path().Equal(path.Root("branch_name")) {
+		t.Errorf("expected the error to be attached to branch_name, got %v", diags)
+	}
+}
+
+func TestBranchAliasResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewBranchAliasResource)
+
+	server.Handle("GetBranchAlias", `{"graphByAccountSlug": {"branchAlias": {"id": "alias-1", "name": "staging", "branchName": "blue"}}}`)
+	state, diags := importResource(t, r, "my-account/my-graph/staging")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "branch_name"); got != "blue" {
+		t.Errorf("expected branch_name blue, got %q", got)
+	}
+}
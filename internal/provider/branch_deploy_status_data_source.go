@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deploymentPollInterval is how often the latest deployment is checked while
+// waiting for it to reach the requested status.
+const deploymentPollInterval = 5 * time.Second
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BranchDeployStatusDataSource{}
+
+func NewBranchDeployStatusDataSource() datasource.DataSource {
+	return &BranchDeployStatusDataSource{}
+}
+
+// BranchDeployStatusDataSource defines the data source implementation.
+type BranchDeployStatusDataSource struct {
+	client client.API
+}
+
+// BranchDeployStatusDataSourceModel describes the data source data model.
+type BranchDeployStatusDataSourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	AccountSlug  types.String   `tfsdk:"account_slug"`
+	GraphSlug    types.String   `tfsdk:"graph_slug"`
+	BranchName   types.String   `tfsdk:"branch_name"`
+	WaitFor      types.String   `tfsdk:"wait_for"`
+	Status       types.String   `tfsdk:"status"`
+	ErrorMessage types.String   `tfsdk:"error_message"`
+	CreatedAt    RFC3339Value   `tfsdk:"created_at"`
+	FinishedAt   RFC3339Value   `tfsdk:"finished_at"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (d *BranchDeployStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch_deploy_status"
+}
+
+func (d *BranchDeployStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Fetches the status of the latest deployment of a branch. With `wait_for`, reading blocks until " +
+			"the deployment reaches the requested status, so dependent resources only proceed once the gateway is serving.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Deployment identifier",
+				Computed:            true,
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Required:            true,
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch whose latest deployment is fetched",
+				Required:            true,
+			},
+			"wait_for": schema.StringAttribute{
+				MarkdownDescription: "Status to wait for, such as `HEALTHY`, up to the read timeout. Waiting fails early when " +
+					"the deployment fails. When unset, the current status is returned without waiting.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.DeploymentStatusDeploying),
+						string(client.DeploymentStatusHealthy),
+						string(client.DeploymentStatusFailed),
+					),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the deployment: `PENDING`, `DEPLOYING`, `HEALTHY`, or `FAILED`",
+				Computed:            true,
+			},
+			"error_message": schema.StringAttribute{
+				MarkdownDescription: "Reason the deployment failed, or null when it did not fail",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "Timestamp the deployment started",
+				Computed:            true,
+			},
+			"finished_at": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "Timestamp the deployment finished, or null while it is in progress",
+				Computed:            true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (d *BranchDeployStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BranchDeployStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BranchDeployStatusDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	var deployment *client.Deployment
+	var err error
+	if data.WaitFor.IsNull() {
+		deployment, err = d.client.GetLatestBranchDeployment(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString())
+	} else {
+		deployment, err = d.waitForStatus(ctx, data, client.DeploymentStatus(data.WaitFor.ValueString()))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read branch deployment status: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(deployment.ID)
+	data.Status = types.StringValue(string(deployment.Status))
+	data.CreatedAt = NewRFC3339Value(deployment.CreatedAt)
+	data.FinishedAt = NewRFC3339PointerValue(deployment.FinishedAt)
+	data.ErrorMessage = types.StringNull()
+	if deployment.ErrorMessage != "" {
+		data.ErrorMessage = types.StringValue(deployment.ErrorMessage)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// waitForStatus polls the latest deployment of the branch until it reaches
+// the status, fails, or ctx expires. A branch without deployments is polled
+// until its first deployment shows up.
+func (d *BranchDeployStatusDataSource) waitForStatus(ctx context.Context, data BranchDeployStatusDataSourceModel, status client.DeploymentStatus) (*client.Deployment, error) {
+	ticker := time.NewTicker(deploymentPollInterval)
+	defer ticker.Stop()
+
+	lastStatus := "no deployment"
+	for {
+		deployment, err := d.client.GetLatestBranchDeployment(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString())
+		if err != nil && err.Error() != "deployment not found" {
+			return nil, err
+		}
+
+		if deployment != nil {
+			if deployment.Status == status {
+				return deployment, nil
+			}
+			if deployment.Status == client.DeploymentStatusFailed {
+				return nil, fmt.Errorf("deployment %s failed: %s", deployment.ID, deployment.ErrorMessage)
+			}
+			lastStatus = "last status " + string(deployment.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for deployment status %s, %s", status, lastStatus)
+		case <-ticker.C:
+		}
+	}
+}
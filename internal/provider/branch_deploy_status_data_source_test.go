@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBranchDeployStatusDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBranchDeployStatusDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.grafbase_branch_deploy_status.test", "id"),
+					resource.TestCheckResourceAttr("data.grafbase_branch_deploy_status.test", "status", "HEALTHY"),
+					resource.TestCheckResourceAttrSet("data.grafbase_branch_deploy_status.test", "finished_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBranchDeployStatusDataSourceConfig() string {
+	return `
+data "grafbase_branch_deploy_status" "test" {
+  account_slug = "test-account"
+  graph_slug   = "test-graph"
+  branch_name  = "main"
+  wait_for     = "HEALTHY"
+
+  timeouts {
+    read = "10m"
+  }
+}
+`
+}
+
+func TestBranchDeployStatusDataSourceRead(t *testing.T) {
+	d, server := newMockDataSource(t, NewBranchDeployStatusDataSource)
+	attributes := map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("my-graph"),
+		"branch_name":  types.StringValue("main"),
+	}
+
+	// Without wait_for, the current status is returned as is
+	server.Handle("GetLatestBranchDeployment", `{"branch": {"latestDeployment": {
+		"id": "deployment-1", "status": "DEPLOYING", "errorMessage": null,
+		"createdAt": "2024-01-15T10:30:00Z", "finishedAt": null
+	}}}`)
+	state, diags := readDataSource(t, d, attributes)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "status"); got != "DEPLOYING" {
+		t.Errorf("expected status DEPLOYING, got %q", got)
+	}
+	if got := stateString(t, state, "finished_at"); got != "" {
+		t.Errorf("expected finished_at to be null, got %q", got)
+	}
+
+	attributes["wait_for"] = types.StringValue("HEALTHY")
+	server.Handle("GetLatestBranchDeployment", `{"branch": {"latestDeployment": {
+		"id": "deployment-1", "status": "HEALTHY", "errorMessage": null,
+		"createdAt": "2024-01-15T10:30:00Z", "finishedAt": "2024-01-15T10:31:00Z"
+	}}}`)
+	state, diags = readDataSource(t, d, attributes)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "finished_at"); got != "2024-01-15T10:31:00Z" {
+		t.Errorf("unexpected finished_at %q", got)
+	}
+
+	// A failed deployment ends the wait instead of running into the timeout
+	server.Handle("GetLatestBranchDeployment", `{"branch": {"latestDeployment": {
+		"id": "deployment-2", "status": "FAILED", "errorMessage": "composition failed",
+		"createdAt": "2024-01-15T11:30:00Z", "finishedAt": "2024-01-15T11:31:00Z"
+	}}}`)
+	_, diags = readDataSource(t, d, attributes)
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "composition failed") {
+		t.Errorf("expected failed deployment error, got %v", diags)
+	}
+}
@@ -0,0 +1,325 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BranchEnvironmentResource{}
+var _ resource.ResourceWithImportState = &BranchEnvironmentResource{}
+
+func NewBranchEnvironmentResource() resource.Resource {
+	return &BranchEnvironmentResource{}
+}
+
+// BranchEnvironmentResource defines the resource implementation.
+type BranchEnvironmentResource struct {
+	client client.API
+}
+
+// BranchEnvironmentResourceModel describes the resource data model.
+type BranchEnvironmentResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	AccountSlug types.String `tfsdk:"account_slug"`
+	GraphSlug   types.String `tfsdk:"graph_slug"`
+	BranchName  types.String `tfsdk:"branch_name"`
+	Variables   types.Map    `tfsdk:"variables"`
+}
+
+func (r *BranchEnvironmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch_environment"
+}
+
+func (r *BranchEnvironmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the full set of environment variables of a branch as a single map. " +
+			"Variables not listed are deleted from the branch.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the format `account_slug/graph_slug/branch_name`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch the environment variables are set on",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"variables": schema.MapAttribute{
+				MarkdownDescription: "Map of environment variable name to value",
+				ElementType:         types.StringType,
+				Required:            true,
+				Sensitive:           true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(environmentVariableNameValidators()...),
+				},
+			},
+		},
+	}
+}
+
+func (r *BranchEnvironmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BranchEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BranchEnvironmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, diags := environmentVariablesFromMap(ctx, data.Variables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The map is authoritative, so variables already set on the branch are reconciled too
+	current, err := r.client.GetBranchEnvironmentVariables(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read branch environment variables: %s", err))
+		return
+	}
+
+	if err := r.reconcile(ctx, data, environmentVariablesByName(current), planned); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set branch environment variables: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BranchEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BranchEnvironmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variables, err := r.client.GetBranchEnvironmentVariables(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString())
+	if err != nil {
+		// If the branch is gone, its environment variables are gone too
+		if err.Error() == "branch not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read branch environment variables: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	var diags diag.Diagnostics
+	data.Variables, diags = types.MapValueFrom(ctx, types.StringType, environmentVariablesByName(variables))
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BranchEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state BranchEnvironmentResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, diags := environmentVariablesFromMap(ctx, data.Variables)
+	resp.Diagnostics.Append(diags...)
+	prior, diags := environmentVariablesFromMap(ctx, state.Variables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The refreshed state matches the branch, so only the differences are sent
+	if err := r.reconcile(ctx, data, prior, planned); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update branch environment variables: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BranchEnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BranchEnvironmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prior, diags := environmentVariablesFromMap(ctx, data.Variables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.reconcile(ctx, data, prior, nil)
+	if err != nil {
+		// If the branch doesn't exist, there is nothing left to delete
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete branch environment variables: %s", err))
+		return
+	}
+}
+
+func (r *BranchEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug/branch_name"
+	// Branch names may contain slashes, so everything after the graph slug is the branch name
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/branch_name', got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_slug"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_slug"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branch_name"), parts[2])...)
+
+	// Get the variables to populate the remaining attributes
+	variables, err := r.client.GetBranchEnvironmentVariables(ctx, parts[0], parts[1], parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read branch environment variables during import: %s", err))
+		return
+	}
+
+	values, diags := types.MapValueFrom(ctx, types.StringType, environmentVariablesByName(variables))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("variables"), values)...)
+}
+
+// reconcile brings the branch variables from current to planned with at most
+// one upsert of the new and changed variables and one delete of the removed ones.
+func (r *BranchEnvironmentResource) reconcile(ctx context.Context, m BranchEnvironmentResourceModel, current, planned map[string]string) error {
+	var changed []client.EnvironmentVariable
+	for name, value := range planned {
+		if currentValue, ok := current[name]; !ok || currentValue != value {
+			changed = append(changed, client.EnvironmentVariable{Name: name, Value: value})
+		}
+	}
+
+	var removed []string
+	for name := range current {
+		if _, ok := planned[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+
+	// Sorted so that requests are stable across runs
+	sort.Slice(changed, func(i, j int) bool { return changed[i].Name < changed[j].Name })
+	sort.Strings(removed)
+
+	if len(changed) > 0 {
+		err := r.client.UpsertBranchEnvironmentVariables(ctx, client.UpsertBranchEnvironmentVariablesInput{
+			AccountSlug: m.AccountSlug.ValueString(),
+			GraphSlug:   m.GraphSlug.ValueString(),
+			BranchName:  m.BranchName.ValueString(),
+			Variables:   changed,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(removed) > 0 {
+		err := r.client.DeleteBranchEnvironmentVariables(ctx, client.DeleteBranchEnvironmentVariablesInput{
+			AccountSlug: m.AccountSlug.ValueString(),
+			GraphSlug:   m.GraphSlug.ValueString(),
+			BranchName:  m.BranchName.ValueString(),
+			Names:       removed,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// environmentVariablesFromMap converts the Terraform map representation into names mapped to values.
+func environmentVariablesFromMap(ctx context.Context, variables types.Map) (map[string]string, diag.Diagnostics) {
+	values := map[string]string{}
+	diags := variables.ElementsAs(ctx, &values, false)
+
+	return values, diags
+}
+
+// environmentVariablesByName converts API environment variables into names mapped to values.
+func environmentVariablesByName(variables []client.EnvironmentVariable) map[string]string {
+	values := make(map[string]string, len(variables))
+	for _, variable := range variables {
+		values[variable.Name] = variable.Value
+	}
+
+	return values
+}
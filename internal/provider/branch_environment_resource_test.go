@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBranchEnvironmentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccBranchEnvironmentResourceConfig(`{ UPSTREAM_URL = "https://api.example.com", LOG_LEVEL = "info" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_branch_environment.test", "id", "test-account/test-graph/preview"),
+					resource.TestCheckResourceAttr("grafbase_branch_environment.test", "variables.%", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_branch_environment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-account/test-graph/preview",
+			},
+			// Update in place, changing one variable and removing another
+			{
+				Config: testAccBranchEnvironmentResourceConfig(`{ UPSTREAM_URL = "https://api.example.org" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_branch_environment.test", "variables.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBranchEnvironmentResourceConfig(variables string) string {
+	return `
+resource "grafbase_graph" "test" {
+  account_slug = "test-account"
+  slug         = "test-graph"
+}
+
+resource "grafbase_branch" "preview" {
+  account_slug = grafbase_graph.test.account_slug
+  graph_slug   = grafbase_graph.test.slug
+  name         = "preview"
+}
+
+resource "grafbase_branch_environment" "test" {
+  account_slug = grafbase_branch.preview.account_slug
+  graph_slug   = grafbase_branch.preview.graph_slug
+  branch_name  = grafbase_branch.preview.name
+  variables    = ` + variables + `
+}
+`
+}
+
+func TestBranchEnvironmentResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewBranchEnvironmentResource)
+
+	// Variables already on the branch are reconciled against the configured map
+	server.Handle("GetBranchEnvironmentVariables", `{"branch": {"environmentVariables": [{"name": "LOG_LEVEL", "value": "info"}, {"name": "LEGACY", "value": "1"}]}}`)
+	server.Handle("UpsertBranchEnvironmentVariables", `{"branchEnvironmentVariablesUpsert": {"__typename": "BranchEnvironmentVariablesUpsertSuccess"}}`)
+	server.Handle("DeleteBranchEnvironmentVariables", `{"branchEnvironmentVariablesDelete": {"__typename": "BranchEnvironmentVariablesDeleteSuccess"}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("my-graph"),
+		"branch_name":  types.StringValue("main"),
+		"variables": types.MapValueMust(types.StringType, map[string]attr.Value{
+			"LOG_LEVEL":    types.StringValue("info"),
+			"UPSTREAM_URL": types.StringValue("https://api.example.com"),
+		}),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "my-account/my-graph/main" {
+		t.Errorf("unexpected id %q", got)
+	}
+
+	// Only the new variable is sent, and the unlisted one is deleted
+	var upserted client.UpsertBranchEnvironmentVariablesInput
+	if err := server.LastRequest("UpsertBranchEnvironmentVariables").Input(&upserted); err != nil || len(upserted.Variables) != 1 || upserted.Variables[0].Name != "UPSTREAM_URL" {
+		t.Errorf("unexpected upsert input: %+v (%v)", upserted, err)
+	}
+	var deleted client.DeleteBranchEnvironmentVariablesInput
+	if err := server.LastRequest("DeleteBranchEnvironmentVariables").Input(&deleted); err != nil || len(deleted.Names) != 1 || deleted.Names[0] != "LEGACY" {
+		t.Errorf("unexpected delete input: %+v (%v)", deleted, err)
+	}
+
+	server.Handle("GetBranchEnvironmentVariables", `{"branch": {"environmentVariables": [{"name": "LOG_LEVEL", "value": "info"}, {"name": "UPSTREAM_URL", "value": "https://api.example.com"}]}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+
+	// Changing one value upserts it alone, without deleting anything
+	server.Handle("UpsertBranchEnvironmentVariables", `{"branchEnvironmentVariablesUpsert": {"__typename": "BranchEnvironmentVariablesUpsertSuccess"}}`)
+	server.Handle("DeleteBranchEnvironmentVariables", `{"branchEnvironmentVariablesDelete": {"__typename": "BranchDoesNotExistError"}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"variables": types.MapValueMust(types.StringType, map[string]attr.Value{
+			"LOG_LEVEL":    types.StringValue("debug"),
+			"UPSTREAM_URL": types.StringValue("https://api.example.com"),
+		}),
+	})
+	requireNoDiagnostics(t, diags)
+	if err := server.LastRequest("UpsertBranchEnvironmentVariables").Input(&upserted); err != nil || len(upserted.Variables) != 1 || upserted.Variables[0].Value != "debug" {
+		t.Errorf("unexpected upsert input: %+v (%v)", upserted, err)
+	}
+
+	// Destroying deletes every managed variable in one request
+	server.Handle("DeleteBranchEnvironmentVariables", `{"branchEnvironmentVariablesDelete": {"__typename": "BranchEnvironmentVariablesDeleteSuccess"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+	if err := server.LastRequest("DeleteBranchEnvironmentVariables").Input(&deleted); err != nil || len(deleted.Names) != 2 {
+		t.Errorf("expected both variables to be deleted, got %+v (%v)", deleted, err)
+	}
+
+	server.Handle("GetBranchEnvironmentVariables", `{"branch": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected environment to be removed from state")
+	}
+}
+
+func TestBranchEnvironmentResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewBranchEnvironmentResource)
+
+	server.Handle("GetBranchEnvironmentVariables", `{"branch": {"environmentVariables": [{"name": "LOG_LEVEL", "value": "info"}]}}`)
+	state, diags := importResource(t, r, "my-account/my-graph/feature/login")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "branch_name"); got != "feature/login" {
+		t.Errorf("expected branch name with slash, got %q", got)
+	}
+}
@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BranchFeatureFlagsResource{}
+var _ resource.ResourceWithImportState = &BranchFeatureFlagsResource{}
+
+func NewBranchFeatureFlagsResource() resource.Resource {
+	return &BranchFeatureFlagsResource{}
+}
+
+// BranchFeatureFlagsResource defines the resource implementation.
+type BranchFeatureFlagsResource struct {
+	client client.API
+}
+
+// BranchFeatureFlagsResourceModel describes the resource data model.
+type BranchFeatureFlagsResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	AccountSlug types.String `tfsdk:"account_slug"`
+	GraphSlug   types.String `tfsdk:"graph_slug"`
+	BranchName  types.String `tfsdk:"branch_name"`
+	Flags       types.Map    `tfsdk:"flags"`
+}
+
+func (r *BranchFeatureFlagsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch_feature_flags"
+}
+
+func (r *BranchFeatureFlagsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the full set of gateway feature flags on a branch. Flags not listed fall back to the gateway defaults.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the format `account_slug/graph_slug/branch_name`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch the feature flags apply to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"flags": schema.MapAttribute{
+				MarkdownDescription: "Map of feature flag name to whether it is enabled",
+				ElementType:         types.BoolType,
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *BranchFeatureFlagsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BranchFeatureFlagsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BranchFeatureFlagsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flags, diags := data.setInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	featureFlags, err := r.client.SetBranchFeatureFlags(ctx, flags)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set branch feature flags: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString()))
+	data.Flags, diags = featureFlagsToMap(ctx, featureFlags)
+	resp.Diagnostics.Append(diags...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BranchFeatureFlagsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BranchFeatureFlagsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	featureFlags, err := r.client.GetBranchFeatureFlags(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString())
+	if err != nil {
+		// If the branch is gone, its feature flags are gone too
+		if err.Error() == "branch not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read branch feature flags: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	var diags diag.Diagnostics
+	data.Flags, diags = featureFlagsToMap(ctx, featureFlags)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BranchFeatureFlagsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BranchFeatureFlagsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flags, diags := data.setInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	featureFlags, err := r.client.SetBranchFeatureFlags(ctx, flags)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update branch feature flags: %s", err))
+		return
+	}
+
+	data.Flags, diags = featureFlagsToMap(ctx, featureFlags)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BranchFeatureFlagsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BranchFeatureFlagsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Clearing all flags restores the gateway defaults
+	resetInput := client.SetBranchFeatureFlagsInput{
+		AccountSlug: data.AccountSlug.ValueString(),
+		GraphSlug:   data.GraphSlug.ValueString(),
+		BranchName:  data.BranchName.ValueString(),
+	}
+
+	_, err := r.client.SetBranchFeatureFlags(ctx, resetInput)
+	if err != nil {
+		// If the branch doesn't exist, there is nothing left to reset
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset branch feature flags: %s", err))
+		return
+	}
+}
+
+func (r *BranchFeatureFlagsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug/branch_name"
+	// Branch names may contain slashes, so everything after the graph slug is the branch name
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/branch_name', got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_slug"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_slug"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branch_name"), parts[2])...)
+
+	// Get the flags to populate the remaining attributes
+	featureFlags, err := r.client.GetBranchFeatureFlags(ctx, parts[0], parts[1], parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read branch feature flags during import: %s", err))
+		return
+	}
+
+	flags, diags := featureFlagsToMap(ctx, featureFlags)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("flags"), flags)...)
+}
+
+// setInput builds the client input replacing the branch flags with the configured map.
+func (m BranchFeatureFlagsResourceModel) setInput(ctx context.Context) (client.SetBranchFeatureFlagsInput, diag.Diagnostics) {
+	input := client.SetBranchFeatureFlagsInput{
+		AccountSlug: m.AccountSlug.ValueString(),
+		GraphSlug:   m.GraphSlug.ValueString(),
+		BranchName:  m.BranchName.ValueString(),
+	}
+
+	var flags map[string]bool
+	diags := m.Flags.ElementsAs(ctx, &flags, false)
+
+	for name, enabled := range flags {
+		input.FeatureFlags = append(input.FeatureFlags, client.FeatureFlag{Name: name, Enabled: enabled})
+	}
+
+	return input, diags
+}
+
+// featureFlagsToMap converts API feature flags into the Terraform map representation.
+func featureFlagsToMap(ctx context.Context, featureFlags []client.FeatureFlag) (types.Map, diag.Diagnostics) {
+	flags := make(map[string]bool, len(featureFlags))
+	for _, flag := range featureFlags {
+		flags[flag.Name] = flag.Enabled
+	}
+
+	return types.MapValueFrom(ctx, types.BoolType, flags)
+}
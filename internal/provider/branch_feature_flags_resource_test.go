@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBranchFeatureFlagsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccBranchFeatureFlagsResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_branch_feature_flags.test", "id", "test-account/test-graph/preview"),
+					resource.TestCheckResourceAttr("grafbase_branch_feature_flags.test", "flags.%", "1"),
+					resource.TestCheckResourceAttr("grafbase_branch_feature_flags.test", "flags.entity_caching", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_branch_feature_flags.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-account/test-graph/preview",
+			},
+			// Update in place
+			{
+				Config: testAccBranchFeatureFlagsResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_branch_feature_flags.test", "flags.entity_caching", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBranchFeatureFlagsResource_Drift(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBranchFeatureFlagsResourceConfig(true),
+			},
+			// Flip and add flags outside Terraform; a refresh must report the drift
+			{
+				PreConfig: func() {
+					_, err := testAccClient(t).SetBranchFeatureFlags(context.Background(), client.SetBranchFeatureFlagsInput{
+						AccountSlug: "test-account",
+						GraphSlug:   "test-graph",
+						BranchName:  "preview",
+						FeatureFlags: []client.FeatureFlag{
+							{Name: "entity_caching", Enabled: false},
+							{Name: "query_planning_cache", Enabled: true},
+						},
+					})
+					if err != nil {
+						t.Fatalf("failed to change feature flags out-of-band: %v", err)
+					}
+				},
+				Config:             testAccBranchFeatureFlagsResourceConfig(true),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccBranchFeatureFlagsResourceConfig(entityCaching bool) string {
+	return fmt.Sprintf(`
+resource "grafbase_graph" "test" {
+  account_slug = "test-account"
+  slug         = "test-graph"
+}
+
+resource "grafbase_branch" "preview" {
+  account_slug = grafbase_graph.test.account_slug
+  graph_slug   = grafbase_graph.test.slug
+  name         = "preview"
+}
+
+resource "grafbase_branch_feature_flags" "test" {
+  account_slug = grafbase_branch.preview.account_slug
+  graph_slug   = grafbase_branch.preview.graph_slug
+  branch_name  = grafbase_branch.preview.name
+
+  flags = {
+    entity_caching = %[1]t
+  }
+}
+`, entityCaching)
+}
+
+func TestBranchFeatureFlagsResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewBranchFeatureFlagsResource)
+
+	server.Handle("SetBranchFeatureFlags", `{"branchFeatureFlagsSet": {"__typename": "BranchFeatureFlagsSetSuccess", "featureFlags": [{"name": "entity_caching", "enabled": true}]}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("my-graph"),
+		"branch_name":  types.StringValue("main"),
+		"flags":        types.MapValueMust(types.BoolType, map[string]attr.Value{"entity_caching": types.BoolValue(true)}),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "my-account/my-graph/main" {
+		t.Errorf("unexpected id %q", got)
+	}
+
+	var sent client.SetBranchFeatureFlagsInput
+	if err := server.LastRequest("SetBranchFeatureFlags").Input(&sent); err != nil || len(sent.FeatureFlags) != 1 || !sent.FeatureFlags[0].Enabled {
+		t.Errorf("unexpected input: %+v (%v)", sent, err)
+	}
+
+	server.Handle("GetBranchFeatureFlags", `{"branch": {"featureFlags": [{"name": "entity_caching", "enabled": true}]}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+
+	server.Handle("SetBranchFeatureFlags", `{"branchFeatureFlagsSet": {"__typename": "BranchFeatureFlagsSetSuccess", "featureFlags": [{"name": "entity_caching", "enabled": false}]}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"flags": types.MapValueMust(types.BoolType, map[string]attr.Value{"entity_caching": types.BoolValue(false)}),
+	})
+	requireNoDiagnostics(t, diags)
+
+	// Destroying clears every flag
+	server.Handle("SetBranchFeatureFlags", `{"branchFeatureFlagsSet": {"__typename": "BranchFeatureFlagsSetSuccess", "featureFlags": []}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+	if err := server.LastRequest("SetBranchFeatureFlags").Input(&sent); err != nil || len(sent.FeatureFlags) != 0 {
+		t.Errorf("expected all flags to be cleared, got %+v (%v)", sent, err)
+	}
+
+	server.Handle("GetBranchFeatureFlags", `{"branch": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected feature flags to be removed from state")
+	}
+}
+
+func TestBranchFeatureFlagsResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewBranchFeatureFlagsResource)
+
+	server.Handle("GetBranchFeatureFlags", `{"branch": {"featureFlags": [{"name": "entity_caching", "enabled": true}]}}`)
+	state, diags := importResource(t, r, "my-account/my-graph/feature/login")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "branch_name"); got != "feature/login" {
+		t.Errorf("expected branch name with slash, got %q", got)
+	}
+}
@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BranchProtectionResource{}
+var _ resource.ResourceWithImportState = &BranchProtectionResource{}
+
+func NewBranchProtectionResource() resource.Resource {
+	return &BranchProtectionResource{}
+}
+
+// BranchProtectionResource defines the resource implementation.
+type BranchProtectionResource struct {
+	client client.API
+}
+
+// BranchProtectionResourceModel describes the resource data model.
+type BranchProtectionResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	AccountSlug      types.String `tfsdk:"account_slug"`
+	GraphSlug        types.String `tfsdk:"graph_slug"`
+	BranchName       types.String `tfsdk:"branch_name"`
+	PublishAPIKeyIDs types.Set    `tfsdk:"publish_api_key_ids"`
+	DeleteAPIKeyIDs  types.Set    `tfsdk:"delete_api_key_ids"`
+	UpdateAPIKeyIDs  types.Set    `tfsdk:"update_api_key_ids"`
+	AllowAdminBypass types.Bool   `tfsdk:"allow_admin_bypass"`
+}
+
+func (r *BranchProtectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch_protection"
+}
+
+func (r *BranchProtectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	apiKeyIDsValidators := []validator.Set{
+		setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Protects a branch so that only the listed API keys, for example the key used by CI, may publish " +
+			"subgraphs to it, update it, or delete it. Destroying the resource removes the protection.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the format `account_slug/graph_slug/branch_name`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch to protect",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"publish_api_key_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the API keys allowed to publish subgraphs to the branch. When unset, nobody may publish.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          apiKeyIDsValidators,
+			},
+			"delete_api_key_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the API keys allowed to delete the branch. When unset, nobody may delete it.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          apiKeyIDsValidators,
+			},
+			"update_api_key_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the API keys allowed to change the branch settings. When unset, nobody may change them.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          apiKeyIDsValidators,
+			},
+			"allow_admin_bypass": schema.BoolAttribute{
+				MarkdownDescription: "Whether account admins may perform every action regardless of the protection. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *BranchProtectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BranchProtectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BranchProtectionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setInput, diags := data.setInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	protection, err := r.client.SetBranchProtection(ctx, setInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to protect branch: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString()))
+	resp.Diagnostics.Append(data.fromProtection(ctx, protection)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitUntilCreated(ctx, "branch protection", func(ctx context.Context) error {
+		_, err := r.client.GetBranchProtection(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString())
+		return err
+	}, "branch protection not found")...)
+}
+
+func (r *BranchProtectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BranchProtectionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	protection, err := r.client.GetBranchProtection(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString())
+	if err != nil {
+		// If the protection was removed outside Terraform or the branch is gone, protect it again
+		if err.Error() == "branch protection not found" || err.Error() == "branch not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read branch protection: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	resp.Diagnostics.Append(data.fromProtection(ctx, protection)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BranchProtectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BranchProtectionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setInput, diags := data.setInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	protection, err := r.client.SetBranchProtection(ctx, setInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update branch protection: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromProtection(ctx, protection)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BranchProtectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BranchProtectionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteInput := client.DeleteBranchProtectionInput{
+		AccountSlug: data.AccountSlug.ValueString(),
+		GraphSlug:   data.GraphSlug.ValueString(),
+		BranchName:  data.BranchName.ValueString(),
+	}
+
+	err := r.client.DeleteBranchProtection(ctx, deleteInput)
+	if err != nil {
+		// If the branch or its protection doesn't exist, there is nothing left to remove
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove branch protection: %s", err))
+		return
+	}
+}
+
+func (r *BranchProtectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug/branch_name"
+	// Branch names may contain slashes, so everything after the graph slug is the branch name
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/branch_name', got: %s", req.ID))
+		return
+	}
+
+	// Get the protection to populate the remaining attributes
+	protection, err := r.client.GetBranchProtection(ctx, parts[0], parts[1], parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read branch protection during import: %s", err))
+		return
+	}
+
+	data := BranchProtectionResourceModel{
+		ID:               types.StringValue(req.ID),
+		AccountSlug:      types.StringValue(parts[0]),
+		GraphSlug:        types.StringValue(parts[1]),
+		BranchName:       types.StringValue(parts[2]),
+		PublishAPIKeyIDs: types.SetNull(types.StringType),
+		DeleteAPIKeyIDs:  types.SetNull(types.StringType),
+		UpdateAPIKeyIDs:  types.SetNull(types.StringType),
+	}
+	resp.Diagnostics.Append(data.fromProtection(ctx, protection)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setInput builds the client input replacing the branch protection.
+func (m BranchProtectionResourceModel) setInput(ctx context.Context) (client.SetBranchProtectionInput, diag.Diagnostics) {
+	input := client.SetBranchProtectionInput{
+		AccountSlug:      m.AccountSlug.ValueString(),
+		GraphSlug:        m.GraphSlug.ValueString(),
+		BranchName:       m.BranchName.ValueString(),
+		AllowAdminBypass: m.AllowAdminBypass.ValueBool(),
+	}
+
+	var diags diag.Diagnostics
+	for _, ids := range []struct {
+		value  types.Set
+		target *[]string
+	}{
+		{m.PublishAPIKeyIDs, &input.PublishAPIKeyIDs},
+		{m.DeleteAPIKeyIDs, &input.DeleteAPIKeyIDs},
+		{m.UpdateAPIKeyIDs, &input.UpdateAPIKeyIDs},
+	} {
+		if !ids.value.IsNull() && !ids.value.IsUnknown() {
+			diags.Append(ids.value.ElementsAs(ctx, ids.target, false)...)
+		}
+	}
+
+	return input, diags
+}
+
+// fromProtection maps an API branch protection onto the model. Empty key lists
+// are kept null when they were not configured, so omitting an attribute does
+// not cause a diff.
+func (m *BranchProtectionResourceModel) fromProtection(ctx context.Context, protection *client.BranchProtection) diag.Diagnostics {
+	m.AllowAdminBypass = types.BoolValue(protection.AllowAdminBypass)
+
+	var diags diag.Diagnostics
+	for _, ids := range []struct {
+		value  []string
+		target *types.Set
+	}{
+		{protection.PublishAPIKeyIDs, &m.PublishAPIKeyIDs},
+		{protection.DeleteAPIKeyIDs, &m.DeleteAPIKeyIDs},
+		{protection.UpdateAPIKeyIDs, &m.UpdateAPIKeyIDs},
+	} {
+		if len(ids.value) == 0 && ids.target.IsNull() {
+			continue
+		}
+
+		set, setDiags := types.SetValueFrom(ctx, types.StringType, ids.value)
+		diags.Append(setDiags...)
+		*ids.target = set
+	}
+
+	return diags
+}
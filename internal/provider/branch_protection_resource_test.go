@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBranchProtectionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccBranchProtectionResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_branch_protection.test", "id", "test-account/test-graph/main"),
+					resource.TestCheckResourceAttr("grafbase_branch_protection.test", "publish_api_key_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("grafbase_branch_protection.test", "publish_api_key_ids.*", "grafbase_api_key.ci", "id"),
+					resource.TestCheckNoResourceAttr("grafbase_branch_protection.test", "delete_api_key_ids"),
+					resource.TestCheckResourceAttr("grafbase_branch_protection.test", "allow_admin_bypass", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_branch_protection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-account/test-graph/main",
+			},
+			// Update in place
+			{
+				Config: testAccBranchProtectionResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_branch_protection.test", "allow_admin_bypass", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBranchProtectionResourceConfig(allowAdminBypass bool) string {
+	return fmt.Sprintf(`
+resource "grafbase_graph" "test" {
+  account_slug = "test-account"
+  slug         = "test-graph"
+}
+
+resource "grafbase_api_key" "ci" {
+  account_slug = "test-account"
+  name         = "ci"
+}
+
+resource "grafbase_branch_protection" "test" {
+  account_slug        = grafbase_graph.test.account_slug
+  graph_slug          = grafbase_graph.test.slug
+  branch_name         = "main"
+  publish_api_key_ids = [grafbase_api_key.ci.id]
+  update_api_key_ids  = [grafbase_api_key.ci.id]
+  allow_admin_bypass  = %[1]t
+}
+`, allowAdminBypass)
+}
+
+func TestBranchProtectionResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewBranchProtectionResource)
+
+	server.Handle("SetBranchProtection", `{"branchProtectionSet": {"__typename": "BranchProtectionSetSuccess", "branchProtection": {
+		"publishApiKeyIds": ["key-1"], "deleteApiKeyIds": [], "updateApiKeyIds": [], "allowAdminBypass": false
+	}}}`)
+	server.Handle("GetBranchProtection", `{"branch": {"protection": {
+		"publishApiKeyIds": ["key-1"], "deleteApiKeyIds": ["key-2"], "updateApiKeyIds": [], "allowAdminBypass": false
+	}}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug":        types.StringValue("my-account"),
+		"graph_slug":          types.StringValue("my-graph"),
+		"branch_name":         types.StringValue("main"),
+		"publish_api_key_ids": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("key-1")}),
+		"allow_admin_bypass":  types.BoolValue(false),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "my-account/my-graph/main" {
+		t.Errorf("expected id my-account/my-graph/main, got %q", got)
+	}
+	var input client.SetBranchProtectionInput
+	if err := server.LastRequest("SetBranchProtection").Input(&input); err != nil {
+		t.Fatalf("unable to decode input: %s", err)
+	}
+	if len(input.PublishAPIKeyIDs) != 1 || input.PublishAPIKeyIDs[0] != "key-1" || len(input.DeleteAPIKeyIDs) != 0 {
+		t.Errorf("unexpected input: %+v", input)
+	}
+
+	// Unconfigured empty key lists stay null
+	var deleteKeys types.Set
+	requireNoDiagnostics(t, state.GetAttribute(context.Background(), path.Root("delete_api_key_ids"), &deleteKeys))
+	if !deleteKeys.IsNull() {
+		t.Errorf("expected delete_api_key_ids to stay null, got %s", deleteKeys)
+	}
+
+	server.Handle("GetBranchProtection", `{"branch": {"protection": {
+		"publishApiKeyIds": ["key-1"], "deleteApiKeyIds": ["key-2"], "updateApiKeyIds": [], "allowAdminBypass": false
+	}}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	requireNoDiagnostics(t, state.GetAttribute(context.Background(), path.Root("delete_api_key_ids"), &deleteKeys))
+	if len(deleteKeys.Elements()) != 1 {
+		t.Errorf("expected drifted delete key to be read, got %s", deleteKeys)
+	}
+
+	server.Handle("SetBranchProtection", `{"branchProtectionSet": {"__typename": "BranchProtectionSetSuccess", "branchProtection": {
+		"publishApiKeyIds": ["key-1"], "deleteApiKeyIds": ["key-2"], "updateApiKeyIds": [], "allowAdminBypass": true
+	}}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"allow_admin_bypass": types.BoolValue(true),
+	})
+	requireNoDiagnostics(t, diags)
+	if err := server.LastRequest("SetBranchProtection").Input(&input); err != nil {
+		t.Fatalf("unable to decode input: %s", err)
+	}
+	if !input.AllowAdminBypass {
+		t.Errorf("expected admin bypass to be sent, got %+v", input)
+	}
+
+	server.Handle("DeleteBranchProtection", `{"branchProtectionDelete": {"__typename": "BranchProtectionDoesNotExistError"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	server.Handle("GetBranchProtection", `{"branch": {"protection": null}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected branch protection to be removed from state")
+	}
+}
+
+func TestBranchProtectionResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewBranchProtectionResource)
+
+	server.Handle("GetBranchProtection", `{"branch": {"protection": {
+		"publishApiKeyIds": ["key-1"], "deleteApiKeyIds": [], "updateApiKeyIds": [], "allowAdminBypass": true
+	}}}`)
+	state, diags := importResource(t, r, "my-account/my-graph/release/v1")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "branch_name"); got != "release/v1" {
+		t.Errorf("expected branch name with slash, got %q", got)
+	}
+}
@@ -2,21 +2,41 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &BranchResource{}
 var _ resource.ResourceWithImportState = &BranchResource{}
+var _ resource.ResourceWithModifyPlan = &BranchResource{}
+var _ resource.ResourceWithConfigValidators = &BranchResource{}
+var _ resource.ResourceWithIdentity = &BranchResource{}
+var _ resource.ResourceWithMoveState = &BranchResource{}
+
+// branchInputAttributes maps branch mutation input fields to resource attributes.
+var branchInputAttributes = map[string]path.Path{
+	"accountSlug":  path.Root("account_slug"),
+	"graphSlug":    path.Root("graph_slug"),
+	"branchName":   path.Root("name"),
+	"sourceBranch": path.Root("source_branch"),
+}
 
 func NewBranchResource() resource.Resource {
 	return &BranchResource{}
@@ -24,18 +44,32 @@ func NewBranchResource() resource.Resource {
 
 // BranchResource defines the resource implementation.
 type BranchResource struct {
-	client *client.Client
+	client client.API
 }
 
 // BranchResourceModel describes the resource data model.
 type BranchResourceModel struct {
-	ID                             types.String `tfsdk:"id"`
-	AccountSlug                    types.String `tfsdk:"account_slug"`
-	GraphSlug                      types.String `tfsdk:"graph_slug"`
-	Name                           types.String `tfsdk:"name"`
-	Environment                    types.String `tfsdk:"environment"`
-	OperationChecksEnabled         types.Bool   `tfsdk:"operation_checks_enabled"`
-	OperationChecksIgnoreUsageData types.Bool   `tfsdk:"operation_checks_ignore_usage_data"`
+	ID                             types.String   `tfsdk:"id"`
+	AccountSlug                    types.String   `tfsdk:"account_slug"`
+	GraphSlug                      types.String   `tfsdk:"graph_slug"`
+	Name                           types.String   `tfsdk:"name"`
+	SourceBranch                   types.String   `tfsdk:"source_branch"`
+	Environment                    types.String   `tfsdk:"environment"`
+	OperationChecksEnabled         types.Bool     `tfsdk:"operation_checks_enabled"`
+	OperationChecksIgnoreUsageData types.Bool     `tfsdk:"operation_checks_ignore_usage_data"`
+	Regions                        types.Set      `tfsdk:"regions"`
+	GraphQLEndpointURL             types.String   `tfsdk:"graphql_endpoint_url"`
+	LatestDeploymentID             types.String   `tfsdk:"latest_deployment_id"`
+	LatestDeploymentStatus         types.String   `tfsdk:"latest_deployment_status"`
+	AdoptExisting                  types.Bool     `tfsdk:"adopt_existing"`
+	AllowProduction                types.Bool     `tfsdk:"allow_production"`
+	Timeouts                       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// BranchResourceIdentityModel describes the resource identity, which tracks the
+// branch by its API ID.
+type BranchResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
 }
 
 func (r *BranchResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -72,13 +106,37 @@ func (r *BranchResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Branch name",
 				Required:            true,
+				Validators:          branchNameValidators(),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"source_branch": schema.StringAttribute{
+				MarkdownDescription: "Name of the branch to copy the schema and configuration from when creating the branch, " +
+					"for example `main`. Changing or removing it recreates the branch. Setting it on a branch that was imported or " +
+					"created without a source branch does not, since the source of an existing branch is unknown.",
+				Optional:   true,
+				Validators: branchNameValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						sourceBranchRequiresReplace,
+						"Changing the source branch recreates the branch.",
+						"Changing the source branch recreates the branch.",
+					),
+				},
+			},
 			"environment": schema.StringAttribute{
-				MarkdownDescription: "Branch environment (PREVIEW or PRODUCTION)",
-				Computed:            true,
+				MarkdownDescription: "Branch environment (PREVIEW or PRODUCTION). Setting `PRODUCTION` promotes the branch " +
+					"to be the production branch of the graph, which turns the previous production branch into a preview branch. " +
+					"A production branch cannot be demoted directly; promote another branch instead.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(client.BranchEnvironmentPreview), string(client.BranchEnvironmentProduction)),
+				},
 			},
 			"operation_checks_enabled": schema.BoolAttribute{
 				MarkdownDescription: "Whether operation checks are enabled for this branch",
@@ -92,22 +150,90 @@ func (r *BranchResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:            true,
 				Default:             nil,
 			},
+			"regions": schema.SetAttribute{
+				MarkdownDescription: "Regions the managed gateway of this branch runs in, for example `us-east-1`. " +
+					"When not set, the platform chooses the regions. Only supported for graphs with a managed gateway.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"graphql_endpoint_url": schema.StringAttribute{
+				MarkdownDescription: "GraphQL endpoint URL serving this branch",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			// Deployments are triggered outside of Terraform, for example by
+			// schema publishes, so the latest one is not carried over in plans
+			"latest_deployment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the latest deployment of this branch, or `null` if it was never deployed",
+				Computed:            true,
+			},
+			"latest_deployment_status": schema.StringAttribute{
+				MarkdownDescription: "Status of the latest deployment of this branch: `PENDING`, `DEPLOYING`, `HEALTHY`, or `FAILED`",
+				Computed:            true,
+			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Adopt the branch into state when it already exists on create, instead of failing. " +
+					"Useful when several workspaces race to create the same branch. Defaults to `false`.",
+				Optional: true,
+			},
+			"allow_production": schema.BoolAttribute{
+				MarkdownDescription: "Confirm that the resource manages a production branch. Required to manage a branch named `main` " +
+					"or to set `environment = \"PRODUCTION\"`, so that production is not brought under Terraform by accident. Defaults to `false`.",
+				Optional: true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
+func (r *BranchResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Branch identifier",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *BranchResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		operationChecksValidator{},
+		productionBranchValidator{},
+	}
+}
+
 func (r *BranchResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.API)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -126,27 +252,127 @@ func (r *BranchResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Create the branch
 	createInput := client.CreateBranchInput{
-		AccountSlug: data.AccountSlug.ValueString(),
-		GraphSlug:   data.GraphSlug.ValueString(),
-		BranchName:  data.Name.ValueString(),
+		AccountSlug:  data.AccountSlug.ValueString(),
+		GraphSlug:    data.GraphSlug.ValueString(),
+		BranchName:   data.Name.ValueString(),
+		SourceBranch: data.SourceBranch.ValueStringPointer(),
 	}
 
 	branch, err := r.client.CreateBranch(ctx, createInput)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create branch: %s", err))
+
+	// Another workspace may have created the branch first, in which case it is adopted as is
+	var alreadyExists *client.BranchAlreadyExistsError
+	if errors.As(err, &alreadyExists) && data.AdoptExisting.ValueBool() {
+		branch, err = r.client.GetBranch(ctx, createInput.AccountSlug, createInput.GraphSlug, createInput.BranchName)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Branch already exists but could not be read to adopt it: %s", err))
+			return
+		}
+
+		// Like an update, adopting cannot demote the production branch
+		if branch.Environment == client.BranchEnvironmentProduction &&
+			data.Environment.ValueString() == string(client.BranchEnvironmentPreview) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("environment"),
+				"Cannot Demote Production Branch",
+				fmt.Sprintf("Branch %q already exists as the production branch of graph %q and cannot be adopted as a preview branch.",
+					createInput.BranchName, createInput.GraphSlug),
+			)
+			return
+		}
+	} else if err != nil {
+		addClientError(&resp.Diagnostics, "create branch", err, branchInputAttributes)
 		return
 	}
 
+	// Branches are always created as preview branches, so promote afterwards if requested
+	promote := data.Environment.ValueString() == string(client.BranchEnvironmentProduction) &&
+		branch.Environment != client.BranchEnvironmentProduction
+
+	var regions []string
+	if !data.Regions.IsNull() && !data.Regions.IsUnknown() {
+		resp.Diagnostics.Append(data.Regions.ElementsAs(ctx, &regions, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Map response body to schema and populate Computed attribute values
-	data.ID = types.StringValue(branch.ID)
-	data.Environment = types.StringValue(string(branch.Environment))
-	data.OperationChecksEnabled = types.BoolValue(branch.OperationChecksEnabled)
-	data.OperationChecksIgnoreUsageData = types.BoolValue(branch.OperationChecksIgnoreUsageData)
+	resp.Diagnostics.Append(data.fromBranch(ctx, branch)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Regions, promotion, and dependent resources look the branch up by name
+	err = waitUntilVisible(ctx, func(ctx context.Context) error {
+		_, err := r.client.GetBranch(ctx, createInput.AccountSlug, createInput.GraphSlug, createInput.BranchName)
+		return err
+	}, "branch not found")
+	if err != nil {
+		// Save the created branch so it is not orphaned
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, BranchResourceIdentityModel{ID: data.ID})...)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Branch was created but could not be read back: %s", err))
+		return
+	}
+
+	if len(regions) > 0 {
+		regionsInput := client.UpdateBranchRegionsInput{
+			AccountSlug: data.AccountSlug.ValueString(),
+			GraphSlug:   data.GraphSlug.ValueString(),
+			BranchName:  data.Name.ValueString(),
+			Regions:     regions,
+		}
+
+		updated, err := r.client.UpdateBranchRegions(ctx, regionsInput)
+		if err != nil {
+			// Save the created branch so it is not orphaned; the next apply retries pinning the regions
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(resp.Identity.Set(ctx, BranchResourceIdentityModel{ID: data.ID})...)
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Branch was created but its regions could not be set: %s", err))
+			return
+		}
+
+		resp.Diagnostics.Append(data.fromBranch(ctx, updated)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if promote {
+		promoteInput := client.PromoteBranchInput{
+			AccountSlug: data.AccountSlug.ValueString(),
+			GraphSlug:   data.GraphSlug.ValueString(),
+			BranchName:  data.Name.ValueString(),
+		}
+
+		promoted, err := r.client.PromoteBranch(ctx, promoteInput)
+		if err != nil {
+			// Save the created preview branch so it is not orphaned; the next apply retries the promotion
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(resp.Identity.Set(ctx, BranchResourceIdentityModel{ID: data.ID})...)
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Branch was created but could not be promoted to production: %s", err))
+			return
+		}
+
+		data.Environment = types.StringValue(string(promoted.Environment))
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, BranchResourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *BranchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -159,6 +385,16 @@ func (r *BranchResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get the branch using the account slug, graph slug, and branch name
 	branch, err := r.client.GetBranch(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.Name.ValueString())
 	if err != nil {
@@ -172,32 +408,143 @@ func (r *BranchResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// Update the model with the latest data
-	data.ID = types.StringValue(branch.ID)
-	data.Environment = types.StringValue(string(branch.Environment))
-	data.OperationChecksEnabled = types.BoolValue(branch.OperationChecksEnabled)
-	data.OperationChecksIgnoreUsageData = types.BoolValue(branch.OperationChecksIgnoreUsageData)
+	resp.Diagnostics.Append(data.fromBranch(ctx, branch)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, BranchResourceIdentityModel{ID: data.ID})...)
+}
+
+func (r *BranchResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state BranchResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A graph always has a production branch, so it can only change by promoting another branch
+	if state.Environment.ValueString() == string(client.BranchEnvironmentProduction) &&
+		plan.Environment.ValueString() == string(client.BranchEnvironmentPreview) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("environment"),
+			"Cannot Demote Production Branch",
+			fmt.Sprintf("Branch %q is the production branch of graph %q and cannot be demoted directly. "+
+				"Set environment = \"PRODUCTION\" on another branch to promote it instead.", state.Name.ValueString(), state.GraphSlug.ValueString()),
+		)
+	}
 }
 
 func (r *BranchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data BranchResourceModel
+	var data, state BranchResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Currently, the Grafbase API doesn't support updating branches for the fields we expose
-	// The account_slug, graph_slug, and name all have RequiresReplace plan modifiers
-	// So this method should not be called in practice
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"Branch updates are not supported. Changes to account_slug, graph_slug, or name require resource replacement.",
-	)
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// The Grafbase API doesn't support updating the operation check settings of a branch yet
+	if (!data.OperationChecksEnabled.IsUnknown() && !data.OperationChecksEnabled.Equal(state.OperationChecksEnabled)) ||
+		(!data.OperationChecksIgnoreUsageData.IsUnknown() && !data.OperationChecksIgnoreUsageData.Equal(state.OperationChecksIgnoreUsageData)) {
+		resp.Diagnostics.AddError(
+			"Update Not Supported",
+			"Changing operation_checks_enabled or operation_checks_ignore_usage_data of an existing branch is not supported.",
+		)
+		return
+	}
+
+	var stateRegions []string
+	resp.Diagnostics.Append(state.Regions.ElementsAs(ctx, &stateRegions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	branch := &client.Branch{
+		ID:                             state.ID.ValueString(),
+		Environment:                    client.BranchEnvironment(state.Environment.ValueString()),
+		OperationChecksEnabled:         state.OperationChecksEnabled.ValueBool(),
+		OperationChecksIgnoreUsageData: state.OperationChecksIgnoreUsageData.ValueBool(),
+		Regions:                        stateRegions,
+		EndpointURL:                    state.GraphQLEndpointURL.ValueString(),
+	}
+	if !state.LatestDeploymentID.IsNull() {
+		branch.LatestDeployment = &client.Deployment{
+			ID:     state.LatestDeploymentID.ValueString(),
+			Status: client.DeploymentStatus(state.LatestDeploymentStatus.ValueString()),
+		}
+	}
+
+	// Pin the managed gateway to the configured regions
+	if !data.Regions.IsUnknown() && !data.Regions.IsNull() && !data.Regions.Equal(state.Regions) {
+		var regions []string
+		resp.Diagnostics.Append(data.Regions.ElementsAs(ctx, &regions, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		regionsInput := client.UpdateBranchRegionsInput{
+			AccountSlug: data.AccountSlug.ValueString(),
+			GraphSlug:   data.GraphSlug.ValueString(),
+			BranchName:  data.Name.ValueString(),
+			Regions:     regions,
+		}
+
+		var err error
+		branch, err = r.client.UpdateBranchRegions(ctx, regionsInput)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update branch regions: %s", err))
+			return
+		}
+	}
+
+	// Promote the branch when it becomes the production branch
+	if data.Environment.ValueString() == string(client.BranchEnvironmentProduction) &&
+		state.Environment.ValueString() != string(client.BranchEnvironmentProduction) {
+		promoteInput := client.PromoteBranchInput{
+			AccountSlug: data.AccountSlug.ValueString(),
+			GraphSlug:   data.GraphSlug.ValueString(),
+			BranchName:  data.Name.ValueString(),
+		}
+
+		var err error
+		branch, err = r.client.PromoteBranch(ctx, promoteInput)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to promote branch to production: %s", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(data.fromBranch(ctx, branch)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, BranchResourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *BranchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -210,6 +557,16 @@ func (r *BranchResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete the branch
 	deleteInput := client.DeleteBranchInput{
 		AccountSlug: data.AccountSlug.ValueString(),
@@ -223,38 +580,251 @@ func (r *BranchResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		if strings.Contains(err.Error(), "does not exist") {
 			return
 		}
+		// The production branch is only deleted together with its graph, so stop
+		// managing it instead of failing the destroy
+		if strings.Contains(err.Error(), "cannot delete production branch") {
+			resp.Diagnostics.AddWarning(
+				"Production Branch Not Deleted",
+				fmt.Sprintf("Branch %q is the production branch of graph %q and cannot be deleted on its own. "+
+					"It was removed from Terraform state and will be deleted together with the graph.", data.Name.ValueString(), data.GraphSlug.ValueString()),
+			)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete branch: %s", err))
 		return
 	}
 }
 
 func (r *BranchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import by ID format: "account_slug/graph_slug/branch_name"
-	// We'll parse this to get the account slug, graph slug, and branch name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/branch_name', got: %s", req.ID))
-		return
-	}
+	var branch *client.Branch
+	var accountSlug, graphSlug, branchName string
+
+	if req.ID == "" {
+		// Import by identity, which tracks the branch by its ID
+		var identity BranchResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
-	accountSlug := parts[0]
-	graphSlug := parts[1]
-	branchName := parts[2]
+		var err error
+		branch, err = r.client.GetBranchByID(ctx, identity.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read branch during import: %s", err))
+			return
+		}
+
+		accountSlug = branch.Graph.Account.Slug
+		graphSlug = branch.Graph.Slug
+		branchName = branch.Name
+	} else {
+		// Import by ID format: "account_slug/graph_slug/branch_name"
+		// We'll parse this to get the account slug, graph slug, and branch name
+		// Branch names may contain slashes, so everything after the graph slug is the branch name
+		parts := strings.SplitN(req.ID, "/", 3)
+		if len(parts) != 3 {
+			resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/branch_name', got: %s", req.ID))
+			return
+		}
+
+		accountSlug = parts[0]
+		graphSlug = parts[1]
+		branchName = parts[2]
+
+		// Get the branch to populate the remaining attributes
+		var err error
+		branch, err = r.client.GetBranch(ctx, accountSlug, graphSlug, branchName)
+		if err != nil {
+			resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read branch during import: %s", err))
+			return
+		}
+	}
 
 	// Set the account_slug, graph_slug, and name attributes
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_slug"), accountSlug)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_slug"), graphSlug)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), branchName)...)
 
-	// Get the branch to populate the remaining attributes
-	branch, err := r.client.GetBranch(ctx, accountSlug, graphSlug, branchName)
-	if err != nil {
-		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read branch during import: %s", err))
-		return
-	}
-
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), branch.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment"), string(branch.Environment))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("operation_checks_enabled"), branch.OperationChecksEnabled)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("operation_checks_ignore_usage_data"), branch.OperationChecksIgnoreUsageData)...)
+	// Imported production branches need the confirmation for the generated configuration to validate
+	if branch.Environment == client.BranchEnvironmentProduction || branchName == defaultProductionBranchName {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_production"), true)...)
+	}
+
+	var data BranchResourceModel
+	resp.Diagnostics.Append(data.fromBranch(ctx, branch)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("regions"), data.Regions)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graphql_endpoint_url"), data.GraphQLEndpointURL)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("latest_deployment_id"), data.LatestDeploymentID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("latest_deployment_status"), data.LatestDeploymentStatus)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, BranchResourceIdentityModel{ID: data.ID})...)
+}
+
+func (r *BranchResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{StateMover: r.moveLegacyBranch},
+	}
+}
+
+// moveLegacyBranch moves a grafbase_branch of a community fork of the provider
+// into this resource. The remaining attributes are refreshed by the next plan.
+func (r *BranchResource) moveLegacyBranch(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !isLegacyResource(req, "grafbase_branch") {
+		return
+	}
+
+	attributes, err := legacyAttributes(req.SourceRawState)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Move Resource State", fmt.Sprintf("Unable to read the %s state: %s", req.SourceTypeName, err))
+		return
+	}
+
+	id, hasID := attributes.first("id")
+	accountSlug, hasAccount := attributes.first("account_slug", "account")
+	graphSlug, hasGraph := attributes.first("graph_slug", "graph")
+	branchName, hasName := attributes.first("name", "branch_name")
+	if !hasID || !hasAccount || !hasGraph || !hasName {
+		resp.Diagnostics.AddError(
+			"Unable to Move Resource State",
+			fmt.Sprintf("The %s state from %s must contain the branch id, account slug, graph slug, and branch name.", req.SourceTypeName, req.SourceProviderAddress),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("account_slug"), accountSlug)...)
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("graph_slug"), graphSlug)...)
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("name"), branchName)...)
+	if resp.TargetIdentity != nil {
+		resp.Diagnostics.Append(resp.TargetIdentity.Set(ctx, BranchResourceIdentityModel{ID: types.StringValue(id)})...)
+	}
+}
+
+// sourceBranchRequiresReplace recreates the branch when its source branch
+// changes. The API does not return the source of a branch, so setting it on an
+// imported branch, whose source is null in state, does not recreate it.
+func sourceBranchRequiresReplace(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+// fromBranch maps the computed attributes of an API branch onto the model.
+func (m *BranchResourceModel) fromBranch(ctx context.Context, branch *client.Branch) diag.Diagnostics {
+	m.ID = types.StringValue(branch.ID)
+	m.Environment = types.StringValue(string(branch.Environment))
+	m.OperationChecksEnabled = types.BoolValue(branch.OperationChecksEnabled)
+	m.OperationChecksIgnoreUsageData = types.BoolValue(branch.OperationChecksIgnoreUsageData)
+	m.GraphQLEndpointURL = types.StringValue(branch.EndpointURL)
+
+	m.LatestDeploymentID = types.StringNull()
+	m.LatestDeploymentStatus = types.StringNull()
+	if branch.LatestDeployment != nil {
+		m.LatestDeploymentID = types.StringValue(branch.LatestDeployment.ID)
+		m.LatestDeploymentStatus = types.StringValue(string(branch.LatestDeployment.Status))
+	}
+
+	regions := branch.Regions
+	if regions == nil {
+		regions = []string{}
+	}
+
+	var diags diag.Diagnostics
+	m.Regions, diags = types.SetValueFrom(ctx, types.StringType, regions)
+	return diags
+}
+
+var _ resource.ConfigValidator = operationChecksValidator{}
+
+// operationChecksValidator ensures usage data is only ignored when operation
+// checks are explicitly enabled, since the setting has no effect otherwise.
+type operationChecksValidator struct{}
+
+func (v operationChecksValidator) Description(ctx context.Context) string {
+	return "operation_checks_ignore_usage_data can only be true when operation_checks_enabled is true"
+}
+
+func (v operationChecksValidator) MarkdownDescription(ctx context.Context) string {
+	return "`operation_checks_ignore_usage_data` can only be `true` when `operation_checks_enabled` is `true`"
+}
+
+func (v operationChecksValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var enabled, ignoreUsageData types.Bool
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("operation_checks_enabled"), &enabled)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("operation_checks_ignore_usage_data"), &ignoreUsageData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values may still satisfy the requirement once known
+	if enabled.IsUnknown() || !ignoreUsageData.ValueBool() {
+		return
+	}
+
+	if !enabled.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("operation_checks_ignore_usage_data"),
+			"Invalid Operation Checks Configuration",
+			"operation_checks_ignore_usage_data can only be set to true when operation_checks_enabled is also set to true.",
+		)
+	}
+}
+
+// defaultProductionBranchName is the name of the production branch of new graphs
+const defaultProductionBranchName = "main"
+
+var _ resource.ConfigValidator = productionBranchValidator{}
+
+// productionBranchValidator requires allow_production to be set on branches
+// that are, or would become, the production branch of their graph. Production
+// branches cannot be deleted on their own, so managing one by accident only
+// surfaces when it is destroyed.
+type productionBranchValidator struct{}
+
+func (v productionBranchValidator) Description(ctx context.Context) string {
+	return "allow_production must be true to manage a branch named main or a PRODUCTION branch"
+}
+
+func (v productionBranchValidator) MarkdownDescription(ctx context.Context) string {
+	return "`allow_production` must be `true` to manage a branch named `main` or a `PRODUCTION` branch"
+}
+
+func (v productionBranchValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var name, environment types.String
+	var allowProduction types.Bool
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("environment"), &environment)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("allow_production"), &allowProduction)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values may still satisfy the requirement once known
+	if allowProduction.IsUnknown() || allowProduction.ValueBool() {
+		return
+	}
+
+	if environment.ValueString() == string(client.BranchEnvironmentProduction) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("environment"),
+			"Production Branch Not Allowed",
+			"Setting environment to PRODUCTION makes this resource manage the production branch of the graph. "+
+				"Set allow_production = true to confirm.",
+		)
+		return
+	}
+
+	if name.ValueString() == defaultProductionBranchName {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Production Branch Not Allowed",
+			fmt.Sprintf("Branch %q is usually the production branch of the graph, which cannot be deleted on its own. "+
+				"Set allow_production = true to confirm that this resource manages it.", name.ValueString()),
+		)
+	}
 }
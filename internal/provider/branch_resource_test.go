@@ -1,10 +1,17 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccBranchResource(t *testing.T) {
@@ -67,6 +74,76 @@ func TestAccBranchResource_MultipleGraphs(t *testing.T) {
 	})
 }
 
+func TestAccBranchResource_PromoteToProduction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBranchResourceConfig_Environment("PREVIEW"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_branch.test", "environment", "PREVIEW"),
+				),
+			},
+			// Promote in place
+			{
+				Config: testAccBranchResourceConfig_Environment("PRODUCTION"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_branch.test", "environment", "PRODUCTION"),
+				),
+			},
+			// Demoting the production branch is rejected at plan time
+			{
+				Config:      testAccBranchResourceConfig_Environment("PREVIEW"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Cannot Demote Production Branch"),
+			},
+			// Invalid environments are rejected at plan time
+			{
+				Config:      testAccBranchResourceConfig_Environment("STAGING"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
+
+func TestAccBranchResource_Regions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with pinned regions
+			{
+				Config: testAccBranchResourceConfig_Regions(`["us-east-1"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_branch.test", "regions.#", "1"),
+					resource.TestCheckTypeSetElemAttr("grafbase_branch.test", "regions.*", "us-east-1"),
+				),
+			},
+			// Change regions in place
+			{
+				Config: testAccBranchResourceConfig_Regions(`["us-east-1", "eu-west-1"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("grafbase_branch.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_branch.test", "regions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("grafbase_branch.test", "regions.*", "eu-west-1"),
+				),
+			},
+			// An empty set is rejected at plan time
+			{
+				Config:      testAccBranchResourceConfig_Regions(`[]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`set must contain at least 1 elements`),
+			},
+		},
+	})
+}
+
 func testAccBranchResourceConfig(branchName string) string {
 	return fmt.Sprintf(`
 resource "grafbase_graph" "test" {
@@ -107,3 +184,344 @@ resource "grafbase_branch" "branch2" {
 }
 `
 }
+
+func testAccBranchResourceConfig_Environment(environment string) string {
+	return fmt.Sprintf(`
+resource "grafbase_graph" "test" {
+  account_slug = "test-account"
+  slug         = "test-graph"
+}
+
+resource "grafbase_branch" "test" {
+  account_slug = grafbase_graph.test.account_slug
+  graph_slug   = grafbase_graph.test.slug
+  name             = "release"
+  environment      = %[1]q
+  allow_production = true
+}
+`, environment)
+}
+
+func testAccBranchResourceConfig_Regions(regions string) string {
+	return fmt.Sprintf(`
+resource "grafbase_graph" "test" {
+  account_slug = "test-account"
+  slug         = "test-graph"
+}
+
+resource "grafbase_branch" "test" {
+  account_slug = grafbase_graph.test.account_slug
+  graph_slug   = grafbase_graph.test.slug
+  name         = "regional"
+  regions      = %[1]s
+}
+`, regions)
+}
+
+func testBranchJSON(environment string, regions string) string {
+	return `{"id": "branch-1", "name": "feature", "environment": "` + environment + `", "operationChecksEnabled": false, "operationChecksIgnoreUsageData": false, "regions": ` + regions + `, "graph": {"id": "graph-1", "slug": "my-graph"}}`
+}
+
+func TestBranchResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewBranchResource)
+
+	regions := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("iad")})
+
+	server.Handle("CreateBranch", `{"branchCreate": {"__typename": "Query", "branch": `+testBranchJSON("PREVIEW", `[]`)+`}}`)
+	server.Handle("GetBranch", `{"branch": `+testBranchJSON("PREVIEW", `[]`)+`}`)
+	server.Handle("UpdateBranchRegions", `{"branchRegionsUpdate": {"__typename": "Query", "branch": `+testBranchJSON("PREVIEW", `["iad"]`)+`}}`)
+	server.Handle("PromoteBranch", `{"branchPromote": {"__typename": "Query", "branch": `+testBranchJSON("PRODUCTION", `["iad"]`)+`}}`)
+
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("my-graph"),
+		"name":         types.StringValue("feature"),
+		"environment":  types.StringValue("PRODUCTION"),
+		"regions":      regions,
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "environment"); got != "PRODUCTION" {
+		t.Errorf("expected created branch to be promoted, got %q", got)
+	}
+	if len(server.Requests("UpdateBranchRegions")) != 1 {
+		t.Error("expected regions to be pinned after creation")
+	}
+
+	server.Handle("GetBranch", `{"branch": `+testBranchJSON("PRODUCTION", `["iad"]`)+`}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "branch-1" {
+		t.Errorf("expected id branch-1, got %q", got)
+	}
+
+	server.Handle("UpdateBranchRegions", `{"branchRegionsUpdate": {"__typename": "Query", "branch": `+testBranchJSON("PRODUCTION", `["iad", "fra"]`)+`}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"regions": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("iad"), types.StringValue("fra")}),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := len(server.Requests("UpdateBranchRegions")); got != 2 {
+		t.Errorf("expected regions to be updated, got %d region updates", got)
+	}
+
+	// The production branch is only deleted together with its graph
+	server.Handle("DeleteBranch", `{"branchDelete": {"__typename": "CannotDeleteProductionBranchError"}}`)
+	diags = deleteResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if diags.WarningsCount() != 1 {
+		t.Errorf("expected a warning for the production branch, got %v", diags)
+	}
+
+	server.Handle("GetBranch", `{"branch": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected branch to be removed from state")
+	}
+}
+
+func TestBranchResourceSourceBranch(t *testing.T) {
+	r, server := newMockResource(t, NewBranchResource)
+	attributes := map[string]attr.Value{
+		"account_slug":  types.StringValue("my-account"),
+		"graph_slug":    types.StringValue("my-graph"),
+		"name":          types.StringValue("feature"),
+		"source_branch": types.StringValue("main"),
+	}
+
+	server.Handle("CreateBranch", `{"branchCreate": {"__typename": "Query", "branch": `+testBranchJSON("PREVIEW", `[]`)+`}}`)
+	server.Handle("GetBranch", `{"branch": `+testBranchJSON("PREVIEW", `[]`)+`}`)
+	state, diags := createResource(t, r, attributes)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "source_branch"); got != "main" {
+		t.Errorf("expected source_branch main, got %q", got)
+	}
+	if got := server.LastRequest("CreateBranch").Variables["input"].(map[string]interface{})["sourceBranch"]; got != "main" {
+		t.Errorf("expected sourceBranch main to be sent, got %v", got)
+	}
+
+	// A missing source branch is reported on the source_branch attribute
+	server.Handle("CreateBranch", `{"branchCreate": {"__typename": "SourceBranchDoesNotExistError"}}`)
+	_, diags = createResource(t, r, attributes)
+	if !diags.HasError() {
+		t.Fatal("expected missing source branch to be an error")
+	}
+	if errDiag, ok := diags.Errors()[0].(diag.DiagnosticWithPath); !ok || !errDiag.Path().Equal(path.Root("source_branch")) {
+		t.Errorf("expected error on source_branch, got %v", diags)
+	}
+}
+
+func TestBranchResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewBranchResource)
+
+	server.Handle("GetBranch", `{"branch": `+testBranchJSON("PREVIEW", `[]`)+`}`)
+	state, diags := importResource(t, r, "my-account/my-graph/feature/login")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := server.LastRequest("GetBranch").Variables["branchName"]; got != "feature/login" {
+		t.Errorf("expected branch name with slash, got %v", got)
+	}
+	if got := stateString(t, state, "environment"); got != "PREVIEW" {
+		t.Errorf("expected environment PREVIEW, got %q", got)
+	}
+	var allowProduction types.Bool
+	requireNoDiagnostics(t, state.GetAttribute(context.Background(), path.Root("allow_production"), &allowProduction))
+	if !allowProduction.IsNull() {
+		t.Errorf("expected allow_production to be unset for a preview branch, got %v", allowProduction)
+	}
+
+	// Imported production branches are confirmed, so generated configuration validates
+	server.Handle("GetBranch", `{"branch": `+testBranchJSON("PRODUCTION", `[]`)+`}`)
+	state, diags = importResource(t, r, "my-account/my-graph/release")
+	requireNoDiagnostics(t, diags)
+	requireNoDiagnostics(t, state.GetAttribute(context.Background(), path.Root("allow_production"), &allowProduction))
+	if !allowProduction.ValueBool() {
+		t.Errorf("expected allow_production to be true for a production branch, got %v", allowProduction)
+	}
+}
+
+func TestBranchResourceOperationChecksValidator(t *testing.T) {
+	r := NewBranchResource()
+
+	tests := []struct {
+		name            string
+		enabled         types.Bool
+		ignoreUsageData types.Bool
+		valid           bool
+	}{
+		{name: "unset", enabled: types.BoolNull(), ignoreUsageData: types.BoolNull(), valid: true},
+		{name: "enabled and ignoring usage data", enabled: types.BoolValue(true), ignoreUsageData: types.BoolValue(true), valid: true},
+		{name: "disabled and not ignoring usage data", enabled: types.BoolValue(false), ignoreUsageData: types.BoolValue(false), valid: true},
+		{name: "unknown enabled", enabled: types.BoolUnknown(), ignoreUsageData: types.BoolValue(true), valid: true},
+		{name: "disabled and ignoring usage data", enabled: types.BoolValue(false), ignoreUsageData: types.BoolValue(true), valid: false},
+		{name: "unset enabled and ignoring usage data", enabled: types.BoolNull(), ignoreUsageData: types.BoolValue(true), valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateResourceConfig(t, r, map[string]attr.Value{
+				"account_slug":                       types.StringValue("my-account"),
+				"graph_slug":                         types.StringValue("my-graph"),
+				"name":                               types.StringValue("feature"),
+				"operation_checks_enabled":           tt.enabled,
+				"operation_checks_ignore_usage_data": tt.ignoreUsageData,
+			})
+
+			if tt.valid && diags.HasError() {
+				t.Errorf("expected configuration to be valid, got: %v", diags)
+			}
+			if !tt.valid && !diags.HasError() {
+				t.Error("expected configuration to be invalid")
+			}
+		})
+	}
+}
+
+func TestBranchResourceProductionBranchValidator(t *testing.T) {
+	r := NewBranchResource()
+
+	tests := []struct {
+		name            string
+		branchName      string
+		environment     types.String
+		allowProduction types.Bool
+		valid           bool
+	}{
+		{name: "preview branch", branchName: "feature", environment: types.StringNull(), allowProduction: types.BoolNull(), valid: true},
+		{name: "main", branchName: "main", environment: types.StringNull(), allowProduction: types.BoolNull(), valid: false},
+		{name: "main not allowed", branchName: "main", environment: types.StringNull(), allowProduction: types.BoolValue(false), valid: false},
+		{name: "main allowed", branchName: "main", environment: types.StringNull(), allowProduction: types.BoolValue(true), valid: true},
+		{name: "promoted", branchName: "release", environment: types.StringValue("PRODUCTION"), allowProduction: types.BoolNull(), valid: false},
+		{name: "promoted allowed", branchName: "release", environment: types.StringValue("PRODUCTION"), allowProduction: types.BoolValue(true), valid: true},
+		{name: "unknown allowed", branchName: "main", environment: types.StringNull(), allowProduction: types.BoolUnknown(), valid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateResourceConfig(t, r, map[string]attr.Value{
+				"account_slug":     types.StringValue("my-account"),
+				"graph_slug":       types.StringValue("my-graph"),
+				"name":             types.StringValue(tt.branchName),
+				"environment":      tt.environment,
+				"allow_production": tt.allowProduction,
+			})
+
+			if tt.valid && diags.HasError() {
+				t.Errorf("expected configuration to be valid, got: %v", diags)
+			}
+			if !tt.valid && !diags.HasError() {
+				t.Error("expected configuration to be invalid")
+			}
+		})
+	}
+}
+
+func TestBranchResourceIdentity(t *testing.T) {
+	r, server := newMockResource(t, NewBranchResource)
+
+	server.Handle("GetBranchByID", `{"node": {"id": "branch-1", "name": "feature/login", "environment": "PREVIEW", "operationChecksEnabled": false, "operationChecksIgnoreUsageData": false, "regions": [],
+		"graph": {"id": "graph-1", "slug": "my-graph", "account": {"id": "account-1", "slug": "my-account"}}}}`)
+	state, identity, diags := importResourceByIdentity(t, r, map[string]attr.Value{
+		"id": types.StringValue("branch-1"),
+	})
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "name"); got != "feature/login" {
+		t.Errorf("expected name feature/login, got %q", got)
+	}
+	if got := stateString(t, state, "account_slug"); got != "my-account" {
+		t.Errorf("expected account slug my-account, got %q", got)
+	}
+	if got := identityString(t, identity, "id"); got != "branch-1" {
+		t.Errorf("expected identity branch-1, got %q", got)
+	}
+
+	server.Handle("GetBranch", `{"branch": `+testBranchJSON("PREVIEW", `[]`)+`}`)
+	identity, diags = readResourceIdentity(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := identityString(t, identity, "id"); got != "branch-1" {
+		t.Errorf("expected identity branch-1 after read, got %q", got)
+	}
+}
+
+func TestBranchResourceMoveState(t *testing.T) {
+	r, _ := newMockResource(t, NewBranchResource)
+
+	state, identity, diags := moveResourceState(t, r, "registry.terraform.io/example/grafbase", "grafbase_branch",
+		`{"id": "branch-1", "account_slug": "my-account", "graph_slug": "my-graph", "branch_name": "main"}`)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "name"); got != "main" {
+		t.Errorf("expected name main, got %q", got)
+	}
+	if got := identityString(t, identity, "id"); got != "branch-1" {
+		t.Errorf("expected identity branch-1, got %q", got)
+	}
+
+	state, _, diags = moveResourceState(t, r, "registry.terraform.io/example/grafbase", "grafbase_graph", `{"id": "graph-1"}`)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected a graph not to be moved into a branch")
+	}
+}
+
+func TestBranchResourceEndpointAndDeployment(t *testing.T) {
+	r, server := newMockResource(t, NewBranchResource)
+
+	server.Handle("CreateBranch", `{"branchCreate": {"__typename": "Query", "branch": {"id": "branch-1", "name": "feature", "environment": "PREVIEW", "regions": [],
+		"endpointUrl": "https://my-graph-feature-my-account.grafbase.app/graphql", "latestDeployment": null, "graph": {"id": "graph-1", "slug": "my-graph"}}}}`)
+	server.Handle("GetBranch", `{"branch": `+testBranchJSON("PREVIEW", `[]`)+`}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("my-graph"),
+		"name":         types.StringValue("feature"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "graphql_endpoint_url"); got != "https://my-graph-feature-my-account.grafbase.app/graphql" {
+		t.Errorf("unexpected graphql_endpoint_url %q", got)
+	}
+	if got := stateString(t, state, "latest_deployment_id"); got != "" {
+		t.Errorf("expected no deployment before the first publish, got %q", got)
+	}
+
+	server.Handle("GetBranch", `{"branch": {"id": "branch-1", "name": "feature", "environment": "PREVIEW", "regions": [],
+		"endpointUrl": "https://my-graph-feature-my-account.grafbase.app/graphql", "latestDeployment": {"id": "deployment-1", "status": "FAILED"},
+		"graph": {"id": "graph-1", "slug": "my-graph"}}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "latest_deployment_id"); got != "deployment-1" {
+		t.Errorf("expected latest deployment deployment-1, got %q", got)
+	}
+	if got := stateString(t, state, "latest_deployment_status"); got != "FAILED" {
+		t.Errorf("expected latest deployment status FAILED, got %q", got)
+	}
+}
+
+func TestBranchResourceAdoptExisting(t *testing.T) {
+	r, server := newMockResource(t, NewBranchResource)
+	attributes := map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("my-graph"),
+		"name":         types.StringValue("feature"),
+	}
+
+	server.Handle("CreateBranch", `{"branchCreate": {"__typename": "BranchAlreadyExistsError"}}`)
+	server.Handle("GetBranch", `{"branch": `+testBranchJSON("PREVIEW", `[]`)+`}`)
+
+	// Without adopt_existing, a branch created by someone else is an error
+	if _, diags := createResource(t, r, attributes); !diags.HasError() {
+		t.Error("expected existing branch to be an error")
+	}
+
+	attributes["adopt_existing"] = types.BoolValue(true)
+	state, diags := createResource(t, r, attributes)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "branch-1" {
+		t.Errorf("expected existing branch-1 to be adopted, got %q", got)
+	}
+
+	// The production branch cannot be adopted as a preview branch
+	server.Handle("GetBranch", `{"branch": `+testBranchJSON("PRODUCTION", `[]`)+`}`)
+	attributes["environment"] = types.StringValue("PREVIEW")
+	if _, diags := createResource(t, r, attributes); !diags.HasError() {
+		t.Error("expected adopting the production branch as a preview branch to be an error")
+	}
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BreakingChangeGuardDataSource{}
+
+func NewBreakingChangeGuardDataSource() datasource.DataSource {
+	return &BreakingChangeGuardDataSource{}
+}
+
+// BreakingChangeGuardDataSource defines the data source implementation.
+type BreakingChangeGuardDataSource struct {
+	client client.API
+}
+
+// BreakingChangeGuardDataSourceModel describes the data source data model.
+type BreakingChangeGuardDataSourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	AccountSlug        types.String `tfsdk:"account_slug"`
+	GraphSlug          types.String `tfsdk:"graph_slug"`
+	BranchName         types.String `tfsdk:"branch_name"`
+	SubgraphName       types.String `tfsdk:"subgraph_name"`
+	Schema             types.String `tfsdk:"schema"`
+	HasBreakingChanges types.Bool   `tfsdk:"has_breaking_changes"`
+	BreakingChanges    types.List   `tfsdk:"breaking_changes"`
+}
+
+func (d *BreakingChangeGuardDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_breaking_change_guard"
+}
+
+func (d *BreakingChangeGuardDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Compares a subgraph SDL against the schema live on a branch and reports whether it contains " +
+			"breaking changes. Intended for `check` blocks and preconditions that guard schema publishing.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the format `account_slug/graph_slug/branch_name/subgraph_name`",
+				Computed:            true,
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Required:            true,
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch whose live schema is compared against",
+				Required:            true,
+			},
+			"subgraph_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the subgraph the SDL belongs to",
+				Required:            true,
+			},
+			"schema": schema.StringAttribute{
+				MarkdownDescription: "Candidate subgraph SDL",
+				Required:            true,
+			},
+			"has_breaking_changes": schema.BoolAttribute{
+				MarkdownDescription: "Whether the candidate SDL contains breaking changes",
+				Computed:            true,
+			},
+			"breaking_changes": schema.ListAttribute{
+				MarkdownDescription: "Descriptions of the breaking changes",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *BreakingChangeGuardDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BreakingChangeGuardDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BreakingChangeGuardDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changes, err := d.client.DiffSubgraphSchema(
+		ctx,
+		data.AccountSlug.ValueString(),
+		data.GraphSlug.ValueString(),
+		data.BranchName.ValueString(),
+		data.SubgraphName.ValueString(),
+		data.Schema.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to compare subgraph schema: %s", err))
+		return
+	}
+
+	breakingChanges := []string{}
+	for _, change := range changes {
+		if change.Breaking {
+			breakingChanges = append(breakingChanges, change.Message)
+		}
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s/%s", data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString(), data.SubgraphName.ValueString()))
+	data.HasBreakingChanges = types.BoolValue(len(breakingChanges) > 0)
+
+	breakingChangeList, diags := types.ListValueFrom(ctx, types.StringType, breakingChanges)
+	resp.Diagnostics.Append(diags...)
+	data.BreakingChanges = breakingChangeList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
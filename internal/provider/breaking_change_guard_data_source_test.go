@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBreakingChangeGuardDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Adding a field is not breaking
+			{
+				Config: testAccBreakingChangeGuardDataSourceConfig("type Query { product(id: ID!): Product, products: [Product!]! } type Product { id: ID! name: String! }"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafbase_breaking_change_guard.test", "id", "test-account/test-graph/main/products"),
+					resource.TestCheckResourceAttr("data.grafbase_breaking_change_guard.test", "has_breaking_changes", "false"),
+					resource.TestCheckResourceAttr("data.grafbase_breaking_change_guard.test", "breaking_changes.#", "0"),
+				),
+			},
+			// Removing every field is breaking
+			{
+				Config: testAccBreakingChangeGuardDataSourceConfig("type Query { health: Boolean }"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafbase_breaking_change_guard.test", "has_breaking_changes", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBreakingChangeGuardDataSourceConfig(schema string) string {
+	return fmt.Sprintf(`
+data "grafbase_breaking_change_guard" "test" {
+  account_slug  = "test-account"
+  graph_slug    = "test-graph"
+  branch_name   = "main"
+  subgraph_name = "products"
+  schema        = %[1]q
+}
+`, schema)
+}
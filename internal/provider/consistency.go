@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Backoff between reads while waiting for a created object to become visible.
+const (
+	visibilityInitialBackoff = 250 * time.Millisecond
+	visibilityMaxBackoff     = 5 * time.Second
+)
+
+// waitUntilVisible reads a newly created object until the read stops failing
+// with notFound, doubling the delay between reads, or until ctx expires. The
+// API is eventually consistent, so an object returned by a create mutation may
+// briefly not be found, which fails dependent resources in the same apply.
+func waitUntilVisible(ctx context.Context, read func(context.Context) error, notFound string) error {
+	backoff := visibilityInitialBackoff
+
+	for {
+		err := read(ctx)
+		if err == nil || err.Error() != notFound {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for the created object to become readable: %w", err)
+		case <-time.After(backoff):
+		}
+
+		backoff = min(backoff*2, visibilityMaxBackoff)
+	}
+}
+
+// waitUntilCreated waits for an object created by a resource to become
+// readable with waitUntilVisible, so dependent resources and the next refresh
+// find it. Resources without a create timeout wait up to the default one. The
+// caller saves the object to state first, so it is not orphaned on failure.
+func waitUntilCreated(ctx context.Context, object string, read func(context.Context) error, notFound string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultCreateTimeout)
+		defer cancel()
+	}
+
+	if err := waitUntilVisible(ctx, read, notFound); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read back the created %s: %s", object, err))
+	}
+
+	return diags
+}
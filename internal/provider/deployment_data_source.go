@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DeploymentDataSource{}
+
+func NewDeploymentDataSource() datasource.DataSource {
+	return &DeploymentDataSource{}
+}
+
+// DeploymentDataSource defines the data source implementation.
+type DeploymentDataSource struct {
+	client client.API
+}
+
+// DeploymentDataSourceModel describes the data source data model.
+type DeploymentDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	AccountSlug      types.String `tfsdk:"account_slug"`
+	GraphSlug        types.String `tfsdk:"graph_slug"`
+	BranchName       types.String `tfsdk:"branch_name"`
+	Status           types.String `tfsdk:"status"`
+	ErrorMessage     types.String `tfsdk:"error_message"`
+	CreatedAt        RFC3339Value `tfsdk:"created_at"`
+	FinishedAt       RFC3339Value `tfsdk:"finished_at"`
+	CommitSHA        types.String `tfsdk:"commit_sha"`
+	CommitMessage    types.String `tfsdk:"commit_message"`
+	CommitAuthorName types.String `tfsdk:"commit_author_name"`
+	Succeeded        types.Bool   `tfsdk:"succeeded"`
+}
+
+func (d *DeploymentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment"
+}
+
+func (d *DeploymentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Fetches the latest deployment of a branch together with the commit that triggered it. " +
+			"Use `grafbase_branch_deploy_status` instead to wait for a deployment to finish.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Deployment identifier",
+				Computed:            true,
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Required:            true,
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch whose latest deployment is fetched",
+				Required:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the deployment: `PENDING`, `DEPLOYING`, `HEALTHY`, or `FAILED`",
+				Computed:            true,
+			},
+			"succeeded": schema.BoolAttribute{
+				MarkdownDescription: "Whether the deployment finished and is serving traffic, that is its status is `HEALTHY`",
+				Computed:            true,
+			},
+			"error_message": schema.StringAttribute{
+				MarkdownDescription: "Reason the deployment failed, or null when it did not fail",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "Timestamp the deployment started",
+				Computed:            true,
+			},
+			"finished_at": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "Timestamp the deployment finished, or null while it is in progress",
+				Computed:            true,
+			},
+			"commit_sha": schema.StringAttribute{
+				MarkdownDescription: "SHA of the commit that triggered the deployment, or null when it was not triggered from version control",
+				Computed:            true,
+			},
+			"commit_message": schema.StringAttribute{
+				MarkdownDescription: "Message of the commit that triggered the deployment",
+				Computed:            true,
+			},
+			"commit_author_name": schema.StringAttribute{
+				MarkdownDescription: "Author of the commit that triggered the deployment",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DeploymentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DeploymentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeploymentDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := d.client.GetLatestBranchDeployment(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deployment: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(deployment.ID)
+	data.Status = types.StringValue(string(deployment.Status))
+	data.Succeeded = types.BoolValue(deployment.Status == client.DeploymentStatusHealthy)
+	data.CreatedAt = NewRFC3339Value(deployment.CreatedAt)
+	data.FinishedAt = NewRFC3339PointerValue(deployment.FinishedAt)
+	data.ErrorMessage = types.StringNull()
+	if deployment.ErrorMessage != "" {
+		data.ErrorMessage = types.StringValue(deployment.ErrorMessage)
+	}
+
+	data.CommitSHA = types.StringNull()
+	data.CommitMessage = types.StringNull()
+	data.CommitAuthorName = types.StringNull()
+	if deployment.Commit != nil {
+		data.CommitSHA = types.StringValue(deployment.Commit.SHA)
+		data.CommitMessage = types.StringValue(deployment.Commit.Message)
+		data.CommitAuthorName = types.StringValue(deployment.Commit.AuthorName)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeploymentDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.grafbase_deployment.test", "id"),
+					resource.TestCheckResourceAttrSet("data.grafbase_deployment.test", "status"),
+					resource.TestCheckResourceAttrSet("data.grafbase_deployment.test", "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeploymentDataSourceConfig() string {
+	return `
+data "grafbase_deployment" "test" {
+  account_slug = "test-account"
+  graph_slug   = "test-graph"
+  branch_name  = "main"
+}
+`
+}
+
+func TestDeploymentDataSourceRead(t *testing.T) {
+	d, server := newMockDataSource(t, NewDeploymentDataSource)
+	attributes := map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("my-graph"),
+		"branch_name":  types.StringValue("main"),
+	}
+
+	server.Handle("GetLatestBranchDeployment", `{"branch": {"latestDeployment": {
+		"id": "deployment-1", "status": "HEALTHY", "errorMessage": null,
+		"createdAt": "2024-01-15T10:30:00Z", "finishedAt": "2024-01-15T10:31:00Z",
+		"commit": {"sha": "4f2c1a9", "message": "Add products subgraph", "authorName": "Jane Doe"}
+	}}}`)
+	state, diags := readDataSource(t, d, attributes)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "commit_sha"); got != "4f2c1a9" {
+		t.Errorf("expected commit 4f2c1a9, got %q", got)
+	}
+	var succeeded types.Bool
+	requireNoDiagnostics(t, state.GetAttribute(context.Background(), path.Root("succeeded"), &succeeded))
+	if !succeeded.ValueBool() {
+		t.Error("expected a healthy deployment to have succeeded")
+	}
+
+	// Deployments not triggered from version control have no commit
+	server.Handle("GetLatestBranchDeployment", `{"branch": {"latestDeployment": {
+		"id": "deployment-2", "status": "DEPLOYING", "errorMessage": null,
+		"createdAt": "2024-01-15T11:30:00Z", "finishedAt": null, "commit": null
+	}}}`)
+	state, diags = readDataSource(t, d, attributes)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "commit_sha"); got != "" {
+		t.Errorf("expected commit_sha to be null, got %q", got)
+	}
+
+	server.Handle("GetLatestBranchDeployment", `{"branch": {"latestDeployment": null}}`)
+	if _, diags := readDataSource(t, d, attributes); !diags.HasError() {
+		t.Error("expected a branch without deployments to be an error")
+	}
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// addClientError reports a client error as a diagnostic. Typed API errors that
+// relate to a mutation input field listed in attributes are attached to the
+// matching attribute, so Terraform points at the offending configuration line.
+func addClientError(diags *diag.Diagnostics, action string, err error, attributes map[string]path.Path) {
+	var unionErr client.UnionError
+	if !errors.As(err, &unionErr) {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to %s: %s", action, err))
+		return
+	}
+
+	detail := fmt.Sprintf("Unable to %s: %s.", action, unionErr)
+	if hint := unionErrorHint(unionErr); hint != "" {
+		detail += " " + hint
+	}
+
+	if attribute, ok := attributes[unionErr.Field()]; ok {
+		diags.AddAttributeError(attribute, unionErr.Summary(), detail)
+		return
+	}
+
+	diags.AddError(unionErr.Summary(), detail)
+}
+
+// unionErrorHint returns a suggestion on how to resolve a typed API error.
+func unionErrorHint(unionErr client.UnionError) string {
+	switch e := unionErr.(type) {
+	case *client.SlugTooLongError:
+		return fmt.Sprintf("Use a slug of at most %d characters.", e.MaxLength)
+	case *client.SlugInvalidError:
+		return "Slugs may only contain lowercase letters, numbers, and single hyphens."
+	case *client.SlugAlreadyExistsError:
+		return "Choose a slug that is not used by another graph in the account, or import the existing graph."
+	case *client.AccountDoesNotExistError:
+		return "Check the account slug and that the API key has access to the account."
+	case *client.DisabledAccountError:
+		return "Re-enable the account in the Grafbase dashboard before managing its resources."
+	case *client.GraphDoesNotExistError:
+		return "Check the graph slug, and create the graph before resources that depend on it."
+	case *client.GraphTransferNotAllowedError:
+		return "Transfer the graph with an API key of a user that owns both the current and the target account."
+	case *client.BranchDoesNotExistError:
+		return "Check the branch name, and create the branch before resources that depend on it."
+	case *client.BranchAlreadyExistsError:
+		return "Import the existing branch with `terraform import`, or choose another name."
+	}
+
+	return ""
+}
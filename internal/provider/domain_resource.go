@@ -0,0 +1,419 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// domainVerificationPollInterval is how often the domain status is checked
+// while waiting for verification.
+const domainVerificationPollInterval = 10 * time.Second
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DomainResource{}
+var _ resource.ResourceWithImportState = &DomainResource{}
+
+func NewDomainResource() resource.Resource {
+	return &DomainResource{}
+}
+
+// DomainResource defines the resource implementation.
+type DomainResource struct {
+	client client.API
+}
+
+// DomainResourceModel describes the resource data model.
+type DomainResourceModel struct {
+	ID                  types.String   `tfsdk:"id"`
+	AccountSlug         types.String   `tfsdk:"account_slug"`
+	GraphSlug           types.String   `tfsdk:"graph_slug"`
+	BranchName          types.String   `tfsdk:"branch_name"`
+	Domain              types.String   `tfsdk:"domain"`
+	WaitForVerification types.Bool     `tfsdk:"wait_for_verification"`
+	Status              types.String   `tfsdk:"status"`
+	ValidationRecords   types.List     `tfsdk:"validation_records"`
+	CreatedAt           RFC3339Value   `tfsdk:"created_at"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+// dnsRecordAttrTypes describes the object type of a validation record.
+var dnsRecordAttrTypes = map[string]attr.Type{
+	"type":  types.StringType,
+	"name":  types.StringType,
+	"value": types.StringType,
+}
+
+func (r *DomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain"
+}
+
+func (r *DomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages a custom domain serving a Grafbase graph branch. The DNS records required to verify " +
+			"the domain are exported in `validation_records`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Custom domain identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug the domain serves",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch the domain serves. Defaults to the production branch.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Fully qualified domain name, for example `api.example.com`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_verification": schema.BoolAttribute{
+				MarkdownDescription: "Wait for the domain to be verified during creation, up to the create timeout. " +
+					"Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Verification status of the domain: `PENDING`, `VERIFIED`, or `FAILED`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"validation_records": schema.ListNestedAttribute{
+				MarkdownDescription: "DNS records that must be created to verify the domain",
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "DNS record type, for example `CNAME` or `TXT`",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "DNS record name",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "DNS record value",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "Custom domain creation timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *DomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DomainResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create the custom domain
+	createInput := client.CreateCustomDomainInput{
+		AccountSlug: data.AccountSlug.ValueString(),
+		GraphSlug:   data.GraphSlug.ValueString(),
+		BranchName:  data.BranchName.ValueString(),
+		Domain:      data.Domain.ValueString(),
+	}
+
+	domain, err := r.client.CreateCustomDomain(ctx, createInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom domain: %s", err))
+		return
+	}
+
+	// Save the domain before waiting so it is tracked even if verification times out
+	resp.Diagnostics.Append(data.fromDomain(ctx, domain)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitUntilCreated(ctx, "custom domain", func(ctx context.Context) error {
+		_, err := r.client.GetCustomDomain(ctx, data.ID.ValueString())
+		return err
+	}, "custom domain not found")...)
+
+	if resp.Diagnostics.HasError() || !data.WaitForVerification.ValueBool() {
+		return
+	}
+
+	domain, err = waitForDomainVerification(ctx, r.client, domain)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Domain Verification Error",
+			fmt.Sprintf("Custom domain %s was created but did not verify: %s. Make sure the records in validation_records exist in DNS.", data.Domain.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromDomain(ctx, domain)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DomainResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	domain, err := r.client.GetCustomDomain(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the domain is not found, remove it from state
+		if err.Error() == "custom domain not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom domain: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	resp.Diagnostics.Append(data.fromDomain(ctx, domain)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DomainResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only wait_for_verification and timeouts can change in place, and both
+	// only affect creation, so the plan is saved as is
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DomainResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete the custom domain
+	err := r.client.DeleteCustomDomain(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the domain is already gone, there is nothing left to delete
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom domain: %s", err))
+		return
+	}
+}
+
+func (r *DomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug/domain_id"
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/domain_id', got: %s", req.ID))
+		return
+	}
+
+	// Get the domain to populate the remaining attributes
+	domain, err := r.client.GetCustomDomain(ctx, parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read custom domain during import: %s", err))
+		return
+	}
+
+	var data DomainResourceModel
+	resp.Diagnostics.Append(data.fromDomain(ctx, domain)...)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), data.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_slug"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_slug"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branch_name"), data.BranchName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), data.Domain)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_verification"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("status"), data.Status)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("validation_records"), data.ValidationRecords)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("created_at"), data.CreatedAt)...)
+}
+
+// waitForDomainVerification polls the domain until it is verified, fails verification, or ctx expires.
+func waitForDomainVerification(ctx context.Context, api client.API, domain *client.CustomDomain) (*client.CustomDomain, error) {
+	ticker := time.NewTicker(domainVerificationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		switch domain.Status {
+		case client.CustomDomainStatusVerified:
+			return domain, nil
+		case client.CustomDomainStatusFailed:
+			return nil, fmt.Errorf("verification failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for verification, last status %s", domain.Status)
+		case <-ticker.C:
+		}
+
+		var err error
+		domain, err = api.GetCustomDomain(ctx, domain.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// fromDomain populates the computed attributes from an API custom domain.
+func (m *DomainResourceModel) fromDomain(ctx context.Context, domain *client.CustomDomain) diag.Diagnostics {
+	m.ID = types.StringValue(domain.ID)
+	m.Domain = types.StringValue(domain.Domain)
+	m.BranchName = types.StringValue(domain.BranchName)
+	m.Status = types.StringValue(string(domain.Status))
+	m.CreatedAt = NewRFC3339Value(domain.CreatedAt)
+
+	validationRecords, diags := dnsRecordsValue(domain.ValidationRecords)
+	m.ValidationRecords = validationRecords
+
+	return diags
+}
+
+// dnsRecordsValue converts validation records to a list of dnsRecordAttrTypes objects.
+func dnsRecordsValue(dnsRecords []client.DNSRecord) (types.List, diag.Diagnostics) {
+	records := make([]attr.Value, 0, len(dnsRecords))
+	for _, record := range dnsRecords {
+		records = append(records, types.ObjectValueMust(dnsRecordAttrTypes, map[string]attr.Value{
+			"type":  types.StringValue(record.Type),
+			"name":  types.StringValue(record.Name),
+			"value": types.StringValue(record.Value),
+		}))
+	}
+
+	return types.ListValue(types.ObjectType{AttrTypes: dnsRecordAttrTypes}, records)
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDomainResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDomainResourceConfig("api.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_domain.test", "domain", "api.example.com"),
+					resource.TestCheckResourceAttr("grafbase_domain.test", "branch_name", "main"),
+					resource.TestCheckResourceAttr("grafbase_domain.test", "wait_for_verification", "false"),
+					resource.TestCheckResourceAttrSet("grafbase_domain.test", "id"),
+					resource.TestCheckResourceAttrSet("grafbase_domain.test", "status"),
+					resource.TestCheckResourceAttrSet("grafbase_domain.test", "validation_records.0.type"),
+					resource.TestCheckResourceAttrSet("grafbase_domain.test", "validation_records.0.name"),
+					resource.TestCheckResourceAttrSet("grafbase_domain.test", "validation_records.0.value"),
+					resource.TestCheckResourceAttrSet("grafbase_domain.test", "created_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_domain.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["grafbase_domain.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found: grafbase_domain.test")
+					}
+					return fmt.Sprintf("test-account/test-graph/%s", rs.Primary.ID), nil
+				},
+			},
+			// Changing the domain replaces the resource
+			{
+				Config: testAccDomainResourceConfig("graphql.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_domain.test", "domain", "graphql.example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainResourceConfig(domain string) string {
+	return fmt.Sprintf(`
+resource "grafbase_graph" "test" {
+  account_slug = "test-account"
+  slug         = "test-graph"
+}
+
+resource "grafbase_domain" "test" {
+  account_slug = grafbase_graph.test.account_slug
+  graph_slug   = grafbase_graph.test.slug
+  domain       = %[1]q
+}
+`, domain)
+}
+
+func testCustomDomainJSON(status string) string {
+	return `{"id": "domain-1", "domain": "api.example.com", "status": "` + status + `", "branchName": "main",
+		"validationRecords": [{"type": "CNAME", "name": "_acme-challenge.api.example.com", "value": "validation.grafbase.com"}],
+		"createdAt": "2024-01-15T10:30:00Z"}`
+}
+
+func TestDomainResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewDomainResource)
+	attributes := map[string]attr.Value{
+		"account_slug":          types.StringValue("my-account"),
+		"graph_slug":            types.StringValue("my-graph"),
+		"domain":                types.StringValue("api.example.com"),
+		"wait_for_verification": types.BoolValue(true),
+	}
+
+	server.Handle("CreateCustomDomain", `{"customDomainCreate": {"__typename": "CustomDomainCreateSuccess", "customDomain": `+testCustomDomainJSON("VERIFIED")+`}}`)
+	server.Handle("GetCustomDomain", `{"node": `+testCustomDomainJSON("VERIFIED")+`}`)
+	state, diags := createResource(t, r, attributes)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "status"); got != "VERIFIED" {
+		t.Errorf("expected status VERIFIED, got %q", got)
+	}
+
+	server.Handle("GetCustomDomain", `{"node": `+testCustomDomainJSON("VERIFIED")+`}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := server.LastRequest("GetCustomDomain").Variables["id"]; got != "domain-1" {
+		t.Errorf("expected domain to be read by ID, got %v", got)
+	}
+
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"wait_for_verification": types.BoolValue(false),
+	})
+	requireNoDiagnostics(t, diags)
+
+	server.Handle("DeleteCustomDomain", `{"customDomainDelete": {"__typename": "CustomDomainDeleteSuccess"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	server.Handle("GetCustomDomain", `{"node": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected domain to be removed from state")
+	}
+
+	// A failed verification keeps the created domain in state
+	server.Handle("CreateCustomDomain", `{"customDomainCreate": {"__typename": "CustomDomainCreateSuccess", "customDomain": `+testCustomDomainJSON("FAILED")+`}}`)
+	server.Handle("GetCustomDomain", `{"node": `+testCustomDomainJSON("FAILED")+`}`)
+	state, diags = createResource(t, r, attributes)
+	if !diags.HasError() {
+		t.Error("expected failed verification to be an error")
+	}
+	if got := stateString(t, state, "id"); got != "domain-1" {
+		t.Errorf("expected created domain to be saved, got id %q", got)
+	}
+}
+
+func TestDomainResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewDomainResource)
+
+	server.Handle("GetCustomDomain", `{"node": `+testCustomDomainJSON("PENDING")+`}`)
+	state, diags := importResource(t, r, "my-account/my-graph/domain-1")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "domain"); got != "api.example.com" {
+		t.Errorf("expected domain api.example.com, got %q", got)
+	}
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DomainVerificationDataSource{}
+
+func NewDomainVerificationDataSource() datasource.DataSource {
+	return &DomainVerificationDataSource{}
+}
+
+// DomainVerificationDataSource defines the data source implementation.
+type DomainVerificationDataSource struct {
+	client client.API
+}
+
+// DomainVerificationDataSourceModel describes the data source data model.
+type DomainVerificationDataSourceModel struct {
+	DomainID            types.String   `tfsdk:"domain_id"`
+	WaitForVerification types.Bool     `tfsdk:"wait_for_verification"`
+	Domain              types.String   `tfsdk:"domain"`
+	BranchName          types.String   `tfsdk:"branch_name"`
+	Status              types.String   `tfsdk:"status"`
+	ValidationRecords   types.List     `tfsdk:"validation_records"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (d *DomainVerificationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_verification"
+}
+
+func (d *DomainVerificationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Fetches the verification status of a custom domain. With `wait_for_verification`, reading blocks " +
+			"until the domain is verified, so resources that depend on the domain serving traffic, such as a CDN, are only " +
+			"created once the DNS records created from `grafbase_domain.validation_records` have been checked.",
+
+		Attributes: map[string]schema.Attribute{
+			"domain_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the custom domain, the `id` of a `grafbase_domain`",
+				Required:            true,
+			},
+			"wait_for_verification": schema.BoolAttribute{
+				MarkdownDescription: "Wait until the domain is verified, up to the read timeout. Waiting fails early when " +
+					"verification fails. When unset, the current status is returned without waiting.",
+				Optional: true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Fully qualified domain name",
+				Computed:            true,
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch served from the domain",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Verification status: `PENDING`, `VERIFIED`, or `FAILED`",
+				Computed:            true,
+			},
+			"validation_records": schema.ListNestedAttribute{
+				MarkdownDescription: "DNS records that must exist for the domain to be verified",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "DNS record type, such as `CNAME` or `TXT`",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "DNS record name",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "DNS record value",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (d *DomainVerificationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DomainVerificationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DomainVerificationDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	domain, err := d.client.GetCustomDomain(ctx, data.DomainID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom domain: %s", err))
+		return
+	}
+
+	if data.WaitForVerification.ValueBool() {
+		domain, err = waitForDomainVerification(ctx, d.client, domain)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Domain Verification Error",
+				fmt.Sprintf("Custom domain %s did not verify: %s. Make sure the records in validation_records exist in DNS.", data.DomainID.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	data.Domain = types.StringValue(domain.Domain)
+	data.BranchName = types.StringValue(domain.BranchName)
+	data.Status = types.StringValue(string(domain.Status))
+
+	validationRecords, diags := dnsRecordsValue(domain.ValidationRecords)
+	resp.Diagnostics.Append(diags...)
+	data.ValidationRecords = validationRecords
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
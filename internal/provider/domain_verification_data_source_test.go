@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDomainVerificationDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainResourceConfig("api.example.com") + `
+data "grafbase_domain_verification" "test" {
+  domain_id = grafbase_domain.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafbase_domain_verification.test", "domain", "api.example.com"),
+					resource.TestCheckResourceAttrSet("data.grafbase_domain_verification.test", "status"),
+					resource.TestCheckResourceAttrSet("data.grafbase_domain_verification.test", "validation_records.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func TestDomainVerificationDataSourceRead(t *testing.T) {
+	d, server := newMockDataSource(t, NewDomainVerificationDataSource)
+	attributes := map[string]attr.Value{
+		"domain_id": types.StringValue("domain-1"),
+	}
+
+	// Without wait_for_verification, the current status is returned as is
+	server.Handle("GetCustomDomain", `{"node": `+testCustomDomainJSON("PENDING")+`}`)
+	state, diags := readDataSource(t, d, attributes)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "status"); got != "PENDING" {
+		t.Errorf("expected status PENDING, got %q", got)
+	}
+	if got := stateString(t, state, "domain"); got != "api.example.com" {
+		t.Errorf("expected domain api.example.com, got %q", got)
+	}
+
+	attributes["wait_for_verification"] = types.BoolValue(true)
+	server.Handle("GetCustomDomain", `{"node": `+testCustomDomainJSON("VERIFIED")+`}`)
+	state, diags = readDataSource(t, d, attributes)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "status"); got != "VERIFIED" {
+		t.Errorf("expected status VERIFIED, got %q", got)
+	}
+
+	// A failed verification ends the wait instead of running into the timeout
+	server.Handle("GetCustomDomain", `{"node": `+testCustomDomainJSON("FAILED")+`}`)
+	_, diags = readDataSource(t, d, attributes)
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "verification failed") {
+		t.Errorf("expected failed verification error, got %v", diags)
+	}
+
+	server.Handle("GetCustomDomain", `{"node": null}`)
+	if _, diags := readDataSource(t, d, attributes); !diags.HasError() {
+		t.Error("expected missing domain to be an error")
+	}
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FederatedSchemaDataSource{}
+
+func NewFederatedSchemaDataSource() datasource.DataSource {
+	return &FederatedSchemaDataSource{}
+}
+
+// FederatedSchemaDataSource defines the data source implementation.
+type FederatedSchemaDataSource struct {
+	client client.API
+}
+
+// FederatedSchemaDataSourceModel describes the data source data model.
+type FederatedSchemaDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	AccountSlug types.String `tfsdk:"account_slug"`
+	GraphSlug   types.String `tfsdk:"graph_slug"`
+	BranchName  types.String `tfsdk:"branch_name"`
+	SDL         types.String `tfsdk:"sdl"`
+}
+
+func (d *FederatedSchemaDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_federated_schema"
+}
+
+func (d *FederatedSchemaDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Fetches the currently composed federated graph SDL of a branch.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the format `account_slug/graph_slug/branch_name`",
+				Computed:            true,
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Required:            true,
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch to fetch the federated schema for",
+				Required:            true,
+			},
+			"sdl": schema.StringAttribute{
+				MarkdownDescription: "Composed federated graph SDL",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *FederatedSchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FederatedSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FederatedSchemaDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sdl, err := d.client.GetFederatedSchema(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read federated schema: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString()))
+	data.SDL = types.StringValue(sdl)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
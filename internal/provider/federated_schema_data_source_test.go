@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFederatedSchemaDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFederatedSchemaDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafbase_federated_schema.test", "id", "test-account/test-graph/main"),
+					resource.TestCheckResourceAttrSet("data.grafbase_federated_schema.test", "sdl"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFederatedSchemaDataSourceConfig() string {
+	return `
+data "grafbase_federated_schema" "test" {
+  account_slug = "test-account"
+  graph_slug   = "test-graph"
+  branch_name  = "main"
+}
+`
+}
@@ -0,0 +1,366 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GatewayConfigResource{}
+var _ resource.ResourceWithImportState = &GatewayConfigResource{}
+var _ resource.ResourceWithModifyPlan = &GatewayConfigResource{}
+
+func NewGatewayConfigResource() resource.Resource {
+	return &GatewayConfigResource{}
+}
+
+// GatewayConfigResource defines the resource implementation.
+type GatewayConfigResource struct {
+	client client.API
+}
+
+// GatewayConfigResourceModel describes the resource data model.
+type GatewayConfigResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	AccountSlug types.String `tfsdk:"account_slug"`
+	GraphSlug   types.String `tfsdk:"graph_slug"`
+	BranchName  types.String `tfsdk:"branch_name"`
+	Format      types.String `tfsdk:"format"`
+	Config      types.String `tfsdk:"config"`
+	Version     types.Int64  `tfsdk:"version"`
+	UpdatedAt   RFC3339Value `tfsdk:"updated_at"`
+
+	GatewayVersion types.String `tfsdk:"gateway_version"`
+}
+
+func (r *GatewayConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateway_config"
+}
+
+func (r *GatewayConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the gateway configuration of a branch, such as rate limiting, header rules, and " +
+			"subscription settings. Destroying the resource restores the default gateway configuration.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the format `account_slug/graph_slug/branch_name`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch the configuration applies to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"format": schema.StringAttribute{
+				MarkdownDescription: "Format of `config`: `TOML` (default), or `JSON` to map the configuration from HCL with `jsonencode()`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(client.GatewayConfigFormatTOML)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(client.GatewayConfigFormatTOML), string(client.GatewayConfigFormatJSON)),
+				},
+			},
+			"config": schema.StringAttribute{
+				MarkdownDescription: "Gateway configuration document",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"gateway_version": schema.StringAttribute{
+				MarkdownDescription: "Gateway runtime version to pin the branch to, such as `0.31.0`. The version must be one of the " +
+					"versions supported by Grafbase, which is checked during planning. When unset, the branch follows automatic gateway upgrades.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the configuration applied to the branch, incremented on every change",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "Timestamp of the last configuration change",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *GatewayConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GatewayConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy, and versions cannot be listed before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan, state GatewayConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only check versions that change, so a pin to a version that was later
+	// deprecated or withdrawn keeps planning cleanly
+	if plan.GatewayVersion.IsNull() || plan.GatewayVersion.IsUnknown() || plan.GatewayVersion.Equal(state.GatewayVersion) {
+		return
+	}
+
+	versions, err := r.client.ListGatewayVersions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list gateway versions: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(checkGatewayVersion(plan.GatewayVersion.ValueString(), versions)...)
+}
+
+// checkGatewayVersion reports an error when version is not a supported
+// gateway version, and a warning when it is deprecated.
+func checkGatewayVersion(version string, versions []client.GatewayVersion) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	supported := make([]string, 0, len(versions))
+	for _, v := range versions {
+		if v.Version == version {
+			if v.Deprecated {
+				diags.AddAttributeWarning(
+					path.Root("gateway_version"),
+					"Deprecated Gateway Version",
+					fmt.Sprintf("Gateway version %s is deprecated and will stop being supported. Pin the branch to a newer version.", version),
+				)
+			}
+			return diags
+		}
+		if !v.Deprecated {
+			supported = append(supported, v.Version)
+		}
+	}
+
+	diags.AddAttributeError(
+		path.Root("gateway_version"),
+		"Unsupported Gateway Version",
+		fmt.Sprintf("Gateway version %s is not supported. Supported versions: %s.", version, strings.Join(supported, ", ")),
+	)
+
+	return diags
+}
+
+func (r *GatewayConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GatewayConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.SetGatewayConfig(ctx, data.setInput())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set gateway config: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString()))
+	data.fromGatewayConfig(config)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitUntilCreated(ctx, "gateway config", func(ctx context.Context) error {
+		_, err := r.client.GetGatewayConfig(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString())
+		return err
+	}, "gateway config not found")...)
+}
+
+func (r *GatewayConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GatewayConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetGatewayConfig(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString())
+	if err != nil {
+		// If the configuration or branch is not found, remove it from state
+		if err.Error() == "gateway config not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read gateway config: %s", err))
+		return
+	}
+
+	// Update the model with the latest data; a configuration pushed outside of
+	// Terraform shows up as a diff of config
+	data.fromGatewayConfig(config)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GatewayConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GatewayConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Setting the configuration replaces it and creates a new version
+	config, err := r.client.SetGatewayConfig(ctx, data.setInput())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update gateway config: %s", err))
+		return
+	}
+
+	data.fromGatewayConfig(config)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GatewayConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GatewayConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteInput := client.DeleteGatewayConfigInput{
+		AccountSlug: data.AccountSlug.ValueString(),
+		GraphSlug:   data.GraphSlug.ValueString(),
+		BranchName:  data.BranchName.ValueString(),
+	}
+
+	err := r.client.DeleteGatewayConfig(ctx, deleteInput)
+	if err != nil {
+		// If the configuration or branch doesn't exist, consider it already deleted
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete gateway config: %s", err))
+		return
+	}
+}
+
+func (r *GatewayConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug/branch_name"
+	// Branch names may contain slashes, so everything after the graph slug is the branch name
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/branch_name', got: %s", req.ID))
+		return
+	}
+
+	config, err := r.client.GetGatewayConfig(ctx, parts[0], parts[1], parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read gateway config during import: %s", err))
+		return
+	}
+
+	data := GatewayConfigResourceModel{
+		ID:          types.StringValue(req.ID),
+		AccountSlug: types.StringValue(parts[0]),
+		GraphSlug:   types.StringValue(parts[1]),
+		BranchName:  types.StringValue(parts[2]),
+	}
+	data.fromGatewayConfig(config)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setInput builds the client input for replacing the configuration.
+func (m GatewayConfigResourceModel) setInput() client.SetGatewayConfigInput {
+	return client.SetGatewayConfigInput{
+		AccountSlug: m.AccountSlug.ValueString(),
+		GraphSlug:   m.GraphSlug.ValueString(),
+		BranchName:  m.BranchName.ValueString(),
+		Format:      client.GatewayConfigFormat(m.Format.ValueString()),
+		Config:      m.Config.ValueString(),
+
+		GatewayVersion: m.GatewayVersion.ValueStringPointer(),
+	}
+}
+
+// fromGatewayConfig maps an applied API gateway configuration onto the model.
+func (m *GatewayConfigResourceModel) fromGatewayConfig(config *client.GatewayConfig) {
+	m.Format = types.StringValue(string(config.Format))
+	m.Config = types.StringValue(config.Config)
+	m.Version = types.Int64Value(config.Version)
+	m.UpdatedAt = NewRFC3339Value(config.UpdatedAt)
+	m.GatewayVersion = types.StringPointerValue(config.GatewayVersion)
+}
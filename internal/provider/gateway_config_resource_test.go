@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGatewayConfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGatewayConfigResourceConfig(100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_gateway_config.test", "id", "test-account/test-graph/main"),
+					resource.TestCheckResourceAttr("grafbase_gateway_config.test", "format", "TOML"),
+					resource.TestCheckResourceAttrSet("grafbase_gateway_config.test", "version"),
+					resource.TestCheckResourceAttrSet("grafbase_gateway_config.test", "updated_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_gateway_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-account/test-graph/main",
+			},
+			// Update in place
+			{
+				Config: testAccGatewayConfigResourceConfig(200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_gateway_config.test", "config", testAccGatewayConfigTOML(200)),
+				),
+			},
+		},
+	})
+}
+
+func testAccGatewayConfigTOML(limit int) string {
+	return fmt.Sprintf("[gateway.rate_limit.global]\nlimit = %d\nduration = \"10s\"\n", limit)
+}
+
+func testAccGatewayConfigResourceConfig(limit int) string {
+	return fmt.Sprintf(`
+resource "grafbase_gateway_config" "test" {
+  account_slug = "test-account"
+  graph_slug   = "test-graph"
+  branch_name  = "main"
+  config       = %[1]q
+}
+`, testAccGatewayConfigTOML(limit))
+}
+
+func TestGatewayConfigResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewGatewayConfigResource)
+
+	server.Handle("SetGatewayConfig", `{"gatewayConfigSet": {"__typename": "GatewayConfigSetSuccess", "gatewayConfig": {"version": 1, "format": "TOML", "config": "[graph]\n", "updatedAt": "2024-01-15T10:30:00Z"}}}`)
+	server.Handle("GetGatewayConfig", `{"branch": {"gatewayConfig": {"version": 2, "format": "TOML", "config": "[graph]\nintrospection = true\n", "updatedAt": "2024-01-16T10:30:00Z"}}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("my-graph"),
+		"branch_name":  types.StringValue("main"),
+		"format":       types.StringValue("TOML"),
+		"config":       types.StringValue("[graph]\n"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "my-account/my-graph/main" {
+		t.Errorf("expected id my-account/my-graph/main, got %q", got)
+	}
+
+	// A configuration pushed outside of Terraform is read back as is
+	server.Handle("GetGatewayConfig", `{"branch": {"gatewayConfig": {"version": 2, "format": "TOML", "config": "[graph]\nintrospection = true\n", "updatedAt": "2024-01-16T10:30:00Z"}}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "config"); got != "[graph]\nintrospection = true\n" {
+		t.Errorf("expected remote config, got %q", got)
+	}
+
+	server.Handle("SetGatewayConfig", `{"gatewayConfigSet": {"__typename": "GatewayConfigSetSuccess", "gatewayConfig": {"version": 3, "format": "TOML", "config": "[graph]\n", "updatedAt": "2024-01-17T10:30:00Z"}}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"config": types.StringValue("[graph]\n"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "updated_at"); got != "2024-01-17T10:30:00Z" {
+		t.Errorf("expected updated_at of the new version, got %q", got)
+	}
+
+	server.Handle("DeleteGatewayConfig", `{"gatewayConfigDelete": {"__typename": "GatewayConfigDeleteSuccess"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	server.Handle("GetGatewayConfig", `{"branch": {"gatewayConfig": null}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected gateway config to be removed from state")
+	}
+}
+
+func TestGatewayConfigResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewGatewayConfigResource)
+
+	server.Handle("GetGatewayConfig", `{"branch": {"gatewayConfig": {"version": 1, "format": "JSON", "config": "{}", "updatedAt": "2024-01-15T10:30:00Z"}}}`)
+	state, diags := importResource(t, r, "my-account/my-graph/feature/login")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "branch_name"); got != "feature/login" {
+		t.Errorf("expected branch_name feature/login, got %q", got)
+	}
+	if got := stateString(t, state, "format"); got != "JSON" {
+		t.Errorf("expected format JSON, got %q", got)
+	}
+}
+
+func TestGatewayConfigResourceGatewayVersion(t *testing.T) {
+	r, server := newMockResource(t, NewGatewayConfigResource)
+
+	server.Handle("SetGatewayConfig", `{"gatewayConfigSet": {"__typename": "GatewayConfigSetSuccess", "gatewayConfig": {"version": 1, "format": "TOML", "config": "[graph]\n", "updatedAt": "2024-01-15T10:30:00Z", "gatewayVersion": "0.30.0"}}}`)
+	server.Handle("GetGatewayConfig", `{"branch": {"gatewayConfig": {"version": 2, "format": "TOML", "config": "[graph]\nintrospection = true\n", "updatedAt": "2024-01-16T10:30:00Z"}}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug":    types.StringValue("my-account"),
+		"graph_slug":      types.StringValue("my-graph"),
+		"branch_name":     types.StringValue("main"),
+		"format":          types.StringValue("TOML"),
+		"config":          types.StringValue("[graph]\n"),
+		"gateway_version": types.StringValue("0.30.0"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "gateway_version"); got != "0.30.0" {
+		t.Errorf("expected gateway_version 0.30.0, got %q", got)
+	}
+	if sent := server.LastRequest("SetGatewayConfig").Variables["input"].(map[string]interface{}); sent["gatewayVersion"] != "0.30.0" {
+		t.Errorf("expected the gateway version to be pinned, got %v", sent)
+	}
+
+	// Unpinning sends a null version, so the branch follows automatic upgrades again
+	server.Handle("SetGatewayConfig", `{"gatewayConfigSet": {"__typename": "GatewayConfigSetSuccess", "gatewayConfig": {"version": 2, "format": "TOML", "config": "[graph]\n", "updatedAt": "2024-01-16T10:30:00Z", "gatewayVersion": null}}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"gateway_version": types.StringNull(),
+	})
+	requireNoDiagnostics(t, diags)
+	if sent := server.LastRequest("SetGatewayConfig").Variables["input"].(map[string]interface{}); sent["gatewayVersion"] != nil {
+		t.Errorf("expected the gateway version to be unpinned, got %v", sent)
+	}
+	var gatewayVersion types.String
+	requireNoDiagnostics(t, state.GetAttribute(context.Background(), path.Root("gateway_version"), &gatewayVersion))
+	if !gatewayVersion.IsNull() {
+		t.Errorf("expected gateway_version to be null, got %v", gatewayVersion)
+	}
+}
+
+func TestCheckGatewayVersion(t *testing.T) {
+	versions := []client.GatewayVersion{
+		{Version: "0.31.0"},
+		{Version: "0.30.0"},
+		{Version: "0.29.0", Deprecated: true},
+	}
+
+	if diags := checkGatewayVersion("0.30.0", versions); diags.HasError() || diags.WarningsCount() != 0 {
+		t.Errorf("expected a supported version to pass, got %v", diags)
+	}
+
+	if diags := checkGatewayVersion("0.29.0", versions); diags.HasError() || diags.WarningsCount() != 1 {
+		t.Errorf("expected a warning for a deprecated version, got %v", diags)
+	}
+
+	diags := checkGatewayVersion("0.1.0", versions)
+	if !diags.HasError() {
+		t.Fatal("expected an error for an unsupported version")
+	}
+	if got := diags.Errors()[0].Detail(); got != "Gateway version 0.1.0 is not supported. Supported versions: 0.31.0, 0.30.0." {
+		t.Errorf("unexpected detail: %q", got)
+	}
+}
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GraphCollaboratorResource{}
+var _ resource.ResourceWithImportState = &GraphCollaboratorResource{}
+var _ resource.ResourceWithConfigValidators = &GraphCollaboratorResource{}
+
+func NewGraphCollaboratorResource() resource.Resource {
+	return &GraphCollaboratorResource{}
+}
+
+// GraphCollaboratorResource defines the resource implementation.
+type GraphCollaboratorResource struct {
+	client client.API
+}
+
+// GraphCollaboratorResourceModel describes the resource data model.
+type GraphCollaboratorResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	AccountSlug types.String `tfsdk:"account_slug"`
+	GraphSlug   types.String `tfsdk:"graph_slug"`
+	Email       types.String `tfsdk:"email"`
+	TeamSlug    types.String `tfsdk:"team_slug"`
+	Role        types.String `tfsdk:"role"`
+	Pending     types.Bool   `tfsdk:"pending"`
+}
+
+func (r *GraphCollaboratorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_collaborator"
+}
+
+func (r *GraphCollaboratorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Grants a user or a team access to a single graph with a role, without making them members of the account. " +
+			"Users that are not Grafbase users yet receive an invite.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Graph collaborator identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph the collaborator is granted access to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user granted access. Exactly one of `email` and `team_slug` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_slug": schema.StringAttribute{
+				MarkdownDescription: "Slug of the account team granted access. Exactly one of `email` and `team_slug` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role of the collaborator on the graph: `ADMIN`, `EDITOR`, or `VIEWER`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.GraphCollaboratorRoleAdmin),
+						string(client.GraphCollaboratorRoleEditor),
+						string(client.GraphCollaboratorRoleViewer),
+					),
+				},
+			},
+			"pending": schema.BoolAttribute{
+				MarkdownDescription: "Whether the invite of the user has not been accepted yet. Always false for teams.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *GraphCollaboratorResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("email"),
+			path.MatchRoot("team_slug"),
+		),
+	}
+}
+
+func (r *GraphCollaboratorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GraphCollaboratorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GraphCollaboratorResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addInput := client.AddGraphCollaboratorInput{
+		AccountSlug: data.AccountSlug.ValueString(),
+		GraphSlug:   data.GraphSlug.ValueString(),
+		UserEmail:   data.Email.ValueStringPointer(),
+		TeamSlug:    data.TeamSlug.ValueStringPointer(),
+		Role:        client.GraphCollaboratorRole(data.Role.ValueString()),
+	}
+
+	collaborator, err := r.client.AddGraphCollaborator(ctx, addInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add graph collaborator: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.fromCollaborator(collaborator)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitUntilCreated(ctx, "graph collaborator", func(ctx context.Context) error {
+		_, err := r.client.GetGraphCollaborator(ctx, data.ID.ValueString())
+		return err
+	}, "graph collaborator not found")...)
+}
+
+func (r *GraphCollaboratorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GraphCollaboratorResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collaborator, err := r.client.GetGraphCollaborator(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the collaborator is not found, remove it from state
+		if err.Error() == "graph collaborator not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read graph collaborator: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	data.fromCollaborator(collaborator)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphCollaboratorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GraphCollaboratorResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the role can change in place
+	updateInput := client.UpdateGraphCollaboratorRoleInput{
+		ID:   data.ID.ValueString(),
+		Role: client.GraphCollaboratorRole(data.Role.ValueString()),
+	}
+
+	collaborator, err := r.client.UpdateGraphCollaboratorRole(ctx, updateInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update graph collaborator role: %s", err))
+		return
+	}
+
+	data.fromCollaborator(collaborator)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphCollaboratorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GraphCollaboratorResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveGraphCollaborator(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the collaborator was already removed, there is nothing left to delete
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove graph collaborator: %s", err))
+		return
+	}
+}
+
+func (r *GraphCollaboratorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug/collaborator_id"
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/collaborator_id', got: %s", req.ID))
+		return
+	}
+
+	// Get the collaborator to populate the remaining attributes
+	collaborator, err := r.client.GetGraphCollaborator(ctx, parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read graph collaborator during import: %s", err))
+		return
+	}
+
+	data := GraphCollaboratorResourceModel{
+		AccountSlug: types.StringValue(parts[0]),
+		GraphSlug:   types.StringValue(parts[1]),
+	}
+	data.fromCollaborator(collaborator)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fromCollaborator maps an API graph collaborator onto the model. A configured
+// email is kept when it only differs in case, since the API may normalize it.
+func (m *GraphCollaboratorResourceModel) fromCollaborator(collaborator *client.GraphCollaborator) {
+	m.ID = types.StringValue(collaborator.ID)
+	m.Role = types.StringValue(string(collaborator.Role))
+	m.Pending = types.BoolValue(collaborator.Pending)
+	m.TeamSlug = types.StringPointerValue(collaborator.TeamSlug)
+
+	if collaborator.UserEmail == nil || !strings.EqualFold(m.Email.ValueString(), *collaborator.UserEmail) {
+		m.Email = types.StringPointerValue(collaborator.UserEmail)
+	}
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccGraphCollaboratorResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGraphCollaboratorResourceConfig("VIEWER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("grafbase_graph_collaborator.test", "id"),
+					resource.TestCheckResourceAttr("grafbase_graph_collaborator.test", "role", "VIEWER"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_graph_collaborator.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["grafbase_graph_collaborator.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found: grafbase_graph_collaborator.test")
+					}
+					return fmt.Sprintf("test-account/test-graph/%s", rs.Primary.ID), nil
+				},
+			},
+			// Update the role in place
+			{
+				Config: testAccGraphCollaboratorResourceConfig("EDITOR"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_graph_collaborator.test", "role", "EDITOR"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGraphCollaboratorResourceConfig(role string) string {
+	return fmt.Sprintf(`
+resource "grafbase_graph_collaborator" "test" {
+  account_slug = "test-account"
+  graph_slug   = "test-graph"
+  email        = "terraform-acc@example.com"
+  role         = %[1]q
+}
+`, role)
+}
+
+func TestGraphCollaboratorResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewGraphCollaboratorResource)
+
+	server.Handle("AddGraphCollaborator", `{"graphCollaboratorAdd": {"__typename": "GraphCollaboratorAddSuccess",
+		"collaborator": {"id": "collaborator-1", "userEmail": "dev@example.com", "teamSlug": null, "role": "VIEWER", "pending": true}}}`)
+	server.Handle("GetGraphCollaborator", `{"node": {"id": "collaborator-1", "userEmail": "Dev@Example.com", "teamSlug": null, "role": "VIEWER", "pending": false}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("my-graph"),
+		"email":        types.StringValue("dev@example.com"),
+		"role":         types.StringValue("VIEWER"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "collaborator-1" {
+		t.Errorf("expected id collaborator-1, got %q", got)
+	}
+
+	server.Handle("GetGraphCollaborator", `{"node": {"id": "collaborator-1", "userEmail": "Dev@Example.com", "teamSlug": null, "role": "VIEWER", "pending": false}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "email"); got != "dev@example.com" {
+		t.Errorf("expected configured email to be kept, got %q", got)
+	}
+
+	server.Handle("UpdateGraphCollaboratorRole", `{"graphCollaboratorRoleUpdate": {"__typename": "GraphCollaboratorRoleUpdateSuccess",
+		"collaborator": {"id": "collaborator-1", "userEmail": "dev@example.com", "teamSlug": null, "role": "ADMIN", "pending": false}}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"role": types.StringValue("ADMIN"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := server.LastRequest("UpdateGraphCollaboratorRole").Variables["input"].(map[string]interface{})["id"]; got != "collaborator-1" {
+		t.Errorf("expected role update of collaborator-1, got %v", got)
+	}
+
+	server.Handle("RemoveGraphCollaborator", `{"graphCollaboratorRemove": {"__typename": "GraphCollaboratorDoesNotExistError"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	server.Handle("GetGraphCollaborator", `{"node": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected collaborator to be removed from state")
+	}
+}
+
+func TestGraphCollaboratorResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewGraphCollaboratorResource)
+
+	server.Handle("GetGraphCollaborator", `{"node": {"id": "collaborator-2", "userEmail": null, "teamSlug": "platform", "role": "EDITOR", "pending": false}}`)
+	state, diags := importResource(t, r, "my-account/my-graph/collaborator-2")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "team_slug"); got != "platform" {
+		t.Errorf("expected team_slug platform, got %q", got)
+	}
+
+	if _, diags := importResource(t, r, "my-account/collaborator-2"); !diags.HasError() {
+		t.Error("expected invalid import ID to be an error")
+	}
+}
+
+func TestGraphCollaboratorResourceConfigValidators(t *testing.T) {
+	r, _ := newMockResource(t, NewGraphCollaboratorResource)
+
+	tests := []struct {
+		name     string
+		email    types.String
+		teamSlug types.String
+		valid    bool
+	}{
+		{name: "user", email: types.StringValue("dev@example.com"), teamSlug: types.StringNull(), valid: true},
+		{name: "team", email: types.StringNull(), teamSlug: types.StringValue("platform"), valid: true},
+		{name: "neither", email: types.StringNull(), teamSlug: types.StringNull(), valid: false},
+		{name: "both", email: types.StringValue("dev@example.com"), teamSlug: types.StringValue("platform"), valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateResourceConfig(t, r, map[string]attr.Value{
+				"account_slug": types.StringValue("my-account"),
+				"graph_slug":   types.StringValue("my-graph"),
+				"email":        tt.email,
+				"team_slug":    tt.teamSlug,
+				"role":         types.StringValue("VIEWER"),
+			})
+
+			if tt.valid && diags.HasError() {
+				t.Errorf("expected configuration to be valid, got: %v", diags)
+			}
+			if !tt.valid && !diags.HasError() {
+				t.Error("expected configuration to be invalid")
+			}
+		})
+	}
+}
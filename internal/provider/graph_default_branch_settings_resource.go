@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Maximum lifetime of a preview branch accepted by the Grafbase API.
+const maxDefaultBranchTTLHours = 24 * 90
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GraphDefaultBranchSettingsResource{}
+var _ resource.ResourceWithImportState = &GraphDefaultBranchSettingsResource{}
+
+func NewGraphDefaultBranchSettingsResource() resource.Resource {
+	return &GraphDefaultBranchSettingsResource{}
+}
+
+// GraphDefaultBranchSettingsResource defines the resource implementation.
+type GraphDefaultBranchSettingsResource struct {
+	client client.API
+}
+
+// GraphDefaultBranchSettingsResourceModel describes the resource data model.
+type GraphDefaultBranchSettingsResourceModel struct {
+	ID                          types.String `tfsdk:"id"`
+	AccountSlug                 types.String `tfsdk:"account_slug"`
+	GraphSlug                   types.String `tfsdk:"graph_slug"`
+	OperationChecksEnabled      types.Bool   `tfsdk:"operation_checks_enabled"`
+	InheritEnvironmentVariables types.Bool   `tfsdk:"inherit_environment_variables"`
+	TTLHours                    types.Int64  `tfsdk:"ttl_hours"`
+}
+
+func (r *GraphDefaultBranchSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_default_branch_settings"
+}
+
+func (r *GraphDefaultBranchSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the settings applied to preview branches when they are created, including branches " +
+			"created outside of Terraform, for example by CI. Existing branches keep their settings. Destroying the " +
+			"resource restores the default settings.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the format `account_slug/graph_slug`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph whose new preview branches the settings apply to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"operation_checks_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether operation checks run for schema checks against new preview branches. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"inherit_environment_variables": schema.BoolAttribute{
+				MarkdownDescription: "Whether new preview branches inherit the environment variables of the production branch. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"ttl_hours": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Hours after their last deployment at which preview branches are deleted, at most `%d`. "+
+					"Omit to keep preview branches until they are deleted explicitly.", maxDefaultBranchTTLHours),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxDefaultBranchTTLHours),
+				},
+			},
+		},
+	}
+}
+
+func (r *GraphDefaultBranchSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GraphDefaultBranchSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GraphDefaultBranchSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.SetDefaultBranchSettings(ctx, data.setInput())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set default branch settings: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.AccountSlug.ValueString(), data.GraphSlug.ValueString()))
+	data.fromSettings(settings)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphDefaultBranchSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GraphDefaultBranchSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetDefaultBranchSettings(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString())
+	if err != nil {
+		// If the graph is gone, its default branch settings are gone too
+		if err.Error() == "graph not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read default branch settings: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	data.fromSettings(settings)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphDefaultBranchSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GraphDefaultBranchSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.SetDefaultBranchSettings(ctx, data.setInput())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update default branch settings: %s", err))
+		return
+	}
+
+	data.fromSettings(settings)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphDefaultBranchSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GraphDefaultBranchSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Preview branches inherit environment variables and never expire by default
+	resetInput := client.SetDefaultBranchSettingsInput{
+		AccountSlug:                 data.AccountSlug.ValueString(),
+		GraphSlug:                   data.GraphSlug.ValueString(),
+		InheritEnvironmentVariables: true,
+	}
+
+	_, err := r.client.SetDefaultBranchSettings(ctx, resetInput)
+	if err != nil {
+		// If the graph doesn't exist, there is nothing left to reset
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset default branch settings: %s", err))
+		return
+	}
+}
+
+func (r *GraphDefaultBranchSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug"
+	accountSlug, graphSlug, err := parseImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug', got: %s", req.ID))
+		return
+	}
+
+	// Get the settings to populate the remaining attributes
+	settings, err := r.client.GetDefaultBranchSettings(ctx, accountSlug, graphSlug)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read default branch settings during import: %s", err))
+		return
+	}
+
+	data := GraphDefaultBranchSettingsResourceModel{
+		ID:          types.StringValue(req.ID),
+		AccountSlug: types.StringValue(accountSlug),
+		GraphSlug:   types.StringValue(graphSlug),
+	}
+	data.fromSettings(settings)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setInput builds the client input replacing the default branch settings.
+func (m GraphDefaultBranchSettingsResourceModel) setInput() client.SetDefaultBranchSettingsInput {
+	return client.SetDefaultBranchSettingsInput{
+		AccountSlug:                 m.AccountSlug.ValueString(),
+		GraphSlug:                   m.GraphSlug.ValueString(),
+		OperationChecksEnabled:      m.OperationChecksEnabled.ValueBool(),
+		InheritEnvironmentVariables: m.InheritEnvironmentVariables.ValueBool(),
+		TTLHours:                    m.TTLHours.ValueInt64Pointer(),
+	}
+}
+
+// fromSettings maps API default branch settings onto the model.
+func (m *GraphDefaultBranchSettingsResourceModel) fromSettings(settings *client.DefaultBranchSettings) {
+	m.OperationChecksEnabled = types.BoolValue(settings.OperationChecksEnabled)
+	m.InheritEnvironmentVariables = types.BoolValue(settings.InheritEnvironmentVariables)
+	m.TTLHours = types.Int64PointerValue(settings.TTLHours)
+}
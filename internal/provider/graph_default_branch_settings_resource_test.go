@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGraphDefaultBranchSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGraphDefaultBranchSettingsResourceConfig(72),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_graph_default_branch_settings.test", "id", "test-account/test-graph"),
+					resource.TestCheckResourceAttr("grafbase_graph_default_branch_settings.test", "operation_checks_enabled", "true"),
+					resource.TestCheckResourceAttr("grafbase_graph_default_branch_settings.test", "inherit_environment_variables", "true"),
+					resource.TestCheckResourceAttr("grafbase_graph_default_branch_settings.test", "ttl_hours", "72"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_graph_default_branch_settings.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-account/test-graph",
+			},
+			// Update in place
+			{
+				Config: testAccGraphDefaultBranchSettingsResourceConfig(24),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_graph_default_branch_settings.test", "ttl_hours", "24"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGraphDefaultBranchSettingsResourceConfig(ttlHours int) string {
+	return fmt.Sprintf(`
+resource "grafbase_graph" "test" {
+  account_slug = "test-account"
+  slug         = "test-graph"
+}
+
+resource "grafbase_graph_default_branch_settings" "test" {
+  account_slug             = grafbase_graph.test.account_slug
+  graph_slug               = grafbase_graph.test.slug
+  operation_checks_enabled = true
+  ttl_hours                = %[1]d
+}
+`, ttlHours)
+}
+
+func TestGraphDefaultBranchSettingsResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewGraphDefaultBranchSettingsResource)
+
+	server.Handle("SetDefaultBranchSettings", `{"defaultBranchSettingsSet": {"__typename": "DefaultBranchSettingsSetSuccess", "defaultBranchSettings": {
+		"operationChecksEnabled": true, "inheritEnvironmentVariables": true, "ttlHours": 72
+	}}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug":                  types.StringValue("my-account"),
+		"graph_slug":                    types.StringValue("my-graph"),
+		"operation_checks_enabled":      types.BoolValue(true),
+		"inherit_environment_variables": types.BoolValue(true),
+		"ttl_hours":                     types.Int64Value(72),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "my-account/my-graph" {
+		t.Errorf("expected id my-account/my-graph, got %q", got)
+	}
+
+	server.Handle("GetDefaultBranchSettings", `{"graphByAccountSlug": {"defaultBranchSettings": {
+		"operationChecksEnabled": true, "inheritEnvironmentVariables": true, "ttlHours": 72
+	}}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+
+	// Removing the TTL sends null so preview branches stop expiring
+	server.Handle("SetDefaultBranchSettings", `{"defaultBranchSettingsSet": {"__typename": "DefaultBranchSettingsSetSuccess", "defaultBranchSettings": {
+		"operationChecksEnabled": true, "inheritEnvironmentVariables": true, "ttlHours": null
+	}}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"ttl_hours": types.Int64Null(),
+	})
+	requireNoDiagnostics(t, diags)
+	if ttl, ok := server.LastRequest("SetDefaultBranchSettings").Variables["input"].(map[string]interface{})["ttlHours"]; !ok || ttl != nil {
+		t.Errorf("expected ttlHours to be null, got %v", ttl)
+	}
+
+	// Destroying restores the defaults
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+	sent := server.LastRequest("SetDefaultBranchSettings").Variables["input"].(map[string]interface{})
+	if sent["operationChecksEnabled"] != false || sent["inheritEnvironmentVariables"] != true {
+		t.Errorf("expected default settings to be restored, got %v", sent)
+	}
+
+	server.Handle("GetDefaultBranchSettings", `{"graphByAccountSlug": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected settings to be removed from state")
+	}
+}
+
+func TestGraphDefaultBranchSettingsResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewGraphDefaultBranchSettingsResource)
+
+	server.Handle("GetDefaultBranchSettings", `{"graphByAccountSlug": {"defaultBranchSettings": {
+		"operationChecksEnabled": false, "inheritEnvironmentVariables": false, "ttlHours": 24
+	}}}`)
+	state, diags := importResource(t, r, "my-account/my-graph")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "graph_slug"); got != "my-graph" {
+		t.Errorf("expected graph_slug my-graph, got %q", got)
+	}
+
+	if _, diags := importResource(t, r, "my-account/my-graph/main"); !diags.HasError() {
+		t.Error("expected invalid import ID to be an error")
+	}
+}
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GraphKeyResource{}
+var _ resource.ResourceWithImportState = &GraphKeyResource{}
+
+func NewGraphKeyResource() resource.Resource {
+	return &GraphKeyResource{}
+}
+
+// GraphKeyResource defines the resource implementation.
+type GraphKeyResource struct {
+	client client.API
+}
+
+// GraphKeyResourceModel describes the resource data model.
+type GraphKeyResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	AccountSlug types.String `tfsdk:"account_slug"`
+	GraphSlug   types.String `tfsdk:"graph_slug"`
+	Name        types.String `tfsdk:"name"`
+	Keepers     types.Map    `tfsdk:"keepers"`
+	Secret      types.String `tfsdk:"secret"`
+	CreatedAt   RFC3339Value `tfsdk:"created_at"`
+	LastUsedAt  RFC3339Value `tfsdk:"last_used_at"`
+}
+
+func (r *GraphKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_key"
+}
+
+func (r *GraphKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages a publish key of a graph, which CI pipelines use to publish subgraphs and run schema checks. " +
+			"The key secret is only available after creation, so changing any argument, including `keepers`, revokes the key and creates a new one.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Graph key identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug the key can publish to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the key, shown in the dashboard",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"keepers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that rotate the key when changed, for example a timestamp from the " +
+					"`time_rotating` resource. The values are not sent to Grafbase.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "Key secret. Not available for imported keys.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "Key creation timestamp",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_used_at": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "Timestamp of the last publish made with the key, or null when it was never used. Refreshed on every read.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *GraphKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GraphKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GraphKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createInput := client.CreateGraphKeyInput{
+		AccountSlug: data.AccountSlug.ValueString(),
+		GraphSlug:   data.GraphSlug.ValueString(),
+		Name:        data.Name.ValueString(),
+	}
+
+	key, secret, err := r.client.CreateGraphKey(ctx, createInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create graph key: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.Secret = types.StringValue(secret)
+	data.fromGraphKey(key)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitUntilCreated(ctx, "graph key", func(ctx context.Context) error {
+		_, err := r.client.GetGraphKey(ctx, data.ID.ValueString())
+		return err
+	}, "graph key not found")...)
+}
+
+func (r *GraphKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GraphKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := r.client.GetGraphKey(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the key was revoked outside Terraform, create a new one
+		if err.Error() == "graph key not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read graph key: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	data.fromGraphKey(key)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GraphKeyResourceModel
+
+	// Every argument requires replacement, so the plan only carries over the prior state
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GraphKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RevokeGraphKey(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the key is already gone, there is nothing left to revoke
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke graph key: %s", err))
+		return
+	}
+}
+
+func (r *GraphKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug/key_id"
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[2] == "" {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/key_id', got: %s", req.ID))
+		return
+	}
+
+	// Get the key to populate the remaining attributes
+	key, err := r.client.GetGraphKey(ctx, parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read graph key during import: %s", err))
+		return
+	}
+
+	// The secret and the keepers cannot be recovered from the API
+	data := GraphKeyResourceModel{
+		AccountSlug: types.StringValue(parts[0]),
+		GraphSlug:   types.StringValue(parts[1]),
+		Keepers:     types.MapNull(types.StringType),
+		Secret:      types.StringNull(),
+	}
+	data.fromGraphKey(key)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fromGraphKey maps an API graph key onto the model
+func (m *GraphKeyResourceModel) fromGraphKey(key *client.GraphKey) {
+	m.ID = types.StringValue(key.ID)
+	m.Name = types.StringValue(key.Name)
+	m.CreatedAt = NewRFC3339Value(key.CreatedAt)
+	m.LastUsedAt = NewRFC3339PointerValue(key.LastUsedAt)
+}
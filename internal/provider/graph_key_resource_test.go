@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccGraphKeyResource(t *testing.T) {
+	var firstSecret string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGraphKeyResourceConfig("2024-01"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_graph_key.test", "name", "terraform-test"),
+					resource.TestCheckResourceAttrSet("grafbase_graph_key.test", "secret"),
+					resource.TestCheckResourceAttrSet("grafbase_graph_key.test", "created_at"),
+					resource.TestCheckResourceAttrWith("grafbase_graph_key.test", "secret", func(value string) error {
+						firstSecret = value
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "grafbase_graph_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret", "keepers"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["grafbase_graph_key.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found: grafbase_graph_key.test")
+					}
+					return fmt.Sprintf("test-account/test-graph/%s", rs.Primary.ID), nil
+				},
+			},
+			// Changing the keepers rotates the key
+			{
+				Config: testAccGraphKeyResourceConfig("2024-02"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("grafbase_graph_key.test", "secret", func(value string) error {
+						if value == firstSecret {
+							return fmt.Errorf("expected the key to be rotated")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccGraphKeyResourceConfig(rotation string) string {
+	return fmt.Sprintf(`
+resource "grafbase_graph_key" "test" {
+  account_slug = "test-account"
+  graph_slug   = "test-graph"
+  name         = "terraform-test"
+
+  keepers = {
+    rotation = %[1]q
+  }
+}
+`, rotation)
+}
+
+func TestGraphKeyResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewGraphKeyResource)
+
+	server.Handle("CreateGraphKey", `{"graphKeyCreate": {"__typename": "GraphKeyCreateSuccess", "graphKey": {
+		"id": "graph-key-1", "name": "ci", "createdAt": "2024-01-15T10:30:00Z", "lastUsedAt": null
+	}, "secret": "gbpk_secret"}}`)
+	server.Handle("GetGraphKey", `{"node": {
+		"id": "graph-key-1", "name": "ci", "createdAt": "2024-01-15T10:30:00Z", "lastUsedAt": "2024-01-20T08:00:00Z"
+	}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("my-graph"),
+		"name":         types.StringValue("ci"),
+		"keepers":      types.MapValueMust(types.StringType, map[string]attr.Value{"rotation": types.StringValue("2024-01")}),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "secret"); got != "gbpk_secret" {
+		t.Errorf("expected secret gbpk_secret, got %q", got)
+	}
+
+	// Keepers only drive replacement and are never sent to the API
+	if _, ok := server.LastRequest("CreateGraphKey").Variables["input"].(map[string]interface{})["keepers"]; ok {
+		t.Error("expected keepers not to be sent")
+	}
+
+	// Reading refreshes the usage timestamp and keeps the secret
+	server.Handle("GetGraphKey", `{"node": {
+		"id": "graph-key-1", "name": "ci", "createdAt": "2024-01-15T10:30:00Z", "lastUsedAt": "2024-01-20T08:00:00Z"
+	}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "last_used_at"); got != "2024-01-20T08:00:00Z" {
+		t.Errorf("unexpected last_used_at %q", got)
+	}
+	if got := stateString(t, state, "secret"); got != "gbpk_secret" {
+		t.Errorf("expected secret to be kept, got %q", got)
+	}
+
+	server.Handle("RevokeGraphKey", `{"graphKeyRevoke": {"__typename": "GraphKeyDoesNotExistError"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	server.Handle("GetGraphKey", `{"node": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected graph key to be removed from state")
+	}
+}
+
+func TestGraphKeyResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewGraphKeyResource)
+
+	server.Handle("GetGraphKey", `{"node": {
+		"id": "graph-key-1", "name": "ci", "createdAt": "2024-01-15T10:30:00Z", "lastUsedAt": null
+	}}`)
+	state, diags := importResource(t, r, "my-account/my-graph/graph-key-1")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "graph_slug"); got != "my-graph" {
+		t.Errorf("expected graph_slug my-graph, got %q", got)
+	}
+	if got := stateString(t, state, "secret"); got != "" {
+		t.Errorf("expected secret to be null, got %q", got)
+	}
+
+	if _, diags := importResource(t, r, "my-account/graph-key-1"); !diags.HasError() {
+		t.Error("expected invalid import ID to be an error")
+	}
+}
@@ -2,12 +2,19 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"strings"
 
 	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,6 +23,15 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &GraphResource{}
 var _ resource.ResourceWithImportState = &GraphResource{}
+var _ resource.ResourceWithIdentity = &GraphResource{}
+var _ resource.ResourceWithMoveState = &GraphResource{}
+var _ resource.ResourceWithModifyPlan = &GraphResource{}
+
+// graphInputAttributes maps graph mutation input fields to resource attributes.
+var graphInputAttributes = map[string]path.Path{
+	"accountSlug": path.Root("account_slug"),
+	"slug":        path.Root("slug"),
+}
 
 func NewGraphResource() resource.Resource {
 	return &GraphResource{}
@@ -23,15 +39,34 @@ func NewGraphResource() resource.Resource {
 
 // GraphResource defines the resource implementation.
 type GraphResource struct {
-	client *client.Client
+	client client.API
 }
 
 // GraphResourceModel describes the resource data model.
 type GraphResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	AccountSlug types.String `tfsdk:"account_slug"`
-	Slug        types.String `tfsdk:"slug"`
-	CreatedAt   types.String `tfsdk:"created_at"`
+	ID                 types.String   `tfsdk:"id"`
+	AccountSlug        types.String   `tfsdk:"account_slug"`
+	Account            types.Object   `tfsdk:"account"`
+	Slug               types.String   `tfsdk:"slug"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	CreatedAt          RFC3339Value   `tfsdk:"created_at"`
+	ProductionBranch   types.String   `tfsdk:"production_branch"`
+	GraphQLEndpointURL types.String   `tfsdk:"graphql_endpoint_url"`
+	DashboardURL       types.String   `tfsdk:"dashboard_url"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// GraphResourceIdentityModel describes the resource identity, which tracks the
+// graph by its API ID across renames and transfers.
+type GraphResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// graphAccountAttrTypes describes the object type of the account of a graph.
+var graphAccountAttrTypes = map[string]attr.Type{
+	"id":   types.StringType,
+	"slug": types.StringType,
+	"name": types.StringType,
 }
 
 func (r *GraphResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,22 +87,90 @@ func (r *GraphResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"account_slug": schema.StringAttribute{
-				MarkdownDescription: "Account slug where the graph belongs",
+				MarkdownDescription: "Account slug where the graph belongs. Changing it transfers the graph to the other account in place.",
 				Required:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+			},
+			"account": schema.SingleNestedAttribute{
+				MarkdownDescription: "Account the graph belongs to, as returned by the API",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "Account identifier",
+						Computed:            true,
+					},
+					"slug": schema.StringAttribute{
+						MarkdownDescription: "Account slug",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Account name",
+						Computed:            true,
+					},
 				},
 			},
 			"slug": schema.StringAttribute{
-				MarkdownDescription: "Graph slug",
+				MarkdownDescription: "Graph slug. Changing it renames the graph in place, keeping its branches and analytics history.",
 				Required:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Validators:          slugValidators(),
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Prevent the graph from being destroyed. While `true`, destroying or replacing the graph " +
+					"fails; set it to `false` and apply before removing the graph. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
 			"created_at": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
 				MarkdownDescription: "Graph creation timestamp",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"production_branch": schema.StringAttribute{
+				MarkdownDescription: "Name of the production branch of the graph, or `null` until a branch is deployed",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graphql_endpoint_url": schema.StringAttribute{
+				MarkdownDescription: "GraphQL endpoint URL of the production branch",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dashboard_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the graph in the Grafbase dashboard",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *GraphResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Graph identifier",
+				RequiredForImport: true,
 			},
 		},
 	}
@@ -79,12 +182,12 @@ func (r *GraphResource) Configure(ctx context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.API)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -103,6 +206,16 @@ func (r *GraphResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// First, get the account ID by slug
 	account, err := r.client.GetAccountBySlug(ctx, data.AccountSlug.ValueString())
 	if err != nil {
@@ -118,16 +231,25 @@ func (r *GraphResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	graph, err := r.client.CreateGraph(ctx, createInput)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create graph: %s", err))
+		addClientError(&resp.Diagnostics, "create graph", err, graphInputAttributes)
 		return
 	}
 
 	// Map response body to schema and populate Computed attribute values
-	data.ID = types.StringValue(graph.ID)
-	data.CreatedAt = types.StringValue(graph.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.fromGraph(graph)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, GraphResourceIdentityModel{ID: data.ID})...)
+
+	// Branches and other resources of the graph look it up by slug
+	err = waitUntilVisible(ctx, func(ctx context.Context) error {
+		_, err := r.client.GetGraph(ctx, data.AccountSlug.ValueString(), data.Slug.ValueString())
+		return err
+	}, "graph not found")
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Graph was created but could not be read back: %s", err))
+	}
 }
 
 func (r *GraphResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -140,8 +262,17 @@ func (r *GraphResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	// Get the graph using the account slug and graph slug
-	graph, err := r.client.GetGraph(ctx, data.AccountSlug.ValueString(), data.Slug.ValueString())
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	graph, err := r.lookupGraph(ctx, data)
 	if err != nil {
 		// If graph is not found, remove it from state
 		if err.Error() == "graph not found" {
@@ -152,31 +283,110 @@ func (r *GraphResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	// Update the model with the latest data
-	data.ID = types.StringValue(graph.ID)
-	data.CreatedAt = types.StringValue(graph.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	// Update the model with the latest data, picking up renames and transfers
+	// made outside of Terraform
+	data.Slug = types.StringValue(graph.Slug)
+	if graph.Account.Slug != "" {
+		data.AccountSlug = types.StringValue(graph.Account.Slug)
+	}
+	data.fromGraph(graph)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, GraphResourceIdentityModel{ID: data.ID})...)
+}
+
+func (r *GraphResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state GraphResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A transfer moves the graph to another account, which is only known after apply
+	if !plan.AccountSlug.Equal(state.AccountSlug) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("account"), types.ObjectUnknown(graphAccountAttrTypes))...)
+	}
+
+	// The URLs contain both slugs, so a rename or transfer changes them
+	if !plan.AccountSlug.Equal(state.AccountSlug) || !plan.Slug.Equal(state.Slug) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("graphql_endpoint_url"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("dashboard_url"), types.StringUnknown())...)
+	}
 }
 
 func (r *GraphResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data GraphResourceModel
+	var data, state GraphResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Currently, the Grafbase API doesn't support updating graphs
-	// The account_slug and slug both have RequiresReplace plan modifiers
-	// So this method should not be called in practice
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"Graph updates are not supported. Changes to account_slug or slug require resource replacement.",
-	)
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Transfer the graph first if the account changed, so a rename applies in the target account
+	if !data.AccountSlug.Equal(state.AccountSlug) {
+		account, err := r.client.GetAccountBySlug(ctx, data.AccountSlug.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get account: %s", err))
+			return
+		}
+
+		transferInput := client.TransferGraphInput{
+			ID:        state.ID.ValueString(),
+			AccountID: account.ID,
+		}
+
+		graph, err := r.client.TransferGraph(ctx, transferInput)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "transfer graph", err, graphInputAttributes)
+			return
+		}
+
+		data.AccountSlug = types.StringValue(graph.Account.Slug)
+		data.fromGraph(graph)
+	}
+
+	// Rename the graph if the slug changed
+	if !data.Slug.Equal(state.Slug) {
+		updateInput := client.UpdateGraphInput{
+			ID:   state.ID.ValueString(),
+			Slug: data.Slug.ValueString(),
+		}
+
+		graph, err := r.client.UpdateGraph(ctx, updateInput)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "rename graph", err, graphInputAttributes)
+			return
+		}
+
+		data.Slug = types.StringValue(graph.Slug)
+		data.fromGraph(graph)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, GraphResourceIdentityModel{ID: data.ID})...)
 }
 
 func (r *GraphResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -189,6 +399,25 @@ func (r *GraphResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			fmt.Sprintf("Graph %s/%s has deletion_protection enabled. Set deletion_protection = false and apply before destroying it.",
+				data.AccountSlug.ValueString(), data.Slug.ValueString()),
+		)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete the graph
 	err := r.client.DeleteGraph(ctx, data.ID.ValueString())
 	if err != nil {
@@ -198,27 +427,162 @@ func (r *GraphResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *GraphResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import by ID format: "account_slug/graph_slug"
-	// We'll parse this to get both the account slug and graph slug
-	accountSlug, graphSlug, err := parseImportID(req.ID)
-	if err != nil {
-		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug', got: %s", req.ID))
-		return
+	var graph *client.Graph
+	var accountSlug string
+
+	if req.ID == "" {
+		// Import by identity, which tracks the graph by its ID
+		var identity GraphResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var err error
+		graph, err = r.client.GetGraphByID(ctx, identity.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read graph during import: %s", err))
+			return
+		}
+		accountSlug = graph.Account.Slug
+	} else if isGraphID(req.ID) {
+		// Import by graph ID, as found in inventory exports
+		var err error
+		graph, err = r.client.GetGraphByID(ctx, req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read graph during import: %s", err))
+			return
+		}
+		accountSlug = graph.Account.Slug
+	} else {
+		// Import by ID format: "account_slug/graph_slug"
+		// We'll parse this to get both the account slug and graph slug
+		var graphSlug string
+		var err error
+		accountSlug, graphSlug, err = parseImportID(req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug' or a graph ID, got: %s", req.ID))
+			return
+		}
+
+		// Get the graph to populate the remaining attributes
+		graph, err = r.client.GetGraph(ctx, accountSlug, graphSlug)
+		if err != nil {
+			resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read graph during import: %s", err))
+			return
+		}
 	}
 
-	// Set the account_slug and slug attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), graph.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_slug"), accountSlug)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), graphSlug)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account"), graphAccount(graph))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), graph.Slug)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("created_at"), NewRFC3339Value(graph.CreatedAt))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("production_branch"), graphProductionBranch(graph))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graphql_endpoint_url"), types.StringValue(graph.EndpointURL))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dashboard_url"), types.StringValue(graph.DashboardURL))...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, GraphResourceIdentityModel{ID: types.StringValue(graph.ID)})...)
+}
 
-	// Get the graph to populate the remaining attributes
-	graph, err := r.client.GetGraph(ctx, accountSlug, graphSlug)
+func (r *GraphResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{StateMover: r.moveLegacyGraph},
+	}
+}
+
+// moveLegacyGraph moves a grafbase_graph of a community fork of the provider
+// into this resource. The remaining attributes are refreshed by the next plan.
+func (r *GraphResource) moveLegacyGraph(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !isLegacyResource(req, "grafbase_graph") {
+		return
+	}
+
+	attributes, err := legacyAttributes(req.SourceRawState)
 	if err != nil {
-		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read graph during import: %s", err))
+		resp.Diagnostics.AddError("Unable to Move Resource State", fmt.Sprintf("Unable to read the %s state: %s", req.SourceTypeName, err))
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), graph.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("created_at"), graph.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))...)
+	id, hasID := attributes.first("id")
+	accountSlug, hasAccount := attributes.first("account_slug", "account")
+	graphSlug, hasSlug := attributes.first("slug", "graph_slug", "name")
+	if !hasID || !hasAccount || !hasSlug {
+		resp.Diagnostics.AddError(
+			"Unable to Move Resource State",
+			fmt.Sprintf("The %s state from %s must contain the graph id, account slug, and graph slug.", req.SourceTypeName, req.SourceProviderAddress),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("account_slug"), accountSlug)...)
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("slug"), graphSlug)...)
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("deletion_protection"), false)...)
+	if resp.TargetIdentity != nil {
+		resp.Diagnostics.Append(resp.TargetIdentity.Set(ctx, GraphResourceIdentityModel{ID: types.StringValue(id)})...)
+	}
+}
+
+// fromGraph maps the computed attributes of an API graph to the model
+func (m *GraphResourceModel) fromGraph(graph *client.Graph) {
+	m.ID = types.StringValue(graph.ID)
+	m.Account = graphAccount(graph)
+	m.CreatedAt = NewRFC3339Value(graph.CreatedAt)
+	m.ProductionBranch = graphProductionBranch(graph)
+	m.GraphQLEndpointURL = types.StringValue(graph.EndpointURL)
+	m.DashboardURL = types.StringValue(graph.DashboardURL)
+}
+
+// graphProductionBranch returns the production branch name of a graph, or
+// null before the graph has one
+func graphProductionBranch(graph *client.Graph) types.String {
+	if graph.ProductionBranch == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(graph.ProductionBranch.Name)
+}
+
+// graphAccount returns the account of a graph as an object value
+func graphAccount(graph *client.Graph) types.Object {
+	return types.ObjectValueMust(graphAccountAttrTypes, map[string]attr.Value{
+		"id":   types.StringValue(graph.Account.ID),
+		"slug": types.StringValue(graph.Account.Slug),
+		"name": types.StringValue(graph.Account.Name),
+	})
+}
+
+// lookupGraph finds the graph of the model by its ID, which survives renames
+// and transfers, falling back to the account and graph slugs when the ID is
+// unknown or no longer exists.
+func (r *GraphResource) lookupGraph(ctx context.Context, data GraphResourceModel) (*client.Graph, error) {
+	if data.ID.ValueString() != "" {
+		graph, err := r.client.GetGraphByID(ctx, data.ID.ValueString())
+		if err == nil {
+			return graph, nil
+		}
+		if err.Error() != "graph not found" {
+			return nil, err
+		}
+	}
+
+	return r.client.GetGraph(ctx, data.AccountSlug.ValueString(), data.Slug.ValueString())
+}
+
+// graphIDPrefix is the type name encoded in the global ID of a graph
+const graphIDPrefix = "Graph:"
+
+// isGraphID reports whether an import ID is a graph ID rather than slugs.
+// Graph IDs are base64 encoded "Graph:<id>" strings, which never form a valid
+// slug since they contain uppercase letters.
+func isGraphID(id string) bool {
+	decoded, err := base64.StdEncoding.DecodeString(id)
+	if err != nil {
+		decoded, err = base64.RawStdEncoding.DecodeString(id)
+	}
+
+	return err == nil && strings.HasPrefix(string(decoded), graphIDPrefix)
 }
 
 // parseImportID parses the import ID in the format "account_slug/graph_slug"
@@ -1,10 +1,22 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/grafbase/terraform-provider-grafbase/internal/client/clientmock"
+	"github.com/grafbase/terraform-provider-grafbase/internal/mockgraphql"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccGraphResource(t *testing.T) {
@@ -29,9 +41,14 @@ func TestAccGraphResource(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateId:     "test-account/test-graph",
 			},
-			// Update testing (should force replacement)
+			// Update testing (renames in place)
 			{
 				Config: testAccGraphResourceConfig("test-account", "test-graph-updated"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("grafbase_graph.test", plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("grafbase_graph.test", "account_slug", "test-account"),
 					resource.TestCheckResourceAttr("grafbase_graph.test", "slug", "test-graph-updated"),
@@ -67,6 +84,20 @@ func TestAccGraphResourceDisappears(t *testing.T) {
 	})
 }
 
+func TestAccGraphResource_InvalidSlug(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGraphResourceConfig("test-account", "Invalid_Slug"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must contain only lowercase letters, numbers, and single hyphens`),
+			},
+		},
+	})
+}
+
 func TestParseImportID(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -252,3 +283,461 @@ func TestAccGraphResourcePerformance(t *testing.T) {
 		},
 	})
 }
+
+func TestGraphResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewGraphResource)
+
+	server.Handle("GetAccount", `{"accountBySlug": {"id": "account-1", "slug": "my-account", "name": "My Account"}}`)
+	server.Handle("CreateGraph", `{"graphCreate": {"__typename": "GraphCreateSuccess", "graph": {"id": "graph-1", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z", "account": {"id": "account-1", "slug": "my-account"}}}}`)
+	server.Handle("GetGraph", `{"graphByAccountSlug": {"id": "graph-1", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z", "account": {"id": "account-1", "slug": "my-account"}}}`)
+
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"slug":         types.StringValue("my-graph"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "graph-1" {
+		t.Errorf("expected id graph-1, got %q", got)
+	}
+	if got := stateString(t, state, "created_at"); got != "2024-01-15T10:30:00Z" {
+		t.Errorf("unexpected created_at %q", got)
+	}
+
+	server.Handle("GetGraphByID", `{"node": {"id": "graph-1", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z", "account": {"id": "account-1", "slug": "my-account", "name": "My Account"}}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "slug"); got != "my-graph" {
+		t.Errorf("expected slug my-graph, got %q", got)
+	}
+	var accountName types.String
+	requireNoDiagnostics(t, state.GetAttribute(t.Context(), path.Root("account").AtName("name"), &accountName))
+	if accountName.ValueString() != "My Account" {
+		t.Errorf("expected account name My Account, got %q", accountName.ValueString())
+	}
+
+	server.Handle("UpdateGraph", `{"graphUpdate": {"__typename": "GraphUpdateSuccess", "graph": {"id": "graph-1", "slug": "renamed-graph", "createdAt": "2024-01-15T10:30:00Z", "account": {"id": "account-1", "slug": "my-account"}}}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"slug": types.StringValue("renamed-graph"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "slug"); got != "renamed-graph" {
+		t.Errorf("expected slug renamed-graph, got %q", got)
+	}
+	if got := server.LastRequest("UpdateGraph").Variables["input"].(map[string]interface{})["slug"]; got != "renamed-graph" {
+		t.Errorf("expected rename to renamed-graph, got %v", got)
+	}
+
+	server.Handle("DeleteGraph", `{"graphDelete": {"__typename": "GraphDeleteSuccess", "deletedId": "graph-1"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	// A graph deleted outside of Terraform is removed from state
+	server.Handle("GetGraphByID", `{"node": null}`)
+	server.Handle("GetGraph", `{"graphByAccountSlug": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected graph to be removed from state")
+	}
+}
+
+func TestGraphResourceCreateWaitsUntilVisible(t *testing.T) {
+	r, server := newMockResource(t, NewGraphResource)
+
+	server.Handle("GetAccount", `{"accountBySlug": {"id": "account-1", "slug": "my-account", "name": "My Account"}}`)
+	server.Handle("CreateGraph", `{"graphCreate": {"__typename": "GraphCreateSuccess", "graph": {"id": "graph-1", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z", "account": {"id": "account-1", "slug": "my-account"}}}}`)
+
+	// The new graph is not found by slug until the second read
+	reads := 0
+	server.HandleFunc("GetGraph", func(mockgraphql.Request) mockgraphql.Response {
+		reads++
+		if reads == 1 {
+			return mockgraphql.Response{Data: `{"graphByAccountSlug": null}`}
+		}
+		return mockgraphql.Response{Data: `{"graphByAccountSlug": {"id": "graph-1", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z", "account": {"id": "account-1", "slug": "my-account"}}}`}
+	})
+
+	_, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"slug":         types.StringValue("my-graph"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := len(server.Requests("GetGraph")); got != 2 {
+		t.Errorf("expected the graph to be read until visible, got %d reads", got)
+	}
+}
+
+func TestGraphResourceReadDrift(t *testing.T) {
+	r, server := newMockResource(t, NewGraphResource)
+
+	server.Handle("GetAccount", `{"accountBySlug": {"id": "account-1", "slug": "my-account", "name": "My Account"}}`)
+	server.Handle("CreateGraph", `{"graphCreate": {"__typename": "GraphCreateSuccess", "graph": {"id": "graph-1", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z", "account": {"id": "account-1", "slug": "my-account"}}}}`)
+	server.Handle("GetGraph", `{"graphByAccountSlug": {"id": "graph-1", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z", "account": {"id": "account-1", "slug": "my-account"}}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"slug":         types.StringValue("my-graph"),
+	})
+	requireNoDiagnostics(t, diags)
+
+	// A graph renamed and transferred outside of Terraform is found by ID and
+	// its slugs are reconciled into state
+	server.Handle("GetGraphByID", `{"node": {"id": "graph-1", "slug": "renamed-graph", "createdAt": "2024-01-15T10:30:00Z", "account": {"id": "account-2", "slug": "my-org"}}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "slug"); got != "renamed-graph" {
+		t.Errorf("expected slug renamed-graph, got %q", got)
+	}
+	if got := stateString(t, state, "account_slug"); got != "my-org" {
+		t.Errorf("expected account_slug my-org, got %q", got)
+	}
+	if requests := server.Requests("GetGraph"); len(requests) != 1 {
+		t.Errorf("expected no lookup by slug after creation, got %d", len(requests)-1)
+	}
+
+	// A graph recreated under the same slug is found by slug instead
+	server.Handle("GetGraphByID", `{"node": null}`)
+	server.Handle("GetGraph", `{"graphByAccountSlug": {"id": "graph-2", "slug": "renamed-graph", "createdAt": "2024-02-01T09:00:00Z", "account": {"id": "account-2", "slug": "my-org"}}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "graph-2" {
+		t.Errorf("expected id graph-2, got %q", got)
+	}
+	if vars := server.LastRequest("GetGraph").Variables; vars["accountSlug"] != "my-org" || vars["graphSlug"] != "renamed-graph" {
+		t.Errorf("unexpected slug lookup %v", vars)
+	}
+}
+
+func TestGraphResourceTransfer(t *testing.T) {
+	r, server := newMockResource(t, NewGraphResource)
+
+	server.Handle("GetAccount", `{"accountBySlug": {"id": "account-1", "slug": "my-account", "name": "My Account"}}`)
+	server.Handle("CreateGraph", `{"graphCreate": {"__typename": "GraphCreateSuccess", "graph": {"id": "graph-1", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z", "account": {"id": "account-1", "slug": "my-account"}}}}`)
+	server.Handle("GetGraph", `{"graphByAccountSlug": {"id": "graph-1", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z", "account": {"id": "account-1", "slug": "my-account"}}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"slug":         types.StringValue("my-graph"),
+	})
+	requireNoDiagnostics(t, diags)
+
+	// Changing the account and slug together transfers the graph, then renames it
+	server.Handle("GetAccount", `{"accountBySlug": {"id": "account-2", "slug": "my-org", "name": "My Org"}}`)
+	server.Handle("TransferGraph", `{"graphTransfer": {"__typename": "GraphTransferSuccess", "graph": {"id": "graph-1", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z", "account": {"id": "account-2", "slug": "my-org"}}}}`)
+	server.Handle("UpdateGraph", `{"graphUpdate": {"__typename": "GraphUpdateSuccess", "graph": {"id": "graph-1", "slug": "org-graph", "createdAt": "2024-01-15T10:30:00Z", "account": {"id": "account-2", "slug": "my-org"}}}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"account_slug": types.StringValue("my-org"),
+		"slug":         types.StringValue("org-graph"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "account_slug"); got != "my-org" {
+		t.Errorf("expected account_slug my-org, got %q", got)
+	}
+	var accountID types.String
+	requireNoDiagnostics(t, state.GetAttribute(t.Context(), path.Root("account").AtName("id"), &accountID))
+	if accountID.ValueString() != "account-2" {
+		t.Errorf("expected account id account-2, got %q", accountID.ValueString())
+	}
+	if got := stateString(t, state, "id"); got != "graph-1" {
+		t.Errorf("expected the graph to keep its id, got %q", got)
+	}
+	if got := server.LastRequest("TransferGraph").Variables["input"].(map[string]interface{})["accountId"]; got != "account-2" {
+		t.Errorf("expected transfer to account-2, got %v", got)
+	}
+	if len(server.Requests("UpdateGraph")) != 1 {
+		t.Error("expected the graph to be renamed after the transfer")
+	}
+
+	server.Handle("GetAccount", `{"accountBySlug": {"id": "account-3", "slug": "other-org", "name": "Other Org"}}`)
+	server.Handle("TransferGraph", `{"graphTransfer": {"__typename": "GraphTransferNotAllowedError"}}`)
+	_, diags = updateResource(t, r, state, map[string]attr.Value{
+		"account_slug": types.StringValue("other-org"),
+	})
+	if !diags.HasError() {
+		t.Fatal("expected a disallowed transfer to be an error")
+	}
+	if diags[0].Summary() != "Graph Transfer Not Allowed" {
+		t.Errorf("unexpected diagnostic: %s", diags[0].Summary())
+	}
+}
+
+func TestGraphResourceDeletionProtection(t *testing.T) {
+	r, server := newMockResource(t, NewGraphResource)
+
+	server.Handle("GetAccount", `{"accountBySlug": {"id": "account-1", "slug": "my-account", "name": "My Account"}}`)
+	server.Handle("CreateGraph", `{"graphCreate": {"__typename": "GraphCreateSuccess", "graph": {"id": "graph-1", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z", "account": {"id": "account-1", "slug": "my-account"}}}}`)
+	server.Handle("GetGraph", `{"graphByAccountSlug": {"id": "graph-1", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z", "account": {"id": "account-1", "slug": "my-account"}}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug":        types.StringValue("my-account"),
+		"slug":                types.StringValue("my-graph"),
+		"deletion_protection": types.BoolValue(true),
+	})
+	requireNoDiagnostics(t, diags)
+
+	// A protected graph is never deleted
+	server.Handle("DeleteGraph", `{"graphDelete": {"__typename": "GraphDeleteSuccess", "deletedId": "graph-1"}}`)
+	if diags := deleteResource(t, r, state); !diags.HasError() {
+		t.Error("expected deleting a protected graph to be an error")
+	}
+	if requests := server.Requests("DeleteGraph"); len(requests) != 0 {
+		t.Errorf("expected no delete request, got %d", len(requests))
+	}
+
+	// Disabling the protection only updates state
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"deletion_protection": types.BoolValue(false),
+	})
+	requireNoDiagnostics(t, diags)
+	if requests := server.Requests("UpdateGraph"); len(requests) != 0 {
+		t.Errorf("expected no update request, got %d", len(requests))
+	}
+
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+	if requests := server.Requests("DeleteGraph"); len(requests) != 1 {
+		t.Errorf("expected one delete request, got %d", len(requests))
+	}
+}
+
+func TestGraphResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewGraphResource)
+
+	server.Handle("GetGraph", `{"graphByAccountSlug": {"id": "graph-1", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z", "account": {"id": "account-1", "slug": "my-account"}}}`)
+	state, diags := importResource(t, r, "my-account/my-graph")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "id"); got != "graph-1" {
+		t.Errorf("expected id graph-1, got %q", got)
+	}
+
+	if _, diags := importResource(t, r, "my-graph"); !diags.HasError() {
+		t.Error("expected error for malformed import ID")
+	}
+}
+
+func TestGraphResourceImportByGraphID(t *testing.T) {
+	r, server := newMockResource(t, NewGraphResource)
+
+	// base64 of "Graph:01J8Z5X"
+	graphID := "R3JhcGg6MDFKOFo1WA=="
+	server.Handle("GetGraphByID", `{"node": {"id": "`+graphID+`", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z", "account": {"id": "account-1", "slug": "my-account"}}}`)
+	state, diags := importResource(t, r, graphID)
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "account_slug"); got != "my-account" {
+		t.Errorf("expected account slug my-account, got %q", got)
+	}
+	if got := server.LastRequest("GetGraphByID").Variables["id"]; got != graphID {
+		t.Errorf("expected graph to be looked up by ID, got %v", got)
+	}
+}
+
+func TestIsGraphID(t *testing.T) {
+	tests := map[string]bool{
+		"R3JhcGg6MDFKOFo1WA==": true,
+		"R3JhcGg6MDFKOFo1WA":   true,
+		"QnJhbmNoOjAxSjhaNVg=": false, // "Branch:01J8Z5X"
+		"my-graph":             false,
+		"my-account/my-graph":  false,
+		"":                     false,
+	}
+
+	for id, want := range tests {
+		if got := isGraphID(id); got != want {
+			t.Errorf("isGraphID(%q) = %t, want %t", id, got, want)
+		}
+	}
+}
+
+func TestGraphResourceIdentity(t *testing.T) {
+	r, server := newMockResource(t, NewGraphResource)
+
+	server.Handle("GetGraphByID", `{"node": {"id": "graph-1", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z", "account": {"id": "account-1", "slug": "my-account"}}}`)
+	state, identity, diags := importResourceByIdentity(t, r, map[string]attr.Value{
+		"id": types.StringValue("graph-1"),
+	})
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "account_slug"); got != "my-account" {
+		t.Errorf("expected account slug my-account, got %q", got)
+	}
+	if got := identityString(t, identity, "id"); got != "graph-1" {
+		t.Errorf("expected identity graph-1, got %q", got)
+	}
+
+	// Reads report the identity of graphs created before identities were supported
+	identity, diags = readResourceIdentity(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := identityString(t, identity, "id"); got != "graph-1" {
+		t.Errorf("expected identity graph-1 after read, got %q", got)
+	}
+
+	server.Handle("GetGraphByID", `{"node": null}`)
+	if _, _, diags := importResourceByIdentity(t, r, map[string]attr.Value{
+		"id": types.StringValue("missing"),
+	}); !diags.HasError() {
+		t.Error("expected importing a missing graph to be an error")
+	}
+}
+
+func TestGraphResourceMoveState(t *testing.T) {
+	r, _ := newMockResource(t, NewGraphResource)
+
+	state, identity, diags := moveResourceState(t, r, "registry.terraform.io/example/grafbase", "grafbase_graph",
+		`{"id": "graph-1", "account": "my-account", "name": "my-graph", "created_at": "2024-01-15T10:30:00Z"}`)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "slug"); got != "my-graph" {
+		t.Errorf("expected slug my-graph, got %q", got)
+	}
+	if got := stateString(t, state, "account_slug"); got != "my-account" {
+		t.Errorf("expected account slug my-account, got %q", got)
+	}
+	if got := identityString(t, identity, "id"); got != "graph-1" {
+		t.Errorf("expected identity graph-1, got %q", got)
+	}
+
+	// Resources of other providers are left to other movers
+	state, _, diags = moveResourceState(t, r, "registry.terraform.io/hashicorp/random", "grafbase_graph", `{"id": "graph-1"}`)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected a resource of another provider not to be moved")
+	}
+
+	if _, _, diags := moveResourceState(t, r, "registry.terraform.io/example/grafbase", "grafbase_graph", `{"id": "graph-1"}`); !diags.HasError() {
+		t.Error("expected moving a graph without slugs to be an error")
+	}
+}
+
+func TestGraphResourceURLs(t *testing.T) {
+	r, server := newMockResource(t, NewGraphResource)
+
+	server.Handle("GetAccount", `{"accountBySlug": {"id": "account-1", "slug": "my-account", "name": "My Account"}}`)
+	server.Handle("CreateGraph", `{"graphCreate": {"__typename": "GraphCreateSuccess", "graph": {"id": "graph-1", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z", "productionBranch": null,
+		"endpointUrl": "https://my-graph-my-account.grafbase.app/graphql", "dashboardUrl": "https://app.grafbase.com/my-account/my-graph", "account": {"id": "account-1", "slug": "my-account"}}}}`)
+	server.Handle("GetGraph", `{"graphByAccountSlug": {"id": "graph-1", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z", "account": {"id": "account-1", "slug": "my-account"}}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"slug":         types.StringValue("my-graph"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "graphql_endpoint_url"); got != "https://my-graph-my-account.grafbase.app/graphql" {
+		t.Errorf("unexpected graphql_endpoint_url %q", got)
+	}
+	if got := stateString(t, state, "dashboard_url"); got != "https://app.grafbase.com/my-account/my-graph" {
+		t.Errorf("unexpected dashboard_url %q", got)
+	}
+	if got := stateString(t, state, "production_branch"); got != "" {
+		t.Errorf("expected no production branch before the first deployment, got %q", got)
+	}
+
+	// The production branch is picked up on refresh once a branch is deployed
+	server.Handle("GetGraphByID", `{"node": {"id": "graph-1", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z", "productionBranch": {"name": "main"},
+		"endpointUrl": "https://my-graph-my-account.grafbase.app/graphql", "dashboardUrl": "https://app.grafbase.com/my-account/my-graph", "account": {"id": "account-1", "slug": "my-account"}}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "production_branch"); got != "main" {
+		t.Errorf("expected production branch main, got %q", got)
+	}
+}
+
+func TestGraphResourceURLsAfterRenameAndTransfer(t *testing.T) {
+	r, server := newMockResource(t, NewGraphResource)
+
+	server.Handle("GetAccount", `{"accountBySlug": {"id": "account-1", "slug": "my-account", "name": "My Account"}}`)
+	server.Handle("CreateGraph", `{"graphCreate": {"__typename": "GraphCreateSuccess", "graph": {"id": "graph-1", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z",
+		"endpointUrl": "https://my-graph-my-account.grafbase.app/graphql", "dashboardUrl": "https://app.grafbase.com/my-account/my-graph", "account": {"id": "account-1", "slug": "my-account"}}}}`)
+	server.Handle("GetGraph", `{"graphByAccountSlug": {"id": "graph-1", "slug": "my-graph", "createdAt": "2024-01-15T10:30:00Z", "account": {"id": "account-1", "slug": "my-account"}}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"slug":         types.StringValue("my-graph"),
+	})
+	requireNoDiagnostics(t, diags)
+
+	requireUnknownURLs := func(plan tfsdk.Plan) {
+		t.Helper()
+		for _, name := range []string{"graphql_endpoint_url", "dashboard_url"} {
+			var value types.String
+			requireNoDiagnostics(t, plan.GetAttribute(t.Context(), path.Root(name), &value))
+			if !value.IsUnknown() {
+				t.Errorf("expected %s to be unknown in the plan, got %s", name, value)
+			}
+		}
+	}
+
+	// A rename changes both URLs
+	plan, _, diags := planResourceUpdate(t, r, state, map[string]attr.Value{
+		"slug": types.StringValue("renamed-graph"),
+	})
+	requireNoDiagnostics(t, diags)
+	requireUnknownURLs(plan)
+
+	server.Handle("UpdateGraph", `{"graphUpdate": {"__typename": "GraphUpdateSuccess", "graph": {"id": "graph-1", "slug": "renamed-graph", "createdAt": "2024-01-15T10:30:00Z",
+		"endpointUrl": "https://renamed-graph-my-account.grafbase.app/graphql", "dashboardUrl": "https://app.grafbase.com/my-account/renamed-graph", "account": {"id": "account-1", "slug": "my-account"}}}}`)
+	state, diags = applyResourceUpdate(t, r, state, plan)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "graphql_endpoint_url"); got != "https://renamed-graph-my-account.grafbase.app/graphql" {
+		t.Errorf("unexpected graphql_endpoint_url after rename %q", got)
+	}
+	if got := stateString(t, state, "dashboard_url"); got != "https://app.grafbase.com/my-account/renamed-graph" {
+		t.Errorf("unexpected dashboard_url after rename %q", got)
+	}
+
+	// So does a transfer
+	plan, _, diags = planResourceUpdate(t, r, state, map[string]attr.Value{
+		"account_slug": types.StringValue("my-org"),
+	})
+	requireNoDiagnostics(t, diags)
+	requireUnknownURLs(plan)
+
+	server.Handle("GetAccount", `{"accountBySlug": {"id": "account-2", "slug": "my-org", "name": "My Org"}}`)
+	server.Handle("TransferGraph", `{"graphTransfer": {"__typename": "GraphTransferSuccess", "graph": {"id": "graph-1", "slug": "renamed-graph", "createdAt": "2024-01-15T10:30:00Z",
+		"endpointUrl": "https://renamed-graph-my-org.grafbase.app/graphql", "dashboardUrl": "https://app.grafbase.com/my-org/renamed-graph", "account": {"id": "account-2", "slug": "my-org"}}}}`)
+	state, diags = applyResourceUpdate(t, r, state, plan)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "graphql_endpoint_url"); got != "https://renamed-graph-my-org.grafbase.app/graphql" {
+		t.Errorf("unexpected graphql_endpoint_url after transfer %q", got)
+	}
+	if got := stateString(t, state, "dashboard_url"); got != "https://app.grafbase.com/my-org/renamed-graph" {
+		t.Errorf("unexpected dashboard_url after transfer %q", got)
+	}
+
+	// Other changes keep the URLs of the prior state
+	plan, _, diags = planResourceUpdate(t, r, state, map[string]attr.Value{
+		"deletion_protection": types.BoolValue(true),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}, "dashboard_url"); got != "https://app.grafbase.com/my-org/renamed-graph" {
+		t.Errorf("expected dashboard_url to be kept, got %q", got)
+	}
+}
+
+func TestGraphResourceMockAPI(t *testing.T) {
+	var created client.CreateGraphInput
+	api := &clientmock.API{
+		GetAccountBySlugFunc: func(ctx context.Context, slug string) (*client.Account, error) {
+			return &client.Account{ID: "account-1", Slug: slug}, nil
+		},
+		CreateGraphFunc: func(ctx context.Context, input client.CreateGraphInput) (*client.Graph, error) {
+			created = input
+			return &client.Graph{ID: "graph-1", Slug: input.GraphSlug, CreatedAt: time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)}, nil
+		},
+		GetGraphFunc: func(ctx context.Context, accountSlug, graphSlug string) (*client.Graph, error) {
+			return &client.Graph{ID: "graph-1", Slug: graphSlug}, nil
+		},
+		DeleteGraphFunc: func(ctx context.Context, id string) error {
+			return fmt.Errorf("graph has active deployments")
+		},
+	}
+	r := newResourceWithAPI(t, NewGraphResource, api)
+
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"slug":         types.StringValue("my-graph"),
+	})
+	requireNoDiagnostics(t, diags)
+	if created.AccountID != "account-1" || created.GraphSlug != "my-graph" {
+		t.Errorf("unexpected create input: %+v", created)
+	}
+
+	// Client errors are reported as diagnostics
+	diags = deleteResource(t, r, state)
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), "graph has active deployments") {
+		t.Errorf("expected delete error, got %v", diags)
+	}
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GraphSearchDataSource{}
+
+func NewGraphSearchDataSource() datasource.DataSource {
+	return &GraphSearchDataSource{}
+}
+
+// GraphSearchDataSource defines the data source implementation.
+type GraphSearchDataSource struct {
+	client client.API
+}
+
+// GraphSearchDataSourceModel describes the data source data model.
+type GraphSearchDataSourceModel struct {
+	ID            types.String             `tfsdk:"id"`
+	AccountSlug   types.String             `tfsdk:"account_slug"`
+	SlugPrefix    types.String             `tfsdk:"slug_prefix"`
+	CreatedAfter  types.String             `tfsdk:"created_after"`
+	CreatedBefore types.String             `tfsdk:"created_before"`
+	Graphs        []GraphSearchResultModel `tfsdk:"graphs"`
+}
+
+// GraphSearchResultModel describes a single graph matching the search.
+type GraphSearchResultModel struct {
+	ID               types.String `tfsdk:"id"`
+	Slug             types.String `tfsdk:"slug"`
+	CreatedAt        RFC3339Value `tfsdk:"created_at"`
+	ProductionBranch types.String `tfsdk:"production_branch"`
+	EndpointURL      types.String `tfsdk:"endpoint_url"`
+	DashboardURL     types.String `tfsdk:"dashboard_url"`
+}
+
+func (d *GraphSearchDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_search"
+}
+
+func (d *GraphSearchDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Searches the graphs of a Grafbase account by slug prefix and creation date, for example to find " +
+			"and report on stale preview graphs.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier, equal to the account slug",
+				Computed:            true,
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug to search graphs in",
+				Required:            true,
+			},
+			"slug_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only return graphs whose slug starts with this prefix, such as `preview-`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"created_after": schema.StringAttribute{
+				MarkdownDescription: "Only return graphs created at or after this RFC3339 timestamp",
+				Optional:            true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"created_before": schema.StringAttribute{
+				MarkdownDescription: "Only return graphs created before this RFC3339 timestamp, such as `timeadd(plantimestamp(), \"-720h\")` " +
+					"for graphs older than 30 days",
+				Optional: true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"graphs": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching graphs",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Graph identifier",
+							Computed:            true,
+						},
+						"slug": schema.StringAttribute{
+							MarkdownDescription: "Graph slug",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							CustomType:          RFC3339Type{},
+							MarkdownDescription: "Timestamp when the graph was created",
+							Computed:            true,
+						},
+						"production_branch": schema.StringAttribute{
+							MarkdownDescription: "Name of the production branch. Null until the first branch of the graph is deployed.",
+							Computed:            true,
+						},
+						"endpoint_url": schema.StringAttribute{
+							MarkdownDescription: "GraphQL endpoint URL of the production branch",
+							Computed:            true,
+						},
+						"dashboard_url": schema.StringAttribute{
+							MarkdownDescription: "URL of the graph in the Grafbase dashboard",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GraphSearchDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GraphSearchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GraphSearchDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := client.GraphFilter{
+		SlugPrefix: data.SlugPrefix.ValueString(),
+	}
+
+	// The validators guarantee both timestamps parse
+	if !data.CreatedAfter.IsNull() {
+		createdAfter, _ := time.Parse(time.RFC3339, data.CreatedAfter.ValueString())
+		filter.CreatedAfter = &createdAfter
+	}
+	if !data.CreatedBefore.IsNull() {
+		createdBefore, _ := time.Parse(time.RFC3339, data.CreatedBefore.ValueString())
+		filter.CreatedBefore = &createdBefore
+	}
+	if filter.CreatedAfter != nil && filter.CreatedBefore != nil && !filter.CreatedAfter.Before(*filter.CreatedBefore) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("created_before"),
+			"Invalid Time Range",
+			fmt.Sprintf("created_before must be after created_after, got created_after %s and created_before %s.",
+				data.CreatedAfter.ValueString(), data.CreatedBefore.ValueString()),
+		)
+		return
+	}
+
+	graphs, err := d.client.SearchGraphs(ctx, data.AccountSlug.ValueString(), filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to search graphs: %s", err))
+		return
+	}
+
+	data.ID = data.AccountSlug
+
+	data.Graphs = make([]GraphSearchResultModel, 0, len(graphs))
+	for _, graph := range graphs {
+		result := GraphSearchResultModel{
+			ID:               types.StringValue(graph.ID),
+			Slug:             types.StringValue(graph.Slug),
+			CreatedAt:        NewRFC3339Value(graph.CreatedAt),
+			ProductionBranch: types.StringNull(),
+			EndpointURL:      types.StringValue(graph.EndpointURL),
+			DashboardURL:     types.StringValue(graph.DashboardURL),
+		}
+		if graph.ProductionBranch != nil {
+			result.ProductionBranch = types.StringValue(graph.ProductionBranch.Name)
+		}
+
+		data.Graphs = append(data.Graphs, result)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGraphSearchDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphSearchDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafbase_graph_search.test", "id", "test-account"),
+					resource.TestCheckResourceAttr("data.grafbase_graph_search.test", "graphs.#", "1"),
+					resource.TestCheckResourceAttr("data.grafbase_graph_search.test", "graphs.0.slug", "test-search-graph"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGraphSearchDataSourceConfig() string {
+	return `
+resource "grafbase_graph" "test" {
+  account_slug = "test-account"
+  slug         = "test-search-graph"
+}
+
+data "grafbase_graph_search" "test" {
+  account_slug  = grafbase_graph.test.account_slug
+  slug_prefix   = "test-search-"
+  created_after = grafbase_graph.test.created_at
+}
+`
+}
+
+func TestGraphSearchDataSourceRead(t *testing.T) {
+	d, server := newMockDataSource(t, NewGraphSearchDataSource)
+
+	server.Handle("SearchGraphs", `{"accountBySlug": {"graphs": {
+		"edges": [
+			{"node": {"id": "graph-1", "slug": "preview-1", "createdAt": "2024-01-15T10:30:00Z", "productionBranch": {"name": "main"},
+				"endpointUrl": "https://preview-1.grafbase.app/graphql", "dashboardUrl": "https://app.grafbase.com/my-account/preview-1",
+				"account": {"id": "account-1", "slug": "my-account", "name": "My Account"}}},
+			{"node": {"id": "graph-2", "slug": "preview-2", "createdAt": "2024-01-20T10:30:00Z", "productionBranch": null,
+				"endpointUrl": "", "dashboardUrl": "https://app.grafbase.com/my-account/preview-2",
+				"account": {"id": "account-1", "slug": "my-account", "name": "My Account"}}}
+		],
+		"pageInfo": {"hasNextPage": false, "endCursor": null}
+	}}}`)
+	state, diags := readDataSource(t, d, map[string]attr.Value{
+		"account_slug":   types.StringValue("my-account"),
+		"slug_prefix":    types.StringValue("preview-"),
+		"created_before": types.StringValue("2024-02-01T00:00:00Z"),
+	})
+	requireNoDiagnostics(t, diags)
+
+	var graphs []GraphSearchResultModel
+	requireNoDiagnostics(t, state.GetAttribute(context.Background(), path.Root("graphs"), &graphs))
+	if len(graphs) != 2 || graphs[0].ProductionBranch.ValueString() != "main" || !graphs[1].ProductionBranch.IsNull() {
+		t.Errorf("unexpected graphs: %+v", graphs)
+	}
+
+	filter, _ := server.LastRequest("SearchGraphs").Variables["filter"].(map[string]interface{})
+	if filter["slugPrefix"] != "preview-" || filter["createdBefore"] != "2024-02-01T00:00:00Z" {
+		t.Errorf("unexpected filter: %v", filter)
+	}
+	if _, ok := filter["createdAfter"]; ok {
+		t.Errorf("expected open ended range, got %v", filter)
+	}
+
+	if _, diags := readDataSource(t, d, map[string]attr.Value{
+		"account_slug":   types.StringValue("my-account"),
+		"created_after":  types.StringValue("2024-03-02T00:00:00Z"),
+		"created_before": types.StringValue("2024-03-01T00:00:00Z"),
+	}); !diags.HasError() {
+		t.Error("expected an inverted time range to be an error")
+	}
+
+	server.Handle("SearchGraphs", `{"accountBySlug": null}`)
+	if _, diags := readDataSource(t, d, map[string]attr.Value{
+		"account_slug": types.StringValue("missing"),
+	}); !diags.HasError() {
+		t.Error("expected a missing account to be an error")
+	}
+}
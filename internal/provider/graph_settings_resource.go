@@ -0,0 +1,276 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Maximum analytics retention accepted by the Grafbase API.
+const maxAnalyticsRetentionDays = 365
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GraphSettingsResource{}
+var _ resource.ResourceWithImportState = &GraphSettingsResource{}
+
+func NewGraphSettingsResource() resource.Resource {
+	return &GraphSettingsResource{}
+}
+
+// GraphSettingsResource defines the resource implementation.
+type GraphSettingsResource struct {
+	client client.API
+}
+
+// GraphSettingsResourceModel describes the resource data model.
+type GraphSettingsResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	AccountSlug            types.String `tfsdk:"account_slug"`
+	GraphSlug              types.String `tfsdk:"graph_slug"`
+	AnalyticsRetentionDays types.Int64  `tfsdk:"analytics_retention_days"`
+	RequestLoggingEnabled  types.Bool   `tfsdk:"request_logging_enabled"`
+	OperationCheckPolicy   types.String `tfsdk:"operation_check_policy"`
+}
+
+func (r *GraphSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_settings"
+}
+
+func (r *GraphSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the graph-wide settings of a graph: how long analytics are retained, whether request " +
+			"logging is enabled, and how failing operation checks affect schema checks. Settings are updated in place, and " +
+			"destroying the resource restores the default settings.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the format `account_slug/graph_slug`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph the settings apply to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"analytics_retention_days": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Days analytics and traces are retained, at most `%d` and limited by the account plan. "+
+					"Defaults to the retention of the account plan.", maxAnalyticsRetentionDays),
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxAnalyticsRetentionDays),
+				},
+			},
+			"request_logging_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether requests are logged according to the graph's request logging rules. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"operation_check_policy": schema.StringAttribute{
+				MarkdownDescription: "How failing operation checks affect schema checks: `BLOCK` fails the schema check, `WARN` reports a warning. Defaults to `BLOCK`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(client.OperationCheckPolicyBlock)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(client.OperationCheckPolicyBlock), string(client.OperationCheckPolicyWarn)),
+				},
+			},
+		},
+	}
+}
+
+func (r *GraphSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GraphSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GraphSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.SetGraphSettings(ctx, data.setInput())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set graph settings: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.AccountSlug.ValueString(), data.GraphSlug.ValueString()))
+	data.fromSettings(settings)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GraphSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetGraphSettings(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString())
+	if err != nil {
+		// If the graph is gone, its settings are gone too
+		if err.Error() == "graph not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read graph settings: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	data.fromSettings(settings)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GraphSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.SetGraphSettings(ctx, data.setInput())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update graph settings: %s", err))
+		return
+	}
+
+	data.fromSettings(settings)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GraphSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Graphs retain analytics for the plan retention and block on failing operation checks by default
+	resetInput := client.SetGraphSettingsInput{
+		AccountSlug:          data.AccountSlug.ValueString(),
+		GraphSlug:            data.GraphSlug.ValueString(),
+		OperationCheckPolicy: client.OperationCheckPolicyBlock,
+	}
+
+	_, err := r.client.SetGraphSettings(ctx, resetInput)
+	if err != nil {
+		// If the graph doesn't exist, there is nothing left to reset
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset graph settings: %s", err))
+		return
+	}
+}
+
+func (r *GraphSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug"
+	accountSlug, graphSlug, err := parseImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug', got: %s", req.ID))
+		return
+	}
+
+	// Get the settings to populate the remaining attributes
+	settings, err := r.client.GetGraphSettings(ctx, accountSlug, graphSlug)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read graph settings during import: %s", err))
+		return
+	}
+
+	data := GraphSettingsResourceModel{
+		ID:          types.StringValue(req.ID),
+		AccountSlug: types.StringValue(accountSlug),
+		GraphSlug:   types.StringValue(graphSlug),
+	}
+	data.fromSettings(settings)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setInput builds the client input replacing the graph settings. An unknown
+// retention is sent as null, keeping the retention of the account plan.
+func (m GraphSettingsResourceModel) setInput() client.SetGraphSettingsInput {
+	input := client.SetGraphSettingsInput{
+		AccountSlug:           m.AccountSlug.ValueString(),
+		GraphSlug:             m.GraphSlug.ValueString(),
+		RequestLoggingEnabled: m.RequestLoggingEnabled.ValueBool(),
+		OperationCheckPolicy:  client.OperationCheckPolicy(m.OperationCheckPolicy.ValueString()),
+	}
+	if !m.AnalyticsRetentionDays.IsUnknown() {
+		input.AnalyticsRetentionDays = m.AnalyticsRetentionDays.ValueInt64Pointer()
+	}
+
+	return input
+}
+
+// fromSettings maps API graph settings onto the model.
+func (m *GraphSettingsResourceModel) fromSettings(settings *client.GraphSettings) {
+	m.AnalyticsRetentionDays = types.Int64Value(settings.AnalyticsRetentionDays)
+	m.RequestLoggingEnabled = types.BoolValue(settings.RequestLoggingEnabled)
+	m.OperationCheckPolicy = types.StringValue(string(settings.OperationCheckPolicy))
+}
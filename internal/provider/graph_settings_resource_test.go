@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGraphSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGraphSettingsResourceConfig("WARN"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_graph_settings.test", "id", "test-account/test-graph"),
+					resource.TestCheckResourceAttr("grafbase_graph_settings.test", "analytics_retention_days", "7"),
+					resource.TestCheckResourceAttr("grafbase_graph_settings.test", "request_logging_enabled", "false"),
+					resource.TestCheckResourceAttr("grafbase_graph_settings.test", "operation_check_policy", "WARN"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_graph_settings.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-account/test-graph",
+			},
+			// Update in place
+			{
+				Config: testAccGraphSettingsResourceConfig("BLOCK"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_graph_settings.test", "operation_check_policy", "BLOCK"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGraphSettingsResourceConfig(operationCheckPolicy string) string {
+	return fmt.Sprintf(`
+resource "grafbase_graph" "test" {
+  account_slug = "test-account"
+  slug         = "test-graph"
+}
+
+resource "grafbase_graph_settings" "test" {
+  account_slug             = grafbase_graph.test.account_slug
+  graph_slug               = grafbase_graph.test.slug
+  analytics_retention_days = 7
+  operation_check_policy   = %[1]q
+}
+`, operationCheckPolicy)
+}
+
+func TestGraphSettingsResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewGraphSettingsResource)
+
+	// Without a configured retention, the plan retention is kept
+	server.Handle("SetGraphSettings", `{"graphSettingsSet": {"__typename": "GraphSettingsSetSuccess", "settings": {
+		"analyticsRetentionDays": 30, "requestLoggingEnabled": true, "operationCheckPolicy": "WARN"
+	}}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug":             types.StringValue("my-account"),
+		"graph_slug":               types.StringValue("my-graph"),
+		"analytics_retention_days": types.Int64Unknown(),
+		"request_logging_enabled":  types.BoolValue(true),
+		"operation_check_policy":   types.StringValue("WARN"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "my-account/my-graph" {
+		t.Errorf("expected id my-account/my-graph, got %q", got)
+	}
+	sent := server.LastRequest("SetGraphSettings").Variables["input"].(map[string]interface{})
+	if retention, ok := sent["analyticsRetentionDays"]; !ok || retention != nil {
+		t.Errorf("expected analyticsRetentionDays to be null, got %v", retention)
+	}
+
+	server.Handle("GetGraphSettings", `{"graphByAccountSlug": {"settings": {
+		"analyticsRetentionDays": 30, "requestLoggingEnabled": true, "operationCheckPolicy": "WARN"
+	}}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+
+	server.Handle("SetGraphSettings", `{"graphSettingsSet": {"__typename": "GraphSettingsSetSuccess", "settings": {
+		"analyticsRetentionDays": 90, "requestLoggingEnabled": true, "operationCheckPolicy": "WARN"
+	}}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"analytics_retention_days": types.Int64Value(90),
+	})
+	requireNoDiagnostics(t, diags)
+	if retention := server.LastRequest("SetGraphSettings").Variables["input"].(map[string]interface{})["analyticsRetentionDays"]; retention != float64(90) {
+		t.Errorf("expected analyticsRetentionDays 90, got %v", retention)
+	}
+
+	// Destroying restores the defaults
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+	sent = server.LastRequest("SetGraphSettings").Variables["input"].(map[string]interface{})
+	if sent["analyticsRetentionDays"] != nil || sent["requestLoggingEnabled"] != false || sent["operationCheckPolicy"] != "BLOCK" {
+		t.Errorf("expected default settings to be restored, got %v", sent)
+	}
+
+	server.Handle("GetGraphSettings", `{"graphByAccountSlug": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected settings to be removed from state")
+	}
+}
+
+func TestGraphSettingsResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewGraphSettingsResource)
+
+	server.Handle("GetGraphSettings", `{"graphByAccountSlug": {"settings": {
+		"analyticsRetentionDays": 30, "requestLoggingEnabled": false, "operationCheckPolicy": "BLOCK"
+	}}}`)
+	state, diags := importResource(t, r, "my-account/my-graph")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "operation_check_policy"); got != "BLOCK" {
+		t.Errorf("expected operation_check_policy BLOCK, got %q", got)
+	}
+}
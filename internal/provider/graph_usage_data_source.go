@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GraphUsageDataSource{}
+
+func NewGraphUsageDataSource() datasource.DataSource {
+	return &GraphUsageDataSource{}
+}
+
+// GraphUsageDataSource defines the data source implementation.
+type GraphUsageDataSource struct {
+	client client.API
+}
+
+// GraphUsageDataSourceModel describes the data source data model.
+type GraphUsageDataSourceModel struct {
+	ID           types.String       `tfsdk:"id"`
+	AccountSlug  types.String       `tfsdk:"account_slug"`
+	GraphSlug    types.String       `tfsdk:"graph_slug"`
+	Since        types.String       `tfsdk:"since"`
+	Until        types.String       `tfsdk:"until"`
+	RequestCount types.Int64        `tfsdk:"request_count"`
+	ErrorCount   types.Int64        `tfsdk:"error_count"`
+	Branches     []BranchUsageModel `tfsdk:"branches"`
+}
+
+// BranchUsageModel describes the usage of a single branch.
+type BranchUsageModel struct {
+	Name         types.String  `tfsdk:"name"`
+	RequestCount types.Int64   `tfsdk:"request_count"`
+	ErrorCount   types.Int64   `tfsdk:"error_count"`
+	LatencyP95Ms types.Float64 `tfsdk:"latency_p95_ms"`
+}
+
+func (d *GraphUsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_usage"
+}
+
+func (d *GraphUsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Fetches the request metrics of a graph over a time window, aggregated per branch.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the format `account_slug/graph_slug`",
+				Computed:            true,
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug to fetch usage for",
+				Required:            true,
+			},
+			"since": schema.StringAttribute{
+				MarkdownDescription: "Start of the time window, as an RFC3339 timestamp",
+				Required:            true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"until": schema.StringAttribute{
+				MarkdownDescription: "End of the time window, as an RFC3339 timestamp. Defaults to the time of the read.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"request_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of requests served by all branches in the time window",
+				Computed:            true,
+			},
+			"error_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of requests of all branches that returned errors in the time window",
+				Computed:            true,
+			},
+			"branches": schema.ListNestedAttribute{
+				MarkdownDescription: "Usage of each branch that served requests in the time window",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Branch name",
+							Computed:            true,
+						},
+						"request_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of requests served by the branch",
+							Computed:            true,
+						},
+						"error_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of requests that returned errors",
+							Computed:            true,
+						},
+						"latency_p95_ms": schema.Float64Attribute{
+							MarkdownDescription: "95th percentile request latency in milliseconds. Null when the branch served no requests.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GraphUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GraphUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GraphUsageDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The validators guarantee both timestamps parse
+	since, _ := time.Parse(time.RFC3339, data.Since.ValueString())
+	until := time.Now().UTC().Truncate(time.Second)
+	if !data.Until.IsNull() {
+		until, _ = time.Parse(time.RFC3339, data.Until.ValueString())
+	}
+
+	if !since.Before(until) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("since"),
+			"Invalid Time Range",
+			fmt.Sprintf("since must be before until, got since %s and until %s.", since.Format(time.RFC3339), until.Format(time.RFC3339)),
+		)
+		return
+	}
+
+	usage, err := d.client.GetGraphUsage(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), since, until)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read graph usage: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.AccountSlug.ValueString(), data.GraphSlug.ValueString()))
+	if data.Until.IsNull() {
+		data.Until = types.StringValue(until.Format(time.RFC3339))
+	}
+
+	var requestCount, errorCount int64
+
+	data.Branches = make([]BranchUsageModel, 0, len(usage.Branches))
+	for _, branch := range usage.Branches {
+		requestCount += branch.RequestCount
+		errorCount += branch.ErrorCount
+
+		branchModel := BranchUsageModel{
+			Name:         types.StringValue(branch.BranchName),
+			RequestCount: types.Int64Value(branch.RequestCount),
+			ErrorCount:   types.Int64Value(branch.ErrorCount),
+			LatencyP95Ms: types.Float64PointerValue(branch.LatencyP95Ms),
+		}
+
+		data.Branches = append(data.Branches, branchModel)
+	}
+
+	data.RequestCount = types.Int64Value(requestCount)
+	data.ErrorCount = types.Int64Value(errorCount)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGraphUsageDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphUsageDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafbase_graph_usage.test", "id", "test-account/test-graph-usage"),
+					resource.TestCheckResourceAttr("data.grafbase_graph_usage.test", "request_count", "0"),
+					resource.TestCheckResourceAttrSet("data.grafbase_graph_usage.test", "until"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGraphUsageDataSourceConfig() string {
+	return `
+resource "grafbase_graph" "test" {
+  account_slug = "test-account"
+  slug         = "test-graph-usage"
+}
+
+data "grafbase_graph_usage" "test" {
+  account_slug = grafbase_graph.test.account_slug
+  graph_slug   = grafbase_graph.test.slug
+  since        = grafbase_graph.test.created_at
+}
+`
+}
+
+func TestGraphUsageDataSourceRead(t *testing.T) {
+	d, server := newMockDataSource(t, NewGraphUsageDataSource)
+
+	server.Handle("GetGraphUsage", `{"graphByAccountSlug": {"usage": {
+		"from": "2024-03-01T00:00:00Z",
+		"to": "2024-03-08T00:00:00Z",
+		"branches": [
+			{"branchName": "main", "requestCount": 1200, "errorCount": 12, "latencyP95Ms": 84.5},
+			{"branchName": "feature", "requestCount": 30, "errorCount": 3, "latencyP95Ms": null}
+		]
+	}}}`)
+	state, diags := readDataSource(t, d, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("my-graph"),
+		"since":        types.StringValue("2024-03-01T00:00:00Z"),
+		"until":        types.StringValue("2024-03-08T00:00:00Z"),
+	})
+	requireNoDiagnostics(t, diags)
+
+	var requestCount, errorCount types.Int64
+	requireNoDiagnostics(t, state.GetAttribute(context.Background(), path.Root("request_count"), &requestCount))
+	requireNoDiagnostics(t, state.GetAttribute(context.Background(), path.Root("error_count"), &errorCount))
+	if requestCount.ValueInt64() != 1230 || errorCount.ValueInt64() != 15 {
+		t.Errorf("expected totals of 1230 requests and 15 errors, got %v and %v", requestCount, errorCount)
+	}
+
+	var branches []BranchUsageModel
+	requireNoDiagnostics(t, state.GetAttribute(context.Background(), path.Root("branches"), &branches))
+	if len(branches) != 2 || branches[0].LatencyP95Ms.ValueFloat64() != 84.5 || !branches[1].LatencyP95Ms.IsNull() {
+		t.Errorf("unexpected branches: %+v", branches)
+	}
+
+	// The window ends at the time of the read when until is not set
+	state, diags = readDataSource(t, d, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("my-graph"),
+		"since":        types.StringValue("2024-03-01T00:00:00Z"),
+	})
+	requireNoDiagnostics(t, diags)
+	until, err := time.Parse(time.RFC3339, stateString(t, state, "until"))
+	if err != nil || time.Since(until) > time.Minute {
+		t.Errorf("expected until to default to now, got %q", stateString(t, state, "until"))
+	}
+
+	if _, diags := readDataSource(t, d, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("my-graph"),
+		"since":        types.StringValue("2024-03-08T00:00:00Z"),
+		"until":        types.StringValue("2024-03-01T00:00:00Z"),
+	}); !diags.HasError() {
+		t.Error("expected an inverted time range to be an error")
+	}
+
+	server.Handle("GetGraphUsage", `{"graphByAccountSlug": null}`)
+	if _, diags := readDataSource(t, d, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("missing"),
+		"since":        types.StringValue("2024-03-01T00:00:00Z"),
+	}); !diags.HasError() {
+		t.Error("expected a missing graph to be an error")
+	}
+}
@@ -0,0 +1,398 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/grafbase/terraform-provider-grafbase/internal/mockgraphql"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// The helpers below drive resources and data sources directly against a mock
+// GraphQL server, so their CRUD paths can be unit tested without credentials
+// or a Terraform binary. Plan modifiers and validators are not run.
+
+// newMockResource configures a resource with a client of a mock GraphQL server
+func newMockResource(t *testing.T, newResource func() resource.Resource) (resource.Resource, *mockgraphql.Server) {
+	t.Helper()
+
+	server := mockgraphql.NewServer(t)
+	r := newResource()
+
+	resp := &resource.ConfigureResponse{}
+	r.(resource.ResourceWithConfigure).Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: client.NewClient("test-api-key", client.WithAPIURL(server.URL)),
+	}, resp)
+	requireNoDiagnostics(t, resp.Diagnostics)
+
+	return r, server
+}
+
+// newResourceWithAPI configures a resource with an API implementation, such as
+// a clientmock.API, to test its logic without a GraphQL server
+func newResourceWithAPI(t *testing.T, newResource func() resource.Resource, api client.API) resource.Resource {
+	t.Helper()
+
+	r := newResource()
+
+	resp := &resource.ConfigureResponse{}
+	r.(resource.ResourceWithConfigure).Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: api,
+	}, resp)
+	requireNoDiagnostics(t, resp.Diagnostics)
+
+	return r
+}
+
+// newMockDataSource configures a data source with a client of a mock GraphQL server
+func newMockDataSource(t *testing.T, newDataSource func() datasource.DataSource) (datasource.DataSource, *mockgraphql.Server) {
+	t.Helper()
+
+	server := mockgraphql.NewServer(t)
+	d := newDataSource()
+
+	resp := &datasource.ConfigureResponse{}
+	d.(datasource.DataSourceWithConfigure).Configure(context.Background(), datasource.ConfigureRequest{
+		ProviderData: client.NewClient("test-api-key", client.WithAPIURL(server.URL)),
+	}, resp)
+	requireNoDiagnostics(t, resp.Diagnostics)
+
+	return d, server
+}
+
+// requireNoDiagnostics fails the test if diags contains errors
+func requireNoDiagnostics(t *testing.T, diags diag.Diagnostics) {
+	t.Helper()
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+}
+
+// nullObject returns a value of the object type with every attribute null
+func nullObject(objectType tftypes.Object) tftypes.Value {
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	return tftypes.NewValue(objectType, attributes)
+}
+
+// resourcePlan builds a plan of the resource with the given attributes set and
+// all others null
+func resourcePlan(t *testing.T, r resource.Resource, attributes map[string]attr.Value) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	requireNoDiagnostics(t, schemaResp.Diagnostics)
+
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    nullObject(schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)),
+	}
+	for name, value := range attributes {
+		requireNoDiagnostics(t, plan.SetAttribute(ctx, path.Root(name), value))
+	}
+
+	return plan
+}
+
+// emptyState returns the state of a resource that does not exist yet
+func emptyState(plan tfsdk.Plan) tfsdk.State {
+	return tfsdk.State{
+		Schema: plan.Schema,
+		Raw:    tftypes.NewValue(plan.Raw.Type(), nil),
+	}
+}
+
+// planConfig returns a configuration with the same values as the plan, so
+// resources can read write-only attributes
+func planConfig(plan tfsdk.Plan) tfsdk.Config {
+	return tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw.Copy()}
+}
+
+// nullIdentity returns an empty identity of the resource, as the framework
+// passes to resources with an identity schema, or nil for other resources
+func nullIdentity(t *testing.T, r resource.Resource) *tfsdk.ResourceIdentity {
+	t.Helper()
+	ctx := context.Background()
+
+	withIdentity, ok := r.(resource.ResourceWithIdentity)
+	if !ok {
+		return nil
+	}
+
+	schemaResp := &resource.IdentitySchemaResponse{}
+	withIdentity.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, schemaResp)
+	requireNoDiagnostics(t, schemaResp.Diagnostics)
+
+	return &tfsdk.ResourceIdentity{
+		Schema: schemaResp.IdentitySchema,
+		Raw:    tftypes.NewValue(schemaResp.IdentitySchema.Type().TerraformType(ctx), nil),
+	}
+}
+
+// validateResourceConfig runs the resource config validators for the given attributes
+func validateResourceConfig(t *testing.T, r resource.Resource, attributes map[string]attr.Value) diag.Diagnostics {
+	t.Helper()
+
+	config := planConfig(resourcePlan(t, r, attributes))
+	resp := &resource.ValidateConfigResponse{}
+	for _, v := range r.(resource.ResourceWithConfigValidators).ConfigValidators(context.Background()) {
+		v.ValidateResource(context.Background(), resource.ValidateConfigRequest{Config: config}, resp)
+	}
+
+	return resp.Diagnostics
+}
+
+// createResource runs Create for the given attributes and returns the new state
+func createResource(t *testing.T, r resource.Resource, attributes map[string]attr.Value) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	plan := resourcePlan(t, r, attributes)
+	resp := &resource.CreateResponse{State: emptyState(plan), Identity: nullIdentity(t, r)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan, Config: planConfig(plan)}, resp)
+
+	return resp.State, resp.Diagnostics
+}
+
+// readResource runs Read for the state and returns the refreshed state, which
+// is null when the resource was removed
+func readResource(t *testing.T, r resource.Resource, state tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	resp := &resource.ReadResponse{State: state, Identity: nullIdentity(t, r)}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	return resp.State, resp.Diagnostics
+}
+
+// readResourceIdentity runs Read for the state and returns the identity
+// reported by the resource
+func readResourceIdentity(t *testing.T, r resource.Resource, state tfsdk.State) (*tfsdk.ResourceIdentity, diag.Diagnostics) {
+	t.Helper()
+
+	resp := &resource.ReadResponse{State: state, Identity: nullIdentity(t, r)}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	return resp.Identity, resp.Diagnostics
+}
+
+// identityString returns a string attribute of a resource identity
+func identityString(t *testing.T, identity *tfsdk.ResourceIdentity, name string) string {
+	t.Helper()
+
+	var value types.String
+	requireNoDiagnostics(t, identity.GetAttribute(context.Background(), path.Root(name), &value))
+
+	return value.ValueString()
+}
+
+// updateResource runs Update from the prior state to a plan with the given
+// attributes, carrying over attributes the plan leaves unset
+func updateResource(t *testing.T, r resource.Resource, prior tfsdk.State, attributes map[string]attr.Value) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+
+	plan := tfsdk.Plan{Schema: prior.Schema, Raw: prior.Raw.Copy()}
+	for name, value := range attributes {
+		requireNoDiagnostics(t, plan.SetAttribute(ctx, path.Root(name), value))
+	}
+
+	return applyResourceUpdate(t, r, prior, plan)
+}
+
+// planResourceUpdate builds the plan from the prior state to the given
+// attributes like updateResource, then runs ModifyPlan on it. It returns the
+// modified plan and the attributes ModifyPlan requires replacement for.
+func planResourceUpdate(t *testing.T, r resource.Resource, prior tfsdk.State, attributes map[string]attr.Value) (tfsdk.Plan, path.Paths, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+
+	plan := tfsdk.Plan{Schema: prior.Schema, Raw: prior.Raw.Copy()}
+	for name, value := range attributes {
+		requireNoDiagnostics(t, plan.SetAttribute(ctx, path.Root(name), value))
+	}
+
+	modifier, ok := r.(resource.ResourceWithModifyPlan)
+	if !ok {
+		return plan, nil, nil
+	}
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	modifier.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, Config: planConfig(plan), State: prior}, resp)
+
+	return resp.Plan, resp.RequiresReplace, resp.Diagnostics
+}
+
+// applyResourceUpdate runs Update from the prior state to the plan
+func applyResourceUpdate(t *testing.T, r resource.Resource, prior tfsdk.State, plan tfsdk.Plan) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw.Copy()}, Identity: nullIdentity(t, r)}
+	r.Update(context.Background(), resource.UpdateRequest{Plan: plan, Config: planConfig(plan), State: prior}, resp)
+
+	return resp.State, resp.Diagnostics
+}
+
+// deleteResource runs Delete for the state
+func deleteResource(t *testing.T, r resource.Resource, state tfsdk.State) diag.Diagnostics {
+	t.Helper()
+
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+
+	return resp.Diagnostics
+}
+
+// importResource runs ImportState for the ID and returns the imported state
+func importResource(t *testing.T, r resource.Resource, id string) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	plan := resourcePlan(t, r, nil)
+	resp := &resource.ImportStateResponse{State: emptyState(plan), Identity: nullIdentity(t, r)}
+	resp.State.Raw = nullObject(plan.Raw.Type().(tftypes.Object))
+	r.(resource.ResourceWithImportState).ImportState(context.Background(), resource.ImportStateRequest{ID: id}, resp)
+
+	return resp.State, resp.Diagnostics
+}
+
+// importResourceByIdentity runs ImportState for an import block with the
+// given identity attributes, and returns the imported state and identity
+func importResourceByIdentity(t *testing.T, r resource.Resource, attributes map[string]attr.Value) (tfsdk.State, *tfsdk.ResourceIdentity, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+
+	identity := nullIdentity(t, r)
+	identity.Raw = nullObject(identity.Raw.Type().(tftypes.Object))
+	for name, value := range attributes {
+		requireNoDiagnostics(t, identity.SetAttribute(ctx, path.Root(name), value))
+	}
+
+	plan := resourcePlan(t, r, nil)
+	resp := &resource.ImportStateResponse{State: emptyState(plan), Identity: nullIdentity(t, r)}
+	resp.State.Raw = nullObject(plan.Raw.Type().(tftypes.Object))
+	r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{Identity: identity}, resp)
+
+	return resp.State, resp.Identity, resp.Diagnostics
+}
+
+// moveResourceState runs the state movers of a resource for a moved block from
+// the given provider address and resource type, like Terraform does, and
+// returns the state and identity of the first mover that handled it
+func moveResourceState(t *testing.T, r resource.Resource, sourceProvider, sourceType, sourceJSON string) (tfsdk.State, *tfsdk.ResourceIdentity, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+
+	plan := resourcePlan(t, r, nil)
+	req := resource.MoveStateRequest{
+		SourceProviderAddress: sourceProvider,
+		SourceTypeName:        sourceType,
+		SourceRawState:        &tfprotov6.RawState{JSON: []byte(sourceJSON)},
+	}
+
+	for _, mover := range r.(resource.ResourceWithMoveState).MoveState(ctx) {
+		resp := &resource.MoveStateResponse{TargetState: emptyState(plan), TargetIdentity: nullIdentity(t, r)}
+		mover.StateMover(ctx, req, resp)
+		if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+			return resp.TargetState, resp.TargetIdentity, resp.Diagnostics
+		}
+	}
+
+	return emptyState(plan), nil, nil
+}
+
+// readDataSource runs Read for a configuration with the given attributes set
+// and returns the resulting state
+func readDataSource(t *testing.T, d datasource.DataSource, attributes map[string]attr.Value) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	requireNoDiagnostics(t, schemaResp.Diagnostics)
+
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    nullObject(schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)),
+	}
+	// Config has no setters, so attributes are set through a state of the same schema
+	state := tfsdk.State{Schema: config.Schema, Raw: config.Raw}
+	for name, value := range attributes {
+		requireNoDiagnostics(t, state.SetAttribute(ctx, path.Root(name), value))
+	}
+	config.Raw = state.Raw
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw.Copy()}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+
+	return resp.State, resp.Diagnostics
+}
+
+// stateString returns a string attribute of the state, or an empty string when
+// the attribute is null or unknown
+func stateString(t *testing.T, state tfsdk.State, name string) string {
+	t.Helper()
+
+	var attributes map[string]tftypes.Value
+	if err := state.Raw.As(&attributes); err != nil {
+		t.Fatalf("unable to read state: %s", err)
+	}
+
+	value, ok := attributes[name]
+	if !ok {
+		t.Fatalf("state has no attribute %s", name)
+	}
+	if !value.IsKnown() || value.IsNull() {
+		return ""
+	}
+
+	var s string
+	if err := value.As(&s); err != nil {
+		t.Fatalf("attribute %s is not a string: %s", name, err)
+	}
+
+	return s
+}
+
+// requireImportedAttributes fails the test if an imported state leaves a
+// required or defaulted attribute null. Configuration generated from such a
+// state is invalid or plans a change right after import. Attributes that
+// cannot be recovered from the API are listed in unrecoverable.
+func requireImportedAttributes(t *testing.T, state tfsdk.State, unrecoverable ...string) {
+	t.Helper()
+
+	var attributes map[string]tftypes.Value
+	if err := state.Raw.As(&attributes); err != nil {
+		t.Fatalf("unable to read state: %s", err)
+	}
+
+	skip := make(map[string]bool, len(unrecoverable))
+	for _, name := range unrecoverable {
+		skip[name] = true
+	}
+
+	for name, attribute := range state.Schema.GetAttributes() {
+		if skip[name] || attribute.IsWriteOnly() {
+			continue
+		}
+		if !attribute.IsRequired() && !(attribute.IsOptional() && attribute.IsComputed()) {
+			continue
+		}
+		if value := attributes[name]; !value.IsKnown() || value.IsNull() {
+			t.Errorf("imported state leaves attribute %s unset", name)
+		}
+	}
+}
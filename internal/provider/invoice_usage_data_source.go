@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &InvoiceUsageDataSource{}
+
+func NewInvoiceUsageDataSource() datasource.DataSource {
+	return &InvoiceUsageDataSource{}
+}
+
+// InvoiceUsageDataSource defines the data source implementation.
+type InvoiceUsageDataSource struct {
+	client client.API
+}
+
+// InvoiceUsageDataSourceModel describes the data source data model.
+type InvoiceUsageDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	AccountSlug       types.String `tfsdk:"account_slug"`
+	PeriodStart       RFC3339Value `tfsdk:"period_start"`
+	PeriodEnd         RFC3339Value `tfsdk:"period_end"`
+	RequestCount      types.Int64  `tfsdk:"request_count"`
+	SeatCount         types.Int64  `tfsdk:"seat_count"`
+	DataTransferBytes types.Int64  `tfsdk:"data_transfer_bytes"`
+}
+
+func (d *InvoiceUsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invoice_usage"
+}
+
+func (d *InvoiceUsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Fetches the usage of an account in the current billing period.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the format `account_slug/period_start`",
+				Computed:            true,
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug to fetch usage for",
+				Required:            true,
+			},
+			"period_start": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "Start of the current billing period",
+				Computed:            true,
+			},
+			"period_end": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "End of the current billing period",
+				Computed:            true,
+			},
+			"request_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of requests served in the billing period",
+				Computed:            true,
+			},
+			"seat_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of billed seats",
+				Computed:            true,
+			},
+			"data_transfer_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Data transferred in the billing period, in bytes",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *InvoiceUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InvoiceUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InvoiceUsageDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	usage, err := d.client.GetInvoiceUsage(ctx, data.AccountSlug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read invoice usage: %s", err))
+		return
+	}
+
+	periodStart := usage.PeriodStart.Format("2006-01-02T15:04:05Z07:00")
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.AccountSlug.ValueString(), periodStart))
+	data.PeriodStart = NewRFC3339Value(usage.PeriodStart)
+	data.PeriodEnd = NewRFC3339Value(usage.PeriodEnd)
+	data.RequestCount = types.Int64Value(usage.RequestCount)
+	data.SeatCount = types.Int64Value(usage.SeatCount)
+	data.DataTransferBytes = types.Int64Value(usage.DataTransferBytes)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInvoiceUsageDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvoiceUsageDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.grafbase_invoice_usage.test", "id"),
+					resource.TestCheckResourceAttrSet("data.grafbase_invoice_usage.test", "period_start"),
+					resource.TestCheckResourceAttrSet("data.grafbase_invoice_usage.test", "period_end"),
+					resource.TestCheckResourceAttrSet("data.grafbase_invoice_usage.test", "request_count"),
+					resource.TestCheckResourceAttrSet("data.grafbase_invoice_usage.test", "seat_count"),
+					resource.TestCheckResourceAttrSet("data.grafbase_invoice_usage.test", "data_transfer_bytes"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInvoiceUsageDataSourceConfig() string {
+	return `
+data "grafbase_invoice_usage" "test" {
+  account_slug = "test-account"
+}
+`
+}
@@ -0,0 +1,342 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultMCPPath is the path the MCP endpoint is served on when none is configured.
+const defaultMCPPath = "/mcp"
+
+// mcpPathRegexp matches absolute URL paths.
+var mcpPathRegexp = regexp.MustCompile(`^/`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MCPEndpointResource{}
+var _ resource.ResourceWithImportState = &MCPEndpointResource{}
+
+func NewMCPEndpointResource() resource.Resource {
+	return &MCPEndpointResource{}
+}
+
+// MCPEndpointResource defines the resource implementation.
+type MCPEndpointResource struct {
+	client client.API
+}
+
+// MCPEndpointResourceModel describes the resource data model.
+type MCPEndpointResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	AccountSlug      types.String `tfsdk:"account_slug"`
+	GraphSlug        types.String `tfsdk:"graph_slug"`
+	BranchName       types.String `tfsdk:"branch_name"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	Path             types.String `tfsdk:"path"`
+	Authentication   types.String `tfsdk:"authentication"`
+	RequiredScopes   types.List   `tfsdk:"required_scopes"`
+	ExecuteMutations types.Bool   `tfsdk:"execute_mutations"`
+	URL              types.String `tfsdk:"url"`
+}
+
+func (r *MCPEndpointResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mcp_endpoint"
+}
+
+func (r *MCPEndpointResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the Model Context Protocol (MCP) endpoint of a branch, which lets AI agents " +
+			"query the graph. Destroying the resource disables the endpoint.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the format `account_slug/graph_slug/branch_name`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch the MCP endpoint belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the MCP endpoint is served",
+				Required:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path the MCP endpoint is served on. Defaults to `/mcp`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultMCPPath),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(mcpPathRegexp, "must start with a slash"),
+				},
+			},
+			"authentication": schema.StringAttribute{
+				MarkdownDescription: "How MCP clients must authenticate: `NONE`, `ACCESS_TOKEN`, or `OAUTH`. Defaults to `ACCESS_TOKEN`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(client.MCPAuthenticationAccessToken)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.MCPAuthenticationNone),
+						string(client.MCPAuthenticationAccessToken),
+						string(client.MCPAuthenticationOAuth),
+					),
+				},
+			},
+			"required_scopes": schema.ListAttribute{
+				MarkdownDescription: "OAuth scopes MCP clients must be granted. Only used with `OAUTH` authentication.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"execute_mutations": schema.BoolAttribute{
+				MarkdownDescription: "Whether MCP clients may run mutations. Defaults to `false`, which limits agents to queries.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "Full URL of the MCP endpoint",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *MCPEndpointResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MCPEndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MCPEndpointResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setInput, diags := data.setInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint, err := r.client.SetMCPEndpoint(ctx, setInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure MCP endpoint: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString()))
+	resp.Diagnostics.Append(data.fromEndpoint(ctx, endpoint)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MCPEndpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MCPEndpointResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint, err := r.client.GetMCPEndpoint(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString())
+	if err != nil {
+		// If the branch is gone, its MCP endpoint is gone too
+		if err.Error() == "branch not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read MCP endpoint: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	resp.Diagnostics.Append(data.fromEndpoint(ctx, endpoint)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MCPEndpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MCPEndpointResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setInput, diags := data.setInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint, err := r.client.SetMCPEndpoint(ctx, setInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update MCP endpoint: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromEndpoint(ctx, endpoint)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MCPEndpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MCPEndpointResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Disabling the endpoint with default settings stops serving it
+	disableInput := client.SetMCPEndpointInput{
+		AccountSlug: data.AccountSlug.ValueString(),
+		GraphSlug:   data.GraphSlug.ValueString(),
+		BranchName:  data.BranchName.ValueString(),
+		Enabled:     false,
+	}
+
+	_, err := r.client.SetMCPEndpoint(ctx, disableInput)
+	if err != nil {
+		// If the branch doesn't exist, there is nothing left to disable
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable MCP endpoint: %s", err))
+		return
+	}
+}
+
+func (r *MCPEndpointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug/branch_name"
+	// Branch names may contain slashes, so everything after the graph slug is the branch name
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/branch_name', got: %s", req.ID))
+		return
+	}
+
+	// Get the endpoint to populate the remaining attributes
+	endpoint, err := r.client.GetMCPEndpoint(ctx, parts[0], parts[1], parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read MCP endpoint during import: %s", err))
+		return
+	}
+
+	data := MCPEndpointResourceModel{
+		ID:             types.StringValue(req.ID),
+		AccountSlug:    types.StringValue(parts[0]),
+		GraphSlug:      types.StringValue(parts[1]),
+		BranchName:     types.StringValue(parts[2]),
+		RequiredScopes: types.ListNull(types.StringType),
+	}
+	resp.Diagnostics.Append(data.fromEndpoint(ctx, endpoint)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setInput builds the client input replacing the MCP endpoint configuration.
+func (m MCPEndpointResourceModel) setInput(ctx context.Context) (client.SetMCPEndpointInput, diag.Diagnostics) {
+	input := client.SetMCPEndpointInput{
+		AccountSlug:      m.AccountSlug.ValueString(),
+		GraphSlug:        m.GraphSlug.ValueString(),
+		BranchName:       m.BranchName.ValueString(),
+		Enabled:          m.Enabled.ValueBool(),
+		Path:             m.Path.ValueString(),
+		Authentication:   client.MCPAuthentication(m.Authentication.ValueString()),
+		ExecuteMutations: m.ExecuteMutations.ValueBool(),
+	}
+
+	var diags diag.Diagnostics
+	if !m.RequiredScopes.IsNull() && !m.RequiredScopes.IsUnknown() {
+		diags = m.RequiredScopes.ElementsAs(ctx, &input.RequiredScopes, false)
+	}
+
+	return input, diags
+}
+
+// fromEndpoint maps an API MCP endpoint onto the model. An empty scope list is
+// kept null when it was not configured, so omitting the attribute does not cause a diff.
+func (m *MCPEndpointResourceModel) fromEndpoint(ctx context.Context, endpoint *client.MCPEndpoint) diag.Diagnostics {
+	m.Enabled = types.BoolValue(endpoint.Enabled)
+	m.Path = types.StringValue(endpoint.Path)
+	m.Authentication = types.StringValue(string(endpoint.Authentication))
+	m.ExecuteMutations = types.BoolValue(endpoint.ExecuteMutations)
+	m.URL = types.StringValue(endpoint.URL)
+
+	if len(endpoint.RequiredScopes) == 0 && m.RequiredScopes.IsNull() {
+		return nil
+	}
+
+	scopes, diags := types.ListValueFrom(ctx, types.StringType, endpoint.RequiredScopes)
+	m.RequiredScopes = scopes
+
+	return diags
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMCPEndpointResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMCPEndpointResourceConfig(true, "ACCESS_TOKEN"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_mcp_endpoint.test", "id", "test-account/test-graph/main"),
+					resource.TestCheckResourceAttr("grafbase_mcp_endpoint.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("grafbase_mcp_endpoint.test", "path", "/mcp"),
+					resource.TestCheckResourceAttr("grafbase_mcp_endpoint.test", "authentication", "ACCESS_TOKEN"),
+					resource.TestCheckResourceAttr("grafbase_mcp_endpoint.test", "execute_mutations", "false"),
+					resource.TestCheckResourceAttrSet("grafbase_mcp_endpoint.test", "url"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_mcp_endpoint.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-account/test-graph/main",
+			},
+			// Update in place
+			{
+				Config: testAccMCPEndpointResourceConfig(false, "NONE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_mcp_endpoint.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("grafbase_mcp_endpoint.test", "authentication", "NONE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMCPEndpointResourceConfig(enabled bool, authentication string) string {
+	return fmt.Sprintf(`
+resource "grafbase_graph" "test" {
+  account_slug = "test-account"
+  slug         = "test-graph"
+}
+
+resource "grafbase_mcp_endpoint" "test" {
+  account_slug   = grafbase_graph.test.account_slug
+  graph_slug     = grafbase_graph.test.slug
+  branch_name    = "main"
+  enabled        = %[1]t
+  authentication = %[2]q
+}
+`, enabled, authentication)
+}
+
+func TestMCPEndpointResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewMCPEndpointResource)
+
+	server.Handle("SetMCPEndpoint", `{"mcpEndpointSet": {"__typename": "McpEndpointSetSuccess", "mcpEndpoint": {
+		"enabled": true, "path": "/mcp", "authentication": "NONE", "requiredScopes": [], "executeMutations": false,
+		"url": "https://my-graph.grafbase.app/mcp"
+	}}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug":      types.StringValue("my-account"),
+		"graph_slug":        types.StringValue("my-graph"),
+		"branch_name":       types.StringValue("main"),
+		"enabled":           types.BoolValue(true),
+		"path":              types.StringValue("/mcp"),
+		"authentication":    types.StringValue("NONE"),
+		"required_scopes":   types.ListNull(types.StringType),
+		"execute_mutations": types.BoolValue(false),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "url"); got != "https://my-graph.grafbase.app/mcp" {
+		t.Errorf("expected url to be set, got %q", got)
+	}
+
+	server.Handle("GetMCPEndpoint", `{"branch": {"mcpEndpoint": {
+		"enabled": true, "path": "/mcp", "authentication": "ACCESS_TOKEN", "requiredScopes": ["read"], "executeMutations": false,
+		"url": "https://my-graph.grafbase.app/mcp"
+	}}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "authentication"); got != "ACCESS_TOKEN" {
+		t.Errorf("expected authentication ACCESS_TOKEN, got %q", got)
+	}
+
+	server.Handle("SetMCPEndpoint", `{"mcpEndpointSet": {"__typename": "McpEndpointSetSuccess", "mcpEndpoint": {
+		"enabled": true, "path": "/mcp", "authentication": "NONE", "requiredScopes": [], "executeMutations": true,
+		"url": "https://my-graph.grafbase.app/mcp"
+	}}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"authentication":    types.StringValue("NONE"),
+		"required_scopes":   types.ListNull(types.StringType),
+		"execute_mutations": types.BoolValue(true),
+	})
+	requireNoDiagnostics(t, diags)
+
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+	var input client.SetMCPEndpointInput
+	if err := server.LastRequest("SetMCPEndpoint").Input(&input); err != nil {
+		t.Fatalf("unable to decode input: %s", err)
+	}
+	if input.Enabled {
+		t.Error("expected delete to disable the endpoint")
+	}
+
+	server.Handle("GetMCPEndpoint", `{"branch": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected MCP endpoint to be removed from state")
+	}
+}
+
+func TestMCPEndpointResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewMCPEndpointResource)
+
+	server.Handle("GetMCPEndpoint", `{"branch": {"mcpEndpoint": {
+		"enabled": true, "path": "/mcp", "authentication": "ACCESS_TOKEN", "requiredScopes": [], "executeMutations": false,
+		"url": "https://my-graph.grafbase.app/mcp"
+	}}}`)
+	state, diags := importResource(t, r, "my-account/my-graph/main")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "path"); got != "/mcp" {
+		t.Errorf("expected path /mcp, got %q", got)
+	}
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// isLegacyResource reports whether a moved block comes from a community fork
+// of this provider, such as registry.terraform.io/example/grafbase, with the
+// given resource type. Resources of this provider are moved by Terraform
+// itself and never reach a state mover.
+func isLegacyResource(req resource.MoveStateRequest, typeName string) bool {
+	parts := strings.Split(req.SourceProviderAddress, "/")
+	if len(parts) != 3 || parts[2] != "grafbase" || parts[1] == "grafbase" {
+		return false
+	}
+
+	return req.SourceTypeName == typeName
+}
+
+// legacyAttributes decodes the string attributes of a legacy resource state.
+// The source schema is not declared, since community forks differ in their
+// attributes; callers look attributes up under each name the forks use.
+func legacyAttributes(rawState *tfprotov6.RawState) (legacyState, error) {
+	if rawState == nil || rawState.JSON == nil {
+		return nil, fmt.Errorf("the source state has no JSON representation")
+	}
+
+	var values map[string]interface{}
+	if err := json.Unmarshal(rawState.JSON, &values); err != nil {
+		return nil, fmt.Errorf("failed to decode the source state: %w", err)
+	}
+
+	attributes := make(legacyState, len(values))
+	for name, value := range values {
+		if s, ok := value.(string); ok && s != "" {
+			attributes[name] = s
+		}
+	}
+
+	return attributes, nil
+}
+
+// legacyState holds the non-empty string attributes of a legacy resource
+type legacyState map[string]string
+
+// first returns the value of the first of names that is set
+func (s legacyState) first(names ...string) (string, bool) {
+	for _, name := range names {
+		if value, ok := s[name]; ok {
+			return value, true
+		}
+	}
+
+	return "", false
+}
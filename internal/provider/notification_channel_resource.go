@@ -0,0 +1,370 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NotificationChannelResource{}
+var _ resource.ResourceWithImportState = &NotificationChannelResource{}
+var _ resource.ResourceWithConfigValidators = &NotificationChannelResource{}
+
+func NewNotificationChannelResource() resource.Resource {
+	return &NotificationChannelResource{}
+}
+
+// NotificationChannelResource defines the resource implementation.
+type NotificationChannelResource struct {
+	client client.API
+}
+
+// NotificationChannelResourceModel describes the resource data model.
+type NotificationChannelResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	AccountSlug  types.String `tfsdk:"account_slug"`
+	GraphSlug    types.String `tfsdk:"graph_slug"`
+	BranchName   types.String `tfsdk:"branch_name"`
+	Name         types.String `tfsdk:"name"`
+	Type         types.String `tfsdk:"type"`
+	URL          types.String `tfsdk:"url"`
+	SlackChannel types.String `tfsdk:"slack_channel"`
+	Events       types.Set    `tfsdk:"events"`
+}
+
+func (r *NotificationChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_channel"
+}
+
+func (r *NotificationChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Routes events of a graph, or of one of its branches, to Slack or to a generic webhook, " +
+			"for example to post schema check failures to a team channel.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Notification channel identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph whose events are sent to the channel",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch whose events are sent to the channel. When unset, events of every branch of the graph are sent.",
+				Optional:            true,
+				Validators:          branchNameValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the channel, shown in the dashboard",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the channel: `SLACK` or `WEBHOOK`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.NotificationChannelTypeSlack),
+						string(client.NotificationChannelTypeWebhook),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "Slack incoming webhook URL for `SLACK` channels, or the HTTPS endpoint events are posted to " +
+					"for `WEBHOOK` channels. The API never returns the URL, so it is not recovered on import.",
+				Required:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					httpsURLValidator{},
+				},
+			},
+			"slack_channel": schema.StringAttribute{
+				MarkdownDescription: "Slack channel to post to instead of the default channel of the incoming webhook, " +
+					"for example `#graphql`. Only supported for `SLACK` channels.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(2),
+				},
+			},
+			"events": schema.SetAttribute{
+				MarkdownDescription: "Events sent to the channel: `DEPLOYMENT_FINISHED`, `SCHEMA_CHECK_FAILED`, or `COMPOSITION_FAILED`",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators:          eventSetValidators(),
+			},
+		},
+	}
+}
+
+func (r *NotificationChannelResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		slackChannelValidator{},
+	}
+}
+
+func (r *NotificationChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NotificationChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NotificationChannelResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	events, diags := webhookEvents(ctx, data.Events)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createInput := client.CreateNotificationChannelInput{
+		AccountSlug:  data.AccountSlug.ValueString(),
+		GraphSlug:    data.GraphSlug.ValueString(),
+		BranchName:   data.BranchName.ValueStringPointer(),
+		Name:         data.Name.ValueString(),
+		Type:         client.NotificationChannelType(data.Type.ValueString()),
+		URL:          data.URL.ValueString(),
+		SlackChannel: data.SlackChannel.ValueStringPointer(),
+		Events:       events,
+	}
+
+	channel, err := r.client.CreateNotificationChannel(ctx, createInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create notification channel: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(data.fromChannel(ctx, channel)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitUntilCreated(ctx, "notification channel", func(ctx context.Context) error {
+		_, err := r.client.GetNotificationChannel(ctx, data.ID.ValueString())
+		return err
+	}, "notification channel not found")...)
+}
+
+func (r *NotificationChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NotificationChannelResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.GetNotificationChannel(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the channel is not found, remove it from state
+		if err.Error() == "notification channel not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read notification channel: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	resp.Diagnostics.Append(data.fromChannel(ctx, channel)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NotificationChannelResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	events, diags := webhookEvents(ctx, data.Events)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateInput := client.UpdateNotificationChannelInput{
+		ID:           data.ID.ValueString(),
+		Name:         data.Name.ValueString(),
+		URL:          data.URL.ValueString(),
+		SlackChannel: data.SlackChannel.ValueStringPointer(),
+		Events:       events,
+	}
+
+	channel, err := r.client.UpdateNotificationChannel(ctx, updateInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update notification channel: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromChannel(ctx, channel)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NotificationChannelResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNotificationChannel(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the channel is already gone, there is nothing left to delete
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete notification channel: %s", err))
+		return
+	}
+}
+
+func (r *NotificationChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug/channel_id"
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/channel_id', got: %s", req.ID))
+		return
+	}
+
+	// Get the channel to populate the remaining attributes
+	channel, err := r.client.GetNotificationChannel(ctx, parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read notification channel during import: %s", err))
+		return
+	}
+
+	// The URL cannot be read back, so it stays null until the next apply sets it
+	data := NotificationChannelResourceModel{
+		AccountSlug: types.StringValue(parts[0]),
+		GraphSlug:   types.StringValue(parts[1]),
+		URL:         types.StringNull(),
+	}
+	resp.Diagnostics.Append(data.fromChannel(ctx, channel)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fromChannel maps an API notification channel onto the model. The URL is
+// never returned, so it is kept from the configuration.
+func (m *NotificationChannelResourceModel) fromChannel(ctx context.Context, channel *client.NotificationChannel) diag.Diagnostics {
+	m.ID = types.StringValue(channel.ID)
+	m.Name = types.StringValue(channel.Name)
+	m.Type = types.StringValue(string(channel.Type))
+	m.BranchName = types.StringPointerValue(channel.BranchName)
+	m.SlackChannel = types.StringPointerValue(channel.SlackChannel)
+
+	var diags diag.Diagnostics
+	m.Events, diags = webhookEventSet(ctx, channel.Events)
+	return diags
+}
+
+var _ resource.ConfigValidator = slackChannelValidator{}
+
+// slackChannelValidator ensures slack_channel is only set on Slack channels,
+// since generic webhooks have no channel to post to.
+type slackChannelValidator struct{}
+
+func (v slackChannelValidator) Description(ctx context.Context) string {
+	return "slack_channel can only be set when type is SLACK"
+}
+
+func (v slackChannelValidator) MarkdownDescription(ctx context.Context) string {
+	return "`slack_channel` can only be set when `type` is `SLACK`"
+}
+
+func (v slackChannelValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var channelType, slackChannel types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &channelType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("slack_channel"), &slackChannel)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values may still satisfy the requirement once known
+	if channelType.IsUnknown() || channelType.IsNull() || slackChannel.IsNull() {
+		return
+	}
+
+	if channelType.ValueString() != string(client.NotificationChannelTypeSlack) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("slack_channel"),
+			"Invalid Notification Channel Configuration",
+			fmt.Sprintf("slack_channel can only be set when type is SLACK, got type %s.", channelType.ValueString()),
+		)
+	}
+}
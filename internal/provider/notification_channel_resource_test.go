@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNotificationChannelResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNotificationChannelResourceConfig("schema alerts"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("grafbase_notification_channel.test", "id"),
+					resource.TestCheckResourceAttr("grafbase_notification_channel.test", "type", "WEBHOOK"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "grafbase_notification_channel.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"url"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["grafbase_notification_channel.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found: grafbase_notification_channel.test")
+					}
+					return fmt.Sprintf("test-account/test-graph/%s", rs.Primary.ID), nil
+				},
+			},
+			// Update in place
+			{
+				Config: testAccNotificationChannelResourceConfig("composition alerts"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_notification_channel.test", "name", "composition alerts"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNotificationChannelResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "grafbase_notification_channel" "test" {
+  account_slug = "test-account"
+  graph_slug   = "test-graph"
+  name         = %[1]q
+  type         = "WEBHOOK"
+  url          = "https://hooks.example.com/grafbase"
+  events       = ["SCHEMA_CHECK_FAILED"]
+}
+`, name)
+}
+
+func testNotificationChannelJSON(name string) string {
+	return fmt.Sprintf(`{"id": "channel-1", "name": %q, "type": "SLACK", "branchName": "main", "slackChannel": "#graphql", "events": ["SCHEMA_CHECK_FAILED"]}`, name)
+}
+
+func TestNotificationChannelResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewNotificationChannelResource)
+
+	server.Handle("CreateNotificationChannel", `{"notificationChannelCreate": {"__typename": "NotificationChannelCreateSuccess", "notificationChannel": `+testNotificationChannelJSON("schema alerts")+`}}`)
+	server.Handle("GetNotificationChannel", `{"node": `+testNotificationChannelJSON("schema alerts")+`}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug":  types.StringValue("my-account"),
+		"graph_slug":    types.StringValue("my-graph"),
+		"branch_name":   types.StringValue("main"),
+		"name":          types.StringValue("schema alerts"),
+		"type":          types.StringValue("SLACK"),
+		"url":           types.StringValue("https://hooks.slack.com/services/T000/B000/XXXX"),
+		"slack_channel": types.StringValue("#graphql"),
+		"events":        types.SetValueMust(types.StringType, []attr.Value{types.StringValue("SCHEMA_CHECK_FAILED")}),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "channel-1" {
+		t.Errorf("expected id channel-1, got %q", got)
+	}
+	if got := server.LastRequest("CreateNotificationChannel").Variables["input"].(map[string]interface{})["branchName"]; got != "main" {
+		t.Errorf("expected branch main to be sent, got %v", got)
+	}
+
+	// The URL is never returned, so it is kept from state
+	server.Handle("GetNotificationChannel", `{"node": `+testNotificationChannelJSON("schema alerts")+`}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "url"); got != "https://hooks.slack.com/services/T000/B000/XXXX" {
+		t.Errorf("expected url to be kept, got %q", got)
+	}
+
+	server.Handle("UpdateNotificationChannel", `{"notificationChannelUpdate": {"__typename": "NotificationChannelUpdateSuccess", "notificationChannel": `+testNotificationChannelJSON("composition alerts")+`}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"name": types.StringValue("composition alerts"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := server.LastRequest("UpdateNotificationChannel").Variables["input"].(map[string]interface{})["id"]; got != "channel-1" {
+		t.Errorf("expected channel-1 to be updated, got %v", got)
+	}
+	if got := stateString(t, state, "name"); got != "composition alerts" {
+		t.Errorf("expected name composition alerts, got %q", got)
+	}
+
+	server.Handle("DeleteNotificationChannel", `{"notificationChannelDelete": {"__typename": "NotificationChannelDeleteSuccess"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	server.Handle("GetNotificationChannel", `{"node": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected notification channel to be removed from state")
+	}
+}
+
+func TestNotificationChannelResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewNotificationChannelResource)
+
+	server.Handle("GetNotificationChannel", `{"node": `+testNotificationChannelJSON("schema alerts")+`}`)
+	state, diags := importResource(t, r, "my-account/my-graph/channel-1")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state, "url")
+
+	if _, diags := importResource(t, r, "channel-1"); !diags.HasError() {
+		t.Error("expected invalid import ID to be an error")
+	}
+}
+
+func TestNotificationChannelResourceSlackChannelValidator(t *testing.T) {
+	r := NewNotificationChannelResource()
+
+	tests := []struct {
+		name         string
+		channelType  types.String
+		slackChannel types.String
+		valid        bool
+	}{
+		{name: "slack with channel", channelType: types.StringValue("SLACK"), slackChannel: types.StringValue("#graphql"), valid: true},
+		{name: "webhook without channel", channelType: types.StringValue("WEBHOOK"), slackChannel: types.StringNull(), valid: true},
+		{name: "unknown type", channelType: types.StringUnknown(), slackChannel: types.StringValue("#graphql"), valid: true},
+		{name: "webhook with channel", channelType: types.StringValue("WEBHOOK"), slackChannel: types.StringValue("#graphql"), valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateResourceConfig(t, r, map[string]attr.Value{
+				"account_slug":  types.StringValue("my-account"),
+				"graph_slug":    types.StringValue("my-graph"),
+				"name":          types.StringValue("alerts"),
+				"type":          tt.channelType,
+				"url":           types.StringValue("https://hooks.example.com/grafbase"),
+				"slack_channel": tt.slackChannel,
+			})
+
+			if tt.valid && diags.HasError() {
+				t.Errorf("expected configuration to be valid, got: %v", diags)
+			}
+			if !tt.valid && !diags.HasError() {
+				t.Error("expected configuration to be invalid")
+			}
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OperationCheckExceptionResource{}
+var _ resource.ResourceWithImportState = &OperationCheckExceptionResource{}
+
+func NewOperationCheckExceptionResource() resource.Resource {
+	return &OperationCheckExceptionResource{}
+}
+
+// OperationCheckExceptionResource defines the resource implementation.
+type OperationCheckExceptionResource struct {
+	client client.API
+}
+
+// OperationCheckExceptionResourceModel describes the resource data model.
+type OperationCheckExceptionResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	AccountSlug types.String `tfsdk:"account_slug"`
+	GraphSlug   types.String `tfsdk:"graph_slug"`
+	BranchName  types.String `tfsdk:"branch_name"`
+	SchemaPath  types.String `tfsdk:"schema_path"`
+	Reason      types.String `tfsdk:"reason"`
+	ExpiresAt   RFC3339Value `tfsdk:"expires_at"`
+}
+
+func (r *OperationCheckExceptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_operation_check_exception"
+}
+
+func (r *OperationCheckExceptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Allows breaking changes to a schema path on a branch until an expiry date, so operation checks " +
+			"pass for an intended change such as removing a deprecated field.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Operation check exception identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch whose operation checks allow the change",
+				Required:            true,
+				Validators:          branchNameValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schema_path": schema.StringAttribute{
+				MarkdownDescription: "Schema coordinate of the type, field, or argument whose breaking changes are allowed, such as `User.legacyId`",
+				Required:            true,
+				Validators:          schemaCoordinateValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reason": schema.StringAttribute{
+				MarkdownDescription: "Why the breaking change is allowed, shown in operation check results",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				CustomType: RFC3339Type{},
+				MarkdownDescription: "RFC3339 timestamp at which the exception expires and operation checks report the change again. " +
+					"Must be in the future.",
+				Required: true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+		},
+	}
+}
+
+func (r *OperationCheckExceptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OperationCheckExceptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OperationCheckExceptionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expiresAt, diags := data.expiresAt()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createInput := client.CreateOperationCheckExceptionInput{
+		AccountSlug: data.AccountSlug.ValueString(),
+		GraphSlug:   data.GraphSlug.ValueString(),
+		BranchName:  data.BranchName.ValueString(),
+		SchemaPath:  data.SchemaPath.ValueString(),
+		Reason:      data.Reason.ValueStringPointer(),
+		ExpiresAt:   expiresAt,
+	}
+
+	exception, err := r.client.CreateOperationCheckException(ctx, createInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create operation check exception: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.fromException(exception)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitUntilCreated(ctx, "operation check exception", func(ctx context.Context) error {
+		_, err := r.client.GetOperationCheckException(ctx, data.ID.ValueString())
+		return err
+	}, "operation check exception not found")...)
+}
+
+func (r *OperationCheckExceptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OperationCheckExceptionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exception, err := r.client.GetOperationCheckException(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the exception is not found, remove it from state
+		if err.Error() == "operation check exception not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read operation check exception: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	data.fromException(exception)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OperationCheckExceptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OperationCheckExceptionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expiresAt, diags := data.expiresAt()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the reason and expiry can change in place
+	updateInput := client.UpdateOperationCheckExceptionInput{
+		ID:        data.ID.ValueString(),
+		Reason:    data.Reason.ValueStringPointer(),
+		ExpiresAt: expiresAt,
+	}
+
+	exception, err := r.client.UpdateOperationCheckException(ctx, updateInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update operation check exception: %s", err))
+		return
+	}
+
+	data.fromException(exception)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OperationCheckExceptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OperationCheckExceptionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteOperationCheckException(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the exception is already gone, there is nothing left to delete
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete operation check exception: %s", err))
+		return
+	}
+}
+
+func (r *OperationCheckExceptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug/exception_id"
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[2] == "" {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/exception_id', got: %s", req.ID))
+		return
+	}
+
+	// Get the exception to populate the remaining attributes
+	exception, err := r.client.GetOperationCheckException(ctx, parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read operation check exception during import: %s", err))
+		return
+	}
+
+	data := OperationCheckExceptionResourceModel{
+		AccountSlug: types.StringValue(parts[0]),
+		GraphSlug:   types.StringValue(parts[1]),
+	}
+	data.fromException(exception)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// expiresAt parses the configured expiry, which the schema validates as RFC3339
+func (m OperationCheckExceptionResourceModel) expiresAt() (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	expiresAt, err := time.Parse(time.RFC3339, m.ExpiresAt.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("expires_at"), "Invalid Timestamp", fmt.Sprintf("expires_at must be an RFC3339 timestamp: %s", err))
+	}
+
+	return expiresAt, diags
+}
+
+// fromException maps an API operation check exception onto the model
+func (m *OperationCheckExceptionResourceModel) fromException(exception *client.OperationCheckException) {
+	m.ID = types.StringValue(exception.ID)
+	m.BranchName = types.StringValue(exception.BranchName)
+	m.SchemaPath = types.StringValue(exception.SchemaPath)
+	m.Reason = types.StringPointerValue(exception.Reason)
+	m.ExpiresAt = NewRFC3339Value(exception.ExpiresAt)
+}
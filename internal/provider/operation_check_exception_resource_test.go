@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccOperationCheckExceptionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOperationCheckExceptionResourceConfig("2030-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("grafbase_operation_check_exception.test", "id"),
+					resource.TestCheckResourceAttr("grafbase_operation_check_exception.test", "schema_path", "User.legacyId"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_operation_check_exception.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["grafbase_operation_check_exception.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found: grafbase_operation_check_exception.test")
+					}
+					return fmt.Sprintf("test-account/test-graph/%s", rs.Primary.ID), nil
+				},
+			},
+			// Update in place
+			{
+				Config: testAccOperationCheckExceptionResourceConfig("2030-02-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_operation_check_exception.test", "expires_at", "2030-02-01T00:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOperationCheckExceptionResourceConfig(expiresAt string) string {
+	return fmt.Sprintf(`
+resource "grafbase_operation_check_exception" "test" {
+  account_slug = "test-account"
+  graph_slug   = "test-graph"
+  branch_name  = "main"
+  schema_path  = "User.legacyId"
+  reason       = "Terraform acceptance test"
+  expires_at   = %[1]q
+}
+`, expiresAt)
+}
+
+func testOperationCheckExceptionJSON(expiresAt, reason string) string {
+	return fmt.Sprintf(`{"id": "exception-1", "branchName": "main", "schemaPath": "User.legacyId", "reason": %s, "expiresAt": %q}`, reason, expiresAt)
+}
+
+func TestOperationCheckExceptionResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewOperationCheckExceptionResource)
+
+	server.Handle("CreateOperationCheckException", `{"operationCheckExceptionCreate": {"__typename": "OperationCheckExceptionCreateSuccess",
+		"operationCheckException": `+testOperationCheckExceptionJSON("2030-01-01T00:00:00Z", "null")+`}}`)
+	server.Handle("GetOperationCheckException", `{"node": `+testOperationCheckExceptionJSON("2030-01-01T00:00:00Z", "null")+`}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("my-graph"),
+		"branch_name":  types.StringValue("main"),
+		"schema_path":  types.StringValue("User.legacyId"),
+		"expires_at":   RFC3339Value{StringValue: types.StringValue("2030-01-01T00:00:00Z")},
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "exception-1" {
+		t.Errorf("expected id exception-1, got %q", got)
+	}
+	var input map[string]interface{}
+	if err := server.LastRequest("CreateOperationCheckException").Input(&input); err != nil {
+		t.Fatalf("failed to decode input: %v", err)
+	}
+	if input["expiresAt"] != "2030-01-01T00:00:00Z" || input["reason"] != nil {
+		t.Errorf("unexpected create input: %v", input)
+	}
+
+	server.Handle("GetOperationCheckException", `{"node": `+testOperationCheckExceptionJSON("2030-01-01T00:00:00Z", "null")+`}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "reason"); got != "" {
+		t.Errorf("expected reason to stay null, got %q", got)
+	}
+
+	server.Handle("UpdateOperationCheckException", `{"operationCheckExceptionUpdate": {"__typename": "OperationCheckExceptionUpdateSuccess",
+		"operationCheckException": `+testOperationCheckExceptionJSON("2030-02-01T00:00:00Z", `"v2 migration"`)+`}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"reason":     types.StringValue("v2 migration"),
+		"expires_at": RFC3339Value{StringValue: types.StringValue("2030-02-01T00:00:00Z")},
+	})
+	requireNoDiagnostics(t, diags)
+	if err := server.LastRequest("UpdateOperationCheckException").Input(&input); err != nil {
+		t.Fatalf("failed to decode input: %v", err)
+	}
+	if input["id"] != "exception-1" || input["expiresAt"] != "2030-02-01T00:00:00Z" || input["reason"] != "v2 migration" {
+		t.Errorf("unexpected update input: %v", input)
+	}
+
+	server.Handle("DeleteOperationCheckException", `{"operationCheckExceptionDelete": {"__typename": "OperationCheckExceptionDoesNotExistError"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	server.Handle("GetOperationCheckException", `{"node": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected operation check exception to be removed from state")
+	}
+}
+
+func TestOperationCheckExceptionResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewOperationCheckExceptionResource)
+
+	server.Handle("GetOperationCheckException", `{"node": `+testOperationCheckExceptionJSON("2030-01-01T00:00:00Z", `"v2 migration"`)+`}`)
+	state, diags := importResource(t, r, "my-account/my-graph/exception-1")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "branch_name"); got != "main" {
+		t.Errorf("expected branch_name main, got %q", got)
+	}
+
+	if _, diags := importResource(t, r, "exception-1"); !diags.HasError() {
+		t.Error("expected invalid import ID to be an error")
+	}
+}
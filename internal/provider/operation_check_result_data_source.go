@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OperationCheckResultDataSource{}
+
+func NewOperationCheckResultDataSource() datasource.DataSource {
+	return &OperationCheckResultDataSource{}
+}
+
+// OperationCheckResultDataSource defines the data source implementation.
+type OperationCheckResultDataSource struct {
+	client client.API
+}
+
+// OperationCheckResultDataSourceModel describes the data source data model.
+type OperationCheckResultDataSourceModel struct {
+	ID                 types.String             `tfsdk:"id"`
+	AccountSlug        types.String             `tfsdk:"account_slug"`
+	GraphSlug          types.String             `tfsdk:"graph_slug"`
+	BranchName         types.String             `tfsdk:"branch_name"`
+	SubgraphName       types.String             `tfsdk:"subgraph_name"`
+	CreatedAt          RFC3339Value             `tfsdk:"created_at"`
+	ErrorCount         types.Int64              `tfsdk:"error_count"`
+	AffectedOperations []AffectedOperationModel `tfsdk:"affected_operations"`
+	ImpactedClients    types.List               `tfsdk:"impacted_clients"`
+}
+
+// AffectedOperationModel describes an operation broken by a schema change.
+type AffectedOperationModel struct {
+	Name       types.String `tfsdk:"name"`
+	ClientName types.String `tfsdk:"client_name"`
+	Message    types.String `tfsdk:"message"`
+	Count      types.Int64  `tfsdk:"count"`
+}
+
+func (d *OperationCheckResultDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_operation_check_result"
+}
+
+func (d *OperationCheckResultDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Fetches the most recent operation check result for a subgraph on a branch.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Operation check identifier",
+				Computed:            true,
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Required:            true,
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch the check ran against",
+				Required:            true,
+			},
+			"subgraph_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the checked subgraph",
+				Required:            true,
+			},
+			"created_at": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "Timestamp of the operation check",
+				Computed:            true,
+			},
+			"error_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of errors reported by the operation check",
+				Computed:            true,
+			},
+			"affected_operations": schema.ListNestedAttribute{
+				MarkdownDescription: "Client operations that would break with the checked schema",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Operation name",
+							Computed:            true,
+						},
+						"client_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the client sending the operation",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Description of the breaking change",
+							Computed:            true,
+						},
+						"count": schema.Int64Attribute{
+							MarkdownDescription: "Number of requests for the operation in the check window",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"impacted_clients": schema.ListAttribute{
+				MarkdownDescription: "Names of the clients impacted by the checked schema",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *OperationCheckResultDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OperationCheckResultDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OperationCheckResultDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check, err := d.client.GetLatestOperationCheckResult(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString(), data.SubgraphName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read operation check result: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(check.ID)
+	data.CreatedAt = NewRFC3339Value(check.CreatedAt)
+	data.ErrorCount = types.Int64Value(int64(check.ErrorCount))
+
+	data.AffectedOperations = make([]AffectedOperationModel, 0, len(check.AffectedOperations))
+	for _, operation := range check.AffectedOperations {
+		data.AffectedOperations = append(data.AffectedOperations, AffectedOperationModel{
+			Name:       types.StringValue(operation.Name),
+			ClientName: types.StringValue(operation.ClientName),
+			Message:    types.StringValue(operation.Message),
+			Count:      types.Int64Value(int64(operation.Count)),
+		})
+	}
+
+	impactedClients, diags := types.ListValueFrom(ctx, types.StringType, check.ImpactedClients)
+	resp.Diagnostics.Append(diags...)
+	data.ImpactedClients = impactedClients
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
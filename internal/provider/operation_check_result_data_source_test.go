@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOperationCheckResultDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOperationCheckResultDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.grafbase_operation_check_result.test", "id"),
+					resource.TestCheckResourceAttrSet("data.grafbase_operation_check_result.test", "created_at"),
+					resource.TestCheckResourceAttrSet("data.grafbase_operation_check_result.test", "error_count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOperationCheckResultDataSourceConfig() string {
+	return `
+data "grafbase_operation_check_result" "test" {
+  account_slug  = "test-account"
+  graph_slug    = "test-graph"
+  branch_name   = "main"
+  subgraph_name = "products"
+}
+`
+}
@@ -0,0 +1,345 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Defaults applied by the Grafbase API to branches without operation check settings.
+const (
+	defaultOperationChecksTimeWindowDays        = 7
+	defaultOperationChecksRequestCountThreshold = 1
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OperationChecksConfigResource{}
+var _ resource.ResourceWithImportState = &OperationChecksConfigResource{}
+
+func NewOperationChecksConfigResource() resource.Resource {
+	return &OperationChecksConfigResource{}
+}
+
+// OperationChecksConfigResource defines the resource implementation.
+type OperationChecksConfigResource struct {
+	client client.API
+}
+
+// OperationChecksConfigResourceModel describes the resource data model.
+type OperationChecksConfigResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	AccountSlug           types.String `tfsdk:"account_slug"`
+	GraphSlug             types.String `tfsdk:"graph_slug"`
+	BranchName            types.String `tfsdk:"branch_name"`
+	Enabled               types.Bool   `tfsdk:"enabled"`
+	IgnoreUsageData       types.Bool   `tfsdk:"ignore_usage_data"`
+	TimeWindowDays        types.Int64  `tfsdk:"time_window_days"`
+	RequestCountThreshold types.Int64  `tfsdk:"request_count_threshold"`
+	ExcludedClients       types.Set    `tfsdk:"excluded_clients"`
+	ExcludedOperations    types.Set    `tfsdk:"excluded_operations"`
+}
+
+func (r *OperationChecksConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_operation_checks_config"
+}
+
+func (r *OperationChecksConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the full operation checks configuration of a branch. Destroying the resource " +
+			"disables operation checks and restores the default settings.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the format `account_slug/graph_slug/branch_name`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch the operation checks apply to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether operation checks run for schema checks against this branch",
+				Required:            true,
+			},
+			"ignore_usage_data": schema.BoolAttribute{
+				MarkdownDescription: "Treat every change to a used type or field as breaking, regardless of recorded traffic. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"time_window_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days of traffic considered when checking operations. Defaults to `7`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultOperationChecksTimeWindowDays),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 90),
+				},
+			},
+			"request_count_threshold": schema.Int64Attribute{
+				MarkdownDescription: "Minimum number of requests in the time window for an operation to be checked. Defaults to `1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultOperationChecksRequestCountThreshold),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"excluded_clients": schema.SetAttribute{
+				MarkdownDescription: "Client names whose operations are ignored by operation checks",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"excluded_operations": schema.SetAttribute{
+				MarkdownDescription: "Operation names ignored by operation checks",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *OperationChecksConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OperationChecksConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OperationChecksConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setInput, diags := data.setInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.SetOperationChecksConfig(ctx, setInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure operation checks: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString()))
+	resp.Diagnostics.Append(data.fromConfig(ctx, config)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OperationChecksConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OperationChecksConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetOperationChecksConfig(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString())
+	if err != nil {
+		// If the branch is gone, its operation checks configuration is gone too
+		if err.Error() == "branch not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read operation checks configuration: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	resp.Diagnostics.Append(data.fromConfig(ctx, config)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OperationChecksConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OperationChecksConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setInput, diags := data.setInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.SetOperationChecksConfig(ctx, setInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update operation checks configuration: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromConfig(ctx, config)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OperationChecksConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OperationChecksConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Disabling operation checks with empty settings restores the defaults
+	resetInput := client.SetOperationChecksConfigInput{
+		AccountSlug: data.AccountSlug.ValueString(),
+		GraphSlug:   data.GraphSlug.ValueString(),
+		BranchName:  data.BranchName.ValueString(),
+	}
+
+	_, err := r.client.SetOperationChecksConfig(ctx, resetInput)
+	if err != nil {
+		// If the branch doesn't exist, there is nothing left to reset
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset operation checks configuration: %s", err))
+		return
+	}
+}
+
+func (r *OperationChecksConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug/branch_name"
+	// Branch names may contain slashes, so everything after the graph slug is the branch name
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/branch_name', got: %s", req.ID))
+		return
+	}
+
+	// Get the configuration to populate the remaining attributes
+	config, err := r.client.GetOperationChecksConfig(ctx, parts[0], parts[1], parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read operation checks configuration during import: %s", err))
+		return
+	}
+
+	data := OperationChecksConfigResourceModel{
+		ID:                 types.StringValue(req.ID),
+		AccountSlug:        types.StringValue(parts[0]),
+		GraphSlug:          types.StringValue(parts[1]),
+		BranchName:         types.StringValue(parts[2]),
+		ExcludedClients:    types.SetNull(types.StringType),
+		ExcludedOperations: types.SetNull(types.StringType),
+	}
+	resp.Diagnostics.Append(data.fromConfig(ctx, config)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setInput builds the client input replacing the operation checks configuration.
+func (m OperationChecksConfigResourceModel) setInput(ctx context.Context) (client.SetOperationChecksConfigInput, diag.Diagnostics) {
+	input := client.SetOperationChecksConfigInput{
+		AccountSlug:           m.AccountSlug.ValueString(),
+		GraphSlug:             m.GraphSlug.ValueString(),
+		BranchName:            m.BranchName.ValueString(),
+		Enabled:               m.Enabled.ValueBool(),
+		IgnoreUsageData:       m.IgnoreUsageData.ValueBool(),
+		TimeWindowDays:        m.TimeWindowDays.ValueInt64(),
+		RequestCountThreshold: m.RequestCountThreshold.ValueInt64(),
+	}
+
+	var diags diag.Diagnostics
+	if !m.ExcludedClients.IsNull() && !m.ExcludedClients.IsUnknown() {
+		diags.Append(m.ExcludedClients.ElementsAs(ctx, &input.ExcludedClients, false)...)
+	}
+	if !m.ExcludedOperations.IsNull() && !m.ExcludedOperations.IsUnknown() {
+		diags.Append(m.ExcludedOperations.ElementsAs(ctx, &input.ExcludedOperations, false)...)
+	}
+
+	return input, diags
+}
+
+// fromConfig maps an API operation checks configuration onto the model. Empty
+// exclusion sets are kept null when they were not configured, so omitting the
+// attributes does not cause a diff.
+func (m *OperationChecksConfigResourceModel) fromConfig(ctx context.Context, config *client.OperationChecksConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Enabled = types.BoolValue(config.Enabled)
+	m.IgnoreUsageData = types.BoolValue(config.IgnoreUsageData)
+	m.TimeWindowDays = types.Int64Value(config.TimeWindowDays)
+	m.RequestCountThreshold = types.Int64Value(config.RequestCountThreshold)
+
+	if len(config.ExcludedClients) > 0 || !m.ExcludedClients.IsNull() {
+		excludedClients, d := types.SetValueFrom(ctx, types.StringType, config.ExcludedClients)
+		diags.Append(d...)
+		m.ExcludedClients = excludedClients
+	}
+
+	if len(config.ExcludedOperations) > 0 || !m.ExcludedOperations.IsNull() {
+		excludedOperations, d := types.SetValueFrom(ctx, types.StringType, config.ExcludedOperations)
+		diags.Append(d...)
+		m.ExcludedOperations = excludedOperations
+	}
+
+	return diags
+}
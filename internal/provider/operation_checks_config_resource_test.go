@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOperationChecksConfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOperationChecksConfigResourceConfig(7, `["internal-dashboard"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_operation_checks_config.test", "id", "test-account/test-graph/main"),
+					resource.TestCheckResourceAttr("grafbase_operation_checks_config.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("grafbase_operation_checks_config.test", "ignore_usage_data", "false"),
+					resource.TestCheckResourceAttr("grafbase_operation_checks_config.test", "time_window_days", "7"),
+					resource.TestCheckResourceAttr("grafbase_operation_checks_config.test", "request_count_threshold", "1"),
+					resource.TestCheckResourceAttr("grafbase_operation_checks_config.test", "excluded_clients.#", "1"),
+					resource.TestCheckTypeSetElemAttr("grafbase_operation_checks_config.test", "excluded_clients.*", "internal-dashboard"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_operation_checks_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-account/test-graph/main",
+			},
+			// Update in place
+			{
+				Config: testAccOperationChecksConfigResourceConfig(30, `["internal-dashboard", "load-tests"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_operation_checks_config.test", "time_window_days", "30"),
+					resource.TestCheckResourceAttr("grafbase_operation_checks_config.test", "excluded_clients.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOperationChecksConfigResourceConfig(timeWindowDays int, excludedClients string) string {
+	return fmt.Sprintf(`
+resource "grafbase_graph" "test" {
+  account_slug = "test-account"
+  slug         = "test-graph"
+}
+
+resource "grafbase_operation_checks_config" "test" {
+  account_slug     = grafbase_graph.test.account_slug
+  graph_slug       = grafbase_graph.test.slug
+  branch_name      = "main"
+  enabled          = true
+  time_window_days = %[1]d
+  excluded_clients = %[2]s
+}
+`, timeWindowDays, excludedClients)
+}
+
+func TestOperationChecksConfigResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewOperationChecksConfigResource)
+
+	server.Handle("SetOperationChecksConfig", `{"operationChecksConfigurationSet": {"__typename": "OperationChecksConfigurationSetSuccess", "operationChecksConfiguration": {
+		"enabled": true, "ignoreUsageData": false, "timeWindowDays": 7, "requestCountThreshold": 1,
+		"excludedClients": ["internal"], "excludedOperations": []
+	}}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug":            types.StringValue("my-account"),
+		"graph_slug":              types.StringValue("my-graph"),
+		"branch_name":             types.StringValue("main"),
+		"enabled":                 types.BoolValue(true),
+		"ignore_usage_data":       types.BoolValue(false),
+		"time_window_days":        types.Int64Value(7),
+		"request_count_threshold": types.Int64Value(1),
+		"excluded_clients":        types.SetValueMust(types.StringType, []attr.Value{types.StringValue("internal")}),
+		"excluded_operations":     types.SetNull(types.StringType),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "my-account/my-graph/main" {
+		t.Errorf("expected id my-account/my-graph/main, got %q", got)
+	}
+
+	server.Handle("GetOperationChecksConfig", `{"branch": {"operationChecksConfiguration": {
+		"enabled": true, "ignoreUsageData": false, "timeWindowDays": 14, "requestCountThreshold": 1,
+		"excludedClients": ["internal"], "excludedOperations": []
+	}}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"time_window_days": types.Int64Value(7),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := server.LastRequest("SetOperationChecksConfig").Variables["input"].(map[string]interface{})["timeWindowDays"]; got != float64(7) {
+		t.Errorf("expected time window of 7 days, got %v", got)
+	}
+
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+	if got := server.LastRequest("SetOperationChecksConfig").Variables["input"].(map[string]interface{})["enabled"]; got != false {
+		t.Errorf("expected delete to disable operation checks, got %v", got)
+	}
+
+	server.Handle("GetOperationChecksConfig", `{"branch": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected configuration to be removed from state")
+	}
+}
+
+func TestOperationChecksConfigResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewOperationChecksConfigResource)
+
+	server.Handle("GetOperationChecksConfig", `{"branch": {"operationChecksConfiguration": {
+		"enabled": true, "ignoreUsageData": false, "timeWindowDays": 14, "requestCountThreshold": 1,
+		"excludedClients": [], "excludedOperations": []
+	}}}`)
+	state, diags := importResource(t, r, "my-account/my-graph/main")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "id"); got != "my-account/my-graph/main" {
+		t.Errorf("expected id my-account/my-graph/main, got %q", got)
+	}
+}
@@ -1,19 +1,32 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"os"
+	"os/exec"
+	"strings"
+	"time"
 
 	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure GrafbaseProvider satisfies various provider interfaces.
 var _ provider.Provider = &GrafbaseProvider{}
+var _ provider.ProviderWithConfigValidators = &GrafbaseProvider{}
+var _ provider.ProviderWithEphemeralResources = &GrafbaseProvider{}
+var _ provider.ProviderWithFunctions = &GrafbaseProvider{}
 
 // GrafbaseProvider defines the provider implementation.
 type GrafbaseProvider struct {
@@ -25,7 +38,20 @@ type GrafbaseProvider struct {
 
 // GrafbaseProviderModel describes the provider data model.
 type GrafbaseProviderModel struct {
-	APIKey types.String `tfsdk:"api_key"`
+	APIKey        types.String `tfsdk:"api_key"`
+	APIKeyFile    types.String `tfsdk:"api_key_file"`
+	APIKeyCommand types.List   `tfsdk:"api_key_command"`
+	OIDCToken     types.String `tfsdk:"oidc_token"`
+	OIDCTokenFile types.String `tfsdk:"oidc_token_file"`
+
+	HTTPProxy          types.String `tfsdk:"http_proxy"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+
+	APIURL              types.String `tfsdk:"api_url"`
+	RequestTimeout      types.String `tfsdk:"request_timeout"`
+	ValidateCredentials types.Bool   `tfsdk:"validate_credentials"`
+	DisableTelemetry    types.Bool   `tfsdk:"disable_telemetry"`
 }
 
 func (p *GrafbaseProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -41,10 +67,73 @@ func (p *GrafbaseProvider) Schema(ctx context.Context, req provider.SchemaReques
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"api_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the Grafbase API key, such as a mounted secret. Can also be set via the `GRAFBASE_API_KEY_FILE` environment variable.",
+				Optional:            true,
+			},
+			"api_key_command": schema.ListAttribute{
+				MarkdownDescription: "Command that prints the Grafbase API key, such as a secrets manager CLI, given as the program followed by its arguments. " +
+					"The command runs without a shell and must finish within 30 seconds; surrounding whitespace is trimmed from its output. " +
+					"Can also be set via the `GRAFBASE_API_KEY_COMMAND` environment variable, split on whitespace.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"oidc_token": schema.StringAttribute{
+				MarkdownDescription: "OIDC identity token issued by a trusted CI provider, exchanged for a short-lived Grafbase access token when no API key is configured. Can also be set via the `GRAFBASE_OIDC_TOKEN` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"oidc_token_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing an OIDC identity token, such as a projected workload identity token. Can also be set via the `GRAFBASE_OIDC_TOKEN_FILE` environment variable.",
+				Optional:            true,
+			},
+			"http_proxy": schema.StringAttribute{
+				MarkdownDescription: "URL of the HTTP(S) proxy used to reach the Grafbase API. Defaults to the standard `HTTPS_PROXY`, `HTTP_PROXY`, and `NO_PROXY` environment variables.",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates to trust in addition to the system certificate pool, for example when an egress proxy uses a private CA.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Disable TLS certificate verification. Only use this for debugging.",
+				Optional:            true,
+			},
+			"api_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the Grafbase GraphQL API. Must be an `https` URL. Defaults to `" + client.DefaultAPIURL + "`. " +
+					"Can also be set via the `GRAFBASE_API_URL` environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					httpsURLValidator{},
+				},
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum duration of a single API request, as a Go duration string such as `90s` or `2m`. Defaults to `30s`. Resource `timeouts` blocks bound whole operations, which may span several requests.",
+				Optional:            true,
+			},
+			"validate_credentials": schema.BoolAttribute{
+				MarkdownDescription: "Check that the credentials authenticate with the Grafbase API when the provider is configured, " +
+					"so that a wrong API key fails before any resource is changed. Defaults to `true`.",
+				Optional: true,
+			},
+			"disable_telemetry": schema.BoolAttribute{
+				MarkdownDescription: "Omit usage metadata from API requests: the `User-Agent` no longer includes the provider and Terraform versions, " +
+					"and the trace context of operations is not propagated to Grafbase. Defaults to `false`.",
+				Optional: true,
+			},
 		},
 	}
 }
 
+func (p *GrafbaseProvider) ConfigValidators(ctx context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		authMethodsValidator{},
+	}
+}
+
 func (p *GrafbaseProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var data GrafbaseProviderModel
 
@@ -55,44 +144,289 @@ func (p *GrafbaseProvider) Configure(ctx context.Context, req provider.Configure
 	}
 
 	// Configuration values are now available.
-	var apiKey string
-	if data.APIKey.IsNull() {
-		apiKey = os.Getenv("GRAFBASE_API_KEY")
-	} else {
-		apiKey = data.APIKey.ValueString()
+	apiKey, err := resolveAPIKey(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read API key", err.Error())
+		return
+	}
+
+	transport, err := client.NewTransport(client.TransportConfig{
+		ProxyURL:           data.HTTPProxy.ValueString(),
+		CACertPEM:          data.CACertPEM.ValueString(),
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid HTTP transport configuration", err.Error())
+		return
+	}
+
+	requestTimeout := client.DefaultRequestTimeout
+	if !data.RequestTimeout.IsNull() {
+		requestTimeout, err = time.ParseDuration(data.RequestTimeout.ValueString())
+		if err != nil || requestTimeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid request timeout",
+				fmt.Sprintf("request_timeout must be a positive duration such as \"90s\" or \"2m\", got: %s", data.RequestTimeout.ValueString()),
+			)
+			return
+		}
+	}
+
+	apiURL := data.APIURL.ValueString()
+	if data.APIURL.IsNull() {
+		apiURL = os.Getenv("GRAFBASE_API_URL")
+		if apiURL != "" {
+			if err := validateHTTPSURL(apiURL); err != nil {
+				resp.Diagnostics.AddError("Invalid API URL", fmt.Sprintf("GRAFBASE_API_URL %s", err))
+				return
+			}
+		}
+	}
+
+	clientOptions := []client.Option{
+		client.WithTransport(transport),
+		client.WithTimeout(requestTimeout),
+		client.WithUserAgent(userAgent(p.version, req.TerraformVersion)),
+	}
+	if apiURL != "" {
+		clientOptions = append(clientOptions, client.WithAPIURL(apiURL))
+	}
+	if data.DisableTelemetry.ValueBool() {
+		clientOptions = append(clientOptions, client.WithTelemetryDisabled())
+	}
+
+	// Fall back to exchanging an OIDC identity token for a short-lived access token
+	if apiKey == "" {
+		oidcToken, err := resolveOIDCToken(data)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to read OIDC token", err.Error())
+			return
+		}
+
+		if oidcToken != "" {
+			accessToken, err := client.NewClient("", clientOptions...).ExchangeOIDCToken(ctx, oidcToken)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to exchange OIDC token",
+					fmt.Sprintf("The OIDC token could not be exchanged for a Grafbase access token: %s", err),
+				)
+				return
+			}
+			apiKey = accessToken
+		}
 	}
 
 	if apiKey == "" {
 		resp.Diagnostics.AddError(
 			"Unable to find API key",
 			"API key cannot be an empty string. "+
-				"Set the api_key attribute in the provider configuration or use the GRAFBASE_API_KEY environment variable.",
+				"Set the api_key, api_key_file, or api_key_command attribute in the provider configuration or use the GRAFBASE_API_KEY, GRAFBASE_API_KEY_FILE, or GRAFBASE_API_KEY_COMMAND environment variable, "+
+				"or configure OIDC authentication with oidc_token or oidc_token_file.",
 		)
 		return
 	}
 
 	// Create a new Grafbase client using the configuration values
-	client := client.NewClient(apiKey)
+	client := client.NewClient(apiKey, clientOptions...)
+
+	if data.ValidateCredentials.IsNull() || data.ValidateCredentials.ValueBool() {
+		if err := client.ValidateCredentials(ctx); err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Grafbase credentials",
+				fmt.Sprintf("The configured credentials could not be used to authenticate with the Grafbase API: %s. "+
+					"Check the API key, or set validate_credentials = false to skip this check.", err),
+			)
+			return
+		}
+	}
 
 	// Make the client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+}
+
+// apiKeyCommandTimeout bounds how long api_key_command may run.
+var apiKeyCommandTimeout = 30 * time.Second
+
+// resolveAPIKey returns the API key from the provider configuration or
+// environment, preferring configured values over environment variables.
+func resolveAPIKey(ctx context.Context, data GrafbaseProviderModel) (string, error) {
+	if !data.APIKey.IsNull() {
+		return data.APIKey.ValueString(), nil
+	}
+
+	if !data.APIKeyCommand.IsNull() {
+		var command []string
+		for _, element := range data.APIKeyCommand.Elements() {
+			command = append(command, element.(types.String).ValueString())
+		}
+		return runAPIKeyCommand(ctx, command)
+	}
+
+	keyFile := data.APIKeyFile.ValueString()
+	if data.APIKeyFile.IsNull() {
+		if apiKey := os.Getenv("GRAFBASE_API_KEY"); apiKey != "" {
+			return apiKey, nil
+		}
+		keyFile = os.Getenv("GRAFBASE_API_KEY_FILE")
+		if keyFile == "" {
+			if command := strings.Fields(os.Getenv("GRAFBASE_API_KEY_COMMAND")); len(command) > 0 {
+				return runAPIKeyCommand(ctx, command)
+			}
+		}
+	}
+
+	if keyFile == "" {
+		return "", nil
+	}
+
+	contents, err := os.ReadFile(keyFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read API key file %s: %w", keyFile, err)
+	}
+
+	return strings.TrimSpace(string(contents)), nil
+}
+
+// runAPIKeyCommand runs the command and returns its trimmed output. The
+// command's standard error is included in the error when it fails.
+func runAPIKeyCommand(ctx context.Context, command []string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, apiKeyCommandTimeout)
+	defer cancel()
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if ctx.Err() == context.DeadlineExceeded {
+		return "", fmt.Errorf("API key command %s did not finish within %s", command[0], apiKeyCommandTimeout)
+	}
+	if err != nil {
+		return "", fmt.Errorf("API key command %s failed: %w: %s", command[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	apiKey := strings.TrimSpace(string(output))
+	if apiKey == "" {
+		return "", fmt.Errorf("API key command %s printed no API key", command[0])
+	}
+
+	return apiKey, nil
+}
+
+// resolveOIDCToken returns the OIDC identity token from the provider
+// configuration or environment, preferring inline tokens over token files.
+func resolveOIDCToken(data GrafbaseProviderModel) (string, error) {
+	if !data.OIDCToken.IsNull() {
+		return data.OIDCToken.ValueString(), nil
+	}
+
+	tokenFile := data.OIDCTokenFile.ValueString()
+	if data.OIDCTokenFile.IsNull() {
+		if token := os.Getenv("GRAFBASE_OIDC_TOKEN"); token != "" {
+			return token, nil
+		}
+		tokenFile = os.Getenv("GRAFBASE_OIDC_TOKEN_FILE")
+	}
+
+	if tokenFile == "" {
+		return "", nil
+	}
+
+	contents, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read OIDC token file %s: %w", tokenFile, err)
+	}
+
+	return strings.TrimSpace(string(contents)), nil
 }
 
 func (p *GrafbaseProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewGraphResource,
 		NewBranchResource,
+		NewSchemaCheckResource,
+		NewSubgraphRoutingOverrideResource,
+		NewBranchFeatureFlagsResource,
+		NewAccountAPIBudgetResource,
+		NewDomainResource,
+		NewAccountMemberResource,
+		NewMCPEndpointResource,
+		NewOperationChecksConfigResource,
+		NewTrustedDocumentsResource,
+		NewSchemaTagResource,
+		NewGatewayConfigResource,
+		NewGraphDefaultBranchSettingsResource,
+		NewTokenPolicyResource,
+		NewAPIKeyResource,
+		NewSchemaRegistryMirrorResource,
+		NewRequestLoggingRuleResource,
+		NewBranchProtectionResource,
+		NewGraphSettingsResource,
+		NewSubgraphHeadersResource,
+		NewSchemaProposalResource,
+		NewWebhookResource,
+		NewNotificationChannelResource,
+		NewBranchEnvironmentResource,
+		NewGraphCollaboratorResource,
+		NewTeamResource,
+		NewTeamMemberResource,
+		NewSchemaContractResource,
+		NewAutoBranchingRuleResource,
+		NewOperationCheckExceptionResource,
+		NewGraphKeyResource,
+		NewBranchAliasResource,
+		NewSchemaPublishResource,
 	}
 }
 
 func (p *GrafbaseProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
-		// Data sources can be added later
+		NewFederatedSchemaDataSource,
+		NewOperationCheckResultDataSource,
+		NewInvoiceUsageDataSource,
+		NewAccountMembersDataSource,
+		NewBreakingChangeGuardDataSource,
+		NewRegionsDataSource,
+		NewSubgraphSDLDiffDataSource,
+		NewBranchDeployStatusDataSource,
+		NewSubgraphSchemaDataSource,
+		NewSchemaProposalDataSource,
+		NewAuditLogsDataSource,
+		NewGraphUsageDataSource,
+		NewDeploymentDataSource,
+		NewViewerDataSource,
+		NewDomainVerificationDataSource,
+		NewGraphSearchDataSource,
+	}
+}
+
+func (p *GrafbaseProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAccessTokenEphemeralResource,
 	}
 }
 
+func (p *GrafbaseProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewSlugifyFunction,
+		NewValidSlugFunction,
+	}
+}
+
+// userAgent returns the User-Agent of API requests, which identifies the
+// provider and Terraform versions for Grafbase support
+func userAgent(providerVersion, terraformVersion string) string {
+	if terraformVersion == "" {
+		terraformVersion = "unknown"
+	}
+
+	return fmt.Sprintf("%s/%s terraform/%s", client.DefaultUserAgent, providerVersion, terraformVersion)
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &GrafbaseProvider{
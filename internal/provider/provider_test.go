@@ -1,10 +1,27 @@
 package provider
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/grafbase/terraform-provider-grafbase/internal/mockgraphql"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -24,3 +41,885 @@ func TestUnit(t *testing.T) {
 func testAccPreCheck(t *testing.T) {
 	// You can add common test setup here
 }
+
+// testAccClient returns an API client for mutating resources out-of-band
+// during acceptance tests, for example to simulate drift.
+func testAccClient(t *testing.T) *client.Client {
+	apiKey := os.Getenv("GRAFBASE_API_KEY")
+	if apiKey == "" {
+		t.Fatal("GRAFBASE_API_KEY must be set for acceptance tests")
+	}
+
+	return client.NewClient(apiKey)
+}
+
+func TestResolveOIDCToken(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("file-token\n"), 0o600); err != nil {
+		t.Fatalf("failed to write token file: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		data          GrafbaseProviderModel
+		env           map[string]string
+		expected      string
+		expectedError bool
+	}{
+		{
+			name: "inline token",
+			data: GrafbaseProviderModel{
+				OIDCToken:     types.StringValue("inline-token"),
+				OIDCTokenFile: types.StringValue(tokenFile),
+			},
+			expected: "inline-token",
+		},
+		{
+			name: "token file is trimmed",
+			data: GrafbaseProviderModel{
+				OIDCToken:     types.StringNull(),
+				OIDCTokenFile: types.StringValue(tokenFile),
+			},
+			expected: "file-token",
+		},
+		{
+			name: "token from environment",
+			data: GrafbaseProviderModel{
+				OIDCToken:     types.StringNull(),
+				OIDCTokenFile: types.StringNull(),
+			},
+			env:      map[string]string{"GRAFBASE_OIDC_TOKEN": "env-token"},
+			expected: "env-token",
+		},
+		{
+			name: "token file from environment",
+			data: GrafbaseProviderModel{
+				OIDCToken:     types.StringNull(),
+				OIDCTokenFile: types.StringNull(),
+			},
+			env:      map[string]string{"GRAFBASE_OIDC_TOKEN_FILE": tokenFile},
+			expected: "file-token",
+		},
+		{
+			name: "no token configured",
+			data: GrafbaseProviderModel{
+				OIDCToken:     types.StringNull(),
+				OIDCTokenFile: types.StringNull(),
+			},
+			expected: "",
+		},
+		{
+			name: "missing token file",
+			data: GrafbaseProviderModel{
+				OIDCToken:     types.StringNull(),
+				OIDCTokenFile: types.StringValue(filepath.Join(t.TempDir(), "missing")),
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GRAFBASE_OIDC_TOKEN", "")
+			t.Setenv("GRAFBASE_OIDC_TOKEN_FILE", "")
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			token, err := resolveOIDCToken(tt.data)
+
+			if tt.expectedError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if token != tt.expected {
+				t.Errorf("expected token %q, got %q", tt.expected, token)
+			}
+		})
+	}
+}
+
+func TestResolveAPIKey(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "api-key")
+	if err := os.WriteFile(keyFile, []byte("file-key\n"), 0o600); err != nil {
+		t.Fatalf("failed to write API key file: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		data          GrafbaseProviderModel
+		env           map[string]string
+		expected      string
+		expectedError bool
+	}{
+		{
+			name: "inline key",
+			data: GrafbaseProviderModel{
+				APIKey:     types.StringValue("inline-key"),
+				APIKeyFile: types.StringNull(),
+			},
+			env:      map[string]string{"GRAFBASE_API_KEY": "env-key"},
+			expected: "inline-key",
+		},
+		{
+			name: "key file is trimmed",
+			data: GrafbaseProviderModel{
+				APIKey:     types.StringNull(),
+				APIKeyFile: types.StringValue(keyFile),
+			},
+			env:      map[string]string{"GRAFBASE_API_KEY": "env-key"},
+			expected: "file-key",
+		},
+		{
+			name: "key from environment",
+			data: GrafbaseProviderModel{
+				APIKey:     types.StringNull(),
+				APIKeyFile: types.StringNull(),
+			},
+			env:      map[string]string{"GRAFBASE_API_KEY": "env-key"},
+			expected: "env-key",
+		},
+		{
+			name: "key file from environment",
+			data: GrafbaseProviderModel{
+				APIKey:     types.StringNull(),
+				APIKeyFile: types.StringNull(),
+			},
+			env:      map[string]string{"GRAFBASE_API_KEY_FILE": keyFile},
+			expected: "file-key",
+		},
+		{
+			name: "no key configured",
+			data: GrafbaseProviderModel{
+				APIKey:     types.StringNull(),
+				APIKeyFile: types.StringNull(),
+			},
+			expected: "",
+		},
+		{
+			name: "key command output is trimmed",
+			data: GrafbaseProviderModel{
+				APIKey:        types.StringNull(),
+				APIKeyFile:    types.StringNull(),
+				APIKeyCommand: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("echo"), types.StringValue(" command-key ")}),
+			},
+			env:      map[string]string{"GRAFBASE_API_KEY": "env-key"},
+			expected: "command-key",
+		},
+		{
+			name: "key command from environment",
+			data: GrafbaseProviderModel{
+				APIKey:     types.StringNull(),
+				APIKeyFile: types.StringNull(),
+			},
+			env:      map[string]string{"GRAFBASE_API_KEY_COMMAND": "echo env-command-key"},
+			expected: "env-command-key",
+		},
+		{
+			name: "failing key command",
+			data: GrafbaseProviderModel{
+				APIKey:        types.StringNull(),
+				APIKeyFile:    types.StringNull(),
+				APIKeyCommand: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("false")}),
+			},
+			expectedError: true,
+		},
+		{
+			name: "key command without output",
+			data: GrafbaseProviderModel{
+				APIKey:        types.StringNull(),
+				APIKeyFile:    types.StringNull(),
+				APIKeyCommand: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("true")}),
+			},
+			expectedError: true,
+		},
+		{
+			name: "missing key file",
+			data: GrafbaseProviderModel{
+				APIKey:     types.StringNull(),
+				APIKeyFile: types.StringValue(filepath.Join(t.TempDir(), "missing")),
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GRAFBASE_API_KEY", "")
+			t.Setenv("GRAFBASE_API_KEY_FILE", "")
+			t.Setenv("GRAFBASE_API_KEY_COMMAND", "")
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			apiKey, err := resolveAPIKey(context.Background(), tt.data)
+
+			if tt.expectedError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if apiKey != tt.expected {
+				t.Errorf("expected API key %q, got %q", tt.expected, apiKey)
+			}
+		})
+	}
+}
+
+func TestRunAPIKeyCommandTimeout(t *testing.T) {
+	timeout := apiKeyCommandTimeout
+	apiKeyCommandTimeout = 100 * time.Millisecond
+	t.Cleanup(func() { apiKeyCommandTimeout = timeout })
+
+	_, err := runAPIKeyCommand(context.Background(), []string{"sleep", "5"})
+	if err == nil || !strings.Contains(err.Error(), "did not finish within 100ms") {
+		t.Errorf("expected timeout error, got %v", err)
+	}
+}
+
+func TestValidateAuthMethods(t *testing.T) {
+	unset := GrafbaseProviderModel{
+		APIKey:        types.StringNull(),
+		APIKeyFile:    types.StringNull(),
+		OIDCToken:     types.StringNull(),
+		OIDCTokenFile: types.StringNull(),
+		APIKeyCommand: types.ListNull(types.StringType),
+	}
+
+	with := func(modify func(*GrafbaseProviderModel)) GrafbaseProviderModel {
+		data := unset
+		modify(&data)
+		return data
+	}
+
+	tests := []struct {
+		name          string
+		data          GrafbaseProviderModel
+		env           map[string]string
+		expectedError string
+	}{
+		{
+			name: "api key only",
+			data: with(func(m *GrafbaseProviderModel) { m.APIKey = types.StringValue("key") }),
+		},
+		{
+			name: "oidc token file only",
+			data: with(func(m *GrafbaseProviderModel) { m.OIDCTokenFile = types.StringValue("/var/run/token") }),
+		},
+		{
+			name: "api key and api key file",
+			data: with(func(m *GrafbaseProviderModel) {
+				m.APIKey = types.StringValue("key")
+				m.APIKeyFile = types.StringValue("/run/secrets/grafbase")
+			}),
+			expectedError: "Conflicting Authentication Methods",
+		},
+		{
+			name: "api key and oidc token",
+			data: with(func(m *GrafbaseProviderModel) {
+				m.APIKey = types.StringValue("key")
+				m.OIDCToken = types.StringValue("token")
+			}),
+			expectedError: "Conflicting Authentication Methods",
+		},
+		{
+			name: "api key file and api key command",
+			data: with(func(m *GrafbaseProviderModel) {
+				m.APIKeyFile = types.StringValue("/run/secrets/grafbase")
+				m.APIKeyCommand = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("vault")})
+			}),
+			expectedError: "Conflicting Authentication Methods",
+		},
+		{
+			name: "unknown values are skipped",
+			data: with(func(m *GrafbaseProviderModel) {
+				m.APIKey = types.StringValue("key")
+				m.OIDCToken = types.StringUnknown()
+			}),
+		},
+		{
+			name: "nothing configured but environment set",
+			data: unset,
+			env:  map[string]string{"GRAFBASE_OIDC_TOKEN_FILE": "/var/run/token"},
+		},
+		{
+			name:          "nothing configured",
+			data:          unset,
+			expectedError: "Missing Authentication",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, envVar := range authEnvVars {
+				t.Setenv(envVar, "")
+			}
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			diags := validateAuthMethods(tt.data)
+
+			if tt.expectedError == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected error: %v", diags)
+				}
+				return
+			}
+
+			if !diags.HasError() {
+				t.Fatalf("expected error %q but got none", tt.expectedError)
+			}
+
+			if summary := diags.Errors()[0].Summary(); summary != tt.expectedError {
+				t.Errorf("expected error %q, got %q", tt.expectedError, summary)
+			}
+		})
+	}
+}
+
+func TestNameValidators(t *testing.T) {
+	tests := []struct {
+		name       string
+		validators []validator.String
+		value      string
+		valid      bool
+	}{
+		{name: "slug", validators: slugValidators(), value: "my-graph-2", valid: true},
+		{name: "slug with uppercase", validators: slugValidators(), value: "My-Graph", valid: false},
+		{name: "slug with leading hyphen", validators: slugValidators(), value: "-graph", valid: false},
+		{name: "slug with consecutive hyphens", validators: slugValidators(), value: "my--graph", valid: false},
+		{name: "slug too long", validators: slugValidators(), value: strings.Repeat("a", maxSlugLength+1), valid: false},
+		{name: "branch name", validators: branchNameValidators(), value: "main", valid: true},
+		{name: "branch name with slash", validators: branchNameValidators(), value: "feature/new_schema", valid: true},
+		{name: "branch name with trailing slash", validators: branchNameValidators(), value: "feature/", valid: false},
+		{name: "branch name with space", validators: branchNameValidators(), value: "my branch", valid: false},
+		{name: "empty branch name", validators: branchNameValidators(), value: "", valid: false},
+		{name: "subgraph name", validators: subgraphNameValidators(), value: "user_reviews", valid: true},
+		{name: "subgraph name with dot", validators: subgraphNameValidators(), value: "user.reviews", valid: false},
+		{name: "schema coordinate of a type", validators: schemaCoordinateValidators(), value: "LegacyUser", valid: true},
+		{name: "schema coordinate of a field", validators: schemaCoordinateValidators(), value: "User.legacyId", valid: true},
+		{name: "schema coordinate of an argument", validators: schemaCoordinateValidators(), value: "Query.user(legacyId:)", valid: true},
+		{name: "schema coordinate with space", validators: schemaCoordinateValidators(), value: "User legacyId", valid: false},
+		{name: "schema coordinate with trailing dot", validators: schemaCoordinateValidators(), value: "User.", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+
+			for _, v := range tt.validators {
+				req := validator.StringRequest{
+					Path:        path.Root("test"),
+					ConfigValue: types.StringValue(tt.value),
+				}
+				resp := &validator.StringResponse{}
+				v.ValidateString(context.Background(), req, resp)
+				diags.Append(resp.Diagnostics...)
+			}
+
+			if tt.valid && diags.HasError() {
+				t.Errorf("expected %q to be valid, got: %v", tt.value, diags)
+			}
+			if !tt.valid && !diags.HasError() {
+				t.Errorf("expected %q to be invalid", tt.value)
+			}
+		})
+	}
+}
+
+func TestHTTPSURLValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		value types.String
+		valid bool
+	}{
+		{name: "https url", value: types.StringValue("https://api.grafbase.com/graphql"), valid: true},
+		{name: "https url with port", value: types.StringValue("https://localhost:8443/graphql"), valid: true},
+		{name: "null", value: types.StringNull(), valid: true},
+		{name: "unknown", value: types.StringUnknown(), valid: true},
+		{name: "http url", value: types.StringValue("http://api.grafbase.com/graphql"), valid: false},
+		{name: "missing host", value: types.StringValue("https:///graphql"), valid: false},
+		{name: "relative url", value: types.StringValue("api.grafbase.com/graphql"), valid: false},
+		{name: "malformed url", value: types.StringValue("https://%zz"), valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("api_url"),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.StringResponse{}
+			httpsURLValidator{}.ValidateString(context.Background(), req, resp)
+
+			if tt.valid && resp.Diagnostics.HasError() {
+				t.Errorf("expected %s to be valid, got: %v", tt.value, resp.Diagnostics)
+			}
+			if !tt.valid && !resp.Diagnostics.HasError() {
+				t.Errorf("expected %s to be invalid", tt.value)
+			}
+		})
+	}
+}
+
+func TestHTTPURLValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		value types.String
+		valid bool
+	}{
+		{name: "https url", value: types.StringValue("https://accounts.example.com/graphql"), valid: true},
+		{name: "http url", value: types.StringValue("http://accounts.internal:4000/graphql"), valid: true},
+		{name: "uppercase scheme", value: types.StringValue("HTTPS://accounts.example.com/graphql"), valid: true},
+		{name: "null", value: types.StringNull(), valid: true},
+		{name: "unknown", value: types.StringUnknown(), valid: true},
+		{name: "other scheme", value: types.StringValue("ws://accounts.example.com/graphql"), valid: false},
+		{name: "missing host", value: types.StringValue("http:///graphql"), valid: false},
+		{name: "relative url", value: types.StringValue("accounts.example.com/graphql"), valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("url"),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.StringResponse{}
+			httpURLValidator{}.ValidateString(context.Background(), req, resp)
+
+			if tt.valid && resp.Diagnostics.HasError() {
+				t.Errorf("expected %s to be valid, got: %v", tt.value, resp.Diagnostics)
+			}
+			if !tt.valid && !resp.Diagnostics.HasError() {
+				t.Errorf("expected %s to be invalid", tt.value)
+			}
+		})
+	}
+}
+
+func TestAddClientError(t *testing.T) {
+	tests := []struct {
+		name            string
+		err             error
+		expectedSummary string
+		expectedPath    path.Path
+	}{
+		{
+			name:            "typed error attached to attribute",
+			err:             &client.SlugTooLongError{MaxLength: 48},
+			expectedSummary: "Slug Too Long",
+			expectedPath:    path.Root("slug"),
+		},
+		{
+			name:            "typed error without matching attribute",
+			err:             &client.GraphNotSelfHostedError{},
+			expectedSummary: "Graph Not Self-Hosted",
+		},
+		{
+			name:            "wrapped typed error",
+			err:             fmt.Errorf("request failed: %w", &client.AccountDoesNotExistError{}),
+			expectedSummary: "Account Not Found",
+			expectedPath:    path.Root("account_slug"),
+		},
+		{
+			name:            "plain error",
+			err:             errors.New("connection refused"),
+			expectedSummary: "Client Error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+
+			addClientError(&diags, "create graph", tt.err, graphInputAttributes)
+
+			if diags.ErrorsCount() != 1 {
+				t.Fatalf("expected 1 error, got %d: %v", diags.ErrorsCount(), diags)
+			}
+
+			d := diags.Errors()[0]
+			if d.Summary() != tt.expectedSummary {
+				t.Errorf("expected summary %q, got %q", tt.expectedSummary, d.Summary())
+			}
+
+			withPath, ok := d.(diag.DiagnosticWithPath)
+			if len(tt.expectedPath.Steps()) == 0 {
+				if ok {
+					t.Errorf("expected no attribute path, got %s", withPath.Path())
+				}
+				return
+			}
+			if !ok || !withPath.Path().Equal(tt.expectedPath) {
+				t.Errorf("expected attribute path %s, got %v", tt.expectedPath, d)
+			}
+		})
+	}
+}
+
+func TestDiffTrustedDocuments(t *testing.T) {
+	getUser := "query GetUser { user { id } }"
+	listProducts := "query ListProducts { products { id } }"
+
+	tests := []struct {
+		name           string
+		documents      map[string]string
+		currentHashes  map[string]string
+		expectedUpload []string
+		expectedRemove []string
+	}{
+		{
+			name:           "nothing stored",
+			documents:      map[string]string{"get-user": getUser, "list-products": listProducts},
+			currentHashes:  map[string]string{},
+			expectedUpload: []string{"get-user", "list-products"},
+		},
+		{
+			name:          "in sync",
+			documents:     map[string]string{"get-user": getUser},
+			currentHashes: map[string]string{"get-user": client.TrustedDocumentHash(getUser)},
+		},
+		{
+			name:           "removed from configuration",
+			documents:      map[string]string{"get-user": getUser},
+			currentHashes:  map[string]string{"get-user": client.TrustedDocumentHash(getUser), "list-products": client.TrustedDocumentHash(listProducts)},
+			expectedRemove: []string{"list-products"},
+		},
+		{
+			name:           "changed outside of terraform",
+			documents:      map[string]string{"get-user": getUser},
+			currentHashes:  map[string]string{"get-user": client.TrustedDocumentHash("query GetUser { user { name } }")},
+			expectedUpload: []string{"get-user"},
+			expectedRemove: []string{"get-user"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upload, remove := diffTrustedDocuments(tt.documents, tt.currentHashes)
+
+			var uploadIDs []string
+			for _, document := range upload {
+				uploadIDs = append(uploadIDs, document.DocumentID)
+				if document.DocumentText != tt.documents[document.DocumentID] {
+					t.Errorf("unexpected text for document %q: %q", document.DocumentID, document.DocumentText)
+				}
+			}
+
+			if strings.Join(uploadIDs, ",") != strings.Join(tt.expectedUpload, ",") {
+				t.Errorf("expected upload %v, got %v", tt.expectedUpload, uploadIDs)
+			}
+			if strings.Join(remove, ",") != strings.Join(tt.expectedRemove, ",") {
+				t.Errorf("expected remove %v, got %v", tt.expectedRemove, remove)
+			}
+		})
+	}
+}
+
+func TestRFC3339SemanticEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		prior    string
+		new      string
+		expected bool
+	}{
+		{
+			name:     "identical",
+			prior:    "2024-01-15T10:30:00Z",
+			new:      "2024-01-15T10:30:00Z",
+			expected: true,
+		},
+		{
+			name:     "zulu and numeric offset",
+			prior:    "2024-01-15T10:30:00Z",
+			new:      "2024-01-15T10:30:00+00:00",
+			expected: true,
+		},
+		{
+			name:     "same instant in another offset",
+			prior:    "2024-01-15T10:30:00Z",
+			new:      "2024-01-15T12:30:00+02:00",
+			expected: true,
+		},
+		{
+			name:     "fractional seconds",
+			prior:    "2024-01-15T10:30:00Z",
+			new:      "2024-01-15T10:30:00.000Z",
+			expected: true,
+		},
+		{
+			name:     "different instants",
+			prior:    "2024-01-15T10:30:00Z",
+			new:      "2024-01-15T10:30:01Z",
+			expected: false,
+		},
+		{
+			name:     "unparsable prior value",
+			prior:    "yesterday",
+			new:      "2024-01-15T10:30:00Z",
+			expected: false,
+		},
+		{
+			name:     "identical unparsable values",
+			prior:    "yesterday",
+			new:      "yesterday",
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prior := RFC3339Value{StringValue: types.StringValue(tt.prior)}
+			newValue := RFC3339Value{StringValue: types.StringValue(tt.new)}
+
+			equal, diags := prior.StringSemanticEquals(context.Background(), newValue)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, equal)
+			}
+		})
+	}
+}
+
+func TestURLSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		prior    string
+		new      string
+		expected bool
+	}{
+		{
+			name:     "identical",
+			prior:    "https://accounts.example.com/graphql",
+			new:      "https://accounts.example.com/graphql",
+			expected: true,
+		},
+		{
+			name:     "trailing slash",
+			prior:    "https://accounts.example.com/graphql/",
+			new:      "https://accounts.example.com/graphql",
+			expected: true,
+		},
+		{
+			name:     "root path",
+			prior:    "https://accounts.example.com",
+			new:      "https://accounts.example.com/",
+			expected: true,
+		},
+		{
+			name:     "scheme and host casing",
+			prior:    "HTTPS://Accounts.Example.com/graphql",
+			new:      "https://accounts.example.com/graphql",
+			expected: true,
+		},
+		{
+			name:     "default port",
+			prior:    "https://accounts.example.com:443/graphql",
+			new:      "https://accounts.example.com/graphql",
+			expected: true,
+		},
+		{
+			name:     "path casing",
+			prior:    "https://accounts.example.com/GraphQL",
+			new:      "https://accounts.example.com/graphql",
+			expected: false,
+		},
+		{
+			name:     "different port",
+			prior:    "http://accounts.internal:4000/graphql",
+			new:      "http://accounts.internal:4001/graphql",
+			expected: false,
+		},
+		{
+			name:     "unparsable prior value",
+			prior:    "https://%zz",
+			new:      "https://accounts.example.com/graphql",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prior := URLValue{StringValue: types.StringValue(tt.prior)}
+			newValue := URLValue{StringValue: types.StringValue(tt.new)}
+
+			equal, diags := prior.StringSemanticEquals(context.Background(), newValue)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, equal)
+			}
+		})
+	}
+}
+
+func TestClassifySchemaChange(t *testing.T) {
+	tests := []struct {
+		name            string
+		change          client.SchemaChange
+		expectedIsField bool
+		expectedAction  string
+	}{
+		{
+			name:           "type added",
+			change:         client.SchemaChange{Kind: "TYPE_ADDED", Path: "Review"},
+			expectedAction: "added",
+		},
+		{
+			name:           "type removed",
+			change:         client.SchemaChange{Kind: "TYPE_REMOVED", Path: "Legacy"},
+			expectedAction: "removed",
+		},
+		{
+			name:           "type kind changed",
+			change:         client.SchemaChange{Kind: "TYPE_KIND_CHANGED", Path: "Node"},
+			expectedAction: "changed",
+		},
+		{
+			name:            "field added",
+			change:          client.SchemaChange{Kind: "FIELD_ADDED", Path: "User.email"},
+			expectedIsField: true,
+			expectedAction:  "added",
+		},
+		{
+			name:            "field removed",
+			change:          client.SchemaChange{Kind: "FIELD_REMOVED", Path: "Query.me"},
+			expectedIsField: true,
+			expectedAction:  "removed",
+		},
+		{
+			name:            "argument added changes its field",
+			change:          client.SchemaChange{Kind: "ARGUMENT_ADDED", Path: "Query.user.id"},
+			expectedIsField: true,
+			expectedAction:  "changed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isField, action := classifySchemaChange(tt.change)
+			if isField != tt.expectedIsField || action != tt.expectedAction {
+				t.Errorf("expected (%t, %s), got (%t, %s)", tt.expectedIsField, tt.expectedAction, isField, action)
+			}
+		})
+	}
+}
+
+func TestSchemaChangeSummary(t *testing.T) {
+	if got := schemaChangeSummary(nil); got != "No schema changes." {
+		t.Errorf("unexpected summary without changes: %q", got)
+	}
+
+	got := schemaChangeSummary([]client.SchemaChange{
+		{Kind: "FIELD_ADDED", Path: "User.email", Message: "Field User.email was added"},
+		{Kind: "FIELD_REMOVED", Path: "Query.me", Message: "Field Query.me was removed", Breaking: true},
+	})
+	expected := "2 schema changes, 1 breaking:\n" +
+		"- **Breaking:** Field Query.me was removed\n" +
+		"- Field User.email was added\n"
+	if got != expected {
+		t.Errorf("expected summary %q, got %q", expected, got)
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	if got := userAgent("1.2.3", "1.9.0"); got != "terraform-provider-grafbase/1.2.3 terraform/1.9.0" {
+		t.Errorf("unexpected user agent %q", got)
+	}
+	if got := userAgent("dev", ""); got != "terraform-provider-grafbase/dev terraform/unknown" {
+		t.Errorf("unexpected user agent without a Terraform version %q", got)
+	}
+}
+
+// configureProvider configures the provider with the given attributes
+func configureProvider(t *testing.T, attributes map[string]attr.Value) *provider.ConfigureResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	p := New("test")()
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    nullObject(schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)),
+	}
+	for name, value := range attributes {
+		requireNoDiagnostics(t, plan.SetAttribute(ctx, path.Root(name), value))
+	}
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: planConfig(plan)}, resp)
+
+	return resp
+}
+
+func TestProviderValidateCredentials(t *testing.T) {
+	server := mockgraphql.NewServer(t)
+	attributes := map[string]attr.Value{
+		"api_key": types.StringValue("test-api-key"),
+		"api_url": types.StringValue(server.URL),
+	}
+
+	server.Handle("ValidateCredentials", `{"viewer": {"id": "user-1"}}`)
+	resp := configureProvider(t, attributes)
+	requireNoDiagnostics(t, resp.Diagnostics)
+	if resp.ResourceData == nil {
+		t.Error("expected the client to be passed to resources")
+	}
+
+	// A rejected key fails at configure time instead of during the first operation
+	server.Handle("ValidateCredentials", `{"viewer": null}`)
+	resp = configureProvider(t, attributes)
+	if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != "Invalid Grafbase credentials" {
+		t.Errorf("expected invalid credentials error, got %v", resp.Diagnostics)
+	}
+
+	// The check can be skipped, for example when planning offline
+	requests := len(server.Requests("ValidateCredentials"))
+	attributes["validate_credentials"] = types.BoolValue(false)
+	resp = configureProvider(t, attributes)
+	requireNoDiagnostics(t, resp.Diagnostics)
+	if got := len(server.Requests("ValidateCredentials")); got != requests {
+		t.Errorf("expected credentials not to be validated, got %d requests", got-requests)
+	}
+}
+
+func TestProviderDisableTelemetry(t *testing.T) {
+	server := mockgraphql.NewServer(t)
+	attributes := map[string]attr.Value{
+		"api_key": types.StringValue("test-api-key"),
+		"api_url": types.StringValue(server.URL),
+	}
+
+	server.Handle("ValidateCredentials", `{"viewer": {"id": "user-1"}}`)
+	requireNoDiagnostics(t, configureProvider(t, attributes).Diagnostics)
+	if got := server.LastRequest("ValidateCredentials").Header.Get("User-Agent"); got != "terraform-provider-grafbase/test terraform/unknown" {
+		t.Errorf("expected the versioned user agent, got %q", got)
+	}
+
+	attributes["disable_telemetry"] = types.BoolValue(true)
+	requireNoDiagnostics(t, configureProvider(t, attributes).Diagnostics)
+	if got := server.LastRequest("ValidateCredentials").Header.Get("User-Agent"); got != client.DefaultUserAgent {
+		t.Errorf("expected the user agent without versions, got %q", got)
+	}
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RegionsDataSource{}
+
+func NewRegionsDataSource() datasource.DataSource {
+	return &RegionsDataSource{}
+}
+
+// RegionsDataSource defines the data source implementation.
+type RegionsDataSource struct {
+	client client.API
+}
+
+// RegionsDataSourceModel describes the data source data model.
+type RegionsDataSourceModel struct {
+	ID      types.String  `tfsdk:"id"`
+	Codes   types.List    `tfsdk:"codes"`
+	Regions []RegionModel `tfsdk:"regions"`
+}
+
+// RegionModel describes a single gateway region.
+type RegionModel struct {
+	Code      types.String `tfsdk:"code"`
+	Name      types.String `tfsdk:"name"`
+	Continent types.String `tfsdk:"continent"`
+}
+
+func (d *RegionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_regions"
+}
+
+func (d *RegionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the regions managed gateways can run in.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Static identifier of the data source",
+				Computed:            true,
+			},
+			"codes": schema.ListAttribute{
+				MarkdownDescription: "Codes of the available regions, usable in the `regions` attribute of `grafbase_branch`",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"regions": schema.ListNestedAttribute{
+				MarkdownDescription: "Available regions",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							MarkdownDescription: "Region code, for example `us-east-1`",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Human readable name of the region",
+							Computed:            true,
+						},
+						"continent": schema.StringAttribute{
+							MarkdownDescription: "Continent the region is located in",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RegionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RegionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RegionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	regions, err := d.client.ListRegions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read regions: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("regions")
+
+	codes := make([]string, 0, len(regions))
+	data.Regions = make([]RegionModel, 0, len(regions))
+	for _, region := range regions {
+		codes = append(codes, region.Code)
+		data.Regions = append(data.Regions, RegionModel{
+			Code:      types.StringValue(region.Code),
+			Name:      types.StringValue(region.Name),
+			Continent: types.StringValue(region.Continent),
+		})
+	}
+
+	codesValue, diags := types.ListValueFrom(ctx, types.StringType, codes)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Codes = codesValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
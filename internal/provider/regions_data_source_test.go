@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRegionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegionsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafbase_regions.test", "id", "regions"),
+					resource.TestCheckResourceAttrSet("data.grafbase_regions.test", "codes.#"),
+					resource.TestCheckResourceAttrSet("data.grafbase_regions.test", "regions.0.code"),
+					resource.TestCheckResourceAttrSet("data.grafbase_regions.test", "regions.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRegionsDataSourceConfig() string {
+	return `
+data "grafbase_regions" "test" {}
+`
+}
+
+func TestRegionsDataSourceRead(t *testing.T) {
+	d, server := newMockDataSource(t, NewRegionsDataSource)
+
+	server.Handle("ListRegions", `{"gatewayRegions": [
+		{"code": "iad", "name": "Ashburn, Virginia", "continent": "North America"},
+		{"code": "fra", "name": "Frankfurt", "continent": "Europe"}
+	]}`)
+	state, diags := readDataSource(t, d, nil)
+	requireNoDiagnostics(t, diags)
+
+	var codes []string
+	requireNoDiagnostics(t, state.GetAttribute(context.Background(), path.Root("codes"), &codes))
+	if len(codes) != 2 || codes[0] != "iad" || codes[1] != "fra" {
+		t.Errorf("unexpected codes: %v", codes)
+	}
+}
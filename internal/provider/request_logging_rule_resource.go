@@ -0,0 +1,363 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RequestLoggingRuleResource{}
+var _ resource.ResourceWithImportState = &RequestLoggingRuleResource{}
+
+func NewRequestLoggingRuleResource() resource.Resource {
+	return &RequestLoggingRuleResource{}
+}
+
+// RequestLoggingRuleResource defines the resource implementation.
+type RequestLoggingRuleResource struct {
+	client client.API
+}
+
+// RequestLoggingRuleResourceModel describes the resource data model.
+type RequestLoggingRuleResourceModel struct {
+	ID                types.String  `tfsdk:"id"`
+	AccountSlug       types.String  `tfsdk:"account_slug"`
+	GraphSlug         types.String  `tfsdk:"graph_slug"`
+	BranchName        types.String  `tfsdk:"branch_name"`
+	Name              types.String  `tfsdk:"name"`
+	OperationNames    types.Set     `tfsdk:"operation_names"`
+	ClientNames       types.Set     `tfsdk:"client_names"`
+	SampleRate        types.Float64 `tfsdk:"sample_rate"`
+	RedactedHeaders   types.Set     `tfsdk:"redacted_headers"`
+	RedactedVariables types.Set     `tfsdk:"redacted_variables"`
+	Enabled           types.Bool    `tfsdk:"enabled"`
+}
+
+func (r *RequestLoggingRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_request_logging_rule"
+}
+
+func (r *RequestLoggingRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	nameSet := func(description string) schema.SetAttribute {
+		return schema.SetAttribute{
+			MarkdownDescription: description,
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Enables full request and response logging on a branch for matching operations and clients, " +
+			"with sampling and redaction, so debugging verbosity is controlled per environment.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Request logging rule identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch the rule applies to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the rule, shown in the dashboard",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"operation_names":    nameSet("Names of the operations to log. When unset, every operation is logged."),
+			"client_names":       nameSet("Names of the clients, from the `x-grafbase-client-name` header, whose requests are logged. When unset, requests of every client are logged."),
+			"redacted_headers":   nameSet("Request and response headers whose values are replaced in the logs, matched case-insensitively. The `Authorization` and `Cookie` headers are always redacted."),
+			"redacted_variables": nameSet("Names of the operation variables whose values are replaced in the logs, at any nesting depth"),
+			"sample_rate": schema.Float64Attribute{
+				MarkdownDescription: "Fraction of matching requests that are logged, between `0` and `1`. Defaults to `1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             float64default.StaticFloat64(1),
+				Validators: []validator.Float64{
+					float64validator.Between(0, 1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the rule is active. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *RequestLoggingRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RequestLoggingRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RequestLoggingRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateInput, diags := data.updateInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createInput := client.CreateRequestLoggingRuleInput{
+		AccountSlug:       data.AccountSlug.ValueString(),
+		GraphSlug:         data.GraphSlug.ValueString(),
+		BranchName:        data.BranchName.ValueString(),
+		Name:              updateInput.Name,
+		OperationNames:    updateInput.OperationNames,
+		ClientNames:       updateInput.ClientNames,
+		SampleRate:        updateInput.SampleRate,
+		RedactedHeaders:   updateInput.RedactedHeaders,
+		RedactedVariables: updateInput.RedactedVariables,
+		Enabled:           updateInput.Enabled,
+	}
+
+	rule, err := r.client.CreateRequestLoggingRule(ctx, createInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request logging rule: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(data.fromRule(ctx, rule)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitUntilCreated(ctx, "request logging rule", func(ctx context.Context) error {
+		_, err := r.client.GetRequestLoggingRule(ctx, data.ID.ValueString())
+		return err
+	}, "request logging rule not found")...)
+}
+
+func (r *RequestLoggingRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RequestLoggingRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.GetRequestLoggingRule(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the rule is not found, remove it from state
+		if err.Error() == "request logging rule not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read request logging rule: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	resp.Diagnostics.Append(data.fromRule(ctx, rule)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RequestLoggingRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RequestLoggingRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateInput, diags := data.updateInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.UpdateRequestLoggingRule(ctx, updateInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update request logging rule: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromRule(ctx, rule)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RequestLoggingRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RequestLoggingRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRequestLoggingRule(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the rule is already gone, there is nothing left to delete
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete request logging rule: %s", err))
+		return
+	}
+}
+
+func (r *RequestLoggingRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug/rule_id"
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/rule_id', got: %s", req.ID))
+		return
+	}
+
+	// Get the rule to populate the remaining attributes
+	rule, err := r.client.GetRequestLoggingRule(ctx, parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read request logging rule during import: %s", err))
+		return
+	}
+
+	data := RequestLoggingRuleResourceModel{
+		AccountSlug: types.StringValue(parts[0]),
+		GraphSlug:   types.StringValue(parts[1]),
+	}
+	resp.Diagnostics.Append(data.fromRule(ctx, rule)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// updateInput builds the client input replacing the rule settings. Unset
+// name sets are sent as empty lists, matching everything.
+func (m RequestLoggingRuleResourceModel) updateInput(ctx context.Context) (client.UpdateRequestLoggingRuleInput, diag.Diagnostics) {
+	input := client.UpdateRequestLoggingRuleInput{
+		ID:         m.ID.ValueString(),
+		Name:       m.Name.ValueString(),
+		SampleRate: m.SampleRate.ValueFloat64(),
+		Enabled:    m.Enabled.ValueBool(),
+	}
+
+	var diags diag.Diagnostics
+	for _, field := range []struct {
+		set    types.Set
+		target *[]string
+	}{
+		{m.OperationNames, &input.OperationNames},
+		{m.ClientNames, &input.ClientNames},
+		{m.RedactedHeaders, &input.RedactedHeaders},
+		{m.RedactedVariables, &input.RedactedVariables},
+	} {
+		if !field.set.IsNull() && !field.set.IsUnknown() {
+			diags.Append(field.set.ElementsAs(ctx, field.target, false)...)
+		}
+	}
+
+	return input, diags
+}
+
+// fromRule maps an API request logging rule onto the model. Empty name lists
+// are kept null, so omitting an attribute does not cause a diff.
+func (m *RequestLoggingRuleResourceModel) fromRule(ctx context.Context, rule *client.RequestLoggingRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(rule.ID)
+	m.BranchName = types.StringValue(rule.BranchName)
+	m.Name = types.StringValue(rule.Name)
+	m.SampleRate = types.Float64Value(rule.SampleRate)
+	m.Enabled = types.BoolValue(rule.Enabled)
+
+	for _, field := range []struct {
+		values []string
+		target *types.Set
+	}{
+		{rule.OperationNames, &m.OperationNames},
+		{rule.ClientNames, &m.ClientNames},
+		{rule.RedactedHeaders, &m.RedactedHeaders},
+		{rule.RedactedVariables, &m.RedactedVariables},
+	} {
+		if len(field.values) == 0 {
+			*field.target = types.SetNull(types.StringType)
+			continue
+		}
+
+		values, d := types.SetValueFrom(ctx, types.StringType, field.values)
+		diags.Append(d...)
+		*field.target = values
+	}
+
+	return diags
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccRequestLoggingRuleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRequestLoggingRuleResourceConfig(0.5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("grafbase_request_logging_rule.test", "id"),
+					resource.TestCheckResourceAttr("grafbase_request_logging_rule.test", "operation_names.#", "1"),
+					resource.TestCheckResourceAttr("grafbase_request_logging_rule.test", "sample_rate", "0.5"),
+					resource.TestCheckResourceAttr("grafbase_request_logging_rule.test", "enabled", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_request_logging_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["grafbase_request_logging_rule.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found: grafbase_request_logging_rule.test")
+					}
+					return fmt.Sprintf("test-account/test-graph/%s", rs.Primary.ID), nil
+				},
+			},
+			// Update in place
+			{
+				Config: testAccRequestLoggingRuleResourceConfig(0.1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_request_logging_rule.test", "sample_rate", "0.1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRequestLoggingRuleResourceConfig(sampleRate float64) string {
+	return fmt.Sprintf(`
+resource "grafbase_request_logging_rule" "test" {
+  account_slug       = "test-account"
+  graph_slug         = "test-graph"
+  branch_name        = "main"
+  name               = "debug checkout"
+  operation_names    = ["Checkout"]
+  sample_rate        = %[1]g
+  redacted_variables = ["cardNumber"]
+}
+`, sampleRate)
+}
+
+func testRequestLoggingRuleJSON(sampleRate float64, clientNames string) string {
+	return fmt.Sprintf(`{"id": "rule-1", "branchName": "main", "name": "debug checkout", "operationNames": ["Checkout"],
+		"clientNames": %s, "sampleRate": %g, "redactedHeaders": [], "redactedVariables": ["cardNumber"], "enabled": true}`, clientNames, sampleRate)
+}
+
+func TestRequestLoggingRuleResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewRequestLoggingRuleResource)
+
+	server.Handle("CreateRequestLoggingRule", `{"requestLoggingRuleCreate": {"__typename": "RequestLoggingRuleCreateSuccess",
+		"requestLoggingRule": `+testRequestLoggingRuleJSON(0.5, "[]")+`}}`)
+	server.Handle("GetRequestLoggingRule", `{"node": `+testRequestLoggingRuleJSON(0.5, "[]")+`}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug":       types.StringValue("my-account"),
+		"graph_slug":         types.StringValue("my-graph"),
+		"branch_name":        types.StringValue("main"),
+		"name":               types.StringValue("debug checkout"),
+		"operation_names":    types.SetValueMust(types.StringType, []attr.Value{types.StringValue("Checkout")}),
+		"redacted_variables": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("cardNumber")}),
+		"sample_rate":        types.Float64Value(0.5),
+		"enabled":            types.BoolValue(true),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "rule-1" {
+		t.Errorf("expected id rule-1, got %q", got)
+	}
+
+	// Unset name sets match everything and stay null in state
+	var data RequestLoggingRuleResourceModel
+	requireNoDiagnostics(t, state.Get(context.Background(), &data))
+	if !data.ClientNames.IsNull() || !data.RedactedHeaders.IsNull() {
+		t.Errorf("expected unset sets to be null, got %s and %s", data.ClientNames, data.RedactedHeaders)
+	}
+	var sent struct {
+		ClientNames []string `json:"clientNames"`
+	}
+	if err := server.LastRequest("CreateRequestLoggingRule").Input(&sent); err != nil {
+		t.Fatal(err)
+	}
+	if sent.ClientNames == nil || len(sent.ClientNames) != 0 {
+		t.Errorf("expected empty clientNames, got %v", sent.ClientNames)
+	}
+
+	server.Handle("GetRequestLoggingRule", `{"node": `+testRequestLoggingRuleJSON(0.5, "[]")+`}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+
+	server.Handle("UpdateRequestLoggingRule", `{"requestLoggingRuleUpdate": {"__typename": "RequestLoggingRuleUpdateSuccess",
+		"requestLoggingRule": `+testRequestLoggingRuleJSON(0.1, `["web"]`)+`}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"sample_rate":  types.Float64Value(0.1),
+		"client_names": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("web")}),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := server.LastRequest("UpdateRequestLoggingRule").Variables["input"].(map[string]interface{})["id"]; got != "rule-1" {
+		t.Errorf("expected rule-1 to be updated, got %v", got)
+	}
+
+	server.Handle("DeleteRequestLoggingRule", `{"requestLoggingRuleDelete": {"__typename": "RequestLoggingRuleDeleteSuccess"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	server.Handle("GetRequestLoggingRule", `{"node": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected request logging rule to be removed from state")
+	}
+}
+
+func TestRequestLoggingRuleResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewRequestLoggingRuleResource)
+
+	server.Handle("GetRequestLoggingRule", `{"node": `+testRequestLoggingRuleJSON(0.5, "[]")+`}`)
+	state, diags := importResource(t, r, "my-account/my-graph/rule-1")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "branch_name"); got != "main" {
+		t.Errorf("expected branch_name main, got %q", got)
+	}
+
+	if _, diags := importResource(t, r, "rule-1"); !diags.HasError() {
+		t.Error("expected invalid import ID to be an error")
+	}
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the custom types fully satisfy framework interfaces.
+var _ basetypes.StringTypable = RFC3339Type{}
+var _ basetypes.StringValuableWithSemanticEquals = RFC3339Value{}
+
+// RFC3339Type is a string type for RFC3339 timestamps. Values that denote the
+// same instant are semantically equal, so the API returning "Z" where
+// "+00:00" was stored, or a different fractional precision, does not cause a diff.
+type RFC3339Type struct {
+	basetypes.StringType
+}
+
+func (t RFC3339Type) String() string {
+	return "RFC3339Type"
+}
+
+func (t RFC3339Type) ValueType(ctx context.Context) attr.Value {
+	return RFC3339Value{}
+}
+
+func (t RFC3339Type) Equal(o attr.Type) bool {
+	other, ok := o.(RFC3339Type)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t RFC3339Type) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return RFC3339Value{StringValue: in}, nil
+}
+
+func (t RFC3339Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// RFC3339Value is a value of RFC3339Type.
+type RFC3339Value struct {
+	basetypes.StringValue
+}
+
+// NewRFC3339Null returns a null timestamp.
+func NewRFC3339Null() RFC3339Value {
+	return RFC3339Value{StringValue: basetypes.NewStringNull()}
+}
+
+// NewRFC3339Value returns a timestamp formatted as RFC3339.
+func NewRFC3339Value(t time.Time) RFC3339Value {
+	return RFC3339Value{StringValue: basetypes.NewStringValue(t.Format(time.RFC3339))}
+}
+
+// NewRFC3339PointerValue returns a timestamp formatted as RFC3339, or null when t is nil.
+func NewRFC3339PointerValue(t *time.Time) RFC3339Value {
+	if t == nil {
+		return NewRFC3339Null()
+	}
+
+	return NewRFC3339Value(*t)
+}
+
+func (v RFC3339Value) Type(ctx context.Context) attr.Type {
+	return RFC3339Type{}
+}
+
+func (v RFC3339Value) Equal(o attr.Value) bool {
+	other, ok := o.(RFC3339Value)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both timestamps denote the same instant.
+// Values that cannot be parsed are only equal if they are identical strings.
+func (v RFC3339Value) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(RFC3339Value)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	priorTime, err := time.Parse(time.RFC3339Nano, v.ValueString())
+	if err != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+
+	newTime, err := time.Parse(time.RFC3339Nano, newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return priorTime.Equal(newTime), diags
+}
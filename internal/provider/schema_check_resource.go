@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SchemaCheckResource{}
+
+func NewSchemaCheckResource() resource.Resource {
+	return &SchemaCheckResource{}
+}
+
+// SchemaCheckResource defines the resource implementation.
+type SchemaCheckResource struct {
+	client client.API
+}
+
+// SchemaCheckResourceModel describes the resource data model.
+type SchemaCheckResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	AccountSlug  types.String   `tfsdk:"account_slug"`
+	GraphSlug    types.String   `tfsdk:"graph_slug"`
+	BranchName   types.String   `tfsdk:"branch_name"`
+	SubgraphName types.String   `tfsdk:"subgraph_name"`
+	Schema       types.String   `tfsdk:"schema"`
+	ErrorCount   types.Int64    `tfsdk:"error_count"`
+	Warnings     types.List     `tfsdk:"warnings"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *SchemaCheckResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_check"
+}
+
+func (r *SchemaCheckResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Runs a Grafbase schema check for a subgraph schema. The apply fails with the reported " +
+			"validation, composition, operation, and lint errors if the schema would break the graph. " +
+			"Any change to the inputs runs a new check.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Schema check identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug to check the schema against",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch to check the schema against. Defaults to the production branch.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subgraph_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the subgraph the schema belongs to",
+				Required:            true,
+				Validators:          subgraphNameValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schema": schema.StringAttribute{
+				MarkdownDescription: "Subgraph schema SDL to check",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"error_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of errors reported by the schema check",
+				Computed:            true,
+			},
+			"warnings": schema.ListAttribute{
+				MarkdownDescription: "Warnings reported by the schema check",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *SchemaCheckResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SchemaCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SchemaCheckResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Run the schema check
+	checkInput := client.SchemaCheckInput{
+		AccountSlug:  data.AccountSlug.ValueString(),
+		GraphSlug:    data.GraphSlug.ValueString(),
+		Branch:       data.BranchName.ValueString(),
+		SubgraphName: data.SubgraphName.ValueString(),
+		Schema:       data.Schema.ValueString(),
+	}
+
+	check, err := r.client.CreateSchemaCheck(ctx, checkInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run schema check: %s", err))
+		return
+	}
+
+	// Surface every failing check as a diagnostic on the schema attribute
+	var warnings []string
+	addCheckErrors := func(step string, checkErrors []client.SchemaCheckError) {
+		for _, checkErr := range checkErrors {
+			summary := fmt.Sprintf("Schema %s Check Failed", step)
+			if checkErr.Title != "" {
+				summary = fmt.Sprintf("%s: %s", summary, checkErr.Title)
+			}
+
+			if checkErr.Severity == client.SchemaCheckSeverityWarning {
+				warnings = append(warnings, checkErr.Message)
+				resp.Diagnostics.AddAttributeWarning(path.Root("schema"), summary, checkErr.Message)
+				continue
+			}
+
+			resp.Diagnostics.AddAttributeError(path.Root("schema"), summary, checkErr.Message)
+		}
+	}
+
+	addCheckErrors("Validation", check.ValidationCheckErrors)
+	addCheckErrors("Composition", check.CompositionCheckErrors)
+	addCheckErrors("Operation", check.OperationCheckErrors)
+	addCheckErrors("Lint", check.LintCheckErrors)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.ID = types.StringValue(check.ID)
+	data.ErrorCount = types.Int64Value(int64(check.ErrorCount))
+
+	warningList, diags := types.ListValueFrom(ctx, types.StringType, warnings)
+	resp.Diagnostics.Append(diags...)
+	data.Warnings = warningList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SchemaCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SchemaCheckResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Schema checks are immutable records of a past run, so there is nothing
+	// to refresh. A new check only runs when the inputs change.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SchemaCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SchemaCheckResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All inputs have RequiresReplace plan modifiers, so a changed schema
+	// always runs a new check through Create
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"Schema check updates are not supported. Changes to any input run a new schema check.",
+	)
+}
+
+func (r *SchemaCheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Schema checks cannot be deleted through the API. Removing the resource
+	// only removes it from Terraform state.
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSchemaCheckResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Passing check
+			{
+				Config: testAccSchemaCheckResourceConfig("type Query { hello: String }"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_schema_check.test", "subgraph_name", "products"),
+					resource.TestCheckResourceAttr("grafbase_schema_check.test", "error_count", "0"),
+					resource.TestCheckResourceAttrSet("grafbase_schema_check.test", "id"),
+				),
+			},
+			// Failing check surfaces errors and fails the apply
+			{
+				Config:      testAccSchemaCheckResourceConfig("type Query { hello: UnknownType }"),
+				ExpectError: regexp.MustCompile(`Schema (Validation|Composition) Check Failed`),
+			},
+		},
+	})
+}
+
+func testAccSchemaCheckResourceConfig(sdl string) string {
+	return fmt.Sprintf(`
+resource "grafbase_graph" "test" {
+  account_slug = "test-account"
+  slug         = "test-graph-schema-check"
+}
+
+resource "grafbase_schema_check" "test" {
+  account_slug  = grafbase_graph.test.account_slug
+  graph_slug    = grafbase_graph.test.slug
+  subgraph_name = "products"
+  schema        = %[1]q
+}
+`, sdl)
+}
+
+func TestSchemaCheckResourceCreate(t *testing.T) {
+	r, server := newMockResource(t, NewSchemaCheckResource)
+	attributes := map[string]attr.Value{
+		"account_slug":  types.StringValue("my-account"),
+		"graph_slug":    types.StringValue("my-graph"),
+		"branch_name":   types.StringValue("main"),
+		"subgraph_name": types.StringValue("users"),
+		"schema":        types.StringValue("type Query { me: User }"),
+	}
+
+	server.Handle("CreateSchemaCheck", `{"schemaCheckCreate": {"__typename": "SchemaCheck", "id": "check-1", "errorCount": 0,
+		"lintCheckErrors": [{"message": "Field names should be camelCase", "severity": "WARNING"}]}}`)
+	state, diags := createResource(t, r, attributes)
+	requireNoDiagnostics(t, diags)
+	if diags.WarningsCount() != 1 {
+		t.Errorf("expected the lint warning as a diagnostic, got %v", diags)
+	}
+	if got := stateString(t, state, "id"); got != "check-1" {
+		t.Errorf("expected id check-1, got %q", got)
+	}
+
+	// Schema checks are not refreshed, so Read keeps the recorded run
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "check-1" {
+		t.Errorf("expected id check-1 after read, got %q", got)
+	}
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	server.Handle("CreateSchemaCheck", `{"schemaCheckCreate": {"__typename": "SchemaCheck", "id": "check-2", "errorCount": 1,
+		"compositionCheckErrors": [{"message": "Type User is not defined"}]}}`)
+	if _, diags := createResource(t, r, attributes); !diags.HasError() {
+		t.Error("expected failing composition check to be an error")
+	}
+}
@@ -0,0 +1,350 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SchemaContractResource{}
+var _ resource.ResourceWithImportState = &SchemaContractResource{}
+var _ resource.ResourceWithConfigValidators = &SchemaContractResource{}
+
+func NewSchemaContractResource() resource.Resource {
+	return &SchemaContractResource{}
+}
+
+// SchemaContractResource defines the resource implementation.
+type SchemaContractResource struct {
+	client client.API
+}
+
+// SchemaContractResourceModel describes the resource data model.
+type SchemaContractResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	AccountSlug          types.String `tfsdk:"account_slug"`
+	GraphSlug            types.String `tfsdk:"graph_slug"`
+	BranchName           types.String `tfsdk:"branch_name"`
+	Name                 types.String `tfsdk:"name"`
+	IncludeTags          types.Set    `tfsdk:"include_tags"`
+	ExcludeTags          types.Set    `tfsdk:"exclude_tags"`
+	HideUnreachableTypes types.Bool   `tfsdk:"hide_unreachable_types"`
+}
+
+func (r *SchemaContractResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_contract"
+}
+
+func (r *SchemaContractResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Defines a contract of a branch: a variant of the federated schema filtered by `@tag` directives, " +
+			"so that for example a public API and an internal API are served from the same subgraphs.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Schema contract identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch whose federated schema the contract filters",
+				Required:            true,
+				Validators:          branchNameValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Contract name, as referenced by the `contracts` of `grafbase_schema_tag`",
+				Required:            true,
+				Validators:          slugValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_tags": schema.SetAttribute{
+				MarkdownDescription: "Tags of the fields kept in the contract. When unset, every field is kept unless excluded.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(schemaTagNameValidators()...),
+				},
+			},
+			"exclude_tags": schema.SetAttribute{
+				MarkdownDescription: "Tags of the fields removed from the contract. Exclusion wins over inclusion.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(schemaTagNameValidators()...),
+				},
+			},
+			"hide_unreachable_types": schema.BoolAttribute{
+				MarkdownDescription: "Whether types no longer reachable from a root type after filtering are removed. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *SchemaContractResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		// A contract without filters would only duplicate the federated schema
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("include_tags"),
+			path.MatchRoot("exclude_tags"),
+		),
+	}
+}
+
+func (r *SchemaContractResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SchemaContractResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SchemaContractResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := data.setInput(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contract, err := r.client.SetSchemaContract(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create schema contract: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(data.fromSchemaContract(ctx, contract)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitUntilCreated(ctx, "schema contract", func(ctx context.Context) error {
+		_, err := r.client.GetSchemaContract(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString(), data.Name.ValueString())
+		return err
+	}, "schema contract not found")...)
+}
+
+func (r *SchemaContractResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SchemaContractResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contract, err := r.client.GetSchemaContract(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// If the contract is not found, remove it from state
+		if err.Error() == "schema contract not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema contract: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	resp.Diagnostics.Append(data.fromSchemaContract(ctx, contract)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SchemaContractResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SchemaContractResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := data.setInput(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Setting a contract replaces its filters
+	contract, err := r.client.SetSchemaContract(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update schema contract: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromSchemaContract(ctx, contract)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SchemaContractResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SchemaContractResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteInput := client.DeleteSchemaContractInput{
+		AccountSlug: data.AccountSlug.ValueString(),
+		GraphSlug:   data.GraphSlug.ValueString(),
+		BranchName:  data.BranchName.ValueString(),
+		Name:        data.Name.ValueString(),
+	}
+
+	err := r.client.DeleteSchemaContract(ctx, deleteInput)
+	if err != nil {
+		// If the contract doesn't exist, consider it already deleted
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete schema contract: %s", err))
+		return
+	}
+}
+
+func (r *SchemaContractResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug/branch_name/name". Branch
+	// names may contain slashes, so the contract name is the last segment.
+	parts := strings.Split(req.ID, "/")
+	if len(parts) < 4 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/branch_name/name', got: %s", req.ID))
+		return
+	}
+	accountSlug, graphSlug := parts[0], parts[1]
+	branchName := strings.Join(parts[2:len(parts)-1], "/")
+	name := parts[len(parts)-1]
+
+	contract, err := r.client.GetSchemaContract(ctx, accountSlug, graphSlug, branchName, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read schema contract during import: %s", err))
+		return
+	}
+
+	data := SchemaContractResourceModel{
+		AccountSlug: types.StringValue(accountSlug),
+		GraphSlug:   types.StringValue(graphSlug),
+		BranchName:  types.StringValue(branchName),
+		IncludeTags: types.SetNull(types.StringType),
+		ExcludeTags: types.SetNull(types.StringType),
+	}
+	resp.Diagnostics.Append(data.fromSchemaContract(ctx, contract)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setInput builds the client input for creating or replacing the contract.
+func (m SchemaContractResourceModel) setInput(ctx context.Context) (client.SetSchemaContractInput, diag.Diagnostics) {
+	input := client.SetSchemaContractInput{
+		AccountSlug:          m.AccountSlug.ValueString(),
+		GraphSlug:            m.GraphSlug.ValueString(),
+		BranchName:           m.BranchName.ValueString(),
+		Name:                 m.Name.ValueString(),
+		HideUnreachableTypes: m.HideUnreachableTypes.ValueBool(),
+	}
+
+	var diags diag.Diagnostics
+	if !m.IncludeTags.IsNull() && !m.IncludeTags.IsUnknown() {
+		diags.Append(m.IncludeTags.ElementsAs(ctx, &input.IncludeTags, false)...)
+	}
+	if !m.ExcludeTags.IsNull() && !m.ExcludeTags.IsUnknown() {
+		diags.Append(m.ExcludeTags.ElementsAs(ctx, &input.ExcludeTags, false)...)
+	}
+
+	return input, diags
+}
+
+// fromSchemaContract maps an API schema contract onto the model. Empty tag
+// sets are kept null when they were not configured, so omitting one does not
+// cause a diff.
+func (m *SchemaContractResourceModel) fromSchemaContract(ctx context.Context, contract *client.SchemaContract) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(contract.ID)
+	m.Name = types.StringValue(contract.Name)
+	m.HideUnreachableTypes = types.BoolValue(contract.HideUnreachableTypes)
+
+	if len(contract.IncludeTags) > 0 || !m.IncludeTags.IsNull() {
+		includeTags, d := types.SetValueFrom(ctx, types.StringType, contract.IncludeTags)
+		diags.Append(d...)
+		m.IncludeTags = includeTags
+	}
+	if len(contract.ExcludeTags) > 0 || !m.ExcludeTags.IsNull() {
+		excludeTags, d := types.SetValueFrom(ctx, types.StringType, contract.ExcludeTags)
+		diags.Append(d...)
+		m.ExcludeTags = excludeTags
+	}
+
+	return diags
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSchemaContractResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSchemaContractResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("grafbase_schema_contract.test", "id"),
+					resource.TestCheckResourceAttr("grafbase_schema_contract.test", "include_tags.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_schema_contract.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-account/test-graph/main/terraform-acc",
+			},
+			// Update in place
+			{
+				Config: testAccSchemaContractResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_schema_contract.test", "hide_unreachable_types", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSchemaContractResourceConfig(hideUnreachableTypes bool) string {
+	return fmt.Sprintf(`
+resource "grafbase_schema_contract" "test" {
+  account_slug           = "test-account"
+  graph_slug             = "test-graph"
+  branch_name            = "main"
+  name                   = "terraform-acc"
+  include_tags           = ["public"]
+  hide_unreachable_types = %[1]t
+}
+`, hideUnreachableTypes)
+}
+
+func TestSchemaContractResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewSchemaContractResource)
+
+	server.Handle("SetSchemaContract", `{"schemaContractSet": {"__typename": "SchemaContractSetSuccess",
+		"schemaContract": {"id": "contract-1", "name": "public-api", "includeTags": ["public"], "excludeTags": [], "hideUnreachableTypes": false}}}`)
+	server.Handle("GetSchemaContract", `{"branch": {"schemaContract": {"id": "contract-1", "name": "public-api", "includeTags": ["public"], "excludeTags": [], "hideUnreachableTypes": false}}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("my-graph"),
+		"branch_name":  types.StringValue("main"),
+		"name":         types.StringValue("public-api"),
+		"include_tags": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("public")}),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "contract-1" {
+		t.Errorf("expected id contract-1, got %q", got)
+	}
+
+	// The unconfigured exclude_tags stays null even though the API returns an empty list
+	server.Handle("GetSchemaContract", `{"branch": {"schemaContract": {"id": "contract-1", "name": "public-api", "includeTags": ["public"], "excludeTags": [], "hideUnreachableTypes": false}}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	var data SchemaContractResourceModel
+	requireNoDiagnostics(t, state.Get(t.Context(), &data))
+	if !data.ExcludeTags.IsNull() {
+		t.Errorf("expected exclude_tags to stay null, got %v", data.ExcludeTags)
+	}
+
+	server.Handle("SetSchemaContract", `{"schemaContractSet": {"__typename": "SchemaContractSetSuccess",
+		"schemaContract": {"id": "contract-1", "name": "public-api", "includeTags": ["public"], "excludeTags": ["internal"], "hideUnreachableTypes": true}}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"exclude_tags":           types.SetValueMust(types.StringType, []attr.Value{types.StringValue("internal")}),
+		"hide_unreachable_types": types.BoolValue(true),
+	})
+	requireNoDiagnostics(t, diags)
+	var input map[string]interface{}
+	if err := server.LastRequest("SetSchemaContract").Input(&input); err != nil {
+		t.Fatalf("failed to decode input: %v", err)
+	}
+	if input["hideUnreachableTypes"] != true || len(input["excludeTags"].([]interface{})) != 1 {
+		t.Errorf("unexpected update input: %v", input)
+	}
+
+	server.Handle("DeleteSchemaContract", `{"schemaContractDelete": {"__typename": "SchemaContractDoesNotExistError"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	server.Handle("GetSchemaContract", `{"branch": {"schemaContract": null}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected schema contract to be removed from state")
+	}
+}
+
+func TestSchemaContractResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewSchemaContractResource)
+
+	server.Handle("GetSchemaContract", `{"branch": {"schemaContract": {"id": "contract-1", "name": "internal-api", "includeTags": [], "excludeTags": ["deprecated"], "hideUnreachableTypes": false}}}`)
+	state, diags := importResource(t, r, "my-account/my-graph/feature/contracts/internal-api")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "branch_name"); got != "feature/contracts" {
+		t.Errorf("expected branch_name feature/contracts, got %q", got)
+	}
+	if got := stateString(t, state, "name"); got != "internal-api" {
+		t.Errorf("expected name internal-api, got %q", got)
+	}
+
+	if _, diags := importResource(t, r, "my-account/my-graph/internal-api"); !diags.HasError() {
+		t.Error("expected invalid import ID to be an error")
+	}
+}
+
+func TestSchemaContractResourceConfigValidators(t *testing.T) {
+	r, _ := newMockResource(t, NewSchemaContractResource)
+	tags := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("public")})
+
+	tests := []struct {
+		name        string
+		includeTags types.Set
+		excludeTags types.Set
+		valid       bool
+	}{
+		{name: "include", includeTags: tags, excludeTags: types.SetNull(types.StringType), valid: true},
+		{name: "exclude", includeTags: types.SetNull(types.StringType), excludeTags: tags, valid: true},
+		{name: "no filter", includeTags: types.SetNull(types.StringType), excludeTags: types.SetNull(types.StringType), valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateResourceConfig(t, r, map[string]attr.Value{
+				"account_slug": types.StringValue("my-account"),
+				"graph_slug":   types.StringValue("my-graph"),
+				"branch_name":  types.StringValue("main"),
+				"name":         types.StringValue("public-api"),
+				"include_tags": tt.includeTags,
+				"exclude_tags": tt.excludeTags,
+			})
+
+			if tt.valid && diags.HasError() {
+				t.Errorf("expected configuration to be valid, got: %v", diags)
+			}
+			if !tt.valid && !diags.HasError() {
+				t.Error("expected configuration to be invalid")
+			}
+		})
+	}
+}
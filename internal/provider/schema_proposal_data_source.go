@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SchemaProposalDataSource{}
+
+func NewSchemaProposalDataSource() datasource.DataSource {
+	return &SchemaProposalDataSource{}
+}
+
+// SchemaProposalDataSource defines the data source implementation.
+type SchemaProposalDataSource struct {
+	client client.API
+}
+
+// SchemaProposalDataSourceModel describes the data source data model.
+type SchemaProposalDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	AccountSlug  types.String `tfsdk:"account_slug"`
+	GraphSlug    types.String `tfsdk:"graph_slug"`
+	BranchName   types.String `tfsdk:"branch_name"`
+	SubgraphName types.String `tfsdk:"subgraph_name"`
+	Title        types.String `tfsdk:"title"`
+	Status       types.String `tfsdk:"status"`
+	Approved     types.Bool   `tfsdk:"approved"`
+	CreatedAt    RFC3339Value `tfsdk:"created_at"`
+}
+
+func (d *SchemaProposalDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_proposal"
+}
+
+func (d *SchemaProposalDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Fetches the review status of a schema proposal, for example to gate publishing on an approved proposal.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Schema proposal identifier",
+				Required:            true,
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Computed:            true,
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Computed:            true,
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch the proposed schema would be published to",
+				Computed:            true,
+			},
+			"subgraph_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the subgraph whose schema is proposed",
+				Computed:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title shown to reviewers",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Review status of the proposal: `OPEN`, `APPROVED`, `REJECTED`, `IMPLEMENTED`, or `CLOSED`",
+				Computed:            true,
+			},
+			"approved": schema.BoolAttribute{
+				MarkdownDescription: "Whether the proposal was approved, including approved proposals that were since implemented",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "Timestamp when the proposal was opened",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SchemaProposalDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SchemaProposalDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SchemaProposalDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	proposal, err := d.client.GetSchemaProposal(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema proposal: %s", err))
+		return
+	}
+
+	data.AccountSlug = types.StringValue(proposal.Branch.Graph.Account.Slug)
+	data.GraphSlug = types.StringValue(proposal.Branch.Graph.Slug)
+	data.BranchName = types.StringValue(proposal.Branch.Name)
+	data.SubgraphName = types.StringValue(proposal.SubgraphName)
+	data.Title = types.StringValue(proposal.Title)
+	data.Status = types.StringValue(string(proposal.Status))
+	data.Approved = types.BoolValue(proposal.Status == client.SchemaProposalStatusApproved || proposal.Status == client.SchemaProposalStatusImplemented)
+	data.CreatedAt = NewRFC3339Value(proposal.CreatedAt)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
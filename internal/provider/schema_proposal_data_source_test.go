@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSchemaProposalDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaProposalResourceConfig("Add reviews") + `
+data "grafbase_schema_proposal" "test" {
+  id = grafbase_schema_proposal.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafbase_schema_proposal.test", "status", "OPEN"),
+					resource.TestCheckResourceAttr("data.grafbase_schema_proposal.test", "approved", "false"),
+					resource.TestCheckResourceAttr("data.grafbase_schema_proposal.test", "subgraph_name", "reviews"),
+				),
+			},
+		},
+	})
+}
+
+func TestSchemaProposalDataSourceRead(t *testing.T) {
+	d, server := newMockDataSource(t, NewSchemaProposalDataSource)
+
+	for status, approved := range map[string]bool{"OPEN": false, "APPROVED": true, "IMPLEMENTED": true, "REJECTED": false} {
+		server.Handle("GetSchemaProposal", `{"node": `+testSchemaProposalJSON("Add reviews", status)+`}`)
+		state, diags := readDataSource(t, d, map[string]attr.Value{
+			"id": types.StringValue("proposal-1"),
+		})
+		requireNoDiagnostics(t, diags)
+
+		var got types.Bool
+		requireNoDiagnostics(t, state.GetAttribute(context.Background(), path.Root("approved"), &got))
+		if got.ValueBool() != approved {
+			t.Errorf("expected approved %t for status %s, got %t", approved, status, got.ValueBool())
+		}
+		if got := stateString(t, state, "graph_slug"); got != "my-graph" {
+			t.Errorf("expected graph slug my-graph, got %q", got)
+		}
+	}
+
+	server.Handle("GetSchemaProposal", `{"node": null}`)
+	if _, diags := readDataSource(t, d, map[string]attr.Value{
+		"id": types.StringValue("missing"),
+	}); !diags.HasError() {
+		t.Error("expected an error for a missing proposal")
+	}
+}
@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SchemaProposalResource{}
+var _ resource.ResourceWithImportState = &SchemaProposalResource{}
+
+func NewSchemaProposalResource() resource.Resource {
+	return &SchemaProposalResource{}
+}
+
+// SchemaProposalResource defines the resource implementation.
+type SchemaProposalResource struct {
+	client client.API
+}
+
+// SchemaProposalResourceModel describes the resource data model.
+type SchemaProposalResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	AccountSlug  types.String `tfsdk:"account_slug"`
+	GraphSlug    types.String `tfsdk:"graph_slug"`
+	BranchName   types.String `tfsdk:"branch_name"`
+	SubgraphName types.String `tfsdk:"subgraph_name"`
+	Title        types.String `tfsdk:"title"`
+	Description  types.String `tfsdk:"description"`
+	Schema       types.String `tfsdk:"schema"`
+	Status       types.String `tfsdk:"status"`
+	CreatedAt    RFC3339Value `tfsdk:"created_at"`
+}
+
+func (r *SchemaProposalResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_proposal"
+}
+
+func (r *SchemaProposalResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Opens a schema proposal so a subgraph schema change can be reviewed before it is published to a branch. " +
+			"Destroying the resource closes the proposal if it is still open.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Schema proposal identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch the proposed schema would be published to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subgraph_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the subgraph whose schema is proposed",
+				Required:            true,
+				Validators:          subgraphNameValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title shown to reviewers",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the proposed change",
+				Optional:            true,
+			},
+			"schema": schema.StringAttribute{
+				MarkdownDescription: "Proposed subgraph schema SDL",
+				Required:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Review status of the proposal: `OPEN`, `APPROVED`, `REJECTED`, `IMPLEMENTED`, or `CLOSED`",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "Timestamp when the proposal was opened",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SchemaProposalResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SchemaProposalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SchemaProposalResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createInput := client.CreateSchemaProposalInput{
+		AccountSlug:  data.AccountSlug.ValueString(),
+		GraphSlug:    data.GraphSlug.ValueString(),
+		BranchName:   data.BranchName.ValueString(),
+		Title:        data.Title.ValueString(),
+		Description:  data.Description.ValueStringPointer(),
+		SubgraphName: data.SubgraphName.ValueString(),
+		Schema:       data.Schema.ValueString(),
+	}
+
+	proposal, err := r.client.CreateSchemaProposal(ctx, createInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create schema proposal: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.fromProposal(proposal)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitUntilCreated(ctx, "schema proposal", func(ctx context.Context) error {
+		_, err := r.client.GetSchemaProposal(ctx, data.ID.ValueString())
+		return err
+	}, "schema proposal not found")...)
+}
+
+func (r *SchemaProposalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SchemaProposalResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	proposal, err := r.client.GetSchemaProposal(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the proposal is not found, remove it from state
+		if err.Error() == "schema proposal not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema proposal: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	data.fromProposal(proposal)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SchemaProposalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SchemaProposalResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Revising an open proposal keeps its review history
+	updateInput := client.UpdateSchemaProposalInput{
+		ID:          data.ID.ValueString(),
+		Title:       data.Title.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		Schema:      data.Schema.ValueString(),
+	}
+
+	proposal, err := r.client.UpdateSchemaProposal(ctx, updateInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update schema proposal: %s", err))
+		return
+	}
+
+	data.fromProposal(proposal)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SchemaProposalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SchemaProposalResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CloseSchemaProposal(ctx, data.ID.ValueString())
+	if err != nil {
+		// Missing proposals are already gone, and reviewed proposals are kept as history
+		if strings.Contains(err.Error(), "does not exist") || strings.Contains(err.Error(), "no longer open") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to close schema proposal: %s", err))
+		return
+	}
+}
+
+func (r *SchemaProposalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by proposal ID
+	proposal, err := r.client.GetSchemaProposal(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read schema proposal during import: %s", err))
+		return
+	}
+
+	var data SchemaProposalResourceModel
+	data.fromProposal(proposal)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fromProposal populates the model from an API schema proposal.
+func (m *SchemaProposalResourceModel) fromProposal(proposal *client.SchemaProposal) {
+	m.ID = types.StringValue(proposal.ID)
+	m.AccountSlug = types.StringValue(proposal.Branch.Graph.Account.Slug)
+	m.GraphSlug = types.StringValue(proposal.Branch.Graph.Slug)
+	m.BranchName = types.StringValue(proposal.Branch.Name)
+	m.SubgraphName = types.StringValue(proposal.SubgraphName)
+	m.Title = types.StringValue(proposal.Title)
+	m.Description = types.StringPointerValue(proposal.Description)
+	m.Schema = types.StringValue(proposal.Schema)
+	m.Status = types.StringValue(string(proposal.Status))
+	m.CreatedAt = NewRFC3339Value(proposal.CreatedAt)
+}
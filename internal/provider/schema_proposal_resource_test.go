@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSchemaProposalResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSchemaProposalResourceConfig("Add reviews"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_schema_proposal.test", "title", "Add reviews"),
+					resource.TestCheckResourceAttr("grafbase_schema_proposal.test", "status", "OPEN"),
+					resource.TestCheckResourceAttrSet("grafbase_schema_proposal.test", "id"),
+					resource.TestCheckResourceAttrSet("grafbase_schema_proposal.test", "created_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_schema_proposal.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update in place
+			{
+				Config: testAccSchemaProposalResourceConfig("Add reviews and ratings"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_schema_proposal.test", "title", "Add reviews and ratings"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSchemaProposalResourceConfig(title string) string {
+	return fmt.Sprintf(`
+resource "grafbase_graph" "test" {
+  account_slug = "test-account"
+  slug         = "test-graph"
+}
+
+resource "grafbase_schema_proposal" "test" {
+  account_slug  = grafbase_graph.test.account_slug
+  graph_slug    = grafbase_graph.test.slug
+  branch_name   = "main"
+  subgraph_name = "reviews"
+  title         = %[1]q
+  schema        = "type Query { reviews: [String] }"
+}
+`, title)
+}
+
+// testSchemaProposalJSON returns a schema proposal API object with the given title and status
+func testSchemaProposalJSON(title, status string) string {
+	return fmt.Sprintf(`{"id": "proposal-1", "title": %q, "description": null, "status": %q,
+		"branch": {"name": "main", "graph": {"slug": "my-graph", "account": {"slug": "my-account"}}},
+		"subgraphName": "reviews", "schema": "type Query { reviews: [String] }", "createdAt": "2024-01-15T10:30:00Z"}`, title, status)
+}
+
+func TestSchemaProposalResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewSchemaProposalResource)
+
+	server.Handle("CreateSchemaProposal", `{"schemaProposalCreate": {"__typename": "SchemaProposalCreateSuccess", "schemaProposal": `+testSchemaProposalJSON("Add reviews", "OPEN")+`}}`)
+	server.Handle("GetSchemaProposal", `{"node": `+testSchemaProposalJSON("Add reviews", "APPROVED")+`}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug":  types.StringValue("my-account"),
+		"graph_slug":    types.StringValue("my-graph"),
+		"branch_name":   types.StringValue("main"),
+		"subgraph_name": types.StringValue("reviews"),
+		"title":         types.StringValue("Add reviews"),
+		"schema":        types.StringValue("type Query { reviews: [String] }"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "status"); got != "OPEN" {
+		t.Errorf("expected status OPEN, got %q", got)
+	}
+
+	// Approval happens outside of Terraform and is picked up on refresh
+	server.Handle("GetSchemaProposal", `{"node": `+testSchemaProposalJSON("Add reviews", "APPROVED")+`}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "status"); got != "APPROVED" {
+		t.Errorf("expected status APPROVED, got %q", got)
+	}
+
+	server.Handle("UpdateSchemaProposal", `{"schemaProposalUpdate": {"__typename": "SchemaProposalUpdateSuccess", "schemaProposal": `+testSchemaProposalJSON("Add reviews and ratings", "OPEN")+`}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"title": types.StringValue("Add reviews and ratings"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := server.LastRequest("UpdateSchemaProposal").Variables["input"].(map[string]interface{})["id"]; got != "proposal-1" {
+		t.Errorf("expected proposal-1 to be updated, got %v", got)
+	}
+
+	// Proposals that were already reviewed are kept when the resource is destroyed
+	server.Handle("CloseSchemaProposal", `{"schemaProposalClose": {"__typename": "SchemaProposalNotOpenError"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	server.Handle("GetSchemaProposal", `{"node": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected schema proposal to be removed from state")
+	}
+}
+
+func TestSchemaProposalResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewSchemaProposalResource)
+
+	server.Handle("GetSchemaProposal", `{"node": `+testSchemaProposalJSON("Add reviews", "OPEN")+`}`)
+	state, diags := importResource(t, r, "proposal-1")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "account_slug"); got != "my-account" {
+		t.Errorf("expected account slug my-account, got %q", got)
+	}
+	if got := stateString(t, state, "branch_name"); got != "main" {
+		t.Errorf("expected branch main, got %q", got)
+	}
+}
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SchemaPublishResource{}
+
+// schemaPublishInputAttributes maps the fields of the publish mutation input
+// to the attributes they are configured by.
+var schemaPublishInputAttributes = map[string]path.Path{
+	"accountSlug": path.Root("account_slug"),
+	"graphSlug":   path.Root("graph_slug"),
+	"branchName":  path.Root("branch_name"),
+}
+
+func NewSchemaPublishResource() resource.Resource {
+	return &SchemaPublishResource{}
+}
+
+// SchemaPublishResource defines the resource implementation.
+type SchemaPublishResource struct {
+	client client.API
+}
+
+// SchemaPublishResourceModel describes the resource data model.
+type SchemaPublishResourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	AccountSlug     types.String   `tfsdk:"account_slug"`
+	GraphSlug       types.String   `tfsdk:"graph_slug"`
+	BranchName      types.String   `tfsdk:"branch_name"`
+	SubgraphName    types.String   `tfsdk:"subgraph_name"`
+	URL             types.String   `tfsdk:"url"`
+	Schema          types.String   `tfsdk:"schema"`
+	Message         types.String   `tfsdk:"message"`
+	Triggers        types.Map      `tfsdk:"triggers"`
+	SchemaVersionID types.String   `tfsdk:"schema_version_id"`
+	PublishedAt     RFC3339Value   `tfsdk:"published_at"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *SchemaPublishResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_publish"
+}
+
+func (r *SchemaPublishResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Publishes a subgraph schema to a branch and records the resulting schema version. " +
+			"Any change to the inputs, including `triggers`, publishes again. Destroying the resource keeps the published schema.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier, equal to `schema_version_id`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug to publish the schema to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch to publish the schema to",
+				Required:            true,
+				Validators:          branchNameValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subgraph_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the subgraph the schema belongs to",
+				Required:            true,
+				Validators:          subgraphNameValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL the gateway sends subgraph requests to. When unset, the URL of the previous publish is kept.",
+				Optional:            true,
+				Validators: []validator.String{
+					httpURLValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schema": schema.StringAttribute{
+				MarkdownDescription: "Subgraph schema SDL to publish",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "Message recorded with the publish, such as the commit or deploy that produced the schema",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that publish the schema again when changed, for example a deploy ID. " +
+					"The values are not sent to Grafbase.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"schema_version_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the schema version created by the publish",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"published_at": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "Timestamp of the publish",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *SchemaPublishResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SchemaPublishResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SchemaPublishResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	publishInput := client.PublishSubgraphInput{
+		AccountSlug:  data.AccountSlug.ValueString(),
+		GraphSlug:    data.GraphSlug.ValueString(),
+		BranchName:   data.BranchName.ValueString(),
+		SubgraphName: data.SubgraphName.ValueString(),
+		URL:          data.URL.ValueStringPointer(),
+		Schema:       data.Schema.ValueString(),
+		Message:      data.Message.ValueStringPointer(),
+	}
+
+	version, err := r.client.PublishSubgraph(ctx, publishInput)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "publish subgraph schema", err, schemaPublishInputAttributes)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.ID = types.StringValue(version.ID)
+	data.SchemaVersionID = types.StringValue(version.ID)
+	data.PublishedAt = NewRFC3339Value(version.CreatedAt)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SchemaPublishResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SchemaPublishResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Publishes are immutable records of a past schema version, so there is
+	// nothing to refresh. A new publish only happens when the inputs change.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SchemaPublishResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SchemaPublishResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All inputs have RequiresReplace plan modifiers, so a changed schema
+	// always publishes again through Create
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"Schema publish updates are not supported. Changes to any input publish the schema again.",
+	)
+}
+
+func (r *SchemaPublishResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Publishes cannot be undone. Removing the resource only removes it from
+	// Terraform state and keeps the subgraph published; use a later publish
+	// or delete the subgraph to change what the branch serves.
+}
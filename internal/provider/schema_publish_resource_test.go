@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccSchemaPublishResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSchemaPublishResourceConfig("deploy-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_schema_publish.test", "subgraph_name", "products"),
+					resource.TestCheckResourceAttrSet("grafbase_schema_publish.test", "schema_version_id"),
+					resource.TestCheckResourceAttrSet("grafbase_schema_publish.test", "published_at"),
+				),
+			},
+			// Changing a trigger publishes again
+			{
+				Config: testAccSchemaPublishResourceConfig("deploy-2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("grafbase_schema_publish.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccSchemaPublishResourceConfig(deploy string) string {
+	return fmt.Sprintf(`
+resource "grafbase_graph" "test" {
+  account_slug = "test-account"
+  slug         = "test-graph-schema-publish"
+}
+
+resource "grafbase_branch" "test" {
+  account_slug = grafbase_graph.test.account_slug
+  graph_slug   = grafbase_graph.test.slug
+  name         = "staging"
+}
+
+resource "grafbase_schema_publish" "test" {
+  account_slug  = grafbase_graph.test.account_slug
+  graph_slug    = grafbase_graph.test.slug
+  branch_name   = grafbase_branch.test.name
+  subgraph_name = "products"
+  url           = "https://products.example.com/graphql"
+  schema        = "type Query { products: [String] }"
+  message       = %[1]q
+
+  triggers = {
+    deploy = %[1]q
+  }
+}
+`, deploy)
+}
+
+func TestSchemaPublishResourceCreate(t *testing.T) {
+	r, server := newMockResource(t, NewSchemaPublishResource)
+
+	server.Handle("PublishSubgraph", `{"publish": {"__typename": "PublishSuccess", "schemaVersion": {"id": "version-1", "createdAt": "2024-01-15T10:30:00Z"}}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug":  types.StringValue("my-account"),
+		"graph_slug":    types.StringValue("my-graph"),
+		"branch_name":   types.StringValue("staging"),
+		"subgraph_name": types.StringValue("products"),
+		"url":           types.StringValue("https://products.example.com/graphql"),
+		"schema":        types.StringValue("type Query { products: [String] }"),
+		"message":       types.StringValue("deploy 42"),
+		"triggers":      types.MapValueMust(types.StringType, map[string]attr.Value{"deploy": types.StringValue("42")}),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "schema_version_id"); got != "version-1" {
+		t.Errorf("expected schema version version-1, got %q", got)
+	}
+	if got := stateString(t, state, "published_at"); got != "2024-01-15T10:30:00Z" {
+		t.Errorf("expected published_at of the schema version, got %q", got)
+	}
+
+	sent := server.LastRequest("PublishSubgraph").Variables["input"].(map[string]interface{})
+	if sent["branchName"] != "staging" || sent["url"] != "https://products.example.com/graphql" || sent["message"] != "deploy 42" {
+		t.Errorf("unexpected input: %v", sent)
+	}
+	if _, ok := sent["triggers"]; ok {
+		t.Errorf("expected triggers not to be sent, got %v", sent)
+	}
+
+	// Publishes are not refreshed, so Read keeps the recorded version
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "schema_version_id"); got != "version-1" {
+		t.Errorf("expected schema version version-1 after read, got %q", got)
+	}
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+	if got := len(server.Requests("PublishSubgraph")); got != 1 {
+		t.Errorf("expected a single publish, got %d", got)
+	}
+}
+
+func TestSchemaPublishResourceCreateErrors(t *testing.T) {
+	r, server := newMockResource(t, NewSchemaPublishResource)
+	attributes := map[string]attr.Value{
+		"account_slug":  types.StringValue("my-account"),
+		"graph_slug":    types.StringValue("my-graph"),
+		"branch_name":   types.StringValue("missing"),
+		"subgraph_name": types.StringValue("products"),
+		"schema":        types.StringValue("type Query { products: [String] }"),
+	}
+
+	server.Handle("PublishSubgraph", `{"publish": {"__typename": "BranchDoesNotExistError"}}`)
+	_, diags := createResource(t, r, attributes)
+	if errDiag, ok := diags.Errors()[0].(diag.DiagnosticWithPath); !ok || !errDiag.Path().Equal(path.Root("branch_name")) {
+		t.Errorf("expected the error to be attached to branch_name, got %v", diags)
+	}
+
+	server.Handle("PublishSubgraph", `{"publish": {"__typename": "FederatedGraphCompositionError", "messages": ["field Product.id is defined twice"]}}`)
+	if _, diags := createResource(t, r, attributes); !diags.HasError() {
+		t.Error("expected a composition failure to be an error")
+	}
+}
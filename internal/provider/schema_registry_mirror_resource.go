@@ -0,0 +1,369 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SchemaRegistryMirrorResource{}
+var _ resource.ResourceWithImportState = &SchemaRegistryMirrorResource{}
+var _ resource.ResourceWithConfigValidators = &SchemaRegistryMirrorResource{}
+
+func NewSchemaRegistryMirrorResource() resource.Resource {
+	return &SchemaRegistryMirrorResource{}
+}
+
+// SchemaRegistryMirrorResource defines the resource implementation.
+type SchemaRegistryMirrorResource struct {
+	client client.API
+}
+
+// SchemaRegistryMirrorResourceModel describes the resource data model.
+type SchemaRegistryMirrorResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	AccountSlug          types.String `tfsdk:"account_slug"`
+	GraphSlug            types.String `tfsdk:"graph_slug"`
+	BranchName           types.String `tfsdk:"branch_name"`
+	Kind                 types.String `tfsdk:"kind"`
+	Target               types.String `tfsdk:"target"`
+	Endpoint             types.String `tfsdk:"endpoint"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	TokenWO              types.String `tfsdk:"token_wo"`
+	AccessKeyIDWO        types.String `tfsdk:"access_key_id_wo"`
+	SecretAccessKeyWO    types.String `tfsdk:"secret_access_key_wo"`
+	CredentialsWOVersion types.Int64  `tfsdk:"credentials_wo_version"`
+}
+
+func (r *SchemaRegistryMirrorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_registry_mirror"
+}
+
+func (r *SchemaRegistryMirrorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Mirrors the composed schema of a branch to an external registry (Apollo GraphOS, Hive, or an S3 " +
+			"bucket) on each publish. Credentials are write-only and never stored in state; change " +
+			"`credentials_wo_version` to send new credentials. Requires Terraform 1.11 or later.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Schema registry mirror identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch whose composed schema is mirrored",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"kind": schema.StringAttribute{
+				MarkdownDescription: "Registry the schema is mirrored to: `APOLLO_GRAPHOS`, `HIVE`, or `S3`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.SchemaRegistryMirrorKindApolloGraphOS),
+						string(client.SchemaRegistryMirrorKindHive),
+						string(client.SchemaRegistryMirrorKindS3),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "Where the schema is published: a graph ref such as `my-graph@current` for Apollo GraphOS, " +
+					"a target such as `my-org/my-project/production` for Hive, or an object URL such as " +
+					"`s3://my-bucket/schemas/main.graphql` for S3",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "Registry endpoint URL, for self-hosted Hive or S3-compatible storage. When unset, the public endpoint of the registry is used.",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the schema is mirrored on publish. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"token_wo": schema.StringAttribute{
+				MarkdownDescription: "API key for Apollo GraphOS or access token for Hive. Write-only.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"access_key_id_wo": schema.StringAttribute{
+				MarkdownDescription: "Access key ID for S3. Write-only.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"secret_access_key_wo": schema.StringAttribute{
+				MarkdownDescription: "Secret access key for S3. Write-only.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"credentials_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the write-only credentials. Terraform cannot detect changes to write-only " +
+					"attributes, so the credentials are only sent on creation and when this value changes.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *SchemaRegistryMirrorResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("token_wo"),
+			path.MatchRoot("access_key_id_wo"),
+		),
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("access_key_id_wo"),
+			path.MatchRoot("secret_access_key_wo"),
+		),
+	}
+}
+
+func (r *SchemaRegistryMirrorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SchemaRegistryMirrorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data, config SchemaRegistryMirrorResourceModel
+
+	// Read Terraform plan data into the model, and the write-only credentials from the configuration
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createInput := client.CreateSchemaRegistryMirrorInput{
+		AccountSlug: data.AccountSlug.ValueString(),
+		GraphSlug:   data.GraphSlug.ValueString(),
+		BranchName:  data.BranchName.ValueString(),
+		Kind:        client.SchemaRegistryMirrorKind(data.Kind.ValueString()),
+		Target:      data.Target.ValueString(),
+		Endpoint:    data.Endpoint.ValueStringPointer(),
+		Enabled:     data.Enabled.ValueBool(),
+		Credentials: config.credentials(),
+	}
+
+	mirror, err := r.client.CreateSchemaRegistryMirror(ctx, createInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create schema registry mirror: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.fromMirror(mirror)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitUntilCreated(ctx, "schema registry mirror", func(ctx context.Context) error {
+		_, err := r.client.GetSchemaRegistryMirror(ctx, data.ID.ValueString())
+		return err
+	}, "schema registry mirror not found")...)
+}
+
+func (r *SchemaRegistryMirrorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SchemaRegistryMirrorResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mirror, err := r.client.GetSchemaRegistryMirror(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the mirror is not found, remove it from state
+		if err.Error() == "schema registry mirror not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema registry mirror: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	data.fromMirror(mirror)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SchemaRegistryMirrorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state, config SchemaRegistryMirrorResourceModel
+
+	// Read Terraform plan, prior state, and configuration data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateInput := client.UpdateSchemaRegistryMirrorInput{
+		ID:       state.ID.ValueString(),
+		Target:   data.Target.ValueString(),
+		Endpoint: data.Endpoint.ValueStringPointer(),
+		Enabled:  data.Enabled.ValueBool(),
+	}
+
+	// Write-only values are always present in the configuration, so only a new
+	// version marks them as changed
+	if !data.CredentialsWOVersion.Equal(state.CredentialsWOVersion) {
+		updateInput.Credentials = config.credentials()
+	}
+
+	mirror, err := r.client.UpdateSchemaRegistryMirror(ctx, updateInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update schema registry mirror: %s", err))
+		return
+	}
+
+	data.fromMirror(mirror)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SchemaRegistryMirrorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SchemaRegistryMirrorResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSchemaRegistryMirror(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the mirror is already gone, there is nothing left to delete
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete schema registry mirror: %s", err))
+		return
+	}
+}
+
+func (r *SchemaRegistryMirrorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug/mirror_id"
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/mirror_id', got: %s", req.ID))
+		return
+	}
+
+	// Get the mirror to populate the remaining attributes
+	mirror, err := r.client.GetSchemaRegistryMirror(ctx, parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read schema registry mirror during import: %s", err))
+		return
+	}
+
+	// Credentials are never returned by the API, so the imported mirror keeps
+	// its stored credentials until credentials_wo_version is set
+	data := SchemaRegistryMirrorResourceModel{
+		AccountSlug:          types.StringValue(parts[0]),
+		GraphSlug:            types.StringValue(parts[1]),
+		CredentialsWOVersion: types.Int64Null(),
+	}
+	data.fromMirror(mirror)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// credentials returns the write-only credentials of the configuration, or nil
+// when none are configured.
+func (m SchemaRegistryMirrorResourceModel) credentials() *client.SchemaRegistryMirrorCredentials {
+	credentials := client.SchemaRegistryMirrorCredentials{
+		Token:           m.TokenWO.ValueString(),
+		AccessKeyID:     m.AccessKeyIDWO.ValueString(),
+		SecretAccessKey: m.SecretAccessKeyWO.ValueString(),
+	}
+
+	if credentials == (client.SchemaRegistryMirrorCredentials{}) {
+		return nil
+	}
+
+	return &credentials
+}
+
+// fromMirror maps an API schema registry mirror onto the model. Write-only
+// attributes are always null in state.
+func (m *SchemaRegistryMirrorResourceModel) fromMirror(mirror *client.SchemaRegistryMirror) {
+	m.ID = types.StringValue(mirror.ID)
+	m.BranchName = types.StringValue(mirror.BranchName)
+	m.Kind = types.StringValue(string(mirror.Kind))
+	m.Target = types.StringValue(mirror.Target)
+	m.Endpoint = types.StringPointerValue(mirror.Endpoint)
+	m.Enabled = types.BoolValue(mirror.Enabled)
+	m.TokenWO = types.StringNull()
+	m.AccessKeyIDWO = types.StringNull()
+	m.SecretAccessKeyWO = types.StringNull()
+}
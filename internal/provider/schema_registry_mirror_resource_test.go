@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSchemaRegistryMirrorResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSchemaRegistryMirrorResourceConfig(true, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("grafbase_schema_registry_mirror.test", "id"),
+					resource.TestCheckResourceAttr("grafbase_schema_registry_mirror.test", "kind", "HIVE"),
+					resource.TestCheckResourceAttr("grafbase_schema_registry_mirror.test", "enabled", "true"),
+					resource.TestCheckNoResourceAttr("grafbase_schema_registry_mirror.test", "token_wo"),
+				),
+			},
+			// Update in place, rotating the credentials
+			{
+				Config: testAccSchemaRegistryMirrorResourceConfig(false, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_schema_registry_mirror.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("grafbase_schema_registry_mirror.test", "credentials_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSchemaRegistryMirrorResourceConfig(enabled bool, credentialsVersion int) string {
+	return fmt.Sprintf(`
+resource "grafbase_schema_registry_mirror" "test" {
+  account_slug = "test-account"
+  graph_slug   = "test-graph"
+  branch_name  = "main"
+  kind         = "HIVE"
+  target       = "test-org/test-project/production"
+  enabled      = %[1]t
+
+  token_wo               = "test-token-%[2]d"
+  credentials_wo_version = %[2]d
+}
+`, enabled, credentialsVersion)
+}
+
+func testSchemaRegistryMirrorJSON(enabled bool) string {
+	return fmt.Sprintf(`{"id": "mirror-1", "branchName": "main", "kind": "S3", "target": "s3://my-bucket/main.graphql",
+		"endpoint": null, "enabled": %t}`, enabled)
+}
+
+func TestSchemaRegistryMirrorResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewSchemaRegistryMirrorResource)
+
+	server.Handle("CreateSchemaRegistryMirror", `{"schemaRegistryMirrorCreate": {"__typename": "SchemaRegistryMirrorCreateSuccess",
+		"schemaRegistryMirror": `+testSchemaRegistryMirrorJSON(true)+`}}`)
+	server.Handle("GetSchemaRegistryMirror", `{"node": `+testSchemaRegistryMirrorJSON(true)+`}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug":           types.StringValue("my-account"),
+		"graph_slug":             types.StringValue("my-graph"),
+		"branch_name":            types.StringValue("main"),
+		"kind":                   types.StringValue("S3"),
+		"target":                 types.StringValue("s3://my-bucket/main.graphql"),
+		"enabled":                types.BoolValue(true),
+		"access_key_id_wo":       types.StringValue("AKIA1"),
+		"secret_access_key_wo":   types.StringValue("secret-1"),
+		"credentials_wo_version": types.Int64Value(1),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "mirror-1" {
+		t.Errorf("expected id mirror-1, got %q", got)
+	}
+
+	// Credentials are sent, but never stored in state
+	var sent struct {
+		Credentials map[string]string `json:"credentials"`
+	}
+	if err := server.LastRequest("CreateSchemaRegistryMirror").Input(&sent); err != nil {
+		t.Fatal(err)
+	}
+	if sent.Credentials["accessKeyId"] != "AKIA1" || sent.Credentials["secretAccessKey"] != "secret-1" {
+		t.Errorf("unexpected credentials %v", sent.Credentials)
+	}
+	if got := stateString(t, state, "secret_access_key_wo"); got != "" {
+		t.Errorf("expected secret_access_key_wo to be null in state, got %q", got)
+	}
+
+	server.Handle("GetSchemaRegistryMirror", `{"node": `+testSchemaRegistryMirrorJSON(true)+`}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+
+	// Without a new credentials version, the stored credentials are kept
+	server.Handle("UpdateSchemaRegistryMirror", `{"schemaRegistryMirrorUpdate": {"__typename": "SchemaRegistryMirrorUpdateSuccess",
+		"schemaRegistryMirror": `+testSchemaRegistryMirrorJSON(false)+`}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"enabled":              types.BoolValue(false),
+		"access_key_id_wo":     types.StringValue("AKIA1"),
+		"secret_access_key_wo": types.StringValue("secret-1"),
+	})
+	requireNoDiagnostics(t, diags)
+	if _, ok := server.LastRequest("UpdateSchemaRegistryMirror").Variables["input"].(map[string]interface{})["credentials"]; ok {
+		t.Error("expected credentials not to be sent")
+	}
+
+	// A new credentials version sends the configured credentials
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"access_key_id_wo":       types.StringValue("AKIA2"),
+		"secret_access_key_wo":   types.StringValue("secret-2"),
+		"credentials_wo_version": types.Int64Value(2),
+	})
+	requireNoDiagnostics(t, diags)
+	if err := server.LastRequest("UpdateSchemaRegistryMirror").Input(&sent); err != nil {
+		t.Fatal(err)
+	}
+	if sent.Credentials["accessKeyId"] != "AKIA2" {
+		t.Errorf("expected rotated credentials, got %v", sent.Credentials)
+	}
+
+	server.Handle("DeleteSchemaRegistryMirror", `{"schemaRegistryMirrorDelete": {"__typename": "SchemaRegistryMirrorDeleteSuccess"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	server.Handle("GetSchemaRegistryMirror", `{"node": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected schema registry mirror to be removed from state")
+	}
+}
+
+func TestSchemaRegistryMirrorResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewSchemaRegistryMirrorResource)
+
+	server.Handle("GetSchemaRegistryMirror", `{"node": `+testSchemaRegistryMirrorJSON(true)+`}`)
+	state, diags := importResource(t, r, "my-account/my-graph/mirror-1")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "branch_name"); got != "main" {
+		t.Errorf("expected branch_name main, got %q", got)
+	}
+
+	if _, diags := importResource(t, r, "my-account/mirror-1"); !diags.HasError() {
+		t.Error("expected invalid import ID to be an error")
+	}
+}
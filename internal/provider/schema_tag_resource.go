@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SchemaTagResource{}
+var _ resource.ResourceWithImportState = &SchemaTagResource{}
+
+func NewSchemaTagResource() resource.Resource {
+	return &SchemaTagResource{}
+}
+
+// SchemaTagResource defines the resource implementation.
+type SchemaTagResource struct {
+	client client.API
+}
+
+// SchemaTagResourceModel describes the resource data model.
+type SchemaTagResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	AccountSlug types.String `tfsdk:"account_slug"`
+	GraphSlug   types.String `tfsdk:"graph_slug"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Contracts   types.Set    `tfsdk:"contracts"`
+}
+
+func (r *SchemaTagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_tag"
+}
+
+func (r *SchemaTagResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Registers a tag that subgraphs may apply with the `@tag` directive, and the contracts " +
+			"whose filters consume it. Schema checks reject tags that are not registered.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Schema tag identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug the tag belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Tag name, as used in `@tag(name: \"...\")`",
+				Required:            true,
+				Validators:          schemaTagNameValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of what the tag marks",
+				Optional:            true,
+			},
+			"contracts": schema.SetAttribute{
+				MarkdownDescription: "Names of the contracts whose filters consume the tag",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+		},
+	}
+}
+
+func (r *SchemaTagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SchemaTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SchemaTagResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := data.setInput(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tag, err := r.client.SetSchemaTag(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create schema tag: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(data.fromSchemaTag(ctx, tag)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitUntilCreated(ctx, "schema tag", func(ctx context.Context) error {
+		_, err := r.client.GetSchemaTag(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.Name.ValueString())
+		return err
+	}, "schema tag not found")...)
+}
+
+func (r *SchemaTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SchemaTagResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tag, err := r.client.GetSchemaTag(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// If the tag is not found, remove it from state
+		if err.Error() == "schema tag not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema tag: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	resp.Diagnostics.Append(data.fromSchemaTag(ctx, tag)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SchemaTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SchemaTagResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := data.setInput(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Setting a tag replaces its description and contracts
+	tag, err := r.client.SetSchemaTag(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update schema tag: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromSchemaTag(ctx, tag)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SchemaTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SchemaTagResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteInput := client.DeleteSchemaTagInput{
+		AccountSlug: data.AccountSlug.ValueString(),
+		GraphSlug:   data.GraphSlug.ValueString(),
+		Name:        data.Name.ValueString(),
+	}
+
+	err := r.client.DeleteSchemaTag(ctx, deleteInput)
+	if err != nil {
+		// If the tag doesn't exist, consider it already deleted
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete schema tag: %s", err))
+		return
+	}
+}
+
+func (r *SchemaTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug/name"
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/name', got: %s", req.ID))
+		return
+	}
+
+	tag, err := r.client.GetSchemaTag(ctx, parts[0], parts[1], parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read schema tag during import: %s", err))
+		return
+	}
+
+	data := SchemaTagResourceModel{
+		AccountSlug: types.StringValue(parts[0]),
+		GraphSlug:   types.StringValue(parts[1]),
+		Name:        types.StringValue(parts[2]),
+		Contracts:   types.SetNull(types.StringType),
+	}
+	resp.Diagnostics.Append(data.fromSchemaTag(ctx, tag)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setInput builds the client input for creating or replacing the tag.
+func (m SchemaTagResourceModel) setInput(ctx context.Context) (client.SetSchemaTagInput, diag.Diagnostics) {
+	input := client.SetSchemaTagInput{
+		AccountSlug: m.AccountSlug.ValueString(),
+		GraphSlug:   m.GraphSlug.ValueString(),
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueStringPointer(),
+	}
+
+	var diags diag.Diagnostics
+	if !m.Contracts.IsNull() && !m.Contracts.IsUnknown() {
+		diags.Append(m.Contracts.ElementsAs(ctx, &input.Contracts, false)...)
+	}
+
+	return input, diags
+}
+
+// fromSchemaTag maps an API schema tag onto the model. An empty contracts set
+// is kept null when it was not configured, so omitting it does not cause a diff.
+func (m *SchemaTagResourceModel) fromSchemaTag(ctx context.Context, tag *client.SchemaTag) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(tag.ID)
+	m.Name = types.StringValue(tag.Name)
+	m.Description = types.StringPointerValue(tag.Description)
+
+	if len(tag.Contracts) > 0 || !m.Contracts.IsNull() {
+		contracts, d := types.SetValueFrom(ctx, types.StringType, tag.Contracts)
+		diags.Append(d...)
+		m.Contracts = contracts
+	}
+
+	return diags
+}
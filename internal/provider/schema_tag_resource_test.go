@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSchemaTagResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSchemaTagResourceConfig("public", `["public-api"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_schema_tag.test", "name", "public"),
+					resource.TestCheckResourceAttr("grafbase_schema_tag.test", "description", "Fields exposed to third parties"),
+					resource.TestCheckResourceAttr("grafbase_schema_tag.test", "contracts.#", "1"),
+					resource.TestCheckTypeSetElemAttr("grafbase_schema_tag.test", "contracts.*", "public-api"),
+					resource.TestCheckResourceAttrSet("grafbase_schema_tag.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_schema_tag.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-account/test-graph/public",
+			},
+			// Update contracts in place
+			{
+				Config: testAccSchemaTagResourceConfig("public", `["public-api", "partner-api"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_schema_tag.test", "contracts.#", "2"),
+					resource.TestCheckTypeSetElemAttr("grafbase_schema_tag.test", "contracts.*", "partner-api"),
+				),
+			},
+			// Invalid tag names are rejected at plan time
+			{
+				Config:      testAccSchemaTagResourceConfig("not public", `[]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must start with a letter or underscore`),
+			},
+		},
+	})
+}
+
+func testAccSchemaTagResourceConfig(name, contracts string) string {
+	return fmt.Sprintf(`
+resource "grafbase_graph" "test" {
+  account_slug = "test-account"
+  slug         = "test-graph"
+}
+
+resource "grafbase_schema_tag" "test" {
+  account_slug = grafbase_graph.test.account_slug
+  graph_slug   = grafbase_graph.test.slug
+  name         = %[1]q
+  description  = "Fields exposed to third parties"
+  contracts    = %[2]s
+}
+`, name, contracts)
+}
+
+func TestSchemaTagResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewSchemaTagResource)
+
+	server.Handle("SetSchemaTag", `{"schemaTagSet": {"__typename": "SchemaTagSetSuccess", "schemaTag": {"id": "tag-1", "name": "public", "description": "Public API", "contracts": ["partners"]}}}`)
+	server.Handle("GetSchemaTag", `{"graphByAccountSlug": {"schemaTag": {"id": "tag-1", "name": "public", "description": "Public API", "contracts": ["partners"]}}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("my-graph"),
+		"name":         types.StringValue("public"),
+		"description":  types.StringValue("Public API"),
+		"contracts":    types.SetValueMust(types.StringType, []attr.Value{types.StringValue("partners")}),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "tag-1" {
+		t.Errorf("expected id tag-1, got %q", got)
+	}
+
+	server.Handle("GetSchemaTag", `{"graphByAccountSlug": {"schemaTag": {"id": "tag-1", "name": "public", "description": "Public API", "contracts": ["partners"]}}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+
+	server.Handle("SetSchemaTag", `{"schemaTagSet": {"__typename": "SchemaTagSetSuccess", "schemaTag": {"id": "tag-1", "name": "public", "description": "Public types", "contracts": ["partners"]}}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"description": types.StringValue("Public types"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "description"); got != "Public types" {
+		t.Errorf("expected updated description, got %q", got)
+	}
+
+	server.Handle("DeleteSchemaTag", `{"schemaTagDelete": {"__typename": "SchemaTagInUseError"}}`)
+	if diags := deleteResource(t, r, state); !diags.HasError() {
+		t.Error("expected deleting a tag in use to be an error")
+	}
+
+	server.Handle("DeleteSchemaTag", `{"schemaTagDelete": {"__typename": "SchemaTagDeleteSuccess"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	server.Handle("GetSchemaTag", `{"graphByAccountSlug": {"schemaTag": null}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected schema tag to be removed from state")
+	}
+}
+
+func TestSchemaTagResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewSchemaTagResource)
+
+	server.Handle("GetSchemaTag", `{"graphByAccountSlug": {"schemaTag": {"id": "tag-1", "name": "public", "description": null, "contracts": []}}}`)
+	state, diags := importResource(t, r, "my-account/my-graph/public")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "name"); got != "public" {
+		t.Errorf("expected name public, got %q", got)
+	}
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// slugSeparatorRegexp matches the runs of characters replaced by a single hyphen when slugifying
+var slugSeparatorRegexp = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &SlugifyFunction{}
+
+func NewSlugifyFunction() function.Function {
+	return &SlugifyFunction{}
+}
+
+// SlugifyFunction defines the function implementation.
+type SlugifyFunction struct{}
+
+func (f *SlugifyFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "slugify"
+}
+
+func (f *SlugifyFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Derives a graph slug or branch name from a human readable name",
+		MarkdownDescription: "Lowercases the name and replaces every run of characters other than ASCII letters and numbers " +
+			fmt.Sprintf("with a single hyphen, trimming hyphens at both ends and truncating the result to %d characters. ", maxSlugLength) +
+			"For example, `My Product API (v2)` becomes `my-product-api-v2`. Fails when the name contains no letters or numbers.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "Human readable name to derive the slug from",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SlugifyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	slug := slugify(name)
+	if slug == "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to derive a slug from %q, which contains no letters or numbers.", name))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, slug))
+}
+
+// slugify converts a name to a slug matching slugRegexp, or an empty string
+// when the name has no ASCII letters or numbers.
+func slugify(name string) string {
+	slug := strings.Trim(slugSeparatorRegexp.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if len(slug) > maxSlugLength {
+		slug = strings.TrimRight(slug[:maxSlugLength], "-")
+	}
+
+	return slug
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccSlugifyFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "slug" {
+  value = provider::grafbase::slugify("My Product API (v2)")
+}
+`,
+				Check: resource.TestCheckOutput("slug", "my-product-api-v2"),
+			},
+		},
+	})
+}
+
+func TestSlugify(t *testing.T) {
+	tests := map[string]string{
+		"my-graph":              "my-graph",
+		"My Product API (v2)":   "my-product-api-v2",
+		"  --Feature__Login--":  "feature-login",
+		"release/2024.06":       "release-2024-06",
+		"Café Ünïcode":          "caf-n-code",
+		"!!!":                   "",
+		strings.Repeat("a", 70): strings.Repeat("a", maxSlugLength),
+		strings.Repeat("a", maxSlugLength-1) + " b": strings.Repeat("a", maxSlugLength-1),
+	}
+
+	for name, expected := range tests {
+		if got := slugify(name); got != expected {
+			t.Errorf("slugify(%q) = %q, expected %q", name, got, expected)
+		}
+		if expected != "" && !slugRegexp.MatchString(slugify(name)) {
+			t.Errorf("slugify(%q) is not a valid slug", name)
+		}
+	}
+}
+
+func TestSlugifyFunctionRun(t *testing.T) {
+	f := NewSlugifyFunction()
+
+	resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("Checkout Service")})}, &resp)
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	if got := resp.Result.Value(); !got.Equal(types.StringValue("checkout-service")) {
+		t.Errorf("expected checkout-service, got %s", got)
+	}
+
+	// Names without letters or numbers have no slug
+	resp = function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("---")})}, &resp)
+	if resp.Error == nil || resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 0 {
+		t.Errorf("expected an argument error, got %v", resp.Error)
+	}
+}
@@ -0,0 +1,339 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SubgraphHeadersResource{}
+var _ resource.ResourceWithImportState = &SubgraphHeadersResource{}
+
+func NewSubgraphHeadersResource() resource.Resource {
+	return &SubgraphHeadersResource{}
+}
+
+// SubgraphHeadersResource defines the resource implementation.
+type SubgraphHeadersResource struct {
+	client client.API
+}
+
+// SubgraphHeadersResourceModel describes the resource data model.
+type SubgraphHeadersResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	AccountSlug    types.String `tfsdk:"account_slug"`
+	GraphSlug      types.String `tfsdk:"graph_slug"`
+	BranchName     types.String `tfsdk:"branch_name"`
+	SubgraphName   types.String `tfsdk:"subgraph_name"`
+	StaticHeaders  types.Map    `tfsdk:"static_headers"`
+	ForwardHeaders types.Set    `tfsdk:"forward_headers"`
+	UpdatedAt      RFC3339Value `tfsdk:"updated_at"`
+}
+
+func (r *SubgraphHeadersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subgraph_headers"
+}
+
+func (r *SubgraphHeadersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the headers the gateway sends to a subgraph on a branch, so the gateway can authenticate to the subgraph origin.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Subgraph headers identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch the headers apply to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subgraph_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the subgraph the headers are sent to",
+				Required:            true,
+				Validators:          subgraphNameValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"static_headers": schema.MapAttribute{
+				MarkdownDescription: "Map of header name to the value the gateway sends with every subgraph request",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(headerNameValidators()...),
+				},
+			},
+			"forward_headers": schema.SetAttribute{
+				MarkdownDescription: "Names of client request headers the gateway forwards to the subgraph",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(headerNameValidators()...),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "Timestamp of the last headers change",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *SubgraphHeadersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SubgraphHeadersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SubgraphHeadersResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setInput, diags := data.setInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	headers, err := r.client.SetSubgraphHeaders(ctx, setInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create subgraph headers: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(data.fromSubgraphHeaders(ctx, headers)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitUntilCreated(ctx, "subgraph headers", func(ctx context.Context) error {
+		_, err := r.client.GetSubgraphHeaders(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString(), data.SubgraphName.ValueString())
+		return err
+	}, "subgraph headers not found")...)
+}
+
+func (r *SubgraphHeadersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SubgraphHeadersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	headers, err := r.client.GetSubgraphHeaders(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString(), data.SubgraphName.ValueString())
+	if err != nil {
+		// If the headers are not found, remove them from state
+		if err.Error() == "subgraph headers not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read subgraph headers: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	resp.Diagnostics.Append(data.fromSubgraphHeaders(ctx, headers)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubgraphHeadersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SubgraphHeadersResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Headers change in place, and setting them replaces the previous headers
+	setInput, diags := data.setInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	headers, err := r.client.SetSubgraphHeaders(ctx, setInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update subgraph headers: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromSubgraphHeaders(ctx, headers)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubgraphHeadersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SubgraphHeadersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteInput := client.DeleteSubgraphHeadersInput{
+		AccountSlug:  data.AccountSlug.ValueString(),
+		GraphSlug:    data.GraphSlug.ValueString(),
+		BranchName:   data.BranchName.ValueString(),
+		SubgraphName: data.SubgraphName.ValueString(),
+	}
+
+	err := r.client.DeleteSubgraphHeaders(ctx, deleteInput)
+	if err != nil {
+		// If the headers don't exist, consider them already deleted
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete subgraph headers: %s", err))
+		return
+	}
+}
+
+func (r *SubgraphHeadersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug/branch_name/subgraph_name"
+	// Branch names may contain slashes, so the branch name is everything between the graph slug and the subgraph name
+	parts := strings.Split(req.ID, "/")
+	if len(parts) < 4 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/branch_name/subgraph_name', got: %s", req.ID))
+		return
+	}
+
+	data := SubgraphHeadersResourceModel{
+		AccountSlug:    types.StringValue(parts[0]),
+		GraphSlug:      types.StringValue(parts[1]),
+		BranchName:     types.StringValue(strings.Join(parts[2:len(parts)-1], "/")),
+		SubgraphName:   types.StringValue(parts[len(parts)-1]),
+		StaticHeaders:  types.MapNull(types.StringType),
+		ForwardHeaders: types.SetNull(types.StringType),
+	}
+
+	// Get the headers to populate the remaining attributes
+	headers, err := r.client.GetSubgraphHeaders(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString(), data.SubgraphName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read subgraph headers during import: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromSubgraphHeaders(ctx, headers)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setInput builds the client input for creating or replacing the headers.
+func (m SubgraphHeadersResourceModel) setInput(ctx context.Context) (client.SetSubgraphHeadersInput, diag.Diagnostics) {
+	input := client.SetSubgraphHeadersInput{
+		AccountSlug:  m.AccountSlug.ValueString(),
+		GraphSlug:    m.GraphSlug.ValueString(),
+		BranchName:   m.BranchName.ValueString(),
+		SubgraphName: m.SubgraphName.ValueString(),
+	}
+
+	var diags diag.Diagnostics
+
+	var static map[string]string
+	diags.Append(m.StaticHeaders.ElementsAs(ctx, &static, false)...)
+	for name, value := range static {
+		input.StaticHeaders = append(input.StaticHeaders, client.SubgraphHeader{Name: name, Value: value})
+	}
+
+	diags.Append(m.ForwardHeaders.ElementsAs(ctx, &input.ForwardHeaders, false)...)
+
+	return input, diags
+}
+
+// fromSubgraphHeaders populates the model from API headers. Header lists that
+// are empty stay null when they were not configured.
+func (m *SubgraphHeadersResourceModel) fromSubgraphHeaders(ctx context.Context, headers *client.SubgraphHeaders) diag.Diagnostics {
+	m.ID = types.StringValue(headers.ID)
+	m.UpdatedAt = NewRFC3339Value(headers.UpdatedAt)
+
+	var diags diag.Diagnostics
+
+	if len(headers.StaticHeaders) > 0 || !m.StaticHeaders.IsNull() {
+		static := make(map[string]string, len(headers.StaticHeaders))
+		for _, header := range headers.StaticHeaders {
+			static[header.Name] = header.Value
+		}
+
+		var staticDiags diag.Diagnostics
+		m.StaticHeaders, staticDiags = types.MapValueFrom(ctx, types.StringType, static)
+		diags.Append(staticDiags...)
+	}
+
+	if len(headers.ForwardHeaders) > 0 || !m.ForwardHeaders.IsNull() {
+		var forwardDiags diag.Diagnostics
+		m.ForwardHeaders, forwardDiags = types.SetValueFrom(ctx, types.StringType, headers.ForwardHeaders)
+		diags.Append(forwardDiags...)
+	}
+
+	return diags
+}
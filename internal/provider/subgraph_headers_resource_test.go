@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSubgraphHeadersResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSubgraphHeadersResourceConfig("Bearer first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_subgraph_headers.test", "subgraph_name", "products"),
+					resource.TestCheckResourceAttr("grafbase_subgraph_headers.test", "static_headers.Authorization", "Bearer first"),
+					resource.TestCheckTypeSetElemAttr("grafbase_subgraph_headers.test", "forward_headers.*", "x-tenant-id"),
+					resource.TestCheckResourceAttrSet("grafbase_subgraph_headers.test", "id"),
+					resource.TestCheckResourceAttrSet("grafbase_subgraph_headers.test", "updated_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_subgraph_headers.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-account/test-graph/main/products",
+			},
+			// Update in place
+			{
+				Config: testAccSubgraphHeadersResourceConfig("Bearer second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_subgraph_headers.test", "static_headers.Authorization", "Bearer second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSubgraphHeadersResourceConfig(authorization string) string {
+	return fmt.Sprintf(`
+resource "grafbase_graph" "test" {
+  account_slug = "test-account"
+  slug         = "test-graph"
+}
+
+resource "grafbase_subgraph_headers" "test" {
+  account_slug  = grafbase_graph.test.account_slug
+  graph_slug    = grafbase_graph.test.slug
+  branch_name   = "main"
+  subgraph_name = "products"
+
+  static_headers = {
+    Authorization = %[1]q
+  }
+
+  forward_headers = ["x-tenant-id"]
+}
+`, authorization)
+}
+
+func TestSubgraphHeadersResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewSubgraphHeadersResource)
+
+	server.Handle("SetSubgraphHeaders", `{"subgraphHeadersSet": {"__typename": "SubgraphHeadersSetSuccess", "subgraphHeaders": {
+		"id": "headers-1", "subgraphName": "products", "staticHeaders": [{"name": "Authorization", "value": "Bearer first"}], "forwardHeaders": [], "updatedAt": "2024-01-15T10:30:00Z"
+	}}}`)
+	server.Handle("GetSubgraphHeaders", `{"branch": {"subgraphHeaders": {
+		"id": "headers-1", "subgraphName": "products", "staticHeaders": [{"name": "Authorization", "value": "Bearer changed"}], "forwardHeaders": [], "updatedAt": "2024-01-16T10:30:00Z"
+	}}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug":  types.StringValue("my-account"),
+		"graph_slug":    types.StringValue("my-graph"),
+		"branch_name":   types.StringValue("main"),
+		"subgraph_name": types.StringValue("products"),
+		"static_headers": types.MapValueMust(types.StringType, map[string]attr.Value{
+			"Authorization": types.StringValue("Bearer first"),
+		}),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "headers-1" {
+		t.Errorf("expected id headers-1, got %q", got)
+	}
+
+	var input client.SetSubgraphHeadersInput
+	if err := server.LastRequest("SetSubgraphHeaders").Input(&input); err != nil {
+		t.Fatalf("unable to decode input: %s", err)
+	}
+	if len(input.StaticHeaders) != 1 || input.StaticHeaders[0].Name != "Authorization" || input.ForwardHeaders == nil {
+		t.Errorf("unexpected input: %+v", input)
+	}
+
+	// Unconfigured forward headers stay null when none are set
+	var forward types.Set
+	requireNoDiagnostics(t, state.GetAttribute(context.Background(), path.Root("forward_headers"), &forward))
+	if !forward.IsNull() {
+		t.Errorf("expected forward_headers to stay null, got %s", forward)
+	}
+
+	server.Handle("GetSubgraphHeaders", `{"branch": {"subgraphHeaders": {
+		"id": "headers-1", "subgraphName": "products", "staticHeaders": [{"name": "Authorization", "value": "Bearer changed"}], "forwardHeaders": [], "updatedAt": "2024-01-16T10:30:00Z"
+	}}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateAuthorizationHeader(t, state); got != "Bearer changed" {
+		t.Errorf("expected header changed outside of Terraform to be read, got %q", got)
+	}
+
+	server.Handle("SetSubgraphHeaders", `{"subgraphHeadersSet": {"__typename": "SubgraphHeadersSetSuccess", "subgraphHeaders": {
+		"id": "headers-1", "subgraphName": "products", "staticHeaders": [{"name": "Authorization", "value": "Bearer second"}], "forwardHeaders": ["x-tenant-id"], "updatedAt": "2024-01-17T10:30:00Z"
+	}}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"static_headers": types.MapValueMust(types.StringType, map[string]attr.Value{
+			"Authorization": types.StringValue("Bearer second"),
+		}),
+		"forward_headers": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("x-tenant-id")}),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "updated_at"); got != "2024-01-17T10:30:00Z" {
+		t.Errorf("unexpected updated_at %q", got)
+	}
+
+	server.Handle("DeleteSubgraphHeaders", `{"subgraphHeadersDelete": {"__typename": "SubgraphHeadersDoesNotExistError"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	server.Handle("GetSubgraphHeaders", `{"branch": {"subgraphHeaders": null}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected subgraph headers to be removed from state")
+	}
+}
+
+func TestSubgraphHeadersResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewSubgraphHeadersResource)
+
+	server.Handle("GetSubgraphHeaders", `{"branch": {"subgraphHeaders": {
+		"id": "headers-1", "subgraphName": "products", "staticHeaders": [{"name": "Authorization", "value": "Bearer secret"}], "forwardHeaders": ["x-tenant-id"], "updatedAt": "2024-01-15T10:30:00Z"
+	}}}`)
+	state, diags := importResource(t, r, "my-account/my-graph/feature/login/products")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "branch_name"); got != "feature/login" {
+		t.Errorf("expected branch name with slash, got %q", got)
+	}
+	if got := stateAuthorizationHeader(t, state); got != "Bearer secret" {
+		t.Errorf("expected imported static header, got %q", got)
+	}
+}
+
+// stateAuthorizationHeader returns the static Authorization header of a subgraph headers state
+func stateAuthorizationHeader(t *testing.T, state tfsdk.State) string {
+	t.Helper()
+
+	var value types.String
+	requireNoDiagnostics(t, state.GetAttribute(context.Background(), path.Root("static_headers").AtMapKey("Authorization"), &value))
+
+	return value.ValueString()
+}
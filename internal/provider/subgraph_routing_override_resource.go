@@ -0,0 +1,384 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// compositionPollInterval is how often the latest composition of the branch is
+// checked while waiting for a routing change to be composed.
+const compositionPollInterval = 5 * time.Second
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SubgraphRoutingOverrideResource{}
+var _ resource.ResourceWithImportState = &SubgraphRoutingOverrideResource{}
+
+func NewSubgraphRoutingOverrideResource() resource.Resource {
+	return &SubgraphRoutingOverrideResource{}
+}
+
+// SubgraphRoutingOverrideResource defines the resource implementation.
+type SubgraphRoutingOverrideResource struct {
+	client client.API
+}
+
+// SubgraphRoutingOverrideResourceModel describes the resource data model.
+type SubgraphRoutingOverrideResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	AccountSlug        types.String   `tfsdk:"account_slug"`
+	GraphSlug          types.String   `tfsdk:"graph_slug"`
+	BranchName         types.String   `tfsdk:"branch_name"`
+	SubgraphName       types.String   `tfsdk:"subgraph_name"`
+	URL                URLValue       `tfsdk:"url"`
+	WaitForComposition types.Bool     `tfsdk:"wait_for_composition"`
+	UpdatedAt          RFC3339Value   `tfsdk:"updated_at"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *SubgraphRoutingOverrideResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subgraph_routing_override"
+}
+
+func (r *SubgraphRoutingOverrideResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Overrides the routing URL of a subgraph on a single branch without changing the subgraph's registered URL.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Routing override identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch the override applies to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subgraph_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the subgraph to override",
+				Required:            true,
+				Validators:          subgraphNameValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				CustomType: URLType{},
+				MarkdownDescription: "URL the gateway routes subgraph requests to on this branch. Must be an `http` or `https` URL. " +
+					"Differences in scheme and host casing, default ports, and trailing slashes are ignored.",
+				Required: true,
+				Validators: []validator.String{
+					httpURLValidator{},
+				},
+			},
+			"wait_for_composition": schema.BoolAttribute{
+				MarkdownDescription: "Wait for the branch to be recomposed with the new URL after creating or updating the override, " +
+					"up to the create or update timeout. Composition errors are reported as diagnostics. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"updated_at": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "Timestamp of the last override change",
+				Computed:            true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *SubgraphRoutingOverrideResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SubgraphRoutingOverrideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SubgraphRoutingOverrideResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	override, err := r.client.SetSubgraphRoutingOverride(ctx, data.setInput())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create subgraph routing override: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.ID = types.StringValue(override.ID)
+	data.URL = NewURLValue(override.URL)
+	data.UpdatedAt = NewRFC3339Value(override.UpdatedAt)
+
+	// Save the override before waiting so it is tracked even if composition fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitUntilCreated(ctx, "subgraph routing override", func(ctx context.Context) error {
+		_, err := r.client.GetSubgraphRoutingOverride(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString(), data.SubgraphName.ValueString())
+		return err
+	}, "routing override not found")...)
+
+	if resp.Diagnostics.HasError() || !data.WaitForComposition.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.waitForComposition(ctx, data, override.UpdatedAt)...)
+}
+
+func (r *SubgraphRoutingOverrideResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SubgraphRoutingOverrideResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Overrides of the same branch are read with a single request shared by all resources
+	override, err := r.client.LookupSubgraphRoutingOverride(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString(), data.SubgraphName.ValueString())
+	if err != nil {
+		// If the override is not found, remove it from state
+		if err.Error() == "routing override not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read subgraph routing override: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	data.ID = types.StringValue(override.ID)
+	data.URL = NewURLValue(override.URL)
+	data.UpdatedAt = NewRFC3339Value(override.UpdatedAt)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubgraphRoutingOverrideResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SubgraphRoutingOverrideResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Only the URL can change in place, and setting an override replaces it
+	override, err := r.client.SetSubgraphRoutingOverride(ctx, data.setInput())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update subgraph routing override: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(override.ID)
+	data.URL = NewURLValue(override.URL)
+	data.UpdatedAt = NewRFC3339Value(override.UpdatedAt)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || !data.WaitForComposition.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.waitForComposition(ctx, data, override.UpdatedAt)...)
+}
+
+func (r *SubgraphRoutingOverrideResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SubgraphRoutingOverrideResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteInput := client.DeleteSubgraphRoutingOverrideInput{
+		AccountSlug:  data.AccountSlug.ValueString(),
+		GraphSlug:    data.GraphSlug.ValueString(),
+		BranchName:   data.BranchName.ValueString(),
+		SubgraphName: data.SubgraphName.ValueString(),
+	}
+
+	err := r.client.DeleteSubgraphRoutingOverride(ctx, deleteInput)
+	if err != nil {
+		// If the override doesn't exist, consider it already deleted
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete subgraph routing override: %s", err))
+		return
+	}
+}
+
+func (r *SubgraphRoutingOverrideResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug/branch_name/subgraph_name"
+	// Branch names may contain slashes, so the branch name is everything between the graph slug and the subgraph name
+	parts := strings.Split(req.ID, "/")
+	if len(parts) < 4 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/branch_name/subgraph_name', got: %s", req.ID))
+		return
+	}
+
+	accountSlug := parts[0]
+	graphSlug := parts[1]
+	branchName := strings.Join(parts[2:len(parts)-1], "/")
+	subgraphName := parts[len(parts)-1]
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_slug"), accountSlug)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_slug"), graphSlug)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branch_name"), branchName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subgraph_name"), subgraphName)...)
+
+	// Get the override to populate the remaining attributes
+	override, err := r.client.GetSubgraphRoutingOverride(ctx, accountSlug, graphSlug, branchName, subgraphName)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read subgraph routing override during import: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), override.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("url"), override.URL)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_composition"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("updated_at"), NewRFC3339Value(override.UpdatedAt))...)
+}
+
+// waitForComposition polls the latest composition of the branch until one
+// started after the override change succeeds, fails, or ctx expires. Errors
+// of a failed composition are reported as one diagnostic each.
+func (r *SubgraphRoutingOverrideResource) waitForComposition(ctx context.Context, data SubgraphRoutingOverrideResourceModel, since time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ticker := time.NewTicker(compositionPollInterval)
+	defer ticker.Stop()
+
+	lastStatus := "no composition"
+	for {
+		composition, err := r.client.GetLatestBranchComposition(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString())
+		if err != nil && err.Error() != "composition not found" {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read branch composition: %s", err))
+			return diags
+		}
+
+		// Compositions started before the change do not include the new URL
+		if composition != nil && !composition.CreatedAt.Before(since) {
+			switch composition.Status {
+			case client.CompositionStatusSucceeded:
+				return diags
+			case client.CompositionStatusFailed:
+				for _, compositionError := range composition.Errors {
+					diags.AddAttributeError(path.Root("url"), "Composition Error", compositionError.Message)
+				}
+				if len(composition.Errors) == 0 {
+					diags.AddAttributeError(path.Root("url"), "Composition Error", fmt.Sprintf("Composition %s of branch %s failed.", composition.ID, data.BranchName.ValueString()))
+				}
+				return diags
+			}
+			lastStatus = "last status " + string(composition.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			diags.AddError("Composition Timeout", fmt.Sprintf("Timed out waiting for branch %s to be composed, %s.", data.BranchName.ValueString(), lastStatus))
+			return diags
+		case <-ticker.C:
+		}
+	}
+}
+
+// setInput builds the client input for creating or replacing the override.
+func (m SubgraphRoutingOverrideResourceModel) setInput() client.SetSubgraphRoutingOverrideInput {
+	return client.SetSubgraphRoutingOverrideInput{
+		AccountSlug:  m.AccountSlug.ValueString(),
+		GraphSlug:    m.GraphSlug.ValueString(),
+		BranchName:   m.BranchName.ValueString(),
+		SubgraphName: m.SubgraphName.ValueString(),
+		URL:          m.URL.ValueString(),
+	}
+}
@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/grafbase/terraform-provider-grafbase/internal/mockgraphql"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSubgraphRoutingOverrideResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSubgraphRoutingOverrideResourceConfig("https://pr-1.products.example.com/graphql"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_subgraph_routing_override.test", "branch_name", "preview"),
+					resource.TestCheckResourceAttr("grafbase_subgraph_routing_override.test", "subgraph_name", "products"),
+					resource.TestCheckResourceAttr("grafbase_subgraph_routing_override.test", "url", "https://pr-1.products.example.com/graphql"),
+					resource.TestCheckResourceAttrSet("grafbase_subgraph_routing_override.test", "id"),
+					resource.TestCheckResourceAttrSet("grafbase_subgraph_routing_override.test", "updated_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_subgraph_routing_override.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-account/test-graph/preview/products",
+			},
+			// Update in place
+			{
+				Config: testAccSubgraphRoutingOverrideResourceConfig("https://pr-2.products.example.com/graphql"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_subgraph_routing_override.test", "url", "https://pr-2.products.example.com/graphql"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSubgraphRoutingOverrideResource_Drift(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubgraphRoutingOverrideResourceConfig("https://pr-1.products.example.com/graphql"),
+			},
+			// Repoint the override outside Terraform; a refresh must report the drift
+			{
+				PreConfig: func() {
+					_, err := testAccClient(t).SetSubgraphRoutingOverride(context.Background(), client.SetSubgraphRoutingOverrideInput{
+						AccountSlug:  "test-account",
+						GraphSlug:    "test-graph",
+						BranchName:   "preview",
+						SubgraphName: "products",
+						URL:          "https://out-of-band.example.com/graphql",
+					})
+					if err != nil {
+						t.Fatalf("failed to change routing override out-of-band: %v", err)
+					}
+				},
+				Config:             testAccSubgraphRoutingOverrideResourceConfig("https://pr-1.products.example.com/graphql"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Remove the override outside Terraform; a refresh must plan to recreate it
+			{
+				PreConfig: func() {
+					err := testAccClient(t).DeleteSubgraphRoutingOverride(context.Background(), client.DeleteSubgraphRoutingOverrideInput{
+						AccountSlug:  "test-account",
+						GraphSlug:    "test-graph",
+						BranchName:   "preview",
+						SubgraphName: "products",
+					})
+					if err != nil {
+						t.Fatalf("failed to delete routing override out-of-band: %v", err)
+					}
+				},
+				Config:             testAccSubgraphRoutingOverrideResourceConfig("https://pr-1.products.example.com/graphql"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccSubgraphRoutingOverrideResourceConfig(url string) string {
+	return fmt.Sprintf(`
+resource "grafbase_graph" "test" {
+  account_slug = "test-account"
+  slug         = "test-graph"
+}
+
+resource "grafbase_branch" "preview" {
+  account_slug = grafbase_graph.test.account_slug
+  graph_slug   = grafbase_graph.test.slug
+  name         = "preview"
+}
+
+resource "grafbase_subgraph_routing_override" "test" {
+  account_slug  = grafbase_branch.preview.account_slug
+  graph_slug    = grafbase_branch.preview.graph_slug
+  branch_name   = grafbase_branch.preview.name
+  subgraph_name = "products"
+  url           = %[1]q
+}
+`, url)
+}
+
+func TestSubgraphRoutingOverrideResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewSubgraphRoutingOverrideResource)
+
+	server.Handle("SetSubgraphRoutingOverride", `{"subgraphRoutingOverrideSet": {"__typename": "SubgraphRoutingOverrideSetSuccess", "routingOverride": {"id": "override-1", "subgraphName": "products", "url": "https://products.example.com", "updatedAt": "2024-01-15T10:30:00Z"}}}`)
+	server.Handle("GetSubgraphRoutingOverride", `{"branch": {"subgraphRoutingOverride": {"id": "override-1", "subgraphName": "products", "url": "https://products.example.com", "updatedAt": "2024-01-15T10:30:00Z"}}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug":  types.StringValue("my-account"),
+		"graph_slug":    types.StringValue("my-graph"),
+		"branch_name":   types.StringValue("main"),
+		"subgraph_name": types.StringValue("products"),
+		"url":           types.StringValue("https://products.example.com"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "override-1" {
+		t.Errorf("expected id override-1, got %q", got)
+	}
+
+	server.Handle("ListSubgraphRoutingOverrides", `{"branch": {"subgraphRoutingOverrides": [{"id": "override-1", "subgraphName": "products", "url": "https://products.internal", "updatedAt": "2024-01-16T10:30:00Z"}]}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "url"); got != "https://products.internal" {
+		t.Errorf("expected URL changed outside of Terraform to be read, got %q", got)
+	}
+
+	server.Handle("SetSubgraphRoutingOverride", `{"subgraphRoutingOverrideSet": {"__typename": "SubgraphRoutingOverrideSetSuccess", "routingOverride": {"id": "override-1", "subgraphName": "products", "url": "https://products-v2.example.com", "updatedAt": "2024-01-17T10:30:00Z"}}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"url": types.StringValue("https://products-v2.example.com"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "updated_at"); got != "2024-01-17T10:30:00Z" {
+		t.Errorf("unexpected updated_at %q", got)
+	}
+
+	server.Handle("DeleteSubgraphRoutingOverride", `{"subgraphRoutingOverrideDelete": {"__typename": "SubgraphRoutingOverrideDoesNotExistError"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	// Deleting invalidates the cached overrides of the branch
+	server.Handle("ListSubgraphRoutingOverrides", `{"branch": {"subgraphRoutingOverrides": []}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected routing override to be removed from state")
+	}
+}
+
+func TestSubgraphRoutingOverrideResourceCreateWaitsUntilVisible(t *testing.T) {
+	r, server := newMockResource(t, NewSubgraphRoutingOverrideResource)
+	attributes := map[string]attr.Value{
+		"account_slug":  types.StringValue("my-account"),
+		"graph_slug":    types.StringValue("my-graph"),
+		"branch_name":   types.StringValue("main"),
+		"subgraph_name": types.StringValue("products"),
+		"url":           types.StringValue("https://products.example.com"),
+	}
+
+	server.Handle("SetSubgraphRoutingOverride", `{"subgraphRoutingOverrideSet": {"__typename": "SubgraphRoutingOverrideSetSuccess", "routingOverride": {"id": "override-1", "subgraphName": "products", "url": "https://products.example.com", "updatedAt": "2024-01-15T10:30:00Z"}}}`)
+
+	// The new override is not found until the second read
+	reads := 0
+	server.HandleFunc("GetSubgraphRoutingOverride", func(mockgraphql.Request) mockgraphql.Response {
+		reads++
+		if reads == 1 {
+			return mockgraphql.Response{Data: `{"branch": {"subgraphRoutingOverride": null}}`}
+		}
+		return mockgraphql.Response{Data: `{"branch": {"subgraphRoutingOverride": {"id": "override-1", "subgraphName": "products", "url": "https://products.example.com", "updatedAt": "2024-01-15T10:30:00Z"}}}`}
+	})
+
+	_, diags := createResource(t, r, attributes)
+	requireNoDiagnostics(t, diags)
+	if got := len(server.Requests("GetSubgraphRoutingOverride")); got != 2 {
+		t.Errorf("expected the override to be read until visible, got %d reads", got)
+	}
+
+	// An override that never becomes visible fails once the create timeout expires, but is kept in state
+	server.Handle("GetSubgraphRoutingOverride", `{"branch": {"subgraphRoutingOverride": null}}`)
+	attributes["timeouts"] = types.ObjectValueMust(
+		map[string]attr.Type{"create": types.StringType, "update": types.StringType},
+		map[string]attr.Value{"create": types.StringValue("100ms"), "update": types.StringNull()},
+	)
+	state, diags := createResource(t, r, attributes)
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), "routing override not found") {
+		t.Errorf("expected the create to time out waiting for the override, got %v", diags)
+	}
+	if got := stateString(t, state, "id"); got != "override-1" {
+		t.Errorf("expected the created override to be saved, got id %q", got)
+	}
+}
+
+func TestSubgraphRoutingOverrideResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewSubgraphRoutingOverrideResource)
+
+	server.Handle("GetSubgraphRoutingOverride", `{"branch": {"subgraphRoutingOverride": {"id": "override-1", "subgraphName": "products", "url": "https://products.example.com", "updatedAt": "2024-01-15T10:30:00Z"}}}`)
+	state, diags := importResource(t, r, "my-account/my-graph/feature/login/products")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "branch_name"); got != "feature/login" {
+		t.Errorf("expected branch name with slash, got %q", got)
+	}
+	if got := stateString(t, state, "subgraph_name"); got != "products" {
+		t.Errorf("expected subgraph products, got %q", got)
+	}
+}
+
+func TestSubgraphRoutingOverrideResourceWaitForComposition(t *testing.T) {
+	r, server := newMockResource(t, NewSubgraphRoutingOverrideResource)
+	attributes := map[string]attr.Value{
+		"account_slug":         types.StringValue("my-account"),
+		"graph_slug":           types.StringValue("my-graph"),
+		"branch_name":          types.StringValue("main"),
+		"subgraph_name":        types.StringValue("products"),
+		"url":                  types.StringValue("https://products.example.com"),
+		"wait_for_composition": types.BoolValue(true),
+	}
+
+	server.Handle("SetSubgraphRoutingOverride", `{"subgraphRoutingOverrideSet": {"__typename": "SubgraphRoutingOverrideSetSuccess", "routingOverride": {"id": "override-1", "subgraphName": "products", "url": "https://products.example.com", "updatedAt": "2024-01-15T10:30:00Z"}}}`)
+	server.Handle("GetLatestBranchComposition", `{"branch": {"latestComposition": {"id": "composition-1", "status": "SUCCEEDED", "errors": [], "createdAt": "2024-01-15T10:30:01Z"}}}`)
+	server.Handle("GetSubgraphRoutingOverride", `{"branch": {"subgraphRoutingOverride": {"id": "override-1", "subgraphName": "products", "url": "https://products.example.com", "updatedAt": "2024-01-15T10:30:00Z"}}}`)
+	state, diags := createResource(t, r, attributes)
+	requireNoDiagnostics(t, diags)
+	if got := len(server.Requests("GetLatestBranchComposition")); got != 1 {
+		t.Errorf("expected a single composition request, got %d", got)
+	}
+
+	// Composition errors are reported as diagnostics, and the override stays tracked
+	server.Handle("GetLatestBranchComposition", `{"branch": {"latestComposition": {"id": "composition-2", "status": "FAILED", "errors": [
+		{"message": "field Product.price has conflicting types"},
+		{"message": "subgraph products is unreachable"}
+	], "createdAt": "2024-01-15T10:30:01Z"}}}`)
+	state, diags = createResource(t, r, attributes)
+	if diags.ErrorsCount() != 2 || diags.Errors()[0].Detail() != "field Product.price has conflicting types" {
+		t.Errorf("expected the composition errors as diagnostics, got %v", diags)
+	}
+	if got := stateString(t, state, "id"); got != "override-1" {
+		t.Errorf("expected the override to be saved before waiting, got id %q", got)
+	}
+
+	server.Handle("GetLatestBranchComposition", `{"branch": null}`)
+	if _, diags = createResource(t, r, attributes); !diags.HasError() {
+		t.Error("expected an error when the branch composition cannot be read")
+	}
+}
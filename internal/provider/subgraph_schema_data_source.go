@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SubgraphSchemaDataSource{}
+
+func NewSubgraphSchemaDataSource() datasource.DataSource {
+	return &SubgraphSchemaDataSource{}
+}
+
+// SubgraphSchemaDataSource defines the data source implementation.
+type SubgraphSchemaDataSource struct {
+	client client.API
+}
+
+// SubgraphSchemaDataSourceModel describes the data source data model.
+type SubgraphSchemaDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	AccountSlug  types.String `tfsdk:"account_slug"`
+	GraphSlug    types.String `tfsdk:"graph_slug"`
+	BranchName   types.String `tfsdk:"branch_name"`
+	SubgraphName types.String `tfsdk:"subgraph_name"`
+	SDL          types.String `tfsdk:"sdl"`
+	SDLSHA256    types.String `tfsdk:"sdl_sha256"`
+	URL          types.String `tfsdk:"url"`
+	PublishedAt  RFC3339Value `tfsdk:"published_at"`
+}
+
+func (d *SubgraphSchemaDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subgraph_schema"
+}
+
+func (d *SubgraphSchemaDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Fetches the currently published SDL of a subgraph on a branch, for example to detect drift " +
+			"against the schema files committed to a repository.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the format `account_slug/graph_slug/branch_name/subgraph_name`",
+				Computed:            true,
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Required:            true,
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch the subgraph is published to",
+				Required:            true,
+			},
+			"subgraph_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the subgraph",
+				Required:            true,
+			},
+			"sdl": schema.StringAttribute{
+				MarkdownDescription: "Published subgraph SDL",
+				Computed:            true,
+			},
+			"sdl_sha256": schema.StringAttribute{
+				MarkdownDescription: "Hex-encoded SHA-256 checksum of the published SDL, comparable with `filesha256()`",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL the gateway routes subgraph requests to",
+				Computed:            true,
+			},
+			"published_at": schema.StringAttribute{
+				CustomType:          RFC3339Type{},
+				MarkdownDescription: "Timestamp the schema was published",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SubgraphSchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SubgraphSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SubgraphSchemaDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subgraph, err := d.client.GetSubgraphSchema(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString(), data.SubgraphName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read subgraph schema: %s", err))
+		return
+	}
+
+	checksum := sha256.Sum256([]byte(subgraph.Schema))
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s/%s", data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString(), data.SubgraphName.ValueString()))
+	data.SDL = types.StringValue(subgraph.Schema)
+	data.SDLSHA256 = types.StringValue(hex.EncodeToString(checksum[:]))
+	data.URL = types.StringValue(subgraph.URL)
+	data.PublishedAt = NewRFC3339Value(subgraph.PublishedAt)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSubgraphSchemaDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubgraphSchemaDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafbase_subgraph_schema.test", "id", "test-account/test-graph/main/products"),
+					resource.TestCheckResourceAttrSet("data.grafbase_subgraph_schema.test", "sdl"),
+					resource.TestCheckResourceAttrSet("data.grafbase_subgraph_schema.test", "sdl_sha256"),
+					resource.TestCheckResourceAttrSet("data.grafbase_subgraph_schema.test", "published_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSubgraphSchemaDataSourceConfig() string {
+	return `
+data "grafbase_subgraph_schema" "test" {
+  account_slug  = "test-account"
+  graph_slug    = "test-graph"
+  branch_name   = "main"
+  subgraph_name = "products"
+}
+`
+}
+
+func TestSubgraphSchemaDataSourceRead(t *testing.T) {
+	d, server := newMockDataSource(t, NewSubgraphSchemaDataSource)
+	attributes := map[string]attr.Value{
+		"account_slug":  types.StringValue("my-account"),
+		"graph_slug":    types.StringValue("my-graph"),
+		"branch_name":   types.StringValue("main"),
+		"subgraph_name": types.StringValue("products"),
+	}
+
+	server.Handle("GetSubgraphSchema", `{"branch": {"subgraph": {
+		"name": "products", "url": "https://products.example.com/graphql",
+		"schema": "type Query { hello: String }\n", "publishedAt": "2024-01-15T10:30:00Z"
+	}}}`)
+	state, diags := readDataSource(t, d, attributes)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "sdl"); got != "type Query { hello: String }\n" {
+		t.Errorf("unexpected sdl %q", got)
+	}
+	// Checksum of the SDL, as returned by filesha256() for the same file
+	if got := stateString(t, state, "sdl_sha256"); got != "6857b6d6b31b106e76fbdc47a590386ae89aeb43b0d0e82ca61fc4bde360485d" {
+		t.Errorf("unexpected sdl_sha256 %q", got)
+	}
+
+	server.Handle("GetSubgraphSchema", `{"branch": {"subgraph": null}}`)
+	if _, diags := readDataSource(t, d, attributes); !diags.HasError() {
+		t.Error("expected missing subgraph to be an error")
+	}
+}
@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SubgraphSDLDiffDataSource{}
+
+func NewSubgraphSDLDiffDataSource() datasource.DataSource {
+	return &SubgraphSDLDiffDataSource{}
+}
+
+// SubgraphSDLDiffDataSource defines the data source implementation.
+type SubgraphSDLDiffDataSource struct {
+	client client.API
+}
+
+// SubgraphSDLDiffDataSourceModel describes the data source data model.
+type SubgraphSDLDiffDataSourceModel struct {
+	ID                 types.String        `tfsdk:"id"`
+	AccountSlug        types.String        `tfsdk:"account_slug"`
+	GraphSlug          types.String        `tfsdk:"graph_slug"`
+	BranchName         types.String        `tfsdk:"branch_name"`
+	SubgraphName       types.String        `tfsdk:"subgraph_name"`
+	Schema             types.String        `tfsdk:"schema"`
+	HasChanges         types.Bool          `tfsdk:"has_changes"`
+	HasBreakingChanges types.Bool          `tfsdk:"has_breaking_changes"`
+	Changes            []SchemaChangeModel `tfsdk:"changes"`
+	AddedTypes         []types.String      `tfsdk:"added_types"`
+	RemovedTypes       []types.String      `tfsdk:"removed_types"`
+	ChangedTypes       []types.String      `tfsdk:"changed_types"`
+	AddedFields        []types.String      `tfsdk:"added_fields"`
+	RemovedFields      []types.String      `tfsdk:"removed_fields"`
+	ChangedFields      []types.String      `tfsdk:"changed_fields"`
+	Summary            types.String        `tfsdk:"summary"`
+}
+
+// SchemaChangeModel describes a single schema change.
+type SchemaChangeModel struct {
+	Kind     types.String `tfsdk:"kind"`
+	Path     types.String `tfsdk:"path"`
+	Message  types.String `tfsdk:"message"`
+	Breaking types.Bool   `tfsdk:"breaking"`
+}
+
+func (d *SubgraphSDLDiffDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subgraph_sdl_diff"
+}
+
+func (d *SubgraphSDLDiffDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	pathList := func(description string) schema.ListAttribute {
+		return schema.ListAttribute{
+			MarkdownDescription: description,
+			ElementType:         types.StringType,
+			Computed:            true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Compares a subgraph SDL against the schema published on a branch and returns the structured " +
+			"diff, for example to post a summary of schema changes from CI during `terraform plan`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the format `account_slug/graph_slug/branch_name/subgraph_name`",
+				Computed:            true,
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Required:            true,
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch whose published subgraph schema is compared against",
+				Required:            true,
+			},
+			"subgraph_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the subgraph the SDL belongs to. A subgraph that is not published yet is compared against an empty schema.",
+				Required:            true,
+			},
+			"schema": schema.StringAttribute{
+				MarkdownDescription: "Local subgraph SDL, for example read with `file()`",
+				Required:            true,
+			},
+			"has_changes": schema.BoolAttribute{
+				MarkdownDescription: "Whether the SDL differs from the published schema",
+				Computed:            true,
+			},
+			"has_breaking_changes": schema.BoolAttribute{
+				MarkdownDescription: "Whether any of the changes is breaking",
+				Computed:            true,
+			},
+			"changes": schema.ListNestedAttribute{
+				MarkdownDescription: "All changes, in the order reported by the API",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "Kind of change, such as `FIELD_REMOVED` or `TYPE_ADDED`",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Schema coordinate of the changed element, such as `User` or `User.email`",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Human-readable description of the change",
+							Computed:            true,
+						},
+						"breaking": schema.BoolAttribute{
+							MarkdownDescription: "Whether the change is breaking",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"added_types":    pathList("Types added by the SDL"),
+			"removed_types":  pathList("Types removed by the SDL"),
+			"changed_types":  pathList("Types changed by the SDL, such as a changed kind or directive"),
+			"added_fields":   pathList("Fields added by the SDL, as `Type.field` coordinates"),
+			"removed_fields": pathList("Fields removed by the SDL, as `Type.field` coordinates"),
+			"changed_fields": pathList("Fields changed by the SDL, such as a changed type or argument, as `Type.field` coordinates"),
+			"summary": schema.StringAttribute{
+				MarkdownDescription: "Markdown summary of the changes, suitable for a pull request comment",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SubgraphSDLDiffDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SubgraphSDLDiffDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SubgraphSDLDiffDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changes, err := d.client.DiffSubgraphSchema(
+		ctx,
+		data.AccountSlug.ValueString(),
+		data.GraphSlug.ValueString(),
+		data.BranchName.ValueString(),
+		data.SubgraphName.ValueString(),
+		data.Schema.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to diff subgraph schema: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s/%s", data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString(), data.SubgraphName.ValueString()))
+	data.fromChanges(changes)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fromChanges maps the API schema changes onto the model, grouping the
+// changed coordinates by element and action.
+func (m *SubgraphSDLDiffDataSourceModel) fromChanges(changes []client.SchemaChange) {
+	m.Changes = make([]SchemaChangeModel, 0, len(changes))
+	m.AddedTypes = []types.String{}
+	m.RemovedTypes = []types.String{}
+	m.ChangedTypes = []types.String{}
+	m.AddedFields = []types.String{}
+	m.RemovedFields = []types.String{}
+	m.ChangedFields = []types.String{}
+
+	hasBreakingChanges := false
+	for _, change := range changes {
+		m.Changes = append(m.Changes, SchemaChangeModel{
+			Kind:     types.StringValue(change.Kind),
+			Path:     types.StringValue(change.Path),
+			Message:  types.StringValue(change.Message),
+			Breaking: types.BoolValue(change.Breaking),
+		})
+		hasBreakingChanges = hasBreakingChanges || change.Breaking
+
+		isField, action := classifySchemaChange(change)
+		path := types.StringValue(change.Path)
+
+		switch {
+		case !isField && action == "added":
+			m.AddedTypes = append(m.AddedTypes, path)
+		case !isField && action == "removed":
+			m.RemovedTypes = append(m.RemovedTypes, path)
+		case !isField:
+			m.ChangedTypes = appendUniquePath(m.ChangedTypes, path)
+		case action == "added":
+			m.AddedFields = append(m.AddedFields, path)
+		case action == "removed":
+			m.RemovedFields = append(m.RemovedFields, path)
+		default:
+			m.ChangedFields = appendUniquePath(m.ChangedFields, path)
+		}
+	}
+
+	m.HasChanges = types.BoolValue(len(changes) > 0)
+	m.HasBreakingChanges = types.BoolValue(hasBreakingChanges)
+	m.Summary = types.StringValue(schemaChangeSummary(changes))
+}
+
+// classifySchemaChange reports whether a change applies to a field rather than
+// a type, and whether the element was "added", "removed", or "changed".
+// Coordinates of fields and their arguments contain a dot, such as
+// `User.email` or `Query.user.id`; arguments count as changes of their field.
+func classifySchemaChange(change client.SchemaChange) (bool, string) {
+	segments := strings.Split(change.Path, ".")
+	isField := len(segments) > 1
+	isArgument := len(segments) > 2
+
+	action := "changed"
+	if !isArgument && strings.HasSuffix(change.Kind, "_ADDED") {
+		action = "added"
+	} else if !isArgument && strings.HasSuffix(change.Kind, "_REMOVED") {
+		action = "removed"
+	}
+
+	return isField, action
+}
+
+// appendUniquePath appends the coordinate of a field or argument change,
+// reducing argument coordinates to their field and skipping duplicates.
+func appendUniquePath(paths []types.String, path types.String) []types.String {
+	segments := strings.SplitN(path.ValueString(), ".", 3)
+	if len(segments) == 3 {
+		path = types.StringValue(segments[0] + "." + segments[1])
+	}
+
+	for _, existing := range paths {
+		if existing.Equal(path) {
+			return paths
+		}
+	}
+
+	return append(paths, path)
+}
+
+// schemaChangeSummary renders the changes as a Markdown list, breaking changes first.
+func schemaChangeSummary(changes []client.SchemaChange) string {
+	if len(changes) == 0 {
+		return "No schema changes."
+	}
+
+	var breaking, safe []string
+	for _, change := range changes {
+		if change.Breaking {
+			breaking = append(breaking, fmt.Sprintf("- **Breaking:** %s", change.Message))
+		} else {
+			safe = append(safe, fmt.Sprintf("- %s", change.Message))
+		}
+	}
+
+	noun := "changes"
+	if len(changes) == 1 {
+		noun = "change"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d schema %s, %d breaking:\n", len(changes), noun, len(breaking))
+	for _, line := range append(breaking, safe...) {
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
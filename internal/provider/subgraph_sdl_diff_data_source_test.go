@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSubgraphSDLDiffDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Adding a field is a safe change
+			{
+				Config: testAccSubgraphSDLDiffDataSourceConfig("type Query { product(id: ID!): Product, products: [Product!]! } type Product { id: ID! name: String! }"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafbase_subgraph_sdl_diff.test", "id", "test-account/test-graph/main/products"),
+					resource.TestCheckResourceAttr("data.grafbase_subgraph_sdl_diff.test", "has_breaking_changes", "false"),
+					resource.TestCheckResourceAttrSet("data.grafbase_subgraph_sdl_diff.test", "summary"),
+				),
+			},
+			// Removing every field is breaking
+			{
+				Config: testAccSubgraphSDLDiffDataSourceConfig("type Query { health: Boolean }"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.grafbase_subgraph_sdl_diff.test", "has_changes", "true"),
+					resource.TestCheckResourceAttr("data.grafbase_subgraph_sdl_diff.test", "has_breaking_changes", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSubgraphSDLDiffDataSourceConfig(schema string) string {
+	return fmt.Sprintf(`
+data "grafbase_subgraph_sdl_diff" "test" {
+  account_slug  = "test-account"
+  graph_slug    = "test-graph"
+  branch_name   = "main"
+  subgraph_name = "products"
+  schema        = %[1]q
+}
+`, schema)
+}
+
+func TestSubgraphSDLDiffDataSourceRead(t *testing.T) {
+	d, server := newMockDataSource(t, NewSubgraphSDLDiffDataSource)
+	ctx := context.Background()
+
+	server.Handle("DiffSubgraphSchema", `{"branch": {"subgraphSchemaDiff": [
+		{"kind": "TYPE_ADDED", "path": "Review", "message": "Type Review was added", "breaking": false},
+		{"kind": "FIELD_ADDED", "path": "Product.reviews", "message": "Field Product.reviews was added", "breaking": false},
+		{"kind": "FIELD_REMOVED", "path": "Product.legacyId", "message": "Field Product.legacyId was removed", "breaking": true},
+		{"kind": "ARGUMENT_ADDED", "path": "Query.products.first", "message": "Argument first was added to Query.products", "breaking": false},
+		{"kind": "ARGUMENT_ADDED", "path": "Query.products.after", "message": "Argument after was added to Query.products", "breaking": false}
+	]}}`)
+	state, diags := readDataSource(t, d, map[string]attr.Value{
+		"account_slug":  types.StringValue("my-account"),
+		"graph_slug":    types.StringValue("my-graph"),
+		"branch_name":   types.StringValue("main"),
+		"subgraph_name": types.StringValue("products"),
+		"schema":        types.StringValue("type Query { products(first: Int, after: String): [Product!]! }"),
+	})
+	requireNoDiagnostics(t, diags)
+
+	var data SubgraphSDLDiffDataSourceModel
+	requireNoDiagnostics(t, state.Get(ctx, &data))
+	if !data.HasChanges.ValueBool() || !data.HasBreakingChanges.ValueBool() {
+		t.Errorf("expected breaking changes, got has_changes=%s has_breaking_changes=%s", data.HasChanges, data.HasBreakingChanges)
+	}
+	if len(data.Changes) != 5 {
+		t.Errorf("expected 5 changes, got %d", len(data.Changes))
+	}
+	if len(data.AddedTypes) != 1 || data.AddedTypes[0].ValueString() != "Review" {
+		t.Errorf("unexpected added types: %v", data.AddedTypes)
+	}
+	if len(data.RemovedFields) != 1 || data.RemovedFields[0].ValueString() != "Product.legacyId" {
+		t.Errorf("unexpected removed fields: %v", data.RemovedFields)
+	}
+	// Both argument changes are reported once for their field
+	if len(data.ChangedFields) != 1 || data.ChangedFields[0].ValueString() != "Query.products" {
+		t.Errorf("unexpected changed fields: %v", data.ChangedFields)
+	}
+
+	var removedTypes []string
+	requireNoDiagnostics(t, state.GetAttribute(ctx, path.Root("removed_types"), &removedTypes))
+	if removedTypes == nil || len(removedTypes) != 0 {
+		t.Errorf("expected an empty list of removed types, got %v", removedTypes)
+	}
+
+	server.Handle("DiffSubgraphSchema", `{"branch": null}`)
+	if _, diags := readDataSource(t, d, map[string]attr.Value{
+		"account_slug":  types.StringValue("my-account"),
+		"graph_slug":    types.StringValue("my-graph"),
+		"branch_name":   types.StringValue("missing"),
+		"subgraph_name": types.StringValue("products"),
+		"schema":        types.StringValue("type Query { health: Boolean }"),
+	}); !diags.HasError() {
+		t.Error("expected a missing branch to be an error")
+	}
+}
@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/grafbase/terraform-provider-grafbase/internal/mockgraphql"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// sweepPrefix is the prefix of the graph slugs, branch names, and subgraph
+// names created by acceptance tests. Sweepers delete everything carrying it.
+const sweepPrefix = "test-"
+
+// TestMain runs the sweepers instead of the tests when -sweep is set, for
+// example: go test ./internal/provider -v -sweep=<account_slug>
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("grafbase_graph", &resource.Sweeper{
+		Name:         "grafbase_graph",
+		F:            sweepGraphs,
+		Dependencies: []string{"grafbase_branch"},
+	})
+	resource.AddTestSweepers("grafbase_branch", &resource.Sweeper{
+		Name:         "grafbase_branch",
+		F:            sweepBranches,
+		Dependencies: []string{"grafbase_subgraph"},
+	})
+	resource.AddTestSweepers("grafbase_subgraph", &resource.Sweeper{
+		Name: "grafbase_subgraph",
+		F:    sweepSubgraphs,
+	})
+}
+
+// sweeperClient returns an API client for the sweepers. The -sweep value names
+// the account to clean up.
+func sweeperClient(accountSlug string) (*client.Client, error) {
+	apiKey := os.Getenv("GRAFBASE_API_KEY")
+	if apiKey == "" {
+		return nil, fmt.Errorf("GRAFBASE_API_KEY must be set for sweepers")
+	}
+	if accountSlug == "" {
+		return nil, fmt.Errorf("the -sweep flag must be set to the slug of the account to sweep")
+	}
+
+	return client.NewClient(apiKey), nil
+}
+
+func sweepGraphs(accountSlug string) error {
+	c, err := sweeperClient(accountSlug)
+	if err != nil {
+		return err
+	}
+
+	return sweepAccountGraphs(context.Background(), c, accountSlug)
+}
+
+func sweepBranches(accountSlug string) error {
+	c, err := sweeperClient(accountSlug)
+	if err != nil {
+		return err
+	}
+
+	return sweepAccountBranches(context.Background(), c, accountSlug)
+}
+
+func sweepSubgraphs(accountSlug string) error {
+	c, err := sweeperClient(accountSlug)
+	if err != nil {
+		return err
+	}
+
+	return sweepAccountSubgraphs(context.Background(), c, accountSlug)
+}
+
+// sweepAccountGraphs deletes the graphs of the account whose slug carries the
+// sweep prefix, along with all of their branches and subgraphs.
+func sweepAccountGraphs(ctx context.Context, c *client.Client, accountSlug string) error {
+	graphs, err := c.ListGraphs(ctx, accountSlug)
+	if err != nil {
+		return fmt.Errorf("unable to list graphs: %w", err)
+	}
+
+	var errs []error
+	for _, graph := range graphs {
+		if !strings.HasPrefix(graph.Slug, sweepPrefix) {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting graph %s/%s", accountSlug, graph.Slug)
+		if err := c.DeleteGraph(ctx, graph.ID); err != nil && !strings.Contains(err.Error(), "does not exist") {
+			errs = append(errs, fmt.Errorf("unable to delete graph %s: %w", graph.Slug, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sweepAccountBranches deletes the branches carrying the sweep prefix from
+// every graph of the account.
+func sweepAccountBranches(ctx context.Context, c *client.Client, accountSlug string) error {
+	graphs, err := c.ListGraphs(ctx, accountSlug)
+	if err != nil {
+		return fmt.Errorf("unable to list graphs: %w", err)
+	}
+
+	var errs []error
+	for _, graph := range graphs {
+		branches, err := c.ListBranches(ctx, accountSlug, graph.Slug)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to list branches of graph %s: %w", graph.Slug, err))
+			continue
+		}
+
+		for _, branch := range branches {
+			if !strings.HasPrefix(branch.Name, sweepPrefix) {
+				continue
+			}
+
+			log.Printf("[INFO] Deleting branch %s/%s/%s", accountSlug, graph.Slug, branch.Name)
+			err := c.DeleteBranch(ctx, client.DeleteBranchInput{
+				AccountSlug: accountSlug,
+				GraphSlug:   graph.Slug,
+				BranchName:  branch.Name,
+			})
+			if err != nil && !strings.Contains(err.Error(), "does not exist") {
+				errs = append(errs, fmt.Errorf("unable to delete branch %s of graph %s: %w", branch.Name, graph.Slug, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sweepAccountSubgraphs deletes the subgraphs carrying the sweep prefix from
+// every branch of every graph of the account.
+func sweepAccountSubgraphs(ctx context.Context, c *client.Client, accountSlug string) error {
+	graphs, err := c.ListGraphs(ctx, accountSlug)
+	if err != nil {
+		return fmt.Errorf("unable to list graphs: %w", err)
+	}
+
+	var errs []error
+	for _, graph := range graphs {
+		branches, err := c.ListBranches(ctx, accountSlug, graph.Slug)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to list branches of graph %s: %w", graph.Slug, err))
+			continue
+		}
+
+		for _, branch := range branches {
+			subgraphs, err := c.ListSubgraphs(ctx, accountSlug, graph.Slug, branch.Name)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("unable to list subgraphs of branch %s of graph %s: %w", branch.Name, graph.Slug, err))
+				continue
+			}
+
+			for _, subgraph := range subgraphs {
+				if !strings.HasPrefix(subgraph.Name, sweepPrefix) {
+					continue
+				}
+
+				log.Printf("[INFO] Deleting subgraph %s/%s/%s/%s", accountSlug, graph.Slug, branch.Name, subgraph.Name)
+				err := c.DeleteSubgraph(ctx, client.DeleteSubgraphInput{
+					AccountSlug:  accountSlug,
+					GraphSlug:    graph.Slug,
+					BranchName:   branch.Name,
+					SubgraphName: subgraph.Name,
+				})
+				if err != nil && !strings.Contains(err.Error(), "does not exist") {
+					errs = append(errs, fmt.Errorf("unable to delete subgraph %s of branch %s of graph %s: %w", subgraph.Name, branch.Name, graph.Slug, err))
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func TestSweepAccountGraphs(t *testing.T) {
+	server := mockgraphql.NewServer(t)
+	c := client.NewClient("test-api-key", client.WithAPIURL(server.URL))
+
+	server.Handle("ListGraphs", `{"accountBySlug": {"graphs": {
+		"edges": [{"node": {"id": "graph-1", "slug": "test-graph"}}, {"node": {"id": "graph-2", "slug": "production"}}],
+		"pageInfo": {"hasNextPage": false, "endCursor": null}
+	}}}`)
+	server.Handle("DeleteGraph", `{"graphDelete": {"__typename": "GraphDeleteSuccess", "deletedId": "graph-1"}}`)
+	if err := sweepAccountGraphs(context.Background(), c, "my-account"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	requests := server.Requests("DeleteGraph")
+	if len(requests) != 1 {
+		t.Fatalf("expected only the prefixed graph to be deleted, got %d deletions", len(requests))
+	}
+	if got := requests[0].Variables["input"].(map[string]interface{})["id"]; got != "graph-1" {
+		t.Errorf("expected graph-1 to be deleted, got %v", got)
+	}
+}
+
+func TestSweepAccountSubgraphs(t *testing.T) {
+	server := mockgraphql.NewServer(t)
+	c := client.NewClient("test-api-key", client.WithAPIURL(server.URL))
+
+	server.Handle("ListGraphs", `{"accountBySlug": {"graphs": {
+		"edges": [{"node": {"id": "graph-1", "slug": "production"}}],
+		"pageInfo": {"hasNextPage": false, "endCursor": null}
+	}}}`)
+	server.Handle("ListBranches", `{"graphByAccountSlug": {"branches": {
+		"edges": [{"node": {"name": "main"}}],
+		"pageInfo": {"hasNextPage": false, "endCursor": null}
+	}}}`)
+	server.Handle("ListSubgraphs", `{"branch": {"subgraphs": {
+		"edges": [{"node": {"name": "test-products"}}, {"node": {"name": "reviews"}}],
+		"pageInfo": {"hasNextPage": false, "endCursor": null}
+	}}}`)
+	server.Handle("DeleteSubgraph", `{"subgraphDelete": {"__typename": "SubgraphDoesNotExistError"}}`)
+	if err := sweepAccountSubgraphs(context.Background(), c, "my-account"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var input client.DeleteSubgraphInput
+	if err := server.LastRequest("DeleteSubgraph").Input(&input); err != nil {
+		t.Fatalf("unable to decode input: %s", err)
+	}
+	if len(server.Requests("DeleteSubgraph")) != 1 || input.SubgraphName != "test-products" || input.BranchName != "main" {
+		t.Errorf("expected only test-products to be deleted, got %+v", input)
+	}
+}
@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TeamMemberResource{}
+var _ resource.ResourceWithImportState = &TeamMemberResource{}
+
+func NewTeamMemberResource() resource.Resource {
+	return &TeamMemberResource{}
+}
+
+// TeamMemberResource defines the resource implementation.
+type TeamMemberResource struct {
+	client client.API
+}
+
+// TeamMemberResourceModel describes the resource data model.
+type TeamMemberResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	AccountSlug types.String `tfsdk:"account_slug"`
+	TeamSlug    types.String `tfsdk:"team_slug"`
+	Email       types.String `tfsdk:"email"`
+	Role        types.String `tfsdk:"role"`
+}
+
+func (r *TeamMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_member"
+}
+
+func (r *TeamMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the membership of an account member in a team. Destroying the resource removes the member " +
+			"from the team only; they stay a member of the account.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Team member identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug the team belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_slug": schema.StringAttribute{
+				MarkdownDescription: "Slug of the team",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the account member added to the team",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role of the member in the team: `MAINTAINER` or `MEMBER`. Maintainers can manage the members of the team.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.TeamMemberRoleMaintainer),
+						string(client.TeamMemberRoleMember),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TeamMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamMemberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addInput := client.AddTeamMemberInput{
+		AccountSlug: data.AccountSlug.ValueString(),
+		TeamSlug:    data.TeamSlug.ValueString(),
+		Email:       data.Email.ValueString(),
+		Role:        client.TeamMemberRole(data.Role.ValueString()),
+	}
+
+	member, err := r.client.AddTeamMember(ctx, addInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add team member: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.fromTeamMember(member)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitUntilCreated(ctx, "team member", func(ctx context.Context) error {
+		_, err := r.client.GetTeamMember(ctx, data.AccountSlug.ValueString(), data.TeamSlug.ValueString(), data.Email.ValueString())
+		return err
+	}, "team member not found")...)
+}
+
+func (r *TeamMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.client.GetTeamMember(ctx, data.AccountSlug.ValueString(), data.TeamSlug.ValueString(), data.Email.ValueString())
+	if err != nil {
+		// If the member or its team is not found, remove it from state
+		if err.Error() == "team member not found" || err.Error() == "team not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team member: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	data.fromTeamMember(member)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TeamMemberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the role can change in place
+	updateInput := client.UpdateTeamMemberRoleInput{
+		ID:   data.ID.ValueString(),
+		Role: client.TeamMemberRole(data.Role.ValueString()),
+	}
+
+	member, err := r.client.UpdateTeamMemberRole(ctx, updateInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update team member role: %s", err))
+		return
+	}
+
+	data.fromTeamMember(member)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveTeamMember(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the member was already removed, there is nothing left to delete
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove team member: %s", err))
+		return
+	}
+}
+
+func (r *TeamMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/team_slug/email"
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/team_slug/email', got: %s", req.ID))
+		return
+	}
+
+	// Get the member to populate the remaining attributes
+	member, err := r.client.GetTeamMember(ctx, parts[0], parts[1], parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read team member during import: %s", err))
+		return
+	}
+
+	data := TeamMemberResourceModel{
+		AccountSlug: types.StringValue(parts[0]),
+		TeamSlug:    types.StringValue(parts[1]),
+		Email:       types.StringValue(parts[2]),
+	}
+	data.fromTeamMember(member)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fromTeamMember maps an API team member onto the model. The configured email
+// is kept as is, since the API may normalize its case.
+func (m *TeamMemberResourceModel) fromTeamMember(member *client.TeamMember) {
+	m.ID = types.StringValue(member.ID)
+	m.Role = types.StringValue(string(member.Role))
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamMemberResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamMemberResourceConfig("MEMBER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("grafbase_team_member.test", "id"),
+					resource.TestCheckResourceAttr("grafbase_team_member.test", "role", "MEMBER"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_team_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-account/terraform-acc/terraform-acc@example.com",
+			},
+			// Update the role in place
+			{
+				Config: testAccTeamMemberResourceConfig("MAINTAINER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_team_member.test", "role", "MAINTAINER"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamMemberResourceConfig(role string) string {
+	return fmt.Sprintf(`
+resource "grafbase_team" "test" {
+  account_slug = "test-account"
+  slug         = "terraform-acc"
+  name         = "Terraform acceptance tests"
+}
+
+resource "grafbase_team_member" "test" {
+  account_slug = grafbase_team.test.account_slug
+  team_slug    = grafbase_team.test.slug
+  email        = "terraform-acc@example.com"
+  role         = %[1]q
+}
+`, role)
+}
+
+func TestTeamMemberResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewTeamMemberResource)
+
+	server.Handle("AddTeamMember", `{"teamMemberAdd": {"__typename": "TeamMemberAddSuccess",
+		"member": {"id": "team-member-1", "email": "dev@example.com", "role": "MEMBER"}}}`)
+	server.Handle("ListTeamMembers", `{"teamBySlug": {"members": [{"id": "team-member-1", "email": "Dev@Example.com", "role": "MEMBER"}]}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"team_slug":    types.StringValue("platform"),
+		"email":        types.StringValue("dev@example.com"),
+		"role":         types.StringValue("MEMBER"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "team-member-1" {
+		t.Errorf("expected id team-member-1, got %q", got)
+	}
+
+	server.Handle("ListTeamMembers", `{"teamBySlug": {"members": [{"id": "team-member-1", "email": "Dev@Example.com", "role": "MEMBER"}]}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "email"); got != "dev@example.com" {
+		t.Errorf("expected configured email to be kept, got %q", got)
+	}
+
+	server.Handle("UpdateTeamMemberRole", `{"teamMemberRoleUpdate": {"__typename": "TeamMemberRoleUpdateSuccess",
+		"member": {"id": "team-member-1", "email": "dev@example.com", "role": "MAINTAINER"}}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"role": types.StringValue("MAINTAINER"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "role"); got != "MAINTAINER" {
+		t.Errorf("expected role MAINTAINER, got %q", got)
+	}
+
+	server.Handle("RemoveTeamMember", `{"teamMemberRemove": {"__typename": "TeamMemberRemoveSuccess"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	// Deleting the team removes its memberships with it
+	server.Handle("ListTeamMembers", `{"teamBySlug": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected team member to be removed from state")
+	}
+}
+
+func TestTeamMemberResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewTeamMemberResource)
+
+	server.Handle("ListTeamMembers", `{"teamBySlug": {"members": [{"id": "team-member-1", "email": "dev@example.com", "role": "MAINTAINER"}]}}`)
+	state, diags := importResource(t, r, "my-account/platform/dev@example.com")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "role"); got != "MAINTAINER" {
+		t.Errorf("expected role MAINTAINER, got %q", got)
+	}
+
+	if _, diags := importResource(t, r, "my-account/dev@example.com"); !diags.HasError() {
+		t.Error("expected invalid import ID to be an error")
+	}
+}
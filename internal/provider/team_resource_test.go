@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamResourceConfig("Platform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("grafbase_team.test", "id"),
+					resource.TestCheckResourceAttr("grafbase_team.test", "name", "Platform"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_team.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-account/terraform-acc",
+			},
+			// Rename in place
+			{
+				Config: testAccTeamResourceConfig("Platform Engineering"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_team.test", "name", "Platform Engineering"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "grafbase_team" "test" {
+  account_slug = "test-account"
+  slug         = "terraform-acc"
+  name         = %[1]q
+}
+`, name)
+}
+
+func TestTeamResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewTeamResource)
+
+	server.Handle("CreateTeam", `{"teamCreate": {"__typename": "TeamCreateSuccess",
+		"team": {"id": "team-1", "slug": "platform", "name": "Platform", "description": "Owns the gateway"}}}`)
+	server.Handle("GetTeam", `{"teamBySlug": {"id": "team-1", "slug": "platform", "name": "Platform", "description": "Owns the gateway"}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"slug":         types.StringValue("platform"),
+		"name":         types.StringValue("Platform"),
+		"description":  types.StringValue("Owns the gateway"),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "team-1" {
+		t.Errorf("expected id team-1, got %q", got)
+	}
+
+	server.Handle("UpdateTeam", `{"teamUpdate": {"__typename": "TeamUpdateSuccess",
+		"team": {"id": "team-1", "slug": "platform", "name": "Platform", "description": null}}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"description": types.StringNull(),
+	})
+	requireNoDiagnostics(t, diags)
+	var input map[string]interface{}
+	if err := server.LastRequest("UpdateTeam").Input(&input); err != nil {
+		t.Fatalf("failed to decode input: %v", err)
+	}
+	if input["id"] != "team-1" || input["description"] != nil {
+		t.Errorf("expected description of team-1 to be cleared, got %v", input)
+	}
+
+	server.Handle("DeleteTeam", `{"teamDelete": {"__typename": "TeamDoesNotExistError"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	server.Handle("GetTeam", `{"teamBySlug": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected team to be removed from state")
+	}
+}
+
+func TestTeamResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewTeamResource)
+
+	server.Handle("GetTeam", `{"teamBySlug": {"id": "team-1", "slug": "platform", "name": "Platform", "description": null}}`)
+	state, diags := importResource(t, r, "my-account/platform")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+
+	if _, diags := importResource(t, r, "platform"); !diags.HasError() {
+		t.Error("expected invalid import ID to be an error")
+	}
+}
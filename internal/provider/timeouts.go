@@ -0,0 +1,11 @@
+package provider
+
+import "time"
+
+// Default operation timeouts used when a resource's timeouts block leaves them unset.
+const (
+	defaultCreateTimeout = 10 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 10 * time.Minute
+	defaultDeleteTimeout = 10 * time.Minute
+)
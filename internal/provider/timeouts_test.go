@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/grafbase/terraform-provider-grafbase/internal/mockgraphql"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// timeoutsTransport answers queries with data and records the deadline of
+// mutations. Mutations fail right away, or once their context is done when
+// the transport blocks.
+type timeoutsTransport struct {
+	data     string
+	block    bool
+	deadline time.Time
+}
+
+func (t *timeoutsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body struct {
+		Variables map[string]interface{} `json:"variables"`
+	}
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	if _, ok := body.Variables["input"]; !ok {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(t.data)),
+			Request:    req,
+		}, nil
+	}
+
+	t.deadline, _ = req.Context().Deadline()
+	if t.block {
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	}
+	return nil, errors.New("mutation not available")
+}
+
+func TestProviderRequestTimeout(t *testing.T) {
+	server := mockgraphql.NewServer(t)
+	attributes := map[string]attr.Value{
+		"api_key": types.StringValue("test-api-key"),
+		"api_url": types.StringValue(server.URL),
+	}
+
+	server.HandleFunc("ValidateCredentials", func(req mockgraphql.Request) mockgraphql.Response {
+		time.Sleep(200 * time.Millisecond)
+		return mockgraphql.Response{Data: `{"viewer": {"id": "user-1"}}`}
+	})
+
+	// The timeout bounds each API request
+	attributes["request_timeout"] = types.StringValue("20ms")
+	resp := configureProvider(t, attributes)
+	if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != "Invalid Grafbase credentials" {
+		t.Errorf("expected the request to time out, got %v", resp.Diagnostics)
+	}
+
+	attributes["request_timeout"] = types.StringValue("1m")
+	requireNoDiagnostics(t, configureProvider(t, attributes).Diagnostics)
+
+	for _, value := range []string{"soon", "90", "0s", "-5s"} {
+		attributes["request_timeout"] = types.StringValue(value)
+		diags := configureProvider(t, attributes).Diagnostics
+		if !diags.HasError() || diags[0].Summary() != "Invalid request timeout" {
+			t.Errorf("expected request_timeout %q to be rejected, got %v", value, diags)
+			continue
+		}
+		if d, ok := diags[0].(diag.DiagnosticWithPath); !ok || !d.Path().Equal(path.Root("request_timeout")) {
+			t.Errorf("expected the error of %q to be attached to request_timeout, got %v", value, diags[0])
+		}
+	}
+}
+
+func TestGraphResourceCreateTimeout(t *testing.T) {
+	ctx := context.Background()
+	transport := &timeoutsTransport{data: `{"data": {"accountBySlug": {"id": "account-1", "slug": "my-account", "name": "My Account"}}}`}
+	r := &GraphResource{client: client.NewClient("test-api-key", client.WithTransport(transport), client.WithTimeout(time.Hour))}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	create := func(createTimeout string) diag.Diagnostics {
+		objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+		for name, value := range map[string]string{"account_slug": "my-account", "slug": "my-graph"} {
+			if diags := plan.SetAttribute(ctx, path.Root(name), types.StringValue(value)); diags.HasError() {
+				t.Fatalf("unable to set %s: %v", name, diags)
+			}
+		}
+		if createTimeout != "" {
+			if diags := plan.SetAttribute(ctx, path.Root("timeouts").AtName("create"), types.StringValue(createTimeout)); diags.HasError() {
+				t.Fatalf("unable to set the create timeout: %v", diags)
+			}
+		}
+
+		resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+		r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+		return resp.Diagnostics
+	}
+
+	// Without a timeouts block, the default create timeout applies
+	create("")
+	if remaining := time.Until(transport.deadline); remaining <= defaultCreateTimeout-time.Minute || remaining > defaultCreateTimeout {
+		t.Errorf("expected the default create timeout, got %s remaining", remaining)
+	}
+
+	// The configured timeout bounds the context of the client
+	create("2m")
+	if remaining := time.Until(transport.deadline); remaining <= time.Minute || remaining > 2*time.Minute {
+		t.Errorf("expected the configured create timeout, got %s remaining", remaining)
+	}
+
+	// Calls still running when the timeout expires are cancelled
+	transport.block = true
+	diags := create("10ms")
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), context.DeadlineExceeded.Error()) {
+		t.Errorf("expected the create to time out, got %v", diags)
+	}
+}
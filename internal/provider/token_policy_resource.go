@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TokenPolicyResource{}
+var _ resource.ResourceWithImportState = &TokenPolicyResource{}
+
+func NewTokenPolicyResource() resource.Resource {
+	return &TokenPolicyResource{}
+}
+
+// TokenPolicyResource defines the resource implementation.
+type TokenPolicyResource struct {
+	client client.API
+}
+
+// TokenPolicyResourceModel describes the resource data model.
+type TokenPolicyResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	AccountSlug           types.String `tfsdk:"account_slug"`
+	MaxLifetimeDays       types.Int64  `tfsdk:"max_lifetime_days"`
+	AllowedScopes         types.Set    `tfsdk:"allowed_scopes"`
+	PersonalTokensAllowed types.Bool   `tfsdk:"personal_tokens_allowed"`
+}
+
+func (r *TokenPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_token_policy"
+}
+
+func (r *TokenPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the policy for access tokens created in an account: their maximum lifetime, the scopes " +
+			"they may be granted, and whether members may create personal tokens. Destroying the resource removes all restrictions.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier, equal to the account slug",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug the policy applies to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_lifetime_days": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of days a token is valid. When unset, tokens may be created without expiry.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"allowed_scopes": schema.SetAttribute{
+				MarkdownDescription: "Scopes tokens may be granted. When unset, every scope is allowed.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"personal_tokens_allowed": schema.BoolAttribute{
+				MarkdownDescription: "Whether members may create personal access tokens for the account. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *TokenPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TokenPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TokenPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setInput, diags := data.setInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.SetTokenPolicy(ctx, setInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set token policy: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.ID = data.AccountSlug
+	resp.Diagnostics.Append(data.fromPolicy(ctx, policy)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TokenPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TokenPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.GetTokenPolicy(ctx, data.AccountSlug.ValueString())
+	if err != nil {
+		// If the account is gone, its token policy is gone too
+		if err.Error() == "account not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read token policy: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	resp.Diagnostics.Append(data.fromPolicy(ctx, policy)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TokenPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TokenPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setInput, diags := data.setInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.SetTokenPolicy(ctx, setInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update token policy: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromPolicy(ctx, policy)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TokenPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TokenPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A policy without limits restores the account defaults
+	resetInput := client.SetTokenPolicyInput{
+		AccountSlug:           data.AccountSlug.ValueString(),
+		PersonalTokensAllowed: true,
+	}
+
+	_, err := r.client.SetTokenPolicy(ctx, resetInput)
+	if err != nil {
+		// If the account doesn't exist, there is nothing left to reset
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset token policy: %s", err))
+		return
+	}
+}
+
+func (r *TokenPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug"
+	if req.ID == "" || strings.Contains(req.ID, "/") {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug', got: %s", req.ID))
+		return
+	}
+
+	// Get the policy to populate the remaining attributes
+	policy, err := r.client.GetTokenPolicy(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read token policy during import: %s", err))
+		return
+	}
+
+	data := TokenPolicyResourceModel{
+		ID:            types.StringValue(req.ID),
+		AccountSlug:   types.StringValue(req.ID),
+		AllowedScopes: types.SetNull(types.StringType),
+	}
+	resp.Diagnostics.Append(data.fromPolicy(ctx, policy)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setInput builds the client input replacing the token policy.
+func (m TokenPolicyResourceModel) setInput(ctx context.Context) (client.SetTokenPolicyInput, diag.Diagnostics) {
+	input := client.SetTokenPolicyInput{
+		AccountSlug:           m.AccountSlug.ValueString(),
+		MaxLifetimeDays:       m.MaxLifetimeDays.ValueInt64Pointer(),
+		PersonalTokensAllowed: m.PersonalTokensAllowed.ValueBool(),
+	}
+
+	var diags diag.Diagnostics
+	if !m.AllowedScopes.IsNull() && !m.AllowedScopes.IsUnknown() {
+		diags = m.AllowedScopes.ElementsAs(ctx, &input.AllowedScopes, false)
+	}
+
+	return input, diags
+}
+
+// fromPolicy maps an API token policy onto the model. An empty scope list
+// allows every scope and is kept null, so omitting the attribute does not cause a diff.
+func (m *TokenPolicyResourceModel) fromPolicy(ctx context.Context, policy *client.TokenPolicy) diag.Diagnostics {
+	m.MaxLifetimeDays = types.Int64PointerValue(policy.MaxLifetimeDays)
+	m.PersonalTokensAllowed = types.BoolValue(policy.PersonalTokensAllowed)
+
+	if len(policy.AllowedScopes) == 0 {
+		m.AllowedScopes = types.SetNull(types.StringType)
+		return nil
+	}
+
+	allowedScopes, diags := types.SetValueFrom(ctx, types.StringType, policy.AllowedScopes)
+	m.AllowedScopes = allowedScopes
+
+	return diags
+}
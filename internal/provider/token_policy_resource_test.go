@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTokenPolicyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTokenPolicyResourceConfig(90),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_token_policy.test", "id", "test-account"),
+					resource.TestCheckResourceAttr("grafbase_token_policy.test", "max_lifetime_days", "90"),
+					resource.TestCheckResourceAttr("grafbase_token_policy.test", "allowed_scopes.#", "1"),
+					resource.TestCheckResourceAttr("grafbase_token_policy.test", "personal_tokens_allowed", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "grafbase_token_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "test-account",
+			},
+			// Update in place
+			{
+				Config: testAccTokenPolicyResourceConfig(30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_token_policy.test", "max_lifetime_days", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTokenPolicyResourceConfig(maxLifetimeDays int) string {
+	return fmt.Sprintf(`
+resource "grafbase_token_policy" "test" {
+  account_slug            = "test-account"
+  max_lifetime_days       = %[1]d
+  allowed_scopes          = ["schema:publish"]
+  personal_tokens_allowed = false
+}
+`, maxLifetimeDays)
+}
+
+func TestTokenPolicyResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewTokenPolicyResource)
+
+	server.Handle("SetTokenPolicy", `{"tokenPolicySet": {"__typename": "TokenPolicySetSuccess", "tokenPolicy": {
+		"maxLifetimeDays": 90, "allowedScopes": ["schema:publish"], "personalTokensAllowed": false
+	}}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug":            types.StringValue("my-account"),
+		"max_lifetime_days":       types.Int64Value(90),
+		"allowed_scopes":          types.SetValueMust(types.StringType, []attr.Value{types.StringValue("schema:publish")}),
+		"personal_tokens_allowed": types.BoolValue(false),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "my-account" {
+		t.Errorf("expected id my-account, got %q", got)
+	}
+
+	// Scopes widened outside of Terraform show up as a diff
+	server.Handle("GetTokenPolicy", `{"accountBySlug": {"tokenPolicy": {
+		"maxLifetimeDays": 90, "allowedScopes": [], "personalTokensAllowed": false
+	}}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	var data TokenPolicyResourceModel
+	requireNoDiagnostics(t, state.Get(context.Background(), &data))
+	if !data.AllowedScopes.IsNull() {
+		t.Errorf("expected allowed_scopes to be null, got %s", data.AllowedScopes)
+	}
+
+	server.Handle("SetTokenPolicy", `{"tokenPolicySet": {"__typename": "TokenPolicySetSuccess", "tokenPolicy": {
+		"maxLifetimeDays": 30, "allowedScopes": ["schema:publish"], "personalTokensAllowed": false
+	}}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"max_lifetime_days": types.Int64Value(30),
+		"allowed_scopes":    types.SetValueMust(types.StringType, []attr.Value{types.StringValue("schema:publish")}),
+	})
+	requireNoDiagnostics(t, diags)
+
+	// Destroying removes all restrictions
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+	sent := server.LastRequest("SetTokenPolicy").Variables["input"].(map[string]interface{})
+	if sent["maxLifetimeDays"] != nil || sent["personalTokensAllowed"] != true {
+		t.Errorf("expected an unrestricted policy, got %v", sent)
+	}
+
+	server.Handle("GetTokenPolicy", `{"accountBySlug": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected token policy to be removed from state")
+	}
+}
+
+func TestTokenPolicyResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewTokenPolicyResource)
+
+	server.Handle("GetTokenPolicy", `{"accountBySlug": {"tokenPolicy": {
+		"maxLifetimeDays": null, "allowedScopes": ["graph:read"], "personalTokensAllowed": true
+	}}}`)
+	state, diags := importResource(t, r, "my-account")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+	if got := stateString(t, state, "account_slug"); got != "my-account" {
+		t.Errorf("expected account_slug my-account, got %q", got)
+	}
+
+	if _, diags := importResource(t, r, "my-account/my-graph"); !diags.HasError() {
+		t.Error("expected invalid import ID to be an error")
+	}
+}
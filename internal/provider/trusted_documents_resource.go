@@ -0,0 +1,462 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TrustedDocumentsResource{}
+var _ resource.ResourceWithImportState = &TrustedDocumentsResource{}
+var _ resource.ResourceWithModifyPlan = &TrustedDocumentsResource{}
+
+func NewTrustedDocumentsResource() resource.Resource {
+	return &TrustedDocumentsResource{}
+}
+
+// TrustedDocumentsResource defines the resource implementation.
+type TrustedDocumentsResource struct {
+	client client.API
+}
+
+// TrustedDocumentsResourceModel describes the resource data model.
+type TrustedDocumentsResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	AccountSlug    types.String `tfsdk:"account_slug"`
+	GraphSlug      types.String `tfsdk:"graph_slug"`
+	BranchName     types.String `tfsdk:"branch_name"`
+	ClientName     types.String `tfsdk:"client_name"`
+	Documents      types.Map    `tfsdk:"documents"`
+	DocumentHashes types.Map    `tfsdk:"document_hashes"`
+}
+
+func (r *TrustedDocumentsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trusted_documents"
+}
+
+func (r *TrustedDocumentsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the trusted documents (persisted queries) of a client on a branch. " +
+			"Documents removed from the configuration are deleted, and documents changed outside of Terraform are detected by their hash.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier in the format `account_slug/graph_slug/branch_name/client_name`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph slug where the branch belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_name": schema.StringAttribute{
+				MarkdownDescription: "Branch the documents are trusted on",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the client sending the documents, matched against the `x-grafbase-client-name` header",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"documents": schema.MapAttribute{
+				MarkdownDescription: "Trusted documents keyed by document ID. Use `file()` to read documents from disk.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"document_hashes": schema.MapAttribute{
+				MarkdownDescription: "SHA-256 hashes of the documents stored on the branch, keyed by document ID",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *TrustedDocumentsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TrustedDocumentsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan TrustedDocumentsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Documents read from files are usually known during planning; otherwise the hashes stay unknown
+	documents, ok := knownStringMap(plan.Documents)
+	if !ok {
+		return
+	}
+
+	// Planning the hashes makes documents that changed outside of Terraform show up as a diff
+	hashes, diags := types.MapValueFrom(ctx, types.StringType, trustedDocumentHashes(documents))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("document_hashes"), hashes)...)
+}
+
+func (r *TrustedDocumentsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TrustedDocumentsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	documents := map[string]string{}
+	resp.Diagnostics.Append(data.Documents.ElementsAs(ctx, &documents, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upload, _ := diffTrustedDocuments(documents, map[string]string{})
+
+	err := r.client.UploadTrustedDocuments(ctx, data.uploadInput(upload))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upload trusted documents: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.ID = types.StringValue(data.id())
+	resp.Diagnostics.Append(data.setDocumentHashes(ctx, trustedDocumentHashes(documents))...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrustedDocumentsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TrustedDocumentsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remote, err := r.client.ListTrustedDocuments(ctx, data.AccountSlug.ValueString(), data.GraphSlug.ValueString(), data.BranchName.ValueString(), data.ClientName.ValueString())
+	if err != nil {
+		// If the branch is not found, remove the documents from state
+		if err.Error() == "branch not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read trusted documents: %s", err))
+		return
+	}
+
+	remoteHashes := make(map[string]string, len(remote))
+	for _, document := range remote {
+		remoteHashes[document.DocumentID] = document.Hash
+	}
+
+	// Only the hashes are stored remotely, so keep the known texts of documents
+	// that still exist; missing documents drop out and are uploaded again
+	if !data.Documents.IsNull() {
+		documents := map[string]string{}
+		resp.Diagnostics.Append(data.Documents.ElementsAs(ctx, &documents, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for documentID := range documents {
+			if _, ok := remoteHashes[documentID]; !ok {
+				delete(documents, documentID)
+			}
+		}
+
+		documentsValue, diags := types.MapValueFrom(ctx, types.StringType, documents)
+		resp.Diagnostics.Append(diags...)
+		data.Documents = documentsValue
+	}
+
+	// Update the model with the latest data
+	data.ID = types.StringValue(data.id())
+	resp.Diagnostics.Append(data.setDocumentHashes(ctx, remoteHashes)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrustedDocumentsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state TrustedDocumentsResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	documents := map[string]string{}
+	resp.Diagnostics.Append(data.Documents.ElementsAs(ctx, &documents, false)...)
+
+	// The refreshed hashes describe what is stored on the branch
+	currentHashes := map[string]string{}
+	resp.Diagnostics.Append(state.DocumentHashes.ElementsAs(ctx, &currentHashes, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upload, remove := diffTrustedDocuments(documents, currentHashes)
+
+	// Document IDs cannot be reused for a different text, so stale documents are deleted before uploading
+	if len(remove) > 0 {
+		err := r.client.DeleteTrustedDocuments(ctx, data.deleteInput(remove))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete trusted documents: %s", err))
+			return
+		}
+	}
+
+	if len(upload) > 0 {
+		err := r.client.UploadTrustedDocuments(ctx, data.uploadInput(upload))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upload trusted documents: %s", err))
+			return
+		}
+	}
+
+	data.ID = types.StringValue(data.id())
+	resp.Diagnostics.Append(data.setDocumentHashes(ctx, trustedDocumentHashes(documents))...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrustedDocumentsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TrustedDocumentsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hashes := map[string]string{}
+	resp.Diagnostics.Append(data.DocumentHashes.ElementsAs(ctx, &hashes, false)...)
+
+	if resp.Diagnostics.HasError() || len(hashes) == 0 {
+		return
+	}
+
+	documentIDs := make([]string, 0, len(hashes))
+	for documentID := range hashes {
+		documentIDs = append(documentIDs, documentID)
+	}
+	sort.Strings(documentIDs)
+
+	err := r.client.DeleteTrustedDocuments(ctx, data.deleteInput(documentIDs))
+	if err != nil {
+		// If the branch doesn't exist, consider the documents already deleted
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete trusted documents: %s", err))
+		return
+	}
+}
+
+func (r *TrustedDocumentsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug/branch_name/client_name"
+	// Branch names may contain slashes, so the branch name is everything between the graph slug and the client name
+	parts := strings.Split(req.ID, "/")
+	if len(parts) < 4 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/branch_name/client_name', got: %s", req.ID))
+		return
+	}
+
+	accountSlug := parts[0]
+	graphSlug := parts[1]
+	branchName := strings.Join(parts[2:len(parts)-1], "/")
+	clientName := parts[len(parts)-1]
+
+	// Get the stored documents to populate the hashes
+	remote, err := r.client.ListTrustedDocuments(ctx, accountSlug, graphSlug, branchName, clientName)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read trusted documents during import: %s", err))
+		return
+	}
+
+	hashes := make(map[string]string, len(remote))
+	for _, document := range remote {
+		hashes[document.DocumentID] = document.Hash
+	}
+
+	// The document texts are not stored remotely, so documents stays null and
+	// must be configured; the next apply only uploads documents whose hash differs
+	data := TrustedDocumentsResourceModel{
+		ID:          types.StringValue(req.ID),
+		AccountSlug: types.StringValue(accountSlug),
+		GraphSlug:   types.StringValue(graphSlug),
+		BranchName:  types.StringValue(branchName),
+		ClientName:  types.StringValue(clientName),
+		Documents:   types.MapNull(types.StringType),
+	}
+	resp.Diagnostics.Append(data.setDocumentHashes(ctx, hashes)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// id returns the resource identifier.
+func (m TrustedDocumentsResourceModel) id() string {
+	return fmt.Sprintf("%s/%s/%s/%s", m.AccountSlug.ValueString(), m.GraphSlug.ValueString(), m.BranchName.ValueString(), m.ClientName.ValueString())
+}
+
+// uploadInput builds the client input for uploading documents.
+func (m TrustedDocumentsResourceModel) uploadInput(documents []client.TrustedDocumentInput) client.UploadTrustedDocumentsInput {
+	return client.UploadTrustedDocumentsInput{
+		AccountSlug: m.AccountSlug.ValueString(),
+		GraphSlug:   m.GraphSlug.ValueString(),
+		BranchName:  m.BranchName.ValueString(),
+		ClientName:  m.ClientName.ValueString(),
+		Documents:   documents,
+	}
+}
+
+// deleteInput builds the client input for deleting documents.
+func (m TrustedDocumentsResourceModel) deleteInput(documentIDs []string) client.DeleteTrustedDocumentsInput {
+	return client.DeleteTrustedDocumentsInput{
+		AccountSlug: m.AccountSlug.ValueString(),
+		GraphSlug:   m.GraphSlug.ValueString(),
+		BranchName:  m.BranchName.ValueString(),
+		ClientName:  m.ClientName.ValueString(),
+		DocumentIDs: documentIDs,
+	}
+}
+
+// setDocumentHashes sets the document_hashes attribute.
+func (m *TrustedDocumentsResourceModel) setDocumentHashes(ctx context.Context, hashes map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.DocumentHashes, diags = types.MapValueFrom(ctx, types.StringType, hashes)
+	return diags
+}
+
+// trustedDocumentHashes returns the hashes of documents keyed by document ID.
+func trustedDocumentHashes(documents map[string]string) map[string]string {
+	hashes := make(map[string]string, len(documents))
+	for documentID, text := range documents {
+		hashes[documentID] = client.TrustedDocumentHash(text)
+	}
+	return hashes
+}
+
+// diffTrustedDocuments compares the configured documents with the hashes of
+// the documents stored on the branch. It returns the documents to upload, and
+// the IDs to delete because they were removed from the configuration or their
+// stored text differs. Both are sorted by document ID.
+func diffTrustedDocuments(documents map[string]string, currentHashes map[string]string) ([]client.TrustedDocumentInput, []string) {
+	var upload []client.TrustedDocumentInput
+	var remove []string
+
+	for documentID, text := range documents {
+		currentHash, exists := currentHashes[documentID]
+		if exists && currentHash == client.TrustedDocumentHash(text) {
+			continue
+		}
+		if exists {
+			remove = append(remove, documentID)
+		}
+		upload = append(upload, client.TrustedDocumentInput{DocumentID: documentID, DocumentText: text})
+	}
+
+	for documentID := range currentHashes {
+		if _, ok := documents[documentID]; !ok {
+			remove = append(remove, documentID)
+		}
+	}
+
+	sort.Slice(upload, func(i, j int) bool { return upload[i].DocumentID < upload[j].DocumentID })
+	sort.Strings(remove)
+
+	return upload, remove
+}
+
+// knownStringMap returns the elements of a map of strings, or false when the
+// map or any of its elements is unknown.
+func knownStringMap(value types.Map) (map[string]string, bool) {
+	if value.IsUnknown() || value.IsNull() {
+		return nil, false
+	}
+
+	elements := make(map[string]string, len(value.Elements()))
+	for key, element := range value.Elements() {
+		str, ok := element.(types.String)
+		if !ok || str.IsUnknown() || str.IsNull() {
+			return nil, false
+		}
+		elements[key] = str.ValueString()
+	}
+
+	return elements, true
+}
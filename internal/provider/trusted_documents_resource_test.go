@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTrustedDocumentsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTrustedDocumentsResourceConfig(`
+    "get-user"      = "query GetUser($id: ID!) { user(id: $id) { id name } }"
+    "list-products" = "query ListProducts { products { id } }"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_trusted_documents.test", "id", "test-account/test-graph/main/web"),
+					resource.TestCheckResourceAttr("grafbase_trusted_documents.test", "documents.%", "2"),
+					resource.TestCheckResourceAttr("grafbase_trusted_documents.test", "document_hashes.%", "2"),
+					resource.TestCheckResourceAttrSet("grafbase_trusted_documents.test", "document_hashes.get-user"),
+				),
+			},
+			// ImportState testing; document texts are not stored remotely
+			{
+				ResourceName:            "grafbase_trusted_documents.test",
+				ImportState:             true,
+				ImportStateId:           "test-account/test-graph/main/web",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"documents"},
+			},
+			// Removing a document deletes it from the branch
+			{
+				Config: testAccTrustedDocumentsResourceConfig(`
+    "get-user" = "query GetUser($id: ID!) { user(id: $id) { id name } }"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_trusted_documents.test", "documents.%", "1"),
+					resource.TestCheckResourceAttr("grafbase_trusted_documents.test", "document_hashes.%", "1"),
+					resource.TestCheckNoResourceAttr("grafbase_trusted_documents.test", "document_hashes.list-products"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTrustedDocumentsResourceConfig(documents string) string {
+	return fmt.Sprintf(`
+resource "grafbase_trusted_documents" "test" {
+  account_slug = "test-account"
+  graph_slug   = "test-graph"
+  branch_name  = "main"
+  client_name  = "web"
+
+  documents = {
+%[1]s  }
+}
+`, documents)
+}
+
+func TestTrustedDocumentsResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewTrustedDocumentsResource)
+	getUser := "query GetUser { user { id } }"
+	listProducts := "query ListProducts { products { id } }"
+
+	server.Handle("UploadTrustedDocuments", `{"trustedDocumentsUpload": {"__typename": "TrustedDocumentsUploadSuccess"}}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("my-graph"),
+		"branch_name":  types.StringValue("main"),
+		"client_name":  types.StringValue("web"),
+		"documents": types.MapValueMust(types.StringType, map[string]attr.Value{
+			"get-user": types.StringValue(getUser),
+		}),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "my-account/my-graph/main/web" {
+		t.Errorf("expected id my-account/my-graph/main/web, got %q", got)
+	}
+
+	server.Handle("ListTrustedDocuments", `{"branch": {"trustedDocuments": [{"documentId": "get-user", "hash": "`+client.TrustedDocumentHash(getUser)+`"}]}}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+
+	// Only the added document is uploaded
+	server.Handle("UploadTrustedDocuments", `{"trustedDocumentsUpload": {"__typename": "TrustedDocumentsUploadSuccess"}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"documents": types.MapValueMust(types.StringType, map[string]attr.Value{
+			"get-user":      types.StringValue(getUser),
+			"list-products": types.StringValue(listProducts),
+		}),
+	})
+	requireNoDiagnostics(t, diags)
+	var input client.UploadTrustedDocumentsInput
+	if err := server.LastRequest("UploadTrustedDocuments").Input(&input); err != nil {
+		t.Fatalf("unable to decode input: %s", err)
+	}
+	if len(input.Documents) != 1 || input.Documents[0].DocumentID != "list-products" {
+		t.Errorf("expected only list-products to be uploaded, got %+v", input.Documents)
+	}
+
+	server.Handle("DeleteTrustedDocuments", `{"trustedDocumentsDelete": {"__typename": "TrustedDocumentsDeleteSuccess"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+	if got := server.LastRequest("DeleteTrustedDocuments").Variables["input"].(map[string]interface{})["documentIds"]; len(got.([]interface{})) != 2 {
+		t.Errorf("expected both documents to be deleted, got %v", got)
+	}
+
+	server.Handle("ListTrustedDocuments", `{"branch": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected trusted documents to be removed from state")
+	}
+}
+
+func TestTrustedDocumentsResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewTrustedDocumentsResource)
+
+	server.Handle("ListTrustedDocuments", `{"branch": {"trustedDocuments": [{"documentId": "get-user", "hash": "abc123"}]}}`)
+	state, diags := importResource(t, r, "my-account/my-graph/feature/login/web")
+	requireNoDiagnostics(t, diags)
+	// Only the hashes are stored remotely, so the documents must be configured
+	requireImportedAttributes(t, state, "documents")
+	if got := stateString(t, state, "branch_name"); got != "feature/login" {
+		t.Errorf("expected branch name with slash, got %q", got)
+	}
+
+	var hashes map[string]string
+	requireNoDiagnostics(t, state.GetAttribute(context.Background(), path.Root("document_hashes"), &hashes))
+	if hashes["get-user"] != "abc123" {
+		t.Errorf("expected imported document hash, got %v", hashes)
+	}
+}
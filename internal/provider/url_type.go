@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the custom types fully satisfy framework interfaces.
+var _ basetypes.StringTypable = URLType{}
+var _ basetypes.StringValuableWithSemanticEquals = URLValue{}
+
+// URLType is a string type for http(s) URLs. URLs that only differ in the
+// casing of the scheme and host, a default port, or a trailing slash are
+// semantically equal, so the API normalizing a URL does not cause a diff.
+type URLType struct {
+	basetypes.StringType
+}
+
+func (t URLType) String() string {
+	return "URLType"
+}
+
+func (t URLType) ValueType(ctx context.Context) attr.Value {
+	return URLValue{}
+}
+
+func (t URLType) Equal(o attr.Type) bool {
+	other, ok := o.(URLType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t URLType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return URLValue{StringValue: in}, nil
+}
+
+func (t URLType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// URLValue is a value of URLType.
+type URLValue struct {
+	basetypes.StringValue
+}
+
+// NewURLValue returns a URL value.
+func NewURLValue(u string) URLValue {
+	return URLValue{StringValue: basetypes.NewStringValue(u)}
+}
+
+func (v URLValue) Type(ctx context.Context) attr.Type {
+	return URLType{}
+}
+
+func (v URLValue) Equal(o attr.Value) bool {
+	other, ok := o.(URLValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both URLs are the same once
+// normalized. Values that cannot be parsed are only equal if they are
+// identical strings.
+func (v URLValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(URLValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	prior, err := normalizeURL(v.ValueString())
+	if err != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+
+	normalized, err := normalizeURL(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return prior == normalized, diags
+}
+
+// normalizeURL lowercases the scheme and host of a URL and removes a default
+// port and trailing slashes of the path, the way the API stores URLs.
+func normalizeURL(raw string) (string, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", err
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	if (u.Scheme == "http" && u.Port() == "80") || (u.Scheme == "https" && u.Port() == "443") {
+		u.Host = strings.TrimSuffix(u.Host, ":"+u.Port())
+	}
+	u.Path = strings.TrimRight(u.Path, "/")
+	u.RawPath = ""
+
+	return u.String(), nil
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ValidSlugFunction{}
+
+func NewValidSlugFunction() function.Function {
+	return &ValidSlugFunction{}
+}
+
+// ValidSlugFunction defines the function implementation.
+type ValidSlugFunction struct{}
+
+func (f *ValidSlugFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "valid_slug"
+}
+
+func (f *ValidSlugFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Checks whether a string is a valid graph slug",
+		MarkdownDescription: fmt.Sprintf("Returns `true` when the string has 1 to %d lowercase letters, numbers, and single hyphens, ", maxSlugLength) +
+			"and does not start or end with a hyphen, the slugs accepted by `grafbase_graph`. Useful in variable validations.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "slug",
+				MarkdownDescription: "String to check",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *ValidSlugFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var slug string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &slug))
+	if resp.Error != nil {
+		return
+	}
+
+	valid := len(slug) <= maxSlugLength && slugRegexp.MatchString(slug)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, valid))
+}
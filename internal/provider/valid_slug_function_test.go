@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccValidSlugFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "valid" {
+  value = provider::grafbase::valid_slug("my-graph")
+}
+
+output "invalid" {
+  value = provider::grafbase::valid_slug("My Graph")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("valid", "true"),
+					resource.TestCheckOutput("invalid", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidSlugFunctionRun(t *testing.T) {
+	f := NewValidSlugFunction()
+
+	tests := map[string]bool{
+		"my-graph":                           true,
+		"graph2":                             true,
+		"":                                   false,
+		"My-Graph":                           false,
+		"my--graph":                          false,
+		"-my-graph":                          false,
+		"my_graph":                           false,
+		strings.Repeat("a", maxSlugLength):   true,
+		strings.Repeat("a", maxSlugLength+1): false,
+	}
+
+	for slug, expected := range tests {
+		resp := function.RunResponse{Result: function.NewResultData(types.BoolUnknown())}
+		f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(slug)})}, &resp)
+		if resp.Error != nil {
+			t.Fatalf("unexpected error for %q: %s", slug, resp.Error)
+		}
+		if got := resp.Result.Value(); !got.Equal(types.BoolValue(expected)) {
+			t.Errorf("valid_slug(%q) = %s, expected %t", slug, got, expected)
+		}
+	}
+}
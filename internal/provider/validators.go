@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	// maxSlugLength is the longest graph slug accepted by the Grafbase API
+	maxSlugLength = 64
+	// maxBranchNameLength is the longest branch name accepted by the Grafbase API
+	maxBranchNameLength = 255
+	// maxSubgraphNameLength is the longest subgraph name accepted by the Grafbase API
+	maxSubgraphNameLength = 64
+)
+
+var (
+	// slugRegexp matches lowercase alphanumeric words separated by single hyphens
+	slugRegexp = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	// branchNameRegexp matches git style branch names such as "main" or "feature/new-schema"
+	branchNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+([./][a-zA-Z0-9_-]+)*$`)
+	// subgraphNameRegexp matches subgraph names such as "products" or "user_reviews"
+	subgraphNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
+	// headerNameRegexp matches HTTP header names such as "Authorization" or "x-tenant-id"
+	headerNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
+	// environmentVariableNameRegexp matches POSIX environment variable names such as "UPSTREAM_TOKEN"
+	environmentVariableNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	// schemaTagNameRegexp matches the names accepted by the @tag directive
+	schemaTagNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+	// schemaCoordinateRegexp matches GraphQL schema coordinates such as "User", "User.email", or "Query.user(id:)"
+	schemaCoordinateRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*(\([A-Za-z_][A-Za-z0-9_]*:\))?)?$`)
+)
+
+// slugValidators reject graph slugs the API would fail with SlugInvalidError or SlugTooLongError.
+func slugValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthBetween(1, maxSlugLength),
+		stringvalidator.RegexMatches(slugRegexp, "must contain only lowercase letters, numbers, and single hyphens, and cannot start or end with a hyphen"),
+	}
+}
+
+// branchNameValidators reject branch names the API would refuse.
+func branchNameValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthBetween(1, maxBranchNameLength),
+		stringvalidator.RegexMatches(branchNameRegexp, "must contain only letters, numbers, hyphens, and underscores, optionally separated by single dots or slashes"),
+	}
+}
+
+// subgraphNameValidators reject subgraph names the API would refuse.
+func subgraphNameValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthBetween(1, maxSubgraphNameLength),
+		stringvalidator.RegexMatches(subgraphNameRegexp, "must start with a letter or number and contain only letters, numbers, hyphens, and underscores"),
+	}
+}
+
+// eventSetValidators require a non-empty set of the graph events that
+// webhooks and notification channels can be notified of.
+func eventSetValidators() []validator.Set {
+	return []validator.Set{
+		setvalidator.SizeAtLeast(1),
+		setvalidator.ValueStringsAre(stringvalidator.OneOf(
+			string(client.WebhookEventDeploymentFinished),
+			string(client.WebhookEventSchemaCheckFailed),
+			string(client.WebhookEventCompositionFailed),
+		)),
+	}
+}
+
+// headerNameValidators reject HTTP header names the API would fail with InvalidHeaderNameError.
+func headerNameValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthAtLeast(1),
+		stringvalidator.RegexMatches(headerNameRegexp, "must start with a letter or number and contain only letters, numbers, hyphens, and underscores"),
+	}
+}
+
+// environmentVariableNameValidators reject names the API would fail with InvalidEnvironmentVariableNameError.
+func environmentVariableNameValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.RegexMatches(environmentVariableNameRegexp, "must start with a letter or underscore and contain only letters, numbers, and underscores"),
+	}
+}
+
+// schemaTagNameValidators reject names the @tag directive does not accept.
+func schemaTagNameValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.RegexMatches(schemaTagNameRegexp, "must start with a letter or underscore and contain only letters, numbers, underscores, and hyphens"),
+	}
+}
+
+// schemaCoordinateValidators reject schema paths that are not GraphQL schema coordinates.
+func schemaCoordinateValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.RegexMatches(schemaCoordinateRegexp, "must be a schema coordinate such as \"User\", \"User.email\", or \"Query.user(id:)\""),
+	}
+}
+
+var _ validator.String = httpsURLValidator{}
+
+// httpsURLValidator requires an absolute https URL with a host.
+type httpsURLValidator struct{}
+
+func (v httpsURLValidator) Description(ctx context.Context) string {
+	return "value must be an https URL"
+}
+
+func (v httpsURLValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be an `https` URL"
+}
+
+func (v httpsURLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateHTTPSURL(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid URL",
+			fmt.Sprintf("%s %s", req.Path, err),
+		)
+	}
+}
+
+// validateHTTPSURL reports why raw is not an absolute https URL with a host.
+func validateHTTPSURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("must be a valid URL, got: %q", raw)
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("must be an https URL such as %q, got: %q", "https://api.grafbase.com/graphql", raw)
+	}
+	return nil
+}
+
+var _ validator.String = httpURLValidator{}
+
+// httpURLValidator requires an absolute http or https URL with a host.
+type httpURLValidator struct{}
+
+func (v httpURLValidator) Description(ctx context.Context) string {
+	return "value must be an http or https URL"
+}
+
+func (v httpURLValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be an `http` or `https` URL"
+}
+
+func (v httpURLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	u, err := url.Parse(req.ConfigValue.ValueString())
+	if err != nil || (!strings.EqualFold(u.Scheme, "http") && !strings.EqualFold(u.Scheme, "https")) || u.Host == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid URL",
+			fmt.Sprintf("%s must be an http or https URL such as %q, got: %q", req.Path, "https://accounts.example.com/graphql", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.String = rfc3339Validator{}
+
+// rfc3339Validator requires a timestamp in RFC3339 format.
+type rfc3339Validator struct{}
+
+func (v rfc3339Validator) Description(ctx context.Context) string {
+	return "value must be an RFC3339 timestamp"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return "value must be an RFC3339 timestamp such as `2024-01-15T10:30:00Z`"
+}
+
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timestamp",
+			fmt.Sprintf("%s must be an RFC3339 timestamp such as \"2024-01-15T10:30:00Z\", got: %q", req.Path, req.ConfigValue.ValueString()),
+		)
+	}
+}
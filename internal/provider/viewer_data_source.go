@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ViewerDataSource{}
+
+func NewViewerDataSource() datasource.DataSource {
+	return &ViewerDataSource{}
+}
+
+// ViewerDataSource defines the data source implementation.
+type ViewerDataSource struct {
+	client client.API
+}
+
+// ViewerDataSourceModel describes the data source data model.
+type ViewerDataSourceModel struct {
+	ID                 types.String         `tfsdk:"id"`
+	Name               types.String         `tfsdk:"name"`
+	Email              types.String         `tfsdk:"email"`
+	DefaultAccountSlug types.String         `tfsdk:"default_account_slug"`
+	Accounts           []ViewerAccountModel `tfsdk:"accounts"`
+	Scopes             []types.String       `tfsdk:"scopes"`
+}
+
+// ViewerAccountModel describes an account the viewer is a member of.
+type ViewerAccountModel struct {
+	ID   types.String `tfsdk:"id"`
+	Slug types.String `tfsdk:"slug"`
+	Name types.String `tfsdk:"name"`
+	Role types.String `tfsdk:"role"`
+}
+
+func (d *ViewerDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_viewer"
+}
+
+func (d *ViewerDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Reads the principal authenticated by the provider credentials, with its account memberships and token scopes, " +
+			"so configurations can check they run against the intended account and derive `account_slug` from the credentials.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the authenticated user or service account",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the authenticated principal",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the authenticated principal. Empty for service accounts.",
+				Computed:            true,
+			},
+			"default_account_slug": schema.StringAttribute{
+				MarkdownDescription: "Slug of the default account of the principal. Null when the credentials are scoped to no account.",
+				Computed:            true,
+			},
+			"accounts": schema.ListNestedAttribute{
+				MarkdownDescription: "Accounts the principal is a member of",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Account identifier",
+							Computed:            true,
+						},
+						"slug": schema.StringAttribute{
+							MarkdownDescription: "Account slug",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Account name",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "Role of the principal in the account: `OWNER`, `ADMIN`, or `MEMBER`",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"scopes": schema.ListAttribute{
+				MarkdownDescription: "Scopes granted to the API key or access token",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ViewerDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ViewerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ViewerDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	viewer, err := d.client.GetViewer(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read viewer: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(viewer.ID)
+	data.Name = types.StringValue(viewer.Name)
+	data.Email = types.StringValue(viewer.Email)
+	data.DefaultAccountSlug = types.StringNull()
+	if viewer.DefaultAccount != nil {
+		data.DefaultAccountSlug = types.StringValue(viewer.DefaultAccount.Slug)
+	}
+
+	data.Accounts = make([]ViewerAccountModel, 0, len(viewer.Accounts))
+	for _, account := range viewer.Accounts {
+		data.Accounts = append(data.Accounts, ViewerAccountModel{
+			ID:   types.StringValue(account.ID),
+			Slug: types.StringValue(account.Slug),
+			Name: types.StringValue(account.Name),
+			Role: types.StringValue(string(account.Role)),
+		})
+	}
+
+	data.Scopes = make([]types.String, 0, len(viewer.Scopes))
+	for _, scope := range viewer.Scopes {
+		data.Scopes = append(data.Scopes, types.StringValue(scope))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
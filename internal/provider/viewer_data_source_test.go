@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccViewerDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "grafbase_viewer" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.grafbase_viewer.test", "id"),
+					resource.TestCheckResourceAttrSet("data.grafbase_viewer.test", "accounts.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestViewerDataSourceRead(t *testing.T) {
+	d, server := newMockDataSource(t, NewViewerDataSource)
+
+	server.Handle("GetViewer", `{"viewer": {"id": "user-1", "name": "Jane", "email": "jane@example.com",
+		"defaultAccount": {"id": "account-1", "slug": "my-account", "name": "My Account", "role": "OWNER"},
+		"accounts": [
+			{"id": "account-1", "slug": "my-account", "name": "My Account", "role": "OWNER"},
+			{"id": "account-2", "slug": "other-account", "name": "Other Account", "role": "MEMBER"}
+		],
+		"scopes": ["graphs:read", "graphs:write"]}}`)
+	state, diags := readDataSource(t, d, nil)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "default_account_slug"); got != "my-account" {
+		t.Errorf("expected default account my-account, got %q", got)
+	}
+
+	var accounts []ViewerAccountModel
+	requireNoDiagnostics(t, state.GetAttribute(context.Background(), path.Root("accounts"), &accounts))
+	if len(accounts) != 2 || accounts[1].Slug.ValueString() != "other-account" || accounts[1].Role.ValueString() != "MEMBER" {
+		t.Errorf("unexpected accounts: %+v", accounts)
+	}
+
+	var scopes []types.String
+	requireNoDiagnostics(t, state.GetAttribute(context.Background(), path.Root("scopes"), &scopes))
+	if len(scopes) != 2 {
+		t.Errorf("expected 2 scopes, got %v", scopes)
+	}
+
+	// Credentials scoped to no account have no default account
+	server.Handle("GetViewer", `{"viewer": {"id": "user-1", "name": "CI", "email": "", "defaultAccount": null, "accounts": [], "scopes": []}}`)
+	state, diags = readDataSource(t, d, nil)
+	requireNoDiagnostics(t, diags)
+	var defaultAccount types.String
+	requireNoDiagnostics(t, state.GetAttribute(context.Background(), path.Root("default_account_slug"), &defaultAccount))
+	if !defaultAccount.IsNull() {
+		t.Errorf("expected no default account, got %v", defaultAccount)
+	}
+}
@@ -0,0 +1,408 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WebhookResource{}
+var _ resource.ResourceWithImportState = &WebhookResource{}
+var _ resource.ResourceWithModifyPlan = &WebhookResource{}
+
+func NewWebhookResource() resource.Resource {
+	return &WebhookResource{}
+}
+
+// WebhookResource defines the resource implementation.
+type WebhookResource struct {
+	client client.API
+}
+
+// WebhookResourceModel describes the resource data model.
+type WebhookResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	AccountSlug types.String `tfsdk:"account_slug"`
+	GraphSlug   types.String `tfsdk:"graph_slug"`
+	URL         types.String `tfsdk:"url"`
+	Secret      types.String `tfsdk:"secret"`
+	Events      types.Set    `tfsdk:"events"`
+
+	RotateTriggers types.Map    `tfsdk:"rotate_triggers"`
+	SigningSecret  types.String `tfsdk:"signing_secret"`
+}
+
+func (r *WebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+func (r *WebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Registers a webhook notified of deployment, schema check, and composition events of a graph, " +
+			"so alerting integrations are managed alongside the graph.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Webhook identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_slug": schema.StringAttribute{
+				MarkdownDescription: "Account slug where the graph belongs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"graph_slug": schema.StringAttribute{
+				MarkdownDescription: "Graph whose events are sent to the webhook",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "HTTPS URL events are posted to",
+				Required:            true,
+				Validators: []validator.String{
+					httpsURLValidator{},
+				},
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "Secret used to sign event payloads in the `X-Grafbase-Signature` header. " +
+					"When not set, Grafbase generates one, exposed as `signing_secret`. " +
+					"The API never returns the secret, so it is not recovered on import.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(16),
+				},
+			},
+			"events": schema.SetAttribute{
+				MarkdownDescription: "Events sent to the webhook: `DEPLOYMENT_FINISHED`, `SCHEMA_CHECK_FAILED`, or `COMPOSITION_FAILED`",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators:          eventSetValidators(),
+			},
+			"rotate_triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that rotate the generated signing secret when changed, for example a " +
+					"timestamp from the `time_rotating` resource. Rotating replaces the webhook. The values are not sent to Grafbase.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"signing_secret": schema.StringAttribute{
+				MarkdownDescription: "Secret event payloads are signed with: the configured `secret`, or the one generated by " +
+					"Grafbase when the webhook was created. Not available for imported webhooks.",
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *WebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WebhookResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	events, diags := webhookEvents(ctx, data.Events)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createInput := client.CreateWebhookInput{
+		AccountSlug: data.AccountSlug.ValueString(),
+		GraphSlug:   data.GraphSlug.ValueString(),
+		URL:         data.URL.ValueString(),
+		Secret:      data.Secret.ValueStringPointer(),
+		Events:      events,
+	}
+
+	webhook, signingSecret, err := r.client.CreateWebhook(ctx, createInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook: %s", err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.SigningSecret = data.Secret
+	if data.SigningSecret.IsNull() {
+		data.SigningSecret = types.StringValue(signingSecret)
+	}
+	resp.Diagnostics.Append(data.fromWebhook(ctx, webhook)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitUntilCreated(ctx, "webhook", func(ctx context.Context) error {
+		_, err := r.client.GetWebhook(ctx, data.ID.ValueString())
+		return err
+	}, "webhook not found")...)
+}
+
+func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WebhookResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook, err := r.client.GetWebhook(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the webhook is not found, remove it from state
+		if err.Error() == "webhook not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read webhook: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	resp.Diagnostics.Append(data.fromWebhook(ctx, webhook)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data WebhookResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	events, diags := webhookEvents(ctx, data.Events)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The secret is always sent, so a generated secret is sent back to keep signing with it
+	secret := data.Secret
+	if secret.IsNull() {
+		secret = data.SigningSecret
+	}
+
+	updateInput := client.UpdateWebhookInput{
+		ID:     data.ID.ValueString(),
+		URL:    data.URL.ValueString(),
+		Secret: secret.ValueStringPointer(),
+		Events: events,
+	}
+
+	webhook, err := r.client.UpdateWebhook(ctx, updateInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update webhook: %s", err))
+		return
+	}
+
+	data.SigningSecret = secret
+	resp.Diagnostics.Append(data.fromWebhook(ctx, webhook)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WebhookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan WebhookResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Payloads are signed with the configured secret
+	if !plan.Secret.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("signing_secret"), plan.Secret)...)
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state WebhookResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Grafbase only generates a secret for new webhooks, so the webhook is
+	// replaced when its configured secret is removed
+	replace := !state.Secret.IsNull()
+
+	// The secret of an imported webhook is not known, but the webhook keeps
+	// signing with it unless the API reports that none is configured
+	if !replace && state.SigningSecret.IsNull() {
+		webhook, err := r.client.GetWebhook(ctx, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read webhook: %s", err))
+			return
+		}
+
+		replace = !webhook.SecretConfigured
+	}
+
+	if replace {
+		resp.RequiresReplace.Append(path.Root("secret"))
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("signing_secret"), types.StringUnknown())...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("signing_secret"), state.SigningSecret)...)
+}
+
+func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WebhookResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteWebhook(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the webhook is already gone, there is nothing left to delete
+		if strings.Contains(err.Error(), "does not exist") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete webhook: %s", err))
+		return
+	}
+}
+
+func (r *WebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID format: "account_slug/graph_slug/webhook_id"
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid import ID format. Expected 'account_slug/graph_slug/webhook_id', got: %s", req.ID))
+		return
+	}
+
+	// Get the webhook to populate the remaining attributes
+	webhook, err := r.client.GetWebhook(ctx, parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read webhook during import: %s", err))
+		return
+	}
+
+	// The secret cannot be read back, so it stays null until it is set again
+	data := WebhookResourceModel{
+		AccountSlug:    types.StringValue(parts[0]),
+		GraphSlug:      types.StringValue(parts[1]),
+		Secret:         types.StringNull(),
+		RotateTriggers: types.MapNull(types.StringType),
+		SigningSecret:  types.StringNull(),
+	}
+	resp.Diagnostics.Append(data.fromWebhook(ctx, webhook)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// webhookEvents returns the events of a configured event set
+func webhookEvents(ctx context.Context, set types.Set) ([]client.WebhookEvent, diag.Diagnostics) {
+	var names []string
+	diags := set.ElementsAs(ctx, &names, false)
+
+	events := make([]client.WebhookEvent, 0, len(names))
+	for _, name := range names {
+		events = append(events, client.WebhookEvent(name))
+	}
+
+	return events, diags
+}
+
+// webhookEventSet returns the event set of API events
+func webhookEventSet(ctx context.Context, events []client.WebhookEvent) (types.Set, diag.Diagnostics) {
+	names := make([]string, 0, len(events))
+	for _, event := range events {
+		names = append(names, string(event))
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, names)
+}
+
+// fromWebhook maps an API webhook onto the model. The secrets are kept from
+// the configuration and state, unless the API reports that none is
+// configured, so that a secret removed outside of Terraform shows up as a diff.
+func (m *WebhookResourceModel) fromWebhook(ctx context.Context, webhook *client.Webhook) diag.Diagnostics {
+	m.ID = types.StringValue(webhook.ID)
+	m.URL = types.StringValue(webhook.URL)
+	if !webhook.SecretConfigured {
+		m.Secret = types.StringNull()
+		m.SigningSecret = types.StringNull()
+	}
+
+	var diags diag.Diagnostics
+	m.Events, diags = webhookEventSet(ctx, webhook.Events)
+	return diags
+}
@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccWebhookResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWebhookResourceConfig(`["DEPLOYMENT_FINISHED"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("grafbase_webhook.test", "id"),
+					resource.TestCheckResourceAttr("grafbase_webhook.test", "events.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "grafbase_webhook.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["grafbase_webhook.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found: grafbase_webhook.test")
+					}
+					return fmt.Sprintf("test-account/test-graph/%s", rs.Primary.ID), nil
+				},
+			},
+			// Update in place
+			{
+				Config: testAccWebhookResourceConfig(`["DEPLOYMENT_FINISHED", "COMPOSITION_FAILED"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("grafbase_webhook.test", "events.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWebhookResourceSigningSecretRotation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookResourceRotationConfig("2024-01"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("grafbase_webhook.test", "signing_secret"),
+				),
+			},
+			// Changing the triggers replaces the webhook with a new generated secret
+			{
+				Config: testAccWebhookResourceRotationConfig("2024-02"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("grafbase_webhook.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccWebhookResourceRotationConfig(rotation string) string {
+	return fmt.Sprintf(`
+resource "grafbase_webhook" "test" {
+  account_slug = "test-account"
+  graph_slug   = "test-graph"
+  url          = "https://hooks.example.com/grafbase"
+  events       = ["DEPLOYMENT_FINISHED"]
+
+  rotate_triggers = {
+    rotation = %[1]q
+  }
+}
+`, rotation)
+}
+
+func testAccWebhookResourceConfig(events string) string {
+	return fmt.Sprintf(`
+resource "grafbase_webhook" "test" {
+  account_slug = "test-account"
+  graph_slug   = "test-graph"
+  url          = "https://hooks.example.com/grafbase"
+  secret       = "0123456789abcdef"
+  events       = %[1]s
+}
+`, events)
+}
+
+func testWebhookJSON(events string, secretConfigured bool) string {
+	return fmt.Sprintf(`{"id": "webhook-1", "url": "https://hooks.example.com/grafbase", "events": %s, "secretConfigured": %t}`, events, secretConfigured)
+}
+
+func TestWebhookResourceCRUD(t *testing.T) {
+	r, server := newMockResource(t, NewWebhookResource)
+
+	server.Handle("CreateWebhook", `{"webhookCreate": {"__typename": "WebhookCreateSuccess", "webhook": `+testWebhookJSON(`["DEPLOYMENT_FINISHED"]`, true)+`}}`)
+	server.Handle("GetWebhook", `{"node": `+testWebhookJSON(`["DEPLOYMENT_FINISHED"]`, true)+`}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("my-graph"),
+		"url":          types.StringValue("https://hooks.example.com/grafbase"),
+		"secret":       types.StringValue("0123456789abcdef"),
+		"events":       types.SetValueMust(types.StringType, []attr.Value{types.StringValue("DEPLOYMENT_FINISHED")}),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "id"); got != "webhook-1" {
+		t.Errorf("expected id webhook-1, got %q", got)
+	}
+	if got := server.LastRequest("CreateWebhook").Variables["input"].(map[string]interface{})["secret"]; got != "0123456789abcdef" {
+		t.Errorf("expected secret to be sent, got %v", got)
+	}
+	if got := stateString(t, state, "signing_secret"); got != "0123456789abcdef" {
+		t.Errorf("expected payloads to be signed with the configured secret, got %q", got)
+	}
+
+	// The secret is never returned, so it is kept from state
+	server.Handle("GetWebhook", `{"node": `+testWebhookJSON(`["DEPLOYMENT_FINISHED"]`, true)+`}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "secret"); got != "0123456789abcdef" {
+		t.Errorf("expected secret to be kept, got %q", got)
+	}
+
+	// A secret removed outside of Terraform is cleared, so the next plan sets it again
+	server.Handle("GetWebhook", `{"node": `+testWebhookJSON(`["DEPLOYMENT_FINISHED"]`, false)+`}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "secret"); got != "" {
+		t.Errorf("expected secret to be cleared, got %q", got)
+	}
+
+	server.Handle("UpdateWebhook", `{"webhookUpdate": {"__typename": "WebhookUpdateSuccess", "webhook": `+testWebhookJSON(`["DEPLOYMENT_FINISHED", "SCHEMA_CHECK_FAILED"]`, true)+`}}`)
+	state, diags = updateResource(t, r, state, map[string]attr.Value{
+		"secret": types.StringValue("0123456789abcdef"),
+		"events": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("DEPLOYMENT_FINISHED"), types.StringValue("SCHEMA_CHECK_FAILED")}),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := server.LastRequest("UpdateWebhook").Variables["input"].(map[string]interface{})["id"]; got != "webhook-1" {
+		t.Errorf("expected webhook-1 to be updated, got %v", got)
+	}
+
+	server.Handle("DeleteWebhook", `{"webhookDelete": {"__typename": "WebhookDeleteSuccess"}}`)
+	requireNoDiagnostics(t, deleteResource(t, r, state))
+
+	server.Handle("GetWebhook", `{"node": null}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if !state.Raw.IsNull() {
+		t.Error("expected webhook to be removed from state")
+	}
+}
+
+func TestWebhookResourceSigningSecret(t *testing.T) {
+	r, server := newMockResource(t, NewWebhookResource)
+
+	// Without a secret, Grafbase generates one
+	server.Handle("CreateWebhook", `{"webhookCreate": {"__typename": "WebhookCreateSuccess", "webhook": `+testWebhookJSON(`["DEPLOYMENT_FINISHED"]`, true)+`, "signingSecret": "whsec_generated"}}`)
+	server.Handle("GetWebhook", `{"node": `+testWebhookJSON(`["DEPLOYMENT_FINISHED"]`, true)+`}`)
+	state, diags := createResource(t, r, map[string]attr.Value{
+		"account_slug": types.StringValue("my-account"),
+		"graph_slug":   types.StringValue("my-graph"),
+		"url":          types.StringValue("https://hooks.example.com/grafbase"),
+		"events":       types.SetValueMust(types.StringType, []attr.Value{types.StringValue("DEPLOYMENT_FINISHED")}),
+	})
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "signing_secret"); got != "whsec_generated" {
+		t.Errorf("expected the generated signing secret, got %q", got)
+	}
+	if secret := server.LastRequest("CreateWebhook").Variables["input"].(map[string]interface{})["secret"]; secret != nil {
+		t.Errorf("expected no secret to be sent, got %v", secret)
+	}
+
+	// Updates keep signing with the generated secret
+	events := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("DEPLOYMENT_FINISHED"), types.StringValue("SCHEMA_CHECK_FAILED")})
+	plan, replace, diags := planResourceUpdate(t, r, state, map[string]attr.Value{"events": events})
+	requireNoDiagnostics(t, diags)
+	if len(replace) != 0 {
+		t.Errorf("expected an update in place, got replacement for %v", replace)
+	}
+	server.Handle("UpdateWebhook", `{"webhookUpdate": {"__typename": "WebhookUpdateSuccess", "webhook": `+testWebhookJSON(`["DEPLOYMENT_FINISHED", "SCHEMA_CHECK_FAILED"]`, true)+`}}`)
+	state, diags = applyResourceUpdate(t, r, state, plan)
+	requireNoDiagnostics(t, diags)
+	if got := server.LastRequest("UpdateWebhook").Variables["input"].(map[string]interface{})["secret"]; got != "whsec_generated" {
+		t.Errorf("expected the generated secret to be sent back, got %v", got)
+	}
+	if got := stateString(t, state, "signing_secret"); got != "whsec_generated" {
+		t.Errorf("expected the generated signing secret to be kept, got %q", got)
+	}
+
+	// A configured secret replaces the generated one in place
+	plan, replace, diags = planResourceUpdate(t, r, state, map[string]attr.Value{"secret": types.StringValue("0123456789abcdef")})
+	requireNoDiagnostics(t, diags)
+	if len(replace) != 0 {
+		t.Errorf("expected an update in place, got replacement for %v", replace)
+	}
+	state, diags = applyResourceUpdate(t, r, state, plan)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "signing_secret"); got != "0123456789abcdef" {
+		t.Errorf("expected payloads to be signed with the configured secret, got %q", got)
+	}
+
+	// Removing the configured secret replaces the webhook to generate a new one
+	_, replace, diags = planResourceUpdate(t, r, state, map[string]attr.Value{"secret": types.StringNull()})
+	requireNoDiagnostics(t, diags)
+	if !replace.Contains(path.Root("secret")) {
+		t.Errorf("expected removing the secret to replace the webhook, got %v", replace)
+	}
+
+	// So does a generated secret removed outside of Terraform
+	state, diags = updateResource(t, r, state, map[string]attr.Value{"secret": types.StringNull(), "signing_secret": types.StringValue("whsec_generated")})
+	requireNoDiagnostics(t, diags)
+	server.Handle("GetWebhook", `{"node": `+testWebhookJSON(`["DEPLOYMENT_FINISHED", "SCHEMA_CHECK_FAILED"]`, false)+`}`)
+	state, diags = readResource(t, r, state)
+	requireNoDiagnostics(t, diags)
+	if got := stateString(t, state, "signing_secret"); got != "" {
+		t.Errorf("expected the signing secret to be cleared, got %q", got)
+	}
+	_, replace, diags = planResourceUpdate(t, r, state, nil)
+	requireNoDiagnostics(t, diags)
+	if !replace.Contains(path.Root("secret")) {
+		t.Errorf("expected a webhook without a secret to be replaced, got %v", replace)
+	}
+}
+
+func TestWebhookResourceImport(t *testing.T) {
+	r, server := newMockResource(t, NewWebhookResource)
+
+	server.Handle("GetWebhook", `{"node": `+testWebhookJSON(`["COMPOSITION_FAILED"]`, true)+`}`)
+	state, diags := importResource(t, r, "my-account/my-graph/webhook-1")
+	requireNoDiagnostics(t, diags)
+	requireImportedAttributes(t, state)
+
+	// The imported webhook keeps signing with its secret instead of being replaced
+	events := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("COMPOSITION_FAILED"), types.StringValue("DEPLOYMENT_FINISHED")})
+	plan, replace, diags := planResourceUpdate(t, r, state, map[string]attr.Value{"events": events})
+	requireNoDiagnostics(t, diags)
+	if len(replace) != 0 {
+		t.Errorf("expected an imported webhook to be updated in place, got replacement for %v", replace)
+	}
+	var signingSecret types.String
+	requireNoDiagnostics(t, plan.GetAttribute(context.Background(), path.Root("signing_secret"), &signingSecret))
+	if !signingSecret.IsNull() {
+		t.Errorf("expected the unknown signing secret to stay null, got %v", signingSecret)
+	}
+	server.Handle("UpdateWebhook", `{"webhookUpdate": {"__typename": "WebhookUpdateSuccess", "webhook": `+testWebhookJSON(`["COMPOSITION_FAILED", "DEPLOYMENT_FINISHED"]`, true)+`}}`)
+	_, diags = applyResourceUpdate(t, r, state, plan)
+	requireNoDiagnostics(t, diags)
+	if secret := server.LastRequest("UpdateWebhook").Variables["input"].(map[string]interface{})["secret"]; secret != nil {
+		t.Errorf("expected the secret of the imported webhook to be kept, got %v", secret)
+	}
+
+	if _, diags := importResource(t, r, "webhook-1"); !diags.HasError() {
+		t.Error("expected invalid import ID to be an error")
+	}
+}
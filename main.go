@@ -4,7 +4,9 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
+	"github.com/grafbase/terraform-provider-grafbase/internal/client"
 	"github.com/grafbase/terraform-provider-grafbase/internal/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
@@ -31,7 +33,23 @@ func main() {
 		Debug:   debug,
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	ctx := context.Background()
+
+	// Tracing is optional, so a broken OTEL_* configuration must not stop the provider
+	shutdownTracing, err := client.SetupTracing(ctx)
+	if err != nil {
+		log.Printf("[WARN] OpenTelemetry tracing disabled: %s", err)
+		shutdownTracing = func(context.Context) error { return nil }
+	}
+
+	err = providerserver.Serve(ctx, provider.New(version), opts)
+
+	// Flush the spans of the run before exiting, without hanging on an unreachable collector
+	shutdownCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	if shutdownErr := shutdownTracing(shutdownCtx); shutdownErr != nil {
+		log.Printf("[WARN] Unable to export OpenTelemetry spans: %s", shutdownErr)
+	}
+	cancel()
 
 	if err != nil {
 		log.Fatal(err.Error())